@@ -31,8 +31,11 @@ func newOperatorCmds() *cobra.Command {
 		newRegisterCmd(),
 		newInitCmd(),
 		newCreateValCmd(),
+		newEditValCmd(),
 		newCreateKeyCmd(),
 		newUnjailCmd(),
+		newRewardsCmd(),
+		newStatusCmd(),
 	)
 
 	return cmd
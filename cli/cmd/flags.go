@@ -15,6 +15,8 @@ const (
 	flagRPCURL         = "rpc-url"
 	flagAddress        = "address"
 	flagType           = "type"
+	flagCommissionRate = "commission-rate"
+	flagMinSelfDel     = "min-self-delegation"
 )
 
 func bindRegConfig(cmd *cobra.Command, cfg *RegConfig) {
@@ -61,6 +63,13 @@ func bindRPCURL(cmd *cobra.Command, rpcURL *string) {
 	_ = cmd.MarkFlagRequired(flagRPCURL)
 }
 
+func bindStatusConfig(cmd *cobra.Command, cfg *statusConfig) {
+	netconf.BindFlag(cmd.Flags(), &cfg.Network)
+	cmd.Flags().StringVar(&cfg.Operator, flagOperator, cfg.Operator, "Operator address of the validator")
+	_ = cmd.MarkFlagRequired(flagOperator)
+	_ = cmd.MarkFlagRequired("network")
+}
+
 func bindUnjailConfig(cmd *cobra.Command, cfg *unjailConfig) {
 	netconf.BindFlag(cmd.Flags(), &cfg.Network)
 	bindPrivateKeyFile(cmd, &cfg.PrivateKeyFile)
@@ -79,6 +88,29 @@ func bindCreateValConfig(cmd *cobra.Command, cfg *createValConfig) {
 	_ = cmd.MarkFlagRequired("network")
 }
 
+func bindEditValConfig(cmd *cobra.Command, cfg *editValConfig) {
+	netconf.BindFlag(cmd.Flags(), &cfg.Network)
+	bindPrivateKeyFile(cmd, &cfg.PrivateKeyFile)
+
+	cfg.CommissionRatePercentage = -1
+	cmd.Flags().StringVar(&cfg.Moniker, "moniker", cfg.Moniker, "Optional new validator name")
+	cmd.Flags().StringVar(&cfg.Identity, "identity", cfg.Identity, "Optional new identity signature (ex. UPort or Keybase)")
+	cmd.Flags().StringVar(&cfg.Website, "website", cfg.Website, "Optional new website link")
+	cmd.Flags().StringVar(&cfg.SecurityContact, "security-contact", cfg.SecurityContact, "Optional new security contact email")
+	cmd.Flags().StringVar(&cfg.Details, "details", cfg.Details, "Optional new details")
+	cmd.Flags().Int32Var(&cfg.CommissionRatePercentage, flagCommissionRate, cfg.CommissionRatePercentage, "Optional new commission rate percentage [0-100], -1 leaves it unchanged")
+	cmd.Flags().Uint64Var(&cfg.MinSelfDelegation, flagMinSelfDel, cfg.MinSelfDelegation, "Optional new minimum self-delegation in OMNI, 0 leaves it unchanged")
+
+	_ = cmd.MarkFlagRequired("network")
+}
+
+func bindRewardsConfig(cmd *cobra.Command, cfg *rewardsConfig) {
+	netconf.BindFlag(cmd.Flags(), &cfg.Network)
+	bindPrivateKeyFile(cmd, &cfg.PrivateKeyFile)
+	cmd.Flags().BoolVar(&cfg.Claim, "claim", cfg.Claim, "Claim the operator's rewards and commission to the operator address")
+	_ = cmd.MarkFlagRequired("network")
+}
+
 func bindPrivateKeyFile(cmd *cobra.Command, privateKeyFile *string) {
 	cmd.Flags().StringVar(privateKeyFile, flagPrivateKeyFile, *privateKeyFile, "Path to the private key file")
 	_ = cmd.MarkFlagRequired(flagPrivateKeyFile)
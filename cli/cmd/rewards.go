@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/halo/genutil/evm/predeploys"
+	"github.com/omni-network/omni/lib/cchain/provider"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/ethclient/ethbackend"
+	"github.com/omni-network/omni/lib/evmchain"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/spf13/cobra"
+)
+
+func newRewardsCmd() *cobra.Command {
+	var cfg rewardsConfig
+
+	cmd := &cobra.Command{
+		Use:   "rewards",
+		Short: "Show and claim validator rewards",
+		Long: "Show the outstanding rewards of the operator's validator. " +
+			"If --claim is set, sign and broadcast a claim transaction that withdraws the operator's " +
+			"delegation rewards and validator commission to the operator address on the Omni EVM. " +
+			"This transaction must be sent by the operator address and costs 0.1 OMNI.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cfg.Verify(); err != nil {
+				return errors.Wrap(err, "verify flags")
+			}
+
+			err := rewards(cmd.Context(), cfg)
+			if err != nil {
+				return errors.Wrap(err, "rewards")
+			}
+
+			return nil
+		},
+	}
+
+	bindRewardsConfig(cmd, &cfg)
+
+	return cmd
+}
+
+type rewardsConfig struct {
+	Network        netconf.ID
+	PrivateKeyFile string
+	Claim          bool
+}
+
+func (c rewardsConfig) Verify() error {
+	if c.PrivateKeyFile == "" {
+		return errors.New("required flag --private-key-file not set")
+	}
+
+	if err := c.Network.Verify(); err != nil {
+		return errors.Wrap(err, "verify --network flag")
+	}
+
+	return nil
+}
+
+func rewards(ctx context.Context, cfg rewardsConfig) error {
+	opPrivKey, err := crypto.LoadECDSA(cfg.PrivateKeyFile)
+	if err != nil {
+		return errors.Wrap(err, "load private key")
+	}
+	opAddr := crypto.PubkeyToAddress(opPrivKey.PublicKey)
+
+	cprov, err := provider.Dial(cfg.Network)
+	if err != nil {
+		return err
+	}
+
+	if _, ok, err := cprov.Validator(ctx, opAddr); err != nil {
+		return err
+	} else if !ok {
+		return &CliError{
+			Msg:     "Operator address not a validator: " + opAddr.Hex(),
+			Suggest: "Ensure operator address is a validator",
+		}
+	}
+
+	outstanding, ok, err := cprov.Rewards(ctx, opAddr)
+	if err != nil {
+		return err
+	} else if !ok {
+		outstanding = 0
+	}
+
+	log.Info(ctx, "Validator outstanding rewards (all delegators, including commission)",
+		"operator", opAddr.Hex(),
+		"rewards", fmt.Sprintf("%.6f OMNI", outstanding/params.Ether),
+	)
+
+	if !cfg.Claim {
+		return nil
+	}
+
+	chainID := cfg.Network.Static().OmniExecutionChainID
+	chainMeta, ok := evmchain.MetadataByID(chainID)
+	if !ok {
+		return errors.New("chain metadata not found")
+	}
+
+	ethCl, err := ethclient.Dial(chainMeta.Name, cfg.Network.Static().ExecutionRPC())
+	if err != nil {
+		return err
+	}
+
+	backend, err := ethbackend.NewBackend(chainMeta.Name, chainID, chainMeta.BlockPeriod, ethCl, opPrivKey)
+	if err != nil {
+		return err
+	}
+
+	contract, err := bindings.NewDistribution(common.HexToAddress(predeploys.Distribution), backend)
+	if err != nil {
+		return err
+	}
+
+	fee, err := contract.Fee(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return err
+	}
+
+	txOpts, err := backend.BindOpts(ctx, opAddr)
+	if err != nil {
+		return err
+	}
+
+	txOpts.Value = fee
+	tx, err := contract.Claim(txOpts, opAddr)
+	if err != nil {
+		return errors.Wrap(err, "claim rewards")
+	}
+
+	rec, err := backend.WaitMined(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "wait mined")
+	}
+
+	link := fmt.Sprintf("https://%s.omniscan.network/tx/%s", cfg.Network, rec.TxHash.Hex())
+	log.Info(ctx, "🎉 Claim transaction sent and included on-chain, rewards will be withdrawn to the operator address shortly",
+		"link", link, "block", rec.BlockNumber.Uint64())
+
+	return nil
+}
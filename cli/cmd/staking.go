@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/omni-network/omni/contracts/bindings"
 	"github.com/omni-network/omni/halo/genutil/evm/predeploys"
@@ -23,6 +24,8 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 
+	"cosmossdk.io/math"
+	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/spf13/cobra"
 )
 
@@ -132,7 +135,7 @@ func createValidator(ctx context.Context, cfg createValConfig) error {
 	} else if ok {
 		return &CliError{
 			Msg:     "Operator address already a validator: " + opAddr.Hex(),
-			Suggest: "Ensure correct operator address",
+			Suggest: "Ensure correct operator address, note that consensus public key rotation is not supported",
 		}
 	}
 
@@ -260,7 +263,8 @@ func unjailValidator(ctx context.Context, cfg unjailConfig) error {
 		return err
 	}
 
-	if val, ok, err := cprov.Validator(ctx, opAddr); err != nil {
+	val, ok, err := cprov.Validator(ctx, opAddr)
+	if err != nil {
 		return err
 	} else if !ok {
 		return &CliError{
@@ -274,6 +278,20 @@ func unjailValidator(ctx context.Context, cfg unjailConfig) error {
 		}
 	}
 
+	consAddr, err := consAddress(val)
+	if err != nil {
+		return err
+	}
+
+	if slashing, ok, err := cprov.SlashingStatus(ctx, consAddr); err != nil {
+		return err
+	} else if msg, unjail := unjailable(slashing, time.Now()); ok && !unjail {
+		return &CliError{
+			Msg:     "Validator cannot be unjailed yet: " + msg,
+			Suggest: "See `omni operator status` for the reason and duration of the jailing",
+		}
+	}
+
 	backend, err := ethbackend.NewBackend(chainMeta.Name, chainID, chainMeta.BlockPeriod, ethCl, opPrivKey)
 	if err != nil {
 		return err
@@ -310,3 +328,215 @@ func unjailValidator(ctx context.Context, cfg unjailConfig) error {
 
 	return nil
 }
+
+func newEditValCmd() *cobra.Command {
+	var cfg editValConfig
+
+	cmd := &cobra.Command{
+		Use:   "edit-validator",
+		Short: "Edit an existing validator",
+		Long: "Sign and broadcast an edit-validator transaction that edits the description, commission rate " +
+			"or minimum self-delegation of an existing validator. Unset flags are left unchanged. " +
+			"Note that the consensus public key cannot be changed.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cfg.Verify(); err != nil {
+				return errors.Wrap(err, "verify flags")
+			}
+
+			err := editValidator(cmd.Context(), cfg)
+			if err != nil {
+				return errors.Wrap(err, "edit-validator")
+			}
+
+			return nil
+		},
+	}
+
+	bindEditValConfig(cmd, &cfg)
+
+	return cmd
+}
+
+type editValConfig struct {
+	Network                  netconf.ID
+	PrivateKeyFile           string
+	Moniker                  string
+	Identity                 string
+	Website                  string
+	SecurityContact          string
+	Details                  string
+	CommissionRatePercentage int32  // -1 leaves it unchanged
+	MinSelfDelegation        uint64 // 0 leaves it unchanged
+}
+
+func (c editValConfig) Verify() error {
+	if c.PrivateKeyFile == "" {
+		return errors.New("required flag --private-key-file not set")
+	}
+
+	if err := c.Network.Verify(); err != nil {
+		return errors.Wrap(err, "verify --network flag")
+	}
+
+	if c.CommissionRatePercentage < -1 || c.CommissionRatePercentage > 100 {
+		return errors.New("invalid --commission-rate, must be between 0 and 100", "commission_rate", c.CommissionRatePercentage)
+	}
+
+	desc := stypes.Description{
+		Moniker:         c.Moniker,
+		Identity:        c.Identity,
+		Website:         c.Website,
+		SecurityContact: c.SecurityContact,
+		Details:         c.Details,
+	}
+	if _, err := desc.EnsureLength(); err != nil {
+		return errors.Wrap(err, "verify description flags")
+	}
+
+	if desc == (stypes.Description{}) && c.CommissionRatePercentage < 0 && c.MinSelfDelegation == 0 {
+		return errors.New("nothing to edit, set at least one of the edit flags")
+	}
+
+	return nil
+}
+
+// Params returns the Staking predeploy EditValidator params.
+func (c editValConfig) Params() bindings.StakingEditValidatorParams {
+	minSelfDelegation := big.NewInt(-1)
+	if c.MinSelfDelegation > 0 {
+		minSelfDelegation = new(big.Int).Mul(new(big.Int).SetUint64(c.MinSelfDelegation), big.NewInt(params.Ether))
+	}
+
+	return bindings.StakingEditValidatorParams{
+		Moniker:                  c.Moniker,
+		Identity:                 c.Identity,
+		Website:                  c.Website,
+		SecurityContact:          c.SecurityContact,
+		Details:                  c.Details,
+		CommissionRatePercentage: c.CommissionRatePercentage,
+		MinSelfDelegation:        minSelfDelegation,
+	}
+}
+
+func editValidator(ctx context.Context, cfg editValConfig) error {
+	opPrivKey, err := crypto.LoadECDSA(cfg.PrivateKeyFile)
+	if err != nil {
+		return errors.Wrap(err, "load private key")
+	}
+	opAddr := crypto.PubkeyToAddress(opPrivKey.PublicKey)
+
+	chainID := cfg.Network.Static().OmniExecutionChainID
+	chainMeta, ok := evmchain.MetadataByID(chainID)
+	if !ok {
+		return errors.New("chain metadata not found")
+	}
+
+	ethCl, err := ethclient.Dial(chainMeta.Name, cfg.Network.Static().ExecutionRPC())
+	if err != nil {
+		return err
+	}
+
+	cprov, err := provider.Dial(cfg.Network)
+	if err != nil {
+		return err
+	}
+
+	val, ok, err := cprov.Validator(ctx, opAddr)
+	if err != nil {
+		return err
+	} else if !ok {
+		return &CliError{
+			Msg:     "Operator address not a validator: " + opAddr.Hex(),
+			Suggest: "Ensure operator address is a validator",
+		}
+	}
+
+	if err := verifyValidatorEdit(val, cfg, time.Now()); err != nil {
+		return err
+	}
+
+	backend, err := ethbackend.NewBackend(chainMeta.Name, chainID, chainMeta.BlockPeriod, ethCl, opPrivKey)
+	if err != nil {
+		return err
+	}
+
+	contract, err := bindings.NewStaking(common.HexToAddress(predeploys.Staking), backend)
+	if err != nil {
+		return err
+	}
+
+	fee, err := contract.Fee(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return err
+	}
+
+	txOpts, err := backend.BindOpts(ctx, opAddr)
+	if err != nil {
+		return err
+	}
+
+	txOpts.Value = fee
+	tx, err := contract.EditValidator(txOpts, cfg.Params())
+	if err != nil {
+		return errors.Wrap(err, "edit validator")
+	}
+
+	rec, err := backend.WaitMined(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "wait mined")
+	}
+
+	link := fmt.Sprintf("https://%s.omniscan.network/tx/%s", cfg.Network, rec.TxHash.Hex())
+	log.Info(ctx, "🎉 Edit-validator transaction sent and included on-chain", "link", link, "block", rec.BlockNumber.Uint64())
+
+	return nil
+}
+
+// verifyValidatorEdit returns a CliError if the edit will be rejected by the consensus chain.
+// This avoids sending transactions of edits that fail asynchronously on the consensus chain.
+func verifyValidatorEdit(val stypes.Validator, cfg editValConfig, now time.Time) error {
+	if pct := cfg.CommissionRatePercentage; pct >= 0 {
+		rate := math.LegacyNewDecWithPrec(int64(pct), 2)
+		commission := val.Commission
+		if rate.GT(commission.MaxRate) {
+			return &CliError{
+				Msg:     fmt.Sprintf("Commission rate exceeds validator max rate=%s", commission.MaxRate),
+				Suggest: "Ensure --commission-rate is less than or equal to the max rate",
+			}
+		}
+
+		if now.Sub(commission.UpdateTime) < 24*time.Hour {
+			return &CliError{
+				Msg:     "Commission rate already changed in the last 24h at " + commission.UpdateTime.String(),
+				Suggest: "Wait at least 24h after the previous commission rate change",
+			}
+		}
+
+		if rate.Sub(commission.Rate).Abs().GT(commission.MaxChangeRate) {
+			return &CliError{
+				Msg:     fmt.Sprintf("Commission rate change exceeds validator max change rate=%s, current rate=%s", commission.MaxChangeRate, commission.Rate),
+				Suggest: "Change the commission rate in smaller daily increments",
+			}
+		}
+	}
+
+	if cfg.MinSelfDelegation > 0 {
+		minSelfDelegation := math.NewIntFromBigInt(cfg.Params().MinSelfDelegation)
+		if !minSelfDelegation.GT(val.MinSelfDelegation) {
+			return &CliError{
+				Msg:     "Minimum self-delegation cannot be decreased, current=" + val.MinSelfDelegation.String(),
+				Suggest: "Ensure --min-self-delegation is greater than the current minimum self-delegation",
+			}
+		}
+
+		if minSelfDelegation.GT(val.Tokens) {
+			return &CliError{
+				Msg:     "Minimum self-delegation exceeds validator self-delegation=" + val.Tokens.String(),
+				Suggest: "Ensure --min-self-delegation is less than or equal to the validator self-delegation",
+			}
+		}
+	}
+
+	return nil
+}
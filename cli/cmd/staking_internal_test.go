@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/params"
+
+	"cosmossdk.io/math"
+	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyValidatorEdit(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	ether := func(amount int64) math.Int {
+		return math.NewInt(amount).MulRaw(params.Ether)
+	}
+
+	// The validator has a 5% commission rate (max 20%, max change 1%) last changed 2 days ago,
+	// a minimum self-delegation of 10 ether and 100 ether tokens.
+	val := stypes.Validator{
+		Tokens:            ether(100),
+		MinSelfDelegation: ether(10),
+		Commission: stypes.NewCommissionWithTime(
+			math.LegacyNewDecWithPrec(5, 2),
+			math.LegacyNewDecWithPrec(20, 2),
+			math.LegacyNewDecWithPrec(1, 2),
+			now.Add(-48*time.Hour),
+		),
+	}
+
+	tests := []struct {
+		Name   string
+		Cfg    editValConfig
+		Now    time.Time
+		ErrMsg string
+	}{
+		{
+			Name: "description only",
+			Cfg:  editValConfig{Moniker: "moniker", CommissionRatePercentage: -1},
+		},
+		{
+			Name: "valid commission rate",
+			Cfg:  editValConfig{CommissionRatePercentage: 6},
+		},
+		{
+			Name:   "commission rate exceeds max rate",
+			Cfg:    editValConfig{CommissionRatePercentage: 21},
+			ErrMsg: "Commission rate exceeds validator max rate",
+		},
+		{
+			Name:   "commission rate changed in last 24h",
+			Cfg:    editValConfig{CommissionRatePercentage: 6},
+			Now:    now.Add(-25 * time.Hour),
+			ErrMsg: "Commission rate already changed in the last 24h",
+		},
+		{
+			Name:   "commission rate change exceeds max change rate",
+			Cfg:    editValConfig{CommissionRatePercentage: 7},
+			ErrMsg: "Commission rate change exceeds validator max change rate",
+		},
+		{
+			Name: "valid min self-delegation",
+			Cfg:  editValConfig{CommissionRatePercentage: -1, MinSelfDelegation: 11},
+		},
+		{
+			Name:   "min self-delegation decreased",
+			Cfg:    editValConfig{CommissionRatePercentage: -1, MinSelfDelegation: 10},
+			ErrMsg: "Minimum self-delegation cannot be decreased",
+		},
+		{
+			Name:   "min self-delegation exceeds self-delegation",
+			Cfg:    editValConfig{CommissionRatePercentage: -1, MinSelfDelegation: 101},
+			ErrMsg: "Minimum self-delegation exceeds validator self-delegation",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+
+			at := now
+			if !test.Now.IsZero() {
+				at = test.Now
+			}
+
+			err := verifyValidatorEdit(val, test.Cfg, at)
+			if test.ErrMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			cliErr := new(CliError)
+			require.ErrorAs(t, err, &cliErr)
+			require.Contains(t, cliErr.Msg, test.ErrMsg)
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	atypes "github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/cchain/provider"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/spf13/cobra"
+)
+
+func newStatusCmd() *cobra.Command {
+	var cfg statusConfig
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show validator slashing and jail status",
+		Long: "Show the status of the operator's validator, including its consensus signing info, " +
+			"missed blocks, the reason and duration of its latest jailing, whether it is tombstoned, " +
+			"and the current slashing parameters.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cfg.Verify(); err != nil {
+				return errors.Wrap(err, "verify flags")
+			}
+
+			err := status(cmd.Context(), cfg)
+			if err != nil {
+				return errors.Wrap(err, "status")
+			}
+
+			return nil
+		},
+	}
+
+	bindStatusConfig(cmd, &cfg)
+
+	return cmd
+}
+
+type statusConfig struct {
+	Network  netconf.ID
+	Operator string
+}
+
+func (c statusConfig) Verify() error {
+	if !common.IsHexAddress(c.Operator) {
+		return errors.New("invalid --operator address", "address", c.Operator)
+	}
+
+	if err := c.Network.Verify(); err != nil {
+		return errors.Wrap(err, "verify --network flag")
+	}
+
+	return nil
+}
+
+func status(ctx context.Context, cfg statusConfig) error {
+	opAddr := common.HexToAddress(cfg.Operator)
+
+	cprov, err := provider.Dial(cfg.Network)
+	if err != nil {
+		return err
+	}
+
+	val, ok, err := cprov.Validator(ctx, opAddr)
+	if err != nil {
+		return err
+	} else if !ok {
+		return &CliError{
+			Msg:     "Operator address not a validator: " + opAddr.Hex(),
+			Suggest: "Ensure operator address is a validator",
+		}
+	}
+
+	consAddr, err := consAddress(val)
+	if err != nil {
+		return err
+	}
+
+	slashing, ok, err := cprov.SlashingStatus(ctx, consAddr)
+	if err != nil {
+		return err
+	} else if !ok {
+		return &CliError{
+			Msg:     "Validator signing info not found: " + opAddr.Hex(),
+			Suggest: "Signing info is created once the validator is bonded for the first time",
+		}
+	}
+
+	log.Info(ctx, "Validator",
+		"operator", opAddr.Hex(),
+		"consensus_address", consAddr.String(),
+		"status", val.GetStatus().String(),
+		"jailed", val.IsJailed(),
+		"tokens", fmt.Sprintf("%.6f OMNI", val.Tokens.ToLegacyDec().MustFloat64()/params.Ether),
+	)
+
+	info := slashing.SigningInfo
+	log.Info(ctx, "Signing info",
+		"start_height", info.StartHeight,
+		"missed_blocks", info.MissedBlocksCounter,
+		"max_missed_blocks", maxMissedBlocks(slashing),
+		"signed_blocks_window", slashing.Params.SignedBlocksWindow,
+		"tombstoned", info.Tombstoned,
+	)
+
+	jail := slashing.Jail
+	if jail.Reason != atypes.JailReason_JAIL_REASON_UNSPECIFIED {
+		attrs := []any{"reason", jailReason(jail.Reason), "jailed_until", jail.JailedUntil.Format(time.RFC3339)}
+		if jail.Height != 0 {
+			attrs = append(attrs, "height", jail.Height)
+		}
+		log.Info(ctx, "Latest jailing", attrs...)
+	}
+
+	log.Info(ctx, "Slashing params",
+		"signed_blocks_window", slashing.Params.SignedBlocksWindow,
+		"min_signed_per_window", slashing.Params.MinSignedPerWindow.String(),
+		"downtime_jail_duration", slashing.Params.DowntimeJailDuration,
+		"slash_fraction_downtime", slashing.Params.SlashFractionDowntime.String(),
+		"slash_fraction_double_sign", slashing.Params.SlashFractionDoubleSign.String(),
+	)
+
+	if !val.IsJailed() {
+		return nil
+	}
+
+	if msg, ok := unjailable(slashing, time.Now()); !ok {
+		log.Warn(ctx, "🚨 Validator jailed, cannot unjail yet", nil, "cause", msg)
+	} else {
+		log.Info(ctx, "🔓 Validator jailed, unjail it with `omni operator unjail`")
+	}
+
+	return nil
+}
+
+// unjailable returns true if the jailed validator can be unjailed at the provided time,
+// or a message explaining why not.
+func unjailable(slashing cchain.SlashingStatus, now time.Time) (string, bool) {
+	if slashing.SigningInfo.Tombstoned {
+		return "validator is tombstoned and can never be unjailed", false
+	} else if until := slashing.SigningInfo.JailedUntil; now.Before(until) {
+		return fmt.Sprintf("jailed until %s (%s remaining)", until.Format(time.RFC3339), until.Sub(now).Truncate(time.Second)), false
+	}
+
+	return "", true
+}
+
+// maxMissedBlocks returns the maximum number of blocks the validator can miss in the signed blocks window before being jailed.
+func maxMissedBlocks(slashing cchain.SlashingStatus) int64 {
+	window := slashing.Params.SignedBlocksWindow
+	minSigned := slashing.Params.MinSignedPerWindow.MulInt64(window).RoundInt64()
+
+	return window - minSigned
+}
+
+// jailReason returns a human-readable jail reason.
+func jailReason(reason atypes.JailReason) string {
+	switch reason {
+	case atypes.JailReason_JAIL_REASON_CONSENSUS_DOWNTIME:
+		return "missed too many consensus blocks"
+	case atypes.JailReason_JAIL_REASON_CONSENSUS_DOUBLE_SIGN:
+		return "double signed consensus blocks"
+	case atypes.JailReason_JAIL_REASON_ATTEST_DOWNTIME:
+		return "missed too many attestations"
+	case atypes.JailReason_JAIL_REASON_ATTEST_DOUBLE_SIGN:
+		return "double signed attestations"
+	default:
+		return "unknown"
+	}
+}
+
+// consAddress returns the validator's consensus address.
+// Note that validators returned by cchain.Provider do not have their consensus pubkey unpacked.
+func consAddress(val stypes.Validator) (sdk.ConsAddress, error) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	if err := val.UnpackInterfaces(registry); err != nil {
+		return nil, errors.Wrap(err, "unpack validator")
+	}
+
+	addr, err := val.GetConsAddr()
+	if err != nil {
+		return nil, errors.Wrap(err, "get consensus address")
+	}
+
+	return addr, nil
+}
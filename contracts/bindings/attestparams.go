@@ -0,0 +1,914 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// AttestParamsVoteParams is an auto generated low-level Go binding around an user-defined struct.
+type AttestParamsVoteParams struct {
+	VoteWindow   uint64
+	VoteExtLimit uint64
+	TrimLag      uint64
+	CTrimLag     uint64
+}
+
+// AttestParamsMetaData contains all meta data concerning the AttestParams contract.
+var AttestParamsMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"MaxVoteExtensionEncoding\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"owner_\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setVoteExtensionEncoding\",\"inputs\":[{\"name\":\"encoding\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setVoteParams\",\"inputs\":[{\"name\":\"params\",\"type\":\"tuple\",\"internalType\":\"structAttestParams.VoteParams\",\"components\":[{\"name\":\"voteWindow\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"voteExtLimit\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"trimLag\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"cTrimLag\",\"type\":\"uint64\",\"internalType\":\"uint64\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SetVoteExtensionEncoding\",\"inputs\":[{\"name\":\"encoding\",\"type\":\"uint8\",\"indexed\":false,\"internalType\":\"uint8\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SetVoteParams\",\"inputs\":[{\"name\":\"voteWindow\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"},{\"name\":\"voteExtLimit\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"},{\"name\":\"trimLag\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"},{\"name\":\"cTrimLag\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidInitialization\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitializing\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]}]",
+}
+
+// AttestParamsABI is the input ABI used to generate the binding from.
+// Deprecated: Use AttestParamsMetaData.ABI instead.
+var AttestParamsABI = AttestParamsMetaData.ABI
+
+// AttestParams is an auto generated Go binding around an Ethereum contract.
+type AttestParams struct {
+	AttestParamsCaller     // Read-only binding to the contract
+	AttestParamsTransactor // Write-only binding to the contract
+	AttestParamsFilterer   // Log filterer for contract events
+}
+
+// AttestParamsCaller is an auto generated read-only Go binding around an Ethereum contract.
+type AttestParamsCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AttestParamsTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type AttestParamsTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AttestParamsFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type AttestParamsFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AttestParamsSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type AttestParamsSession struct {
+	Contract     *AttestParams     // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// AttestParamsCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type AttestParamsCallerSession struct {
+	Contract *AttestParamsCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts       // Call options to use throughout this session
+}
+
+// AttestParamsTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type AttestParamsTransactorSession struct {
+	Contract     *AttestParamsTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// AttestParamsRaw is an auto generated low-level Go binding around an Ethereum contract.
+type AttestParamsRaw struct {
+	Contract *AttestParams // Generic contract binding to access the raw methods on
+}
+
+// AttestParamsCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type AttestParamsCallerRaw struct {
+	Contract *AttestParamsCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// AttestParamsTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type AttestParamsTransactorRaw struct {
+	Contract *AttestParamsTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewAttestParams creates a new instance of AttestParams, bound to a specific deployed contract.
+func NewAttestParams(address common.Address, backend bind.ContractBackend) (*AttestParams, error) {
+	contract, err := bindAttestParams(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &AttestParams{AttestParamsCaller: AttestParamsCaller{contract: contract}, AttestParamsTransactor: AttestParamsTransactor{contract: contract}, AttestParamsFilterer: AttestParamsFilterer{contract: contract}}, nil
+}
+
+// NewAttestParamsCaller creates a new read-only instance of AttestParams, bound to a specific deployed contract.
+func NewAttestParamsCaller(address common.Address, caller bind.ContractCaller) (*AttestParamsCaller, error) {
+	contract, err := bindAttestParams(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AttestParamsCaller{contract: contract}, nil
+}
+
+// NewAttestParamsTransactor creates a new write-only instance of AttestParams, bound to a specific deployed contract.
+func NewAttestParamsTransactor(address common.Address, transactor bind.ContractTransactor) (*AttestParamsTransactor, error) {
+	contract, err := bindAttestParams(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AttestParamsTransactor{contract: contract}, nil
+}
+
+// NewAttestParamsFilterer creates a new log filterer instance of AttestParams, bound to a specific deployed contract.
+func NewAttestParamsFilterer(address common.Address, filterer bind.ContractFilterer) (*AttestParamsFilterer, error) {
+	contract, err := bindAttestParams(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &AttestParamsFilterer{contract: contract}, nil
+}
+
+// bindAttestParams binds a generic wrapper to an already deployed contract.
+func bindAttestParams(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := AttestParamsMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AttestParams *AttestParamsRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AttestParams.Contract.AttestParamsCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AttestParams *AttestParamsRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AttestParams.Contract.AttestParamsTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AttestParams *AttestParamsRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AttestParams.Contract.AttestParamsTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AttestParams *AttestParamsCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AttestParams.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AttestParams *AttestParamsTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AttestParams.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AttestParams *AttestParamsTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AttestParams.Contract.contract.Transact(opts, method, params...)
+}
+
+// MaxVoteExtensionEncoding is a free data retrieval call binding the contract method 0x489920ee.
+//
+// Solidity: function MaxVoteExtensionEncoding() view returns(uint8)
+func (_AttestParams *AttestParamsCaller) MaxVoteExtensionEncoding(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _AttestParams.contract.Call(opts, &out, "MaxVoteExtensionEncoding")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// MaxVoteExtensionEncoding is a free data retrieval call binding the contract method 0x489920ee.
+//
+// Solidity: function MaxVoteExtensionEncoding() view returns(uint8)
+func (_AttestParams *AttestParamsSession) MaxVoteExtensionEncoding() (uint8, error) {
+	return _AttestParams.Contract.MaxVoteExtensionEncoding(&_AttestParams.CallOpts)
+}
+
+// MaxVoteExtensionEncoding is a free data retrieval call binding the contract method 0x489920ee.
+//
+// Solidity: function MaxVoteExtensionEncoding() view returns(uint8)
+func (_AttestParams *AttestParamsCallerSession) MaxVoteExtensionEncoding() (uint8, error) {
+	return _AttestParams.Contract.MaxVoteExtensionEncoding(&_AttestParams.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_AttestParams *AttestParamsCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _AttestParams.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_AttestParams *AttestParamsSession) Owner() (common.Address, error) {
+	return _AttestParams.Contract.Owner(&_AttestParams.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_AttestParams *AttestParamsCallerSession) Owner() (common.Address, error) {
+	return _AttestParams.Contract.Owner(&_AttestParams.CallOpts)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc4d66de8.
+//
+// Solidity: function initialize(address owner_) returns()
+func (_AttestParams *AttestParamsTransactor) Initialize(opts *bind.TransactOpts, owner_ common.Address) (*types.Transaction, error) {
+	return _AttestParams.contract.Transact(opts, "initialize", owner_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc4d66de8.
+//
+// Solidity: function initialize(address owner_) returns()
+func (_AttestParams *AttestParamsSession) Initialize(owner_ common.Address) (*types.Transaction, error) {
+	return _AttestParams.Contract.Initialize(&_AttestParams.TransactOpts, owner_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc4d66de8.
+//
+// Solidity: function initialize(address owner_) returns()
+func (_AttestParams *AttestParamsTransactorSession) Initialize(owner_ common.Address) (*types.Transaction, error) {
+	return _AttestParams.Contract.Initialize(&_AttestParams.TransactOpts, owner_)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_AttestParams *AttestParamsTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AttestParams.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_AttestParams *AttestParamsSession) RenounceOwnership() (*types.Transaction, error) {
+	return _AttestParams.Contract.RenounceOwnership(&_AttestParams.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_AttestParams *AttestParamsTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _AttestParams.Contract.RenounceOwnership(&_AttestParams.TransactOpts)
+}
+
+// SetVoteExtensionEncoding is a paid mutator transaction binding the contract method 0x3e206f46.
+//
+// Solidity: function setVoteExtensionEncoding(uint8 encoding) returns()
+func (_AttestParams *AttestParamsTransactor) SetVoteExtensionEncoding(opts *bind.TransactOpts, encoding uint8) (*types.Transaction, error) {
+	return _AttestParams.contract.Transact(opts, "setVoteExtensionEncoding", encoding)
+}
+
+// SetVoteExtensionEncoding is a paid mutator transaction binding the contract method 0x3e206f46.
+//
+// Solidity: function setVoteExtensionEncoding(uint8 encoding) returns()
+func (_AttestParams *AttestParamsSession) SetVoteExtensionEncoding(encoding uint8) (*types.Transaction, error) {
+	return _AttestParams.Contract.SetVoteExtensionEncoding(&_AttestParams.TransactOpts, encoding)
+}
+
+// SetVoteExtensionEncoding is a paid mutator transaction binding the contract method 0x3e206f46.
+//
+// Solidity: function setVoteExtensionEncoding(uint8 encoding) returns()
+func (_AttestParams *AttestParamsTransactorSession) SetVoteExtensionEncoding(encoding uint8) (*types.Transaction, error) {
+	return _AttestParams.Contract.SetVoteExtensionEncoding(&_AttestParams.TransactOpts, encoding)
+}
+
+// SetVoteParams is a paid mutator transaction binding the contract method 0x03aeeb5c.
+//
+// Solidity: function setVoteParams((uint64,uint64,uint64,uint64) params) returns()
+func (_AttestParams *AttestParamsTransactor) SetVoteParams(opts *bind.TransactOpts, params AttestParamsVoteParams) (*types.Transaction, error) {
+	return _AttestParams.contract.Transact(opts, "setVoteParams", params)
+}
+
+// SetVoteParams is a paid mutator transaction binding the contract method 0x03aeeb5c.
+//
+// Solidity: function setVoteParams((uint64,uint64,uint64,uint64) params) returns()
+func (_AttestParams *AttestParamsSession) SetVoteParams(params AttestParamsVoteParams) (*types.Transaction, error) {
+	return _AttestParams.Contract.SetVoteParams(&_AttestParams.TransactOpts, params)
+}
+
+// SetVoteParams is a paid mutator transaction binding the contract method 0x03aeeb5c.
+//
+// Solidity: function setVoteParams((uint64,uint64,uint64,uint64) params) returns()
+func (_AttestParams *AttestParamsTransactorSession) SetVoteParams(params AttestParamsVoteParams) (*types.Transaction, error) {
+	return _AttestParams.Contract.SetVoteParams(&_AttestParams.TransactOpts, params)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_AttestParams *AttestParamsTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _AttestParams.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_AttestParams *AttestParamsSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _AttestParams.Contract.TransferOwnership(&_AttestParams.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_AttestParams *AttestParamsTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _AttestParams.Contract.TransferOwnership(&_AttestParams.TransactOpts, newOwner)
+}
+
+// AttestParamsInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the AttestParams contract.
+type AttestParamsInitializedIterator struct {
+	Event *AttestParamsInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AttestParamsInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AttestParamsInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AttestParamsInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AttestParamsInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AttestParamsInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AttestParamsInitialized represents a Initialized event raised by the AttestParams contract.
+type AttestParamsInitialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_AttestParams *AttestParamsFilterer) FilterInitialized(opts *bind.FilterOpts) (*AttestParamsInitializedIterator, error) {
+
+	logs, sub, err := _AttestParams.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &AttestParamsInitializedIterator{contract: _AttestParams.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_AttestParams *AttestParamsFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *AttestParamsInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _AttestParams.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AttestParamsInitialized)
+				if err := _AttestParams.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_AttestParams *AttestParamsFilterer) ParseInitialized(log types.Log) (*AttestParamsInitialized, error) {
+	event := new(AttestParamsInitialized)
+	if err := _AttestParams.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AttestParamsOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the AttestParams contract.
+type AttestParamsOwnershipTransferredIterator struct {
+	Event *AttestParamsOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AttestParamsOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AttestParamsOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AttestParamsOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AttestParamsOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AttestParamsOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AttestParamsOwnershipTransferred represents a OwnershipTransferred event raised by the AttestParams contract.
+type AttestParamsOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_AttestParams *AttestParamsFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*AttestParamsOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _AttestParams.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AttestParamsOwnershipTransferredIterator{contract: _AttestParams.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_AttestParams *AttestParamsFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *AttestParamsOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _AttestParams.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AttestParamsOwnershipTransferred)
+				if err := _AttestParams.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_AttestParams *AttestParamsFilterer) ParseOwnershipTransferred(log types.Log) (*AttestParamsOwnershipTransferred, error) {
+	event := new(AttestParamsOwnershipTransferred)
+	if err := _AttestParams.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AttestParamsSetVoteExtensionEncodingIterator is returned from FilterSetVoteExtensionEncoding and is used to iterate over the raw logs and unpacked data for SetVoteExtensionEncoding events raised by the AttestParams contract.
+type AttestParamsSetVoteExtensionEncodingIterator struct {
+	Event *AttestParamsSetVoteExtensionEncoding // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AttestParamsSetVoteExtensionEncodingIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AttestParamsSetVoteExtensionEncoding)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AttestParamsSetVoteExtensionEncoding)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AttestParamsSetVoteExtensionEncodingIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AttestParamsSetVoteExtensionEncodingIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AttestParamsSetVoteExtensionEncoding represents a SetVoteExtensionEncoding event raised by the AttestParams contract.
+type AttestParamsSetVoteExtensionEncoding struct {
+	Encoding uint8
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetVoteExtensionEncoding is a free log retrieval operation binding the contract event 0x8333dace5d2f6228d987ffdc535c40ca1d8ec4cd823ea15c16e9e6cf95a22dfb.
+//
+// Solidity: event SetVoteExtensionEncoding(uint8 encoding)
+func (_AttestParams *AttestParamsFilterer) FilterSetVoteExtensionEncoding(opts *bind.FilterOpts) (*AttestParamsSetVoteExtensionEncodingIterator, error) {
+
+	logs, sub, err := _AttestParams.contract.FilterLogs(opts, "SetVoteExtensionEncoding")
+	if err != nil {
+		return nil, err
+	}
+	return &AttestParamsSetVoteExtensionEncodingIterator{contract: _AttestParams.contract, event: "SetVoteExtensionEncoding", logs: logs, sub: sub}, nil
+}
+
+// WatchSetVoteExtensionEncoding is a free log subscription operation binding the contract event 0x8333dace5d2f6228d987ffdc535c40ca1d8ec4cd823ea15c16e9e6cf95a22dfb.
+//
+// Solidity: event SetVoteExtensionEncoding(uint8 encoding)
+func (_AttestParams *AttestParamsFilterer) WatchSetVoteExtensionEncoding(opts *bind.WatchOpts, sink chan<- *AttestParamsSetVoteExtensionEncoding) (event.Subscription, error) {
+
+	logs, sub, err := _AttestParams.contract.WatchLogs(opts, "SetVoteExtensionEncoding")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AttestParamsSetVoteExtensionEncoding)
+				if err := _AttestParams.contract.UnpackLog(event, "SetVoteExtensionEncoding", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetVoteExtensionEncoding is a log parse operation binding the contract event 0x8333dace5d2f6228d987ffdc535c40ca1d8ec4cd823ea15c16e9e6cf95a22dfb.
+//
+// Solidity: event SetVoteExtensionEncoding(uint8 encoding)
+func (_AttestParams *AttestParamsFilterer) ParseSetVoteExtensionEncoding(log types.Log) (*AttestParamsSetVoteExtensionEncoding, error) {
+	event := new(AttestParamsSetVoteExtensionEncoding)
+	if err := _AttestParams.contract.UnpackLog(event, "SetVoteExtensionEncoding", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AttestParamsSetVoteParamsIterator is returned from FilterSetVoteParams and is used to iterate over the raw logs and unpacked data for SetVoteParams events raised by the AttestParams contract.
+type AttestParamsSetVoteParamsIterator struct {
+	Event *AttestParamsSetVoteParams // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AttestParamsSetVoteParamsIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AttestParamsSetVoteParams)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AttestParamsSetVoteParams)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AttestParamsSetVoteParamsIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AttestParamsSetVoteParamsIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AttestParamsSetVoteParams represents a SetVoteParams event raised by the AttestParams contract.
+type AttestParamsSetVoteParams struct {
+	VoteWindow   uint64
+	VoteExtLimit uint64
+	TrimLag      uint64
+	CTrimLag     uint64
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetVoteParams is a free log retrieval operation binding the contract event 0x00766a1f3b9048f161cf0b438f553c224c233c684eb6eb325621cabeb99c2c34.
+//
+// Solidity: event SetVoteParams(uint64 voteWindow, uint64 voteExtLimit, uint64 trimLag, uint64 cTrimLag)
+func (_AttestParams *AttestParamsFilterer) FilterSetVoteParams(opts *bind.FilterOpts) (*AttestParamsSetVoteParamsIterator, error) {
+
+	logs, sub, err := _AttestParams.contract.FilterLogs(opts, "SetVoteParams")
+	if err != nil {
+		return nil, err
+	}
+	return &AttestParamsSetVoteParamsIterator{contract: _AttestParams.contract, event: "SetVoteParams", logs: logs, sub: sub}, nil
+}
+
+// WatchSetVoteParams is a free log subscription operation binding the contract event 0x00766a1f3b9048f161cf0b438f553c224c233c684eb6eb325621cabeb99c2c34.
+//
+// Solidity: event SetVoteParams(uint64 voteWindow, uint64 voteExtLimit, uint64 trimLag, uint64 cTrimLag)
+func (_AttestParams *AttestParamsFilterer) WatchSetVoteParams(opts *bind.WatchOpts, sink chan<- *AttestParamsSetVoteParams) (event.Subscription, error) {
+
+	logs, sub, err := _AttestParams.contract.WatchLogs(opts, "SetVoteParams")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AttestParamsSetVoteParams)
+				if err := _AttestParams.contract.UnpackLog(event, "SetVoteParams", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetVoteParams is a log parse operation binding the contract event 0x00766a1f3b9048f161cf0b438f553c224c233c684eb6eb325621cabeb99c2c34.
+//
+// Solidity: event SetVoteParams(uint64 voteWindow, uint64 voteExtLimit, uint64 trimLag, uint64 cTrimLag)
+func (_AttestParams *AttestParamsFilterer) ParseSetVoteParams(log types.Log) (*AttestParamsSetVoteParams, error) {
+	event := new(AttestParamsSetVoteParams)
+	if err := _AttestParams.contract.UnpackLog(event, "SetVoteParams", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
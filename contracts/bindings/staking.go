@@ -29,9 +29,20 @@ var (
 	_ = abi.ConvertType
 )
 
+// StakingEditValidatorParams is an auto generated low-level Go binding around an user-defined struct.
+type StakingEditValidatorParams struct {
+	Moniker                  string
+	Identity                 string
+	Website                  string
+	SecurityContact          string
+	Details                  string
+	CommissionRatePercentage int32
+	MinSelfDelegation        *big.Int
+}
+
 // StakingMetaData contains all meta data concerning the Staking contract.
 var StakingMetaData = &bind.MetaData{
-	ABI: "[{\"type\":\"function\",\"name\":\"MinDelegation\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MinDeposit\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"allowValidators\",\"inputs\":[{\"name\":\"validators\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"createValidator\",\"inputs\":[{\"name\":\"pubkey\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"delegate\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"disableAllowlist\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"disallowValidators\",\"inputs\":[{\"name\":\"validators\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"enableAllowlist\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"owner_\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"isAllowlistEnabled_\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isAllowedValidator\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isAllowlistEnabled\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"CreateValidator\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"pubkey\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"},{\"name\":\"deposit\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Delegate\",\"inputs\":[{\"name\":\"delegator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidInitialization\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitializing\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]}]",
+	ABI: "[{\"type\":\"function\",\"name\":\"Fee\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MaxDetailsLength\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MaxIdentityLength\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MaxMonikerLength\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MaxSecurityContactLength\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MaxWebsiteLength\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MinDelegation\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MinDeposit\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"allowValidators\",\"inputs\":[{\"name\":\"validators\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"createValidator\",\"inputs\":[{\"name\":\"pubkey\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"delegate\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"disableAllowlist\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"disallowValidators\",\"inputs\":[{\"name\":\"validators\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"editValidator\",\"inputs\":[{\"name\":\"params\",\"type\":\"tuple\",\"internalType\":\"structStaking.EditValidatorParams\",\"components\":[{\"name\":\"moniker\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"identity\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"website\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"securityContact\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"details\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"commissionRatePercentage\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"minSelfDelegation\",\"type\":\"int256\",\"internalType\":\"int256\"}]}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"enableAllowlist\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"owner_\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"isAllowlistEnabled_\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isAllowedValidator\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isAllowlistEnabled\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"undelegate\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"event\",\"name\":\"CreateValidator\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"pubkey\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"},{\"name\":\"deposit\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Delegate\",\"inputs\":[{\"name\":\"delegator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EditValidator\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"params\",\"type\":\"tuple\",\"internalType\":\"structStaking.EditValidatorParams\",\"components\":[{\"name\":\"moniker\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"identity\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"website\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"securityContact\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"details\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"commissionRatePercentage\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"minSelfDelegation\",\"type\":\"int256\",\"internalType\":\"int256\"}],\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Undelegate\",\"inputs\":[{\"name\":\"delegator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidInitialization\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitializing\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]}]",
 	Bin: "0x608060405234801561001057600080fd5b50610a86806100206000396000f3fe6080604052600436106100dd5760003560e01c806384768b7a1161007f578063c6a2aac811610059578063c6a2aac814610252578063cf8e629a14610267578063d146fd1b1461027c578063f2fde38b1461029657600080fd5b806384768b7a146101b85780638da5cb5b146101f8578063a5a470ad1461023f57600080fd5b8063400ada75116100bb578063400ada751461015457806359bcddde146101745780635c19a95c14610190578063715018a6146101a357600080fd5b8063117407e3146100e257806311bcd830146101045780633f0b1edf14610134575b600080fd5b3480156100ee57600080fd5b506101026100fd3660046108b2565b6102b6565b005b34801561011057600080fd5b5061012168056bc75e2d6310000081565b6040519081526020015b60405180910390f35b34801561014057600080fd5b5061010261014f3660046108b2565b61032a565b34801561016057600080fd5b5061010261016f366004610943565b61039a565b34801561018057600080fd5b50610121670de0b6b3a764000081565b61010261019e36600461097f565b6104b9565b3480156101af57600080fd5b506101026105b1565b3480156101c457600080fd5b506101e86101d336600461097f565b60016020526000908152604090205460ff1681565b604051901515815260200161012b565b34801561020457600080fd5b507f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546040516001600160a01b03909116815260200161012b565b61010261024d3660046109a1565b6105c5565b34801561025e57600080fd5b5061010261071b565b34801561027357600080fd5b50610102610732565b34801561028857600080fd5b506000546101e89060ff1681565b3480156102a257600080fd5b506101026102b136600461097f565b610746565b6102be610784565b60005b818110156103255760018060008585858181106102e0576102e0610a01565b90506020020160208101906102f5919061097f565b6001600160a01b031681526020810191909152604001600020805460ff19169115159190911790556001016102c1565b505050565b610332610784565b60005b818110156103255760006001600085858581811061035557610355610a01565b905060200201602081019061036a919061097f565b6001600160a01b031681526020810191909152604001600020805460ff1916911515919091179055600101610335565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a008054600160401b810460ff16159067ffffffffffffffff166000811580156103e05750825b905060008267ffffffffffffffff1660011480156103fd5750303b155b90508115801561040b575080155b156104295760405163f92ee8a960e01b815260040160405180910390fd5b845467ffffffffffffffff19166001178555831561045357845460ff60401b1916600160401b1785555b61045c876107df565b6000805460ff191687151517905583156104b057845460ff60401b19168555604051600181527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b50505050505050565b670de0b6b3a76400003410156105165760405162461bcd60e51b815260206004820152601d60248201527f5374616b696e673a20696e73756666696369656e74206465706f73697400000060448201526064015b60405180910390fd5b336001600160a01b0382161461056e5760405162461bcd60e51b815260206004820152601d60248201527f5374616b696e673a206f6e6c792073656c662064656c65676174696f6e000000604482015260640161050d565b6040513481526001600160a01b0382169033907f510b11bb3f3c799b11307c01ab7db0d335683ef5b2da98f7697de744f465eacc9060200160405180910390a350565b6105b9610784565b6105c360006107f0565b565b60005460ff1615806105e657503360009081526001602052604090205460ff165b6106295760405162461bcd60e51b815260206004820152601460248201527314dd185ada5b99ce881b9bdd08185b1b1bddd95960621b604482015260640161050d565b602181146106795760405162461bcd60e51b815260206004820152601e60248201527f5374616b696e673a20696e76616c6964207075626b6579206c656e6774680000604482015260640161050d565b68056bc75e2d631000003410156106d25760405162461bcd60e51b815260206004820152601d60248201527f5374616b696e673a20696e73756666696369656e74206465706f736974000000604482015260640161050d565b336001600160a01b03167fc7abef7b73f049da6a9bc2349ba5066a39e316eabc9f671b6f9406aa9490a45383833460405161070f93929190610a17565b60405180910390a25050565b610723610784565b6000805460ff19166001179055565b61073a610784565b6000805460ff19169055565b61074e610784565b6001600160a01b03811661077857604051631e4fbdf760e01b81526000600482015260240161050d565b610781816107f0565b50565b336107b67f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b031690565b6001600160a01b0316146105c35760405163118cdaa760e01b815233600482015260240161050d565b6107e7610861565b610781816108aa565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c19930080546001600160a01b031981166001600160a01b03848116918217845560405192169182907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a3505050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a0054600160401b900460ff166105c357604051631afcd79f60e31b815260040160405180910390fd5b61074e610861565b600080602083850312156108c557600080fd5b823567ffffffffffffffff808211156108dd57600080fd5b818501915085601f8301126108f157600080fd5b81358181111561090057600080fd5b8660208260051b850101111561091557600080fd5b60209290920196919550909350505050565b80356001600160a01b038116811461093e57600080fd5b919050565b6000806040838503121561095657600080fd5b61095f83610927565b91506020830135801515811461097457600080fd5b809150509250929050565b60006020828403121561099157600080fd5b61099a82610927565b9392505050565b600080602083850312156109b457600080fd5b823567ffffffffffffffff808211156109cc57600080fd5b818501915085601f8301126109e057600080fd5b8135818111156109ef57600080fd5b86602082850101111561091557600080fd5b634e487b7160e01b600052603260045260246000fd5b604081528260408201528284606083013760006060848301015260006060601f19601f860116830101905082602083015294935050505056fea26469706673582212201ad1d9aad3c7d43b13774db3308d59675ed3c15f01a079646644a291ecce45c964736f6c63430008180033",
 }
 
@@ -202,6 +213,192 @@ func (_Staking *StakingTransactorRaw) Transact(opts *bind.TransactOpts, method s
 	return _Staking.Contract.contract.Transact(opts, method, params...)
 }
 
+// Fee is a free data retrieval call binding the contract method 0xbef7a2f0.
+//
+// Solidity: function Fee() view returns(uint256)
+func (_Staking *StakingCaller) Fee(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "Fee")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Fee is a free data retrieval call binding the contract method 0xbef7a2f0.
+//
+// Solidity: function Fee() view returns(uint256)
+func (_Staking *StakingSession) Fee() (*big.Int, error) {
+	return _Staking.Contract.Fee(&_Staking.CallOpts)
+}
+
+// Fee is a free data retrieval call binding the contract method 0xbef7a2f0.
+//
+// Solidity: function Fee() view returns(uint256)
+func (_Staking *StakingCallerSession) Fee() (*big.Int, error) {
+	return _Staking.Contract.Fee(&_Staking.CallOpts)
+}
+
+// MaxDetailsLength is a free data retrieval call binding the contract method 0x432ffd67.
+//
+// Solidity: function MaxDetailsLength() view returns(uint256)
+func (_Staking *StakingCaller) MaxDetailsLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "MaxDetailsLength")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxDetailsLength is a free data retrieval call binding the contract method 0x432ffd67.
+//
+// Solidity: function MaxDetailsLength() view returns(uint256)
+func (_Staking *StakingSession) MaxDetailsLength() (*big.Int, error) {
+	return _Staking.Contract.MaxDetailsLength(&_Staking.CallOpts)
+}
+
+// MaxDetailsLength is a free data retrieval call binding the contract method 0x432ffd67.
+//
+// Solidity: function MaxDetailsLength() view returns(uint256)
+func (_Staking *StakingCallerSession) MaxDetailsLength() (*big.Int, error) {
+	return _Staking.Contract.MaxDetailsLength(&_Staking.CallOpts)
+}
+
+// MaxIdentityLength is a free data retrieval call binding the contract method 0x6a5e4bb1.
+//
+// Solidity: function MaxIdentityLength() view returns(uint256)
+func (_Staking *StakingCaller) MaxIdentityLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "MaxIdentityLength")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxIdentityLength is a free data retrieval call binding the contract method 0x6a5e4bb1.
+//
+// Solidity: function MaxIdentityLength() view returns(uint256)
+func (_Staking *StakingSession) MaxIdentityLength() (*big.Int, error) {
+	return _Staking.Contract.MaxIdentityLength(&_Staking.CallOpts)
+}
+
+// MaxIdentityLength is a free data retrieval call binding the contract method 0x6a5e4bb1.
+//
+// Solidity: function MaxIdentityLength() view returns(uint256)
+func (_Staking *StakingCallerSession) MaxIdentityLength() (*big.Int, error) {
+	return _Staking.Contract.MaxIdentityLength(&_Staking.CallOpts)
+}
+
+// MaxMonikerLength is a free data retrieval call binding the contract method 0xca7ba4fd.
+//
+// Solidity: function MaxMonikerLength() view returns(uint256)
+func (_Staking *StakingCaller) MaxMonikerLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "MaxMonikerLength")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxMonikerLength is a free data retrieval call binding the contract method 0xca7ba4fd.
+//
+// Solidity: function MaxMonikerLength() view returns(uint256)
+func (_Staking *StakingSession) MaxMonikerLength() (*big.Int, error) {
+	return _Staking.Contract.MaxMonikerLength(&_Staking.CallOpts)
+}
+
+// MaxMonikerLength is a free data retrieval call binding the contract method 0xca7ba4fd.
+//
+// Solidity: function MaxMonikerLength() view returns(uint256)
+func (_Staking *StakingCallerSession) MaxMonikerLength() (*big.Int, error) {
+	return _Staking.Contract.MaxMonikerLength(&_Staking.CallOpts)
+}
+
+// MaxSecurityContactLength is a free data retrieval call binding the contract method 0x943aab20.
+//
+// Solidity: function MaxSecurityContactLength() view returns(uint256)
+func (_Staking *StakingCaller) MaxSecurityContactLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "MaxSecurityContactLength")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxSecurityContactLength is a free data retrieval call binding the contract method 0x943aab20.
+//
+// Solidity: function MaxSecurityContactLength() view returns(uint256)
+func (_Staking *StakingSession) MaxSecurityContactLength() (*big.Int, error) {
+	return _Staking.Contract.MaxSecurityContactLength(&_Staking.CallOpts)
+}
+
+// MaxSecurityContactLength is a free data retrieval call binding the contract method 0x943aab20.
+//
+// Solidity: function MaxSecurityContactLength() view returns(uint256)
+func (_Staking *StakingCallerSession) MaxSecurityContactLength() (*big.Int, error) {
+	return _Staking.Contract.MaxSecurityContactLength(&_Staking.CallOpts)
+}
+
+// MaxWebsiteLength is a free data retrieval call binding the contract method 0x739fad29.
+//
+// Solidity: function MaxWebsiteLength() view returns(uint256)
+func (_Staking *StakingCaller) MaxWebsiteLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "MaxWebsiteLength")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxWebsiteLength is a free data retrieval call binding the contract method 0x739fad29.
+//
+// Solidity: function MaxWebsiteLength() view returns(uint256)
+func (_Staking *StakingSession) MaxWebsiteLength() (*big.Int, error) {
+	return _Staking.Contract.MaxWebsiteLength(&_Staking.CallOpts)
+}
+
+// MaxWebsiteLength is a free data retrieval call binding the contract method 0x739fad29.
+//
+// Solidity: function MaxWebsiteLength() view returns(uint256)
+func (_Staking *StakingCallerSession) MaxWebsiteLength() (*big.Int, error) {
+	return _Staking.Contract.MaxWebsiteLength(&_Staking.CallOpts)
+}
+
 // MinDelegation is a free data retrieval call binding the contract method 0x59bcddde.
 //
 // Solidity: function MinDelegation() view returns(uint256)
@@ -462,6 +659,27 @@ func (_Staking *StakingTransactorSession) DisallowValidators(validators []common
 	return _Staking.Contract.DisallowValidators(&_Staking.TransactOpts, validators)
 }
 
+// EditValidator is a paid mutator transaction binding the contract method 0x40892e10.
+//
+// Solidity: function editValidator((string,string,string,string,string,int32,int256) params) payable returns()
+func (_Staking *StakingTransactor) EditValidator(opts *bind.TransactOpts, params StakingEditValidatorParams) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "editValidator", params)
+}
+
+// EditValidator is a paid mutator transaction binding the contract method 0x40892e10.
+//
+// Solidity: function editValidator((string,string,string,string,string,int32,int256) params) payable returns()
+func (_Staking *StakingSession) EditValidator(params StakingEditValidatorParams) (*types.Transaction, error) {
+	return _Staking.Contract.EditValidator(&_Staking.TransactOpts, params)
+}
+
+// EditValidator is a paid mutator transaction binding the contract method 0x40892e10.
+//
+// Solidity: function editValidator((string,string,string,string,string,int32,int256) params) payable returns()
+func (_Staking *StakingTransactorSession) EditValidator(params StakingEditValidatorParams) (*types.Transaction, error) {
+	return _Staking.Contract.EditValidator(&_Staking.TransactOpts, params)
+}
+
 // EnableAllowlist is a paid mutator transaction binding the contract method 0xc6a2aac8.
 //
 // Solidity: function enableAllowlist() returns()
@@ -546,6 +764,27 @@ func (_Staking *StakingTransactorSession) TransferOwnership(newOwner common.Addr
 	return _Staking.Contract.TransferOwnership(&_Staking.TransactOpts, newOwner)
 }
 
+// Undelegate is a paid mutator transaction binding the contract method 0x4d99dd16.
+//
+// Solidity: function undelegate(address validator, uint256 amount) payable returns()
+func (_Staking *StakingTransactor) Undelegate(opts *bind.TransactOpts, validator common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "undelegate", validator, amount)
+}
+
+// Undelegate is a paid mutator transaction binding the contract method 0x4d99dd16.
+//
+// Solidity: function undelegate(address validator, uint256 amount) payable returns()
+func (_Staking *StakingSession) Undelegate(validator common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Undelegate(&_Staking.TransactOpts, validator, amount)
+}
+
+// Undelegate is a paid mutator transaction binding the contract method 0x4d99dd16.
+//
+// Solidity: function undelegate(address validator, uint256 amount) payable returns()
+func (_Staking *StakingTransactorSession) Undelegate(validator common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Undelegate(&_Staking.TransactOpts, validator, amount)
+}
+
 // StakingCreateValidatorIterator is returned from FilterCreateValidator and is used to iterate over the raw logs and unpacked data for CreateValidator events raised by the Staking contract.
 type StakingCreateValidatorIterator struct {
 	Event *StakingCreateValidator // Event containing the contract specifics and raw log
@@ -846,6 +1085,151 @@ func (_Staking *StakingFilterer) ParseDelegate(log types.Log) (*StakingDelegate,
 	return event, nil
 }
 
+// StakingEditValidatorIterator is returned from FilterEditValidator and is used to iterate over the raw logs and unpacked data for EditValidator events raised by the Staking contract.
+type StakingEditValidatorIterator struct {
+	Event *StakingEditValidator // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingEditValidatorIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingEditValidator)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingEditValidator)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingEditValidatorIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingEditValidatorIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingEditValidator represents a EditValidator event raised by the Staking contract.
+type StakingEditValidator struct {
+	Validator common.Address
+	Params    StakingEditValidatorParams
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterEditValidator is a free log retrieval operation binding the contract event 0xa336bb1335f3b96aec82d6a852e833257fad20328234f85af51296592480752d.
+//
+// Solidity: event EditValidator(address indexed validator, (string,string,string,string,string,int32,int256) params)
+func (_Staking *StakingFilterer) FilterEditValidator(opts *bind.FilterOpts, validator []common.Address) (*StakingEditValidatorIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "EditValidator", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingEditValidatorIterator{contract: _Staking.contract, event: "EditValidator", logs: logs, sub: sub}, nil
+}
+
+// WatchEditValidator is a free log subscription operation binding the contract event 0xa336bb1335f3b96aec82d6a852e833257fad20328234f85af51296592480752d.
+//
+// Solidity: event EditValidator(address indexed validator, (string,string,string,string,string,int32,int256) params)
+func (_Staking *StakingFilterer) WatchEditValidator(opts *bind.WatchOpts, sink chan<- *StakingEditValidator, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "EditValidator", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingEditValidator)
+				if err := _Staking.contract.UnpackLog(event, "EditValidator", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEditValidator is a log parse operation binding the contract event 0xa336bb1335f3b96aec82d6a852e833257fad20328234f85af51296592480752d.
+//
+// Solidity: event EditValidator(address indexed validator, (string,string,string,string,string,int32,int256) params)
+func (_Staking *StakingFilterer) ParseEditValidator(log types.Log) (*StakingEditValidator, error) {
+	event := new(StakingEditValidator)
+	if err := _Staking.contract.UnpackLog(event, "EditValidator", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // StakingInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the Staking contract.
 type StakingInitializedIterator struct {
 	Event *StakingInitialized // Event containing the contract specifics and raw log
@@ -1132,3 +1516,157 @@ func (_Staking *StakingFilterer) ParseOwnershipTransferred(log types.Log) (*Stak
 	event.Raw = log
 	return event, nil
 }
+
+// StakingUndelegateIterator is returned from FilterUndelegate and is used to iterate over the raw logs and unpacked data for Undelegate events raised by the Staking contract.
+type StakingUndelegateIterator struct {
+	Event *StakingUndelegate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingUndelegateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingUndelegate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingUndelegate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingUndelegateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingUndelegateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingUndelegate represents a Undelegate event raised by the Staking contract.
+type StakingUndelegate struct {
+	Delegator common.Address
+	Validator common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterUndelegate is a free log retrieval operation binding the contract event 0xbda8c0e95802a0e6788c3e9027292382d5a41b86556015f846b03a9874b2b827.
+//
+// Solidity: event Undelegate(address indexed delegator, address indexed validator, uint256 amount)
+func (_Staking *StakingFilterer) FilterUndelegate(opts *bind.FilterOpts, delegator []common.Address, validator []common.Address) (*StakingUndelegateIterator, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "Undelegate", delegatorRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingUndelegateIterator{contract: _Staking.contract, event: "Undelegate", logs: logs, sub: sub}, nil
+}
+
+// WatchUndelegate is a free log subscription operation binding the contract event 0xbda8c0e95802a0e6788c3e9027292382d5a41b86556015f846b03a9874b2b827.
+//
+// Solidity: event Undelegate(address indexed delegator, address indexed validator, uint256 amount)
+func (_Staking *StakingFilterer) WatchUndelegate(opts *bind.WatchOpts, sink chan<- *StakingUndelegate, delegator []common.Address, validator []common.Address) (event.Subscription, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "Undelegate", delegatorRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingUndelegate)
+				if err := _Staking.contract.UnpackLog(event, "Undelegate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUndelegate is a log parse operation binding the contract event 0xbda8c0e95802a0e6788c3e9027292382d5a41b86556015f846b03a9874b2b827.
+//
+// Solidity: event Undelegate(address indexed delegator, address indexed validator, uint256 amount)
+func (_Staking *StakingFilterer) ParseUndelegate(log types.Log) (*StakingUndelegate, error) {
+	event := new(StakingUndelegate)
+	if err := _Staking.contract.UnpackLog(event, "Undelegate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
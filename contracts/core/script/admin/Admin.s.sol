@@ -7,6 +7,9 @@ import { ITransparentUpgradeableProxy } from "@openzeppelin/contracts/proxy/tran
 import { InitializableHelper } from "script/utils/InitializableHelper.sol";
 import { EIP1967Helper } from "script/utils/EIP1967Helper.sol";
 import { OmniPortal } from "src/xchain/OmniPortal.sol";
+import { AttestParams } from "src/octane/AttestParams.sol";
+import { Distribution } from "src/octane/Distribution.sol";
+import { Predeploys } from "src/libraries/Predeploys.sol";
 
 /**
  * @title Admin
@@ -58,4 +61,56 @@ contract Admin is Script {
         // TODO: add more
         require(InitializableHelper.areInitializersDisabled(impl), "initializers not disabled");
     }
+
+    /**
+     * @notice Deploy the AttestParams predeploy on networks that predate it.
+     *         The predeploy proxy exists since genesis, but without an implementation.
+     * @param admin     The address of the admin account, owner of the proxy admin and the AttestParams contract.
+     * @param deployer  The address of the account that will deploy the implementation.
+     */
+    function deployAttestParams(address admin, address deployer) public {
+        require(EIP1967Helper.getImplementation(Predeploys.AttestParams) == address(0), "already deployed");
+
+        // deploy implementation
+        vm.startBroadcast(deployer);
+        address impl = address(new AttestParams());
+        vm.stopBroadcast();
+
+        // upgrade proxy, initializing the owner
+        vm.startBroadcast(admin);
+        address proxyAdmin = EIP1967Helper.getAdmin(Predeploys.AttestParams);
+        ProxyAdmin(proxyAdmin).upgradeAndCall(
+            ITransparentUpgradeableProxy(Predeploys.AttestParams), impl, abi.encodeCall(AttestParams.initialize, (admin))
+        );
+        vm.stopBroadcast();
+
+        // run tests
+        require(InitializableHelper.areInitializersDisabled(impl), "initializers not disabled");
+        require(AttestParams(Predeploys.AttestParams).owner() == admin, "owner not set");
+    }
+
+    /**
+     * @notice Deploy the Distribution predeploy on networks that predate it.
+     *         The predeploy proxy exists since genesis, but without an implementation.
+     * @param admin     The address of the admin account, owner of the proxy admin.
+     * @param deployer  The address of the account that will deploy the implementation.
+     */
+    function deployDistribution(address admin, address deployer) public {
+        require(EIP1967Helper.getImplementation(Predeploys.Distribution) == address(0), "already deployed");
+
+        // deploy implementation
+        vm.startBroadcast(deployer);
+        address impl = address(new Distribution());
+        vm.stopBroadcast();
+
+        // upgrade proxy, Distribution has no initializer
+        vm.startBroadcast(admin);
+        address proxyAdmin = EIP1967Helper.getAdmin(Predeploys.Distribution);
+        ProxyAdmin(proxyAdmin).upgradeAndCall(ITransparentUpgradeableProxy(Predeploys.Distribution), impl, "");
+        vm.stopBroadcast();
+
+        // run tests
+        require(EIP1967Helper.getImplementation(Predeploys.Distribution) == impl, "implementation not set");
+        require(Distribution(Predeploys.Distribution).Fee() == 0.1 ether, "fee not set");
+    }
 }
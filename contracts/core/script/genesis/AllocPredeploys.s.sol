@@ -7,6 +7,7 @@ import { PortalRegistry } from "src/xchain/PortalRegistry.sol";
 import { OmniBridgeNative } from "src/token/OmniBridgeNative.sol";
 import { Staking } from "src/octane/Staking.sol";
 import { Upgrade } from "src/octane/Upgrade.sol";
+import { AttestParams } from "src/octane/AttestParams.sol";
 import { Preinstalls } from "src/octane/Preinstalls.sol";
 import { InitializableHelper } from "script/utils/InitializableHelper.sol";
 import { EIP1967Helper } from "script/utils/EIP1967Helper.sol";
@@ -90,6 +91,8 @@ contract AllocPredeploys is Script {
         setStaking();
         setSlashing();
         setUpgrade();
+        setAttestParams();
+        setDistribution();
     }
 
     /**
@@ -240,4 +243,23 @@ contract AllocPredeploys is Script {
         InitializableHelper.disableInitializers(impl);
         Upgrade(Predeploys.Upgrade).initialize(cfg.admin);
     }
+
+    /**
+     * @notice Setup AttestParams predeploy
+     */
+    function setAttestParams() internal {
+        address impl = Predeploys.impl(Predeploys.AttestParams);
+        vm.etch(impl, vm.getDeployedCode("AttestParams.sol:AttestParams"));
+
+        InitializableHelper.disableInitializers(impl);
+        AttestParams(Predeploys.AttestParams).initialize(cfg.admin);
+    }
+
+    /**
+     * @notice Setup Distribution predeploy
+     */
+    function setDistribution() internal {
+        address impl = Predeploys.impl(Predeploys.Distribution);
+        vm.etch(impl, vm.getDeployedCode("Distribution.sol:Distribution"));
+    }
 }
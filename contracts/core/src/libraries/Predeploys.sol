@@ -19,6 +19,8 @@ library Predeploys {
     address internal constant Staking = 0xCCcCcC0000000000000000000000000000000001;
     address internal constant Slashing = 0xCccCCC0000000000000000000000000000000002;
     address internal constant Upgrade = 0xccCCcc0000000000000000000000000000000003;
+    address internal constant AttestParams = 0xCcCcCC0000000000000000000000000000000004;
+    address internal constant Distribution = 0xCCCcCC0000000000000000000000000000000005;
 
     function namespaces() internal pure returns (address[] memory ns) {
         ns = new address[](2);
@@ -49,7 +51,8 @@ library Predeploys {
      */
     function isActivePredeploy(address addr) internal pure returns (bool) {
         return addr == PortalRegistry || addr == OmniBridgeNative || addr == WOmni || addr == Staking
-            || addr == Slashing || addr == Upgrade;
+            || addr == Slashing || addr == Upgrade || addr == AttestParams
+            || addr == Distribution;
     }
 
     /**
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: GPL-3.0-only
+pragma solidity =0.8.24;
+
+import { OwnableUpgradeable } from "@openzeppelin/contracts-upgradeable/access/OwnableUpgradeable.sol";
+
+/**
+ * @title AttestParams
+ * @notice The EVM interface to the consensus chain's attest module parameters.
+ *         Calls are proxied, and not executed syncronously. Their execution is left to
+ *         the consensus chain, and they may fail.
+ * @dev This contract is predeployed, and requires storage slots to be set in genesis.
+ *      Genesis storage slots must:
+ *          - set _owner on proxy
+ *          - set _initialized on proxy to 1, to disable the initializer
+ *          - set _initialized on implementation to type(uint64).max, to disabled all initializers
+ */
+contract AttestParams is OwnableUpgradeable {
+    /**
+     * @notice Emitted when the attest module vote params should be updated
+     * @param voteWindow        Number of attest offsets before and after the latest approved attestation that votes are allowed for
+     * @param voteExtLimit      Maximum number of votes that a validator may include in a single vote extension
+     * @param trimLag           Number of consensus blocks after which non-consensus-chain attestations are deleted
+     * @param cTrimLag          Number of consensus blocks after which consensus-chain attestations are deleted
+     */
+    event SetVoteParams(uint64 voteWindow, uint64 voteExtLimit, uint64 trimLag, uint64 cTrimLag);
+
+    /**
+     * @notice Emitted when the attest module vote extension encoding should be updated
+     * @param encoding          Vote extension encoding, see halo/attest/types.VoteExtensionEncoding
+     */
+    event SetVoteExtensionEncoding(uint8 encoding);
+
+    /**
+     * @notice The highest supported vote extension encoding (COMPACT_FLATE)
+     */
+    uint8 public constant MaxVoteExtensionEncoding = 2;
+
+    /**
+     * @notice VoteParams are the attest module vote params.
+     * @custom:field voteWindow     Number of attest offsets before and after the latest approved attestation that votes are allowed for
+     * @custom:field voteExtLimit   Maximum number of votes that a validator may include in a single vote extension
+     * @custom:field trimLag        Number of consensus blocks after which non-consensus-chain attestations are deleted
+     * @custom:field cTrimLag       Number of consensus blocks after which consensus-chain attestations are deleted
+     */
+    struct VoteParams {
+        uint64 voteWindow;
+        uint64 voteExtLimit;
+        uint64 trimLag;
+        uint64 cTrimLag;
+    }
+
+    /**
+     * @dev Disable initializers of implementations deployed after genesis, see Admin.deployAttestParams.
+     *      Genesis implementations are not constructed, their initializers are disabled via genesis storage slots.
+     */
+    constructor() {
+        _disableInitializers();
+    }
+
+    function initialize(address owner_) public initializer {
+        __Ownable_init(owner_);
+    }
+
+    //////////////////////////////////////////////////////////////////////////////
+    //                                  Admin                                   //
+    //////////////////////////////////////////////////////////////////////////////
+
+    /**
+     * @notice Update the attest module vote params
+     */
+    function setVoteParams(VoteParams calldata params) external onlyOwner {
+        require(params.voteWindow > 0, "AttestParams: zero vote window");
+        require(params.voteExtLimit > 0, "AttestParams: zero vote ext limit");
+        require(params.trimLag > 0, "AttestParams: zero trim lag");
+        require(params.cTrimLag >= params.trimLag, "AttestParams: cTrimLag < trimLag");
+        emit SetVoteParams(params.voteWindow, params.voteExtLimit, params.trimLag, params.cTrimLag);
+    }
+
+    /**
+     * @notice Update the attest module vote extension encoding
+     *         0: PROTO, 1: COMPACT, 2: COMPACT_FLATE
+     */
+    function setVoteExtensionEncoding(uint8 encoding) external onlyOwner {
+        require(encoding <= MaxVoteExtensionEncoding, "AttestParams: unknown encoding");
+        emit SetVoteExtensionEncoding(encoding);
+    }
+}
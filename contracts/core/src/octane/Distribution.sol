@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: GPL-3.0-only
+pragma solidity =0.8.24;
+
+/**
+ * @title Distribution
+ * @notice The EVM interface to the consensus chain's x/distribution module.
+ *         Calls are proxied, and not executed syncronously. Their execution is left to
+ *         the consensus chain, and they may fail.
+ * @dev This contract is predeployed as an upgradable proxy, though currently has no storage.
+ *      It therefoes does not need to be Initializeable. Initializeable should be added when
+ *      initialization logic is required.
+ */
+contract Distribution {
+    /**
+     * @notice Emitted when a delegator claims their rewards
+     * @param delegator     (MsgWithdrawDelegatorReward.delegator_address) The delegator claiming rewards
+     * @param validator     (MsgWithdrawDelegatorReward.validator_address) The validator to claim rewards from
+     */
+    event Claim(address indexed delegator, address indexed validator);
+
+    /**
+     * @notice The address to burn fees to
+     */
+    address private constant BurnAddr = 0x000000000000000000000000000000000000dEaD;
+
+    /**
+     * @notice Static fee to claim. Used to prevent spamming of Claim events, which require consensus
+     *         chain work that is not metered by execution chain gas.
+     */
+    uint256 public constant Fee = 0.1 ether;
+
+    /**
+     * @notice Claim your delegation rewards from a validator.
+     *         If msg.sender is the validator, its accumulated commission is also claimed.
+     *         Claimed rewards are withdrawn to msg.sender on the EVM.
+     * @dev Proxies x/distribution.MsgWithdrawDelegatorReward and MsgWithdrawValidatorCommission
+     */
+    function claim(address validator) external payable {
+        _burnFee();
+        emit Claim(msg.sender, validator);
+    }
+
+    /**
+     * @notice Burn the fee, requiring it be sent with the call
+     */
+    function _burnFee() internal {
+        require(msg.value >= Fee, "Distribution: insufficient fee");
+        payable(BurnAddr).transfer(msg.value);
+    }
+}
@@ -32,6 +32,42 @@ contract Staking is OwnableUpgradeable {
      */
     event Delegate(address indexed delegator, address indexed validator, uint256 amount);
 
+    /**
+     * @notice Emitted when a delegation is undelegated (unbonded) from a validator
+     * @param delegator     (MsgUndelegate.delegator_addr) The address of the delegator
+     * @param validator     (MsgUndelegate.validator_addr) The address of the validator to undelegate from
+     * @param amount        (MsgUndelegate.amount) The amount of tokens to undelegate
+     */
+    event Undelegate(address indexed delegator, address indexed validator, uint256 amount);
+
+    /**
+     * @notice Emitted when a validator is edited
+     * @param validator     (MsgEditValidator.validator_address) The address of the validator to edit
+     * @param params        The validator parameters to edit, see EditValidatorParams
+     */
+    event EditValidator(address indexed validator, EditValidatorParams params);
+
+    /**
+     * @notice Validator parameters to edit.
+     *         Empty description fields and negative numeric fields are left unchanged.
+     * @custom:field moniker                    (Description.moniker) The validator's name
+     * @custom:field identity                   (Description.identity) Optional identity signature (ex. UPort or Keybase)
+     * @custom:field website                    (Description.website) Optional website link
+     * @custom:field securityContact            (Description.security_contact) Optional security contact information
+     * @custom:field details                    (Description.details) Optional details
+     * @custom:field commissionRatePercentage   (MsgEditValidator.commission_rate) The new commission rate percentage [0-100], or -1 to leave unchanged
+     * @custom:field minSelfDelegation          (MsgEditValidator.min_self_delegation) The new minimum self delegation (in wei), or -1 to leave unchanged
+     */
+    struct EditValidatorParams {
+        string moniker;
+        string identity;
+        string website;
+        string securityContact;
+        string details;
+        int32 commissionRatePercentage;
+        int256 minSelfDelegation;
+    }
+
     /**
      * @notice The minimum deposit required to create a validator
      */
@@ -42,6 +78,26 @@ contract Staking is OwnableUpgradeable {
      */
     uint256 public constant MinDelegation = 1 ether;
 
+    /**
+     * @notice Static fee to undelegate or edit a validator. Used to prevent spamming of events, which require consensus
+     *         chain work that is not metered by execution chain gas.
+     */
+    uint256 public constant Fee = 0.1 ether;
+
+    /**
+     * @notice Maximum validator description field lengths, matching x/staking's Description limits.
+     */
+    uint256 public constant MaxMonikerLength = 70;
+    uint256 public constant MaxIdentityLength = 3000;
+    uint256 public constant MaxWebsiteLength = 140;
+    uint256 public constant MaxSecurityContactLength = 140;
+    uint256 public constant MaxDetailsLength = 280;
+
+    /**
+     * @notice The address to burn fees to
+     */
+    address private constant BurnAddr = 0x000000000000000000000000000000000000dEaD;
+
     /**
      * @notice True of the validator allowlist is enabled.
      */
@@ -59,6 +115,7 @@ contract Staking is OwnableUpgradeable {
 
     /**
      * @notice Create a new validator
+     *         NOTE: Creating an existing validator fails, the consensus public key cannot be rotated.
      * @param pubkey The validators consensus public key. 33 bytes compressed secp256k1 public key
      * @dev Proxies x/staking.MsgCreateValidator
      */
@@ -85,6 +142,55 @@ contract Staking is OwnableUpgradeable {
         emit Delegate(msg.sender, validator, msg.value);
     }
 
+    /**
+     * @notice Undelegate (unbond) from your validators self delegation.
+     *         NOTE: Only self undelegations are currently supported.
+     *         The undelegated amount is locked for the consensus chain's unbonding period.
+     *         If msg.sender is not a validator, or the amount exceeds the delegation, the undelegation fails.
+     * @dev Proxies x/staking.MsgUndelegate
+     */
+    function undelegate(address validator, uint256 amount) external payable {
+        _burnFee();
+        require(amount > 0, "Staking: zero amount");
+
+        // only support self undelegation for now
+        require(msg.sender == validator, "Staking: only self undelegation");
+
+        emit Undelegate(msg.sender, validator, amount);
+    }
+
+    /**
+     * @notice Edit your validator's description, commission rate or minimum self delegation.
+     *         NOTE: Commission rate changes are subject to the validator's max rate and max change rate,
+     *         and minimum self delegation may only be increased (up to the validator's self delegation).
+     *         If msg.sender is not a validator, or the edit is invalid, the edit fails.
+     *         NOTE: The consensus public key cannot be edited, since x/staking doesn't support key rotation.
+     * @dev Proxies x/staking.MsgEditValidator
+     */
+    function editValidator(EditValidatorParams calldata params) external payable {
+        _burnFee();
+        require(bytes(params.moniker).length <= MaxMonikerLength, "Staking: moniker too long");
+        require(bytes(params.identity).length <= MaxIdentityLength, "Staking: identity too long");
+        require(bytes(params.website).length <= MaxWebsiteLength, "Staking: website too long");
+        require(bytes(params.securityContact).length <= MaxSecurityContactLength, "Staking: security contact too long");
+        require(bytes(params.details).length <= MaxDetailsLength, "Staking: details too long");
+        require(
+            params.commissionRatePercentage >= -1 && params.commissionRatePercentage <= 100,
+            "Staking: invalid commission rate"
+        );
+        require(params.minSelfDelegation >= -1, "Staking: invalid min self delegation");
+
+        emit EditValidator(msg.sender, params);
+    }
+
+    /**
+     * @notice Burn the fee, requiring it be sent with the call
+     */
+    function _burnFee() internal {
+        require(msg.value >= Fee, "Staking: insufficient fee");
+        payable(BurnAddr).transfer(msg.value);
+    }
+
     //////////////////////////////////////////////////////////////////////////////
     //                                  Admin                                   //
     //////////////////////////////////////////////////////////////////////////////
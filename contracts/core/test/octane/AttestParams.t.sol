@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: GPL-3.0-only
+pragma solidity =0.8.24;
+
+import { TransparentUpgradeableProxy } from "@openzeppelin/contracts/proxy/transparent/TransparentUpgradeableProxy.sol";
+import { AttestParams } from "src/octane/AttestParams.sol";
+import { Test } from "forge-std/Test.sol";
+
+/**
+ * @title AttestParams_Test
+ * @notice Test suite for AttestParams.sol
+ */
+contract AttestParams_Test is Test {
+    /// @dev Matches AttestParams.SetVoteParams event
+    event SetVoteParams(uint64 voteWindow, uint64 voteExtLimit, uint64 trimLag, uint64 cTrimLag);
+
+    /// @dev Matches AttestParams.SetVoteExtensionEncoding event
+    event SetVoteExtensionEncoding(uint8 encoding);
+
+    AttestParams attestParams;
+    address owner;
+
+    function setUp() public {
+        owner = makeAddr("owner");
+
+        // initializers are disabled on the implementation, so initialize via a proxy
+        address impl = address(new AttestParams());
+        attestParams = AttestParams(
+            address(new TransparentUpgradeableProxy(impl, owner, abi.encodeCall(AttestParams.initialize, (owner))))
+        );
+    }
+
+    function test_setVoteParams() public {
+        AttestParams.VoteParams memory params =
+            AttestParams.VoteParams({ voteWindow: 64, voteExtLimit: 256, trimLag: 1, cTrimLag: 5 });
+
+        // only owner
+        vm.expectRevert();
+        attestParams.setVoteParams(params);
+
+        // zero vote window
+        params.voteWindow = 0;
+        vm.expectRevert("AttestParams: zero vote window");
+        vm.prank(owner);
+        attestParams.setVoteParams(params);
+        params.voteWindow = 64;
+
+        // cTrimLag < trimLag
+        params.cTrimLag = 0;
+        vm.expectRevert("AttestParams: cTrimLag < trimLag");
+        vm.prank(owner);
+        attestParams.setVoteParams(params);
+        params.cTrimLag = 5;
+
+        // succeeds
+        vm.expectEmit();
+        emit SetVoteParams(64, 256, 1, 5);
+
+        vm.prank(owner);
+        attestParams.setVoteParams(params);
+    }
+
+    function test_setVoteExtensionEncoding() public {
+        // only owner
+        vm.expectRevert();
+        attestParams.setVoteExtensionEncoding(1);
+
+        // unknown encoding
+        uint8 max = attestParams.MaxVoteExtensionEncoding();
+        vm.expectRevert("AttestParams: unknown encoding");
+        vm.prank(owner);
+        attestParams.setVoteExtensionEncoding(max + 1);
+
+        // succeeds
+        vm.expectEmit();
+        emit SetVoteExtensionEncoding(max);
+
+        vm.prank(owner);
+        attestParams.setVoteExtensionEncoding(max);
+    }
+}
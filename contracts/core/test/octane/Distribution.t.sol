@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: GPL-3.0-only
+pragma solidity =0.8.24;
+
+import { Distribution } from "src/octane/Distribution.sol";
+import { Test, Vm } from "forge-std/Test.sol";
+
+/**
+ * @title Distribution_Test
+ * @notice Test suite for Distribution.sol
+ */
+contract Distribution_Test is Test {
+    /// @dev Matches Distribution.Claim event
+    event Claim(address indexed delegator, address indexed validator);
+
+    Distribution distribution;
+
+    function setUp() public {
+        distribution = new Distribution();
+    }
+
+    function test_claim() public {
+        address delegator = makeAddr("delegator");
+        address validator = makeAddr("validator");
+        uint256 fee = distribution.Fee();
+        vm.deal(delegator, fee);
+
+        // requires fee
+        vm.expectRevert("Distribution: insufficient fee");
+        vm.prank(delegator);
+        distribution.claim{ value: fee - 1 }(validator);
+
+        vm.expectEmit();
+        emit Claim(delegator, validator);
+
+        vm.prank(delegator);
+        distribution.claim{ value: fee }(validator);
+    }
+}
@@ -12,6 +12,12 @@ contract Staking_Test is Test {
     /// @dev Matches Staking.CreateValidator event
     event CreateValidator(address indexed validator, bytes pubkey, uint256 deposit);
 
+    /// @dev Matches Staking.Undelegate event
+    event Undelegate(address indexed delegator, address indexed validator, uint256 amount);
+
+    /// @dev Matches Staking.EditValidator event
+    event EditValidator(address indexed validator, Staking.EditValidatorParams params);
+
     address owner;
     StakingHarness staking;
 
@@ -89,6 +95,97 @@ contract Staking_Test is Test {
         vm.prank(validator);
         staking.createValidator{ value: deposit }(pubkey);
     }
+
+    function test_undelegate() public {
+        address validator = makeAddr("validator");
+        address other = makeAddr("other");
+        uint256 fee = staking.Fee();
+        vm.deal(validator, 10 * fee);
+        vm.deal(other, 10 * fee);
+
+        // requires fee
+        vm.expectRevert("Staking: insufficient fee");
+        vm.prank(validator);
+        staking.undelegate{ value: fee - 1 }(validator, 1 ether);
+
+        // requires non-zero amount
+        vm.expectRevert("Staking: zero amount");
+        vm.prank(validator);
+        staking.undelegate{ value: fee }(validator, 0);
+
+        // only self undelegation
+        vm.expectRevert("Staking: only self undelegation");
+        vm.prank(other);
+        staking.undelegate{ value: fee }(validator, 1 ether);
+
+        // succeeds, burning the fee
+        vm.expectEmit();
+        emit Undelegate(validator, validator, 1 ether);
+
+        vm.prank(validator);
+        staking.undelegate{ value: fee }(validator, 1 ether);
+        assertEq(address(0x000000000000000000000000000000000000dEaD).balance, fee);
+    }
+
+    function test_editValidator() public {
+        address validator = makeAddr("validator");
+        Staking.EditValidatorParams memory params = Staking.EditValidatorParams({
+            moniker: "moniker",
+            identity: "",
+            website: "https://example.com",
+            securityContact: "",
+            details: "",
+            commissionRatePercentage: 5,
+            minSelfDelegation: -1
+        });
+
+        uint256 fee = staking.Fee();
+        vm.deal(validator, 10 * fee);
+
+        // requires fee
+        vm.expectRevert("Staking: insufficient fee");
+        vm.prank(validator);
+        staking.editValidator{ value: fee - 1 }(params);
+
+        // requires description fields within x/staking limits
+        params.moniker = string(new bytes(staking.MaxMonikerLength() + 1));
+        vm.expectRevert("Staking: moniker too long");
+        vm.prank(validator);
+        staking.editValidator{ value: fee }(params);
+
+        params.moniker = "moniker";
+        params.details = string(new bytes(staking.MaxDetailsLength() + 1));
+        vm.expectRevert("Staking: details too long");
+        vm.prank(validator);
+        staking.editValidator{ value: fee }(params);
+
+        // requires valid commission rate
+        params.details = "";
+        params.commissionRatePercentage = 101;
+        vm.expectRevert("Staking: invalid commission rate");
+        vm.prank(validator);
+        staking.editValidator{ value: fee }(params);
+
+        params.commissionRatePercentage = -2;
+        vm.expectRevert("Staking: invalid commission rate");
+        vm.prank(validator);
+        staking.editValidator{ value: fee }(params);
+
+        // requires valid min self delegation
+        params.commissionRatePercentage = 5;
+        params.minSelfDelegation = -2;
+        vm.expectRevert("Staking: invalid min self delegation");
+        vm.prank(validator);
+        staking.editValidator{ value: fee }(params);
+
+        // succeeds
+        params.minSelfDelegation = -1;
+        vm.expectEmit();
+        emit EditValidator(validator, params);
+
+        vm.prank(validator);
+        staking.editValidator{ value: fee }(params);
+    }
 }
 
 /**
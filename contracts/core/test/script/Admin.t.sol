@@ -6,6 +6,9 @@ import { ProxyAdmin } from "@openzeppelin/contracts/proxy/transparent/ProxyAdmin
 import { ConfLevel } from "src/libraries/ConfLevel.sol";
 import { XTypes } from "src/libraries/XTypes.sol";
 import { OmniPortal } from "src/xchain/OmniPortal.sol";
+import { AttestParams } from "src/octane/AttestParams.sol";
+import { Distribution } from "src/octane/Distribution.sol";
+import { Predeploys } from "src/libraries/Predeploys.sol";
 import { Admin } from "script/admin/Admin.s.sol";
 import { EIP1967Helper } from "script/utils/EIP1967Helper.sol";
 import { PortalHarness } from "test/xchain/common/PortalHarness.sol";
@@ -72,6 +75,56 @@ contract Admin_Test is Test {
         makeXCall(portal);
     }
 
+    function test_deployAttestParams() public {
+        Admin a = new Admin();
+
+        address admin = makeAddr("admin");
+        address deployer = makeAddr("deployer");
+
+        // predeploy proxy without implementation, as on networks that predate AttestParams
+        address tmpImpl = makeAddr("tmpImpl");
+        vm.etch(tmpImpl, "00");
+        address tmp = address(new TransparentUpgradeableProxy(tmpImpl, admin, ""));
+        vm.etch(Predeploys.AttestParams, tmp.code);
+        EIP1967Helper.setImplementation(Predeploys.AttestParams, address(0));
+        EIP1967Helper.setAdmin(Predeploys.AttestParams, EIP1967Helper.getAdmin(tmp));
+
+        address expectedImpl = vm.computeCreateAddress(deployer, 0);
+        a.deployAttestParams(admin, deployer);
+
+        assertEq(expectedImpl, EIP1967Helper.getImplementation(Predeploys.AttestParams));
+        assertEq(admin, AttestParams(Predeploys.AttestParams).owner());
+
+        // cannot deploy twice
+        vm.expectRevert("already deployed");
+        a.deployAttestParams(admin, deployer);
+    }
+
+    function test_deployDistribution() public {
+        Admin a = new Admin();
+
+        address admin = makeAddr("admin");
+        address deployer = makeAddr("deployer");
+
+        // predeploy proxy without implementation, as on networks that predate Distribution
+        address tmpImpl = makeAddr("tmpImpl");
+        vm.etch(tmpImpl, "00");
+        address tmp = address(new TransparentUpgradeableProxy(tmpImpl, admin, ""));
+        vm.etch(Predeploys.Distribution, tmp.code);
+        EIP1967Helper.setImplementation(Predeploys.Distribution, address(0));
+        EIP1967Helper.setAdmin(Predeploys.Distribution, EIP1967Helper.getAdmin(tmp));
+
+        address expectedImpl = vm.computeCreateAddress(deployer, 0);
+        a.deployDistribution(admin, deployer);
+
+        assertEq(expectedImpl, EIP1967Helper.getImplementation(Predeploys.Distribution));
+        assertEq(0.1 ether, Distribution(Predeploys.Distribution).Fee());
+
+        // cannot deploy twice
+        vm.expectRevert("already deployed");
+        a.deployDistribution(admin, deployer);
+    }
+
     //////////////////////////////////////////////////////////////////////////////
     //                              Utils                                       //
     //////////////////////////////////////////////////////////////////////////////
@@ -117,12 +117,14 @@ contract AllocPredeploys_Test is Test, AllocPredeploys {
         assertEq(cfg.admin, OwnableUpgradeable(Predeploys.OmniBridgeNative).owner(), "OmniBridgeNative owner check");
         assertEq(cfg.admin, OwnableUpgradeable(Predeploys.Staking).owner(), "Staking owner check");
         assertEq(cfg.admin, OwnableUpgradeable(Predeploys.Upgrade).owner(), "Upgrade owner check");
+        assertEq(cfg.admin, OwnableUpgradeable(Predeploys.AttestParams).owner(), "AttestParams owner check");
 
         // test proxies initialized
         assertTrue(InitializableHelper.isInitialized(Predeploys.PortalRegistry), "PortalRegistry initialized check");
         assertTrue(InitializableHelper.isInitialized(Predeploys.OmniBridgeNative), "OmniBridgeNative initialized check");
         assertTrue(InitializableHelper.isInitialized(Predeploys.Staking), "Staking initialized check");
         assertTrue(InitializableHelper.isInitialized(Predeploys.Upgrade), "Upgrade initialized check");
+        assertTrue(InitializableHelper.isInitialized(Predeploys.AttestParams), "AttestParams initialized check");
 
         // test initializers disabled on implementations
         assertTrue(
@@ -141,6 +143,10 @@ contract AllocPredeploys_Test is Test, AllocPredeploys {
             InitializableHelper.areInitializersDisabled(Predeploys.impl(Predeploys.Upgrade)),
             "Upgrade initializer check"
         );
+        assertTrue(
+            InitializableHelper.areInitializersDisabled(Predeploys.impl(Predeploys.AttestParams)),
+            "AttestParams initializer check"
+        );
     }
 
     /**
@@ -77,6 +77,38 @@ func TestUpgradePortalAction(t *testing.T) {
 	require.Equal(t, []common.Address{s.admin, s.deployer}, r.calls[0].senders)
 }
 
+func TestDeployAttestParamsAction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	r := &mockRunner{}
+	s := mockShared()
+	c := mockChain()
+
+	_, err := deployAttestParams(ctx, s, c, r)
+	require.NoError(t, err)
+	require.Len(t, r.calls, 1)
+	require.Equal(t, mustPack(t, adminABI, "deployAttestParams", s.admin, s.deployer), r.calls[0].calldata)
+	require.Equal(t, []common.Address{s.admin, s.deployer}, r.calls[0].senders)
+}
+
+func TestDeployDistributionAction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	r := &mockRunner{}
+	s := mockShared()
+	c := mockChain()
+
+	_, err := deployDistribution(ctx, s, c, r)
+	require.NoError(t, err)
+	require.Len(t, r.calls, 1)
+	require.Equal(t, mustPack(t, adminABI, "deployDistribution", s.admin, s.deployer), r.calls[0].calldata)
+	require.Equal(t, []common.Address{s.admin, s.deployer}, r.calls[0].senders)
+}
+
 func mockShared() shared {
 	return shared{
 		admin:    common.HexToAddress("0x1"),
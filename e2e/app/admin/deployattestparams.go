@@ -0,0 +1,31 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/omni-network/omni/e2e/app"
+	"github.com/omni-network/omni/lib/errors"
+)
+
+// DeployAttestParams deploys the AttestParams predeploy on networks that predate it.
+// It only runs on the omni execution chain, since that is where predeploys live.
+func DeployAttestParams(ctx context.Context, def app.Definition) error {
+	omniEVM := def.Testnet.Network.Static().OmniExecutionChainName()
+	cfg := PortalAdminConfig{Chain: omniEVM}
+
+	return run(ctx, def, cfg, "deployAttestParams", deployAttestParams)
+}
+
+func deployAttestParams(ctx context.Context, s shared, _ chain, r runner) (string, error) {
+	calldata, err := adminABI.Pack("deployAttestParams", s.admin, s.deployer)
+	if err != nil {
+		return "", errors.Wrap(err, "pack calldata")
+	}
+
+	out, err := r.run(ctx, calldata, s.admin, s.deployer)
+	if err != nil {
+		return out, errors.Wrap(err, "run forge")
+	}
+
+	return out, nil
+}
@@ -0,0 +1,110 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/omni-network/omni/e2e/docker"
+	"github.com/omni-network/omni/e2e/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+)
+
+const (
+	exportedGenesisFile = "exported_genesis.json"
+	exportAttempts      = 3
+)
+
+// exportRestart restarts the halo network from an exported genesis:
+//   - stops all halo nodes,
+//   - exports the application state of a validator as a new genesis file (see `halo export`),
+//   - replaces the genesis of all nodes and deletes their consensus and application data,
+//   - starts all halo nodes, which resume from the exported state and the existing omni EVMs.
+//
+// Priv validator and voter states are retained, since the network continues at the next height.
+func exportRestart(ctx context.Context, testnet types.Testnet) error {
+	var nodes []string
+	for _, node := range testnet.Nodes {
+		if node.StateSync {
+			return errors.New("export restart not supported with state sync nodes", "node", node.Name)
+		}
+		nodes = append(nodes, node.Name)
+	}
+
+	exporter := testnet.BroadcastNode().Name
+	ctx = log.WithCtx(ctx, "exporter", exporter)
+
+	log.Info(ctx, "Restarting network from exported genesis")
+
+	for attempt := 1; ; attempt++ {
+		if err := docker.ExecCompose(ctx, testnet.Dir, append([]string{"stop"}, nodes...)...); err != nil {
+			return errors.Wrap(err, "stop nodes")
+		}
+
+		// First 'run' is the halovisor (cosmovisor) command, followed by the halo command.
+		err := docker.ExecCompose(ctx, testnet.Dir, "run", "--rm", exporter,
+			"run", "export", "--output=/halo/config/"+exportedGenesisFile)
+		if err == nil {
+			break
+		} else if attempt >= exportAttempts {
+			return errors.Wrap(err, "export genesis")
+		}
+
+		// Export fails while a validator set update is in progress, so let the network progress and retry.
+		log.Warn(ctx, "Export failed, retrying at a later height", err, "attempt", attempt)
+		if err := docker.ExecCompose(ctx, testnet.Dir, append([]string{"start"}, nodes...)...); err != nil {
+			return errors.Wrap(err, "start nodes")
+		}
+		if err := Wait(ctx, testnet.Testnet, 5); err != nil {
+			return err
+		}
+	}
+
+	if err := resetNodes(ctx, testnet.Dir, exporter, nodes); err != nil {
+		return err
+	}
+
+	if err := docker.ExecCompose(ctx, testnet.Dir, append([]string{"start"}, nodes...)...); err != nil {
+		return errors.Wrap(err, "start nodes")
+	}
+
+	if err := Wait(ctx, testnet.Testnet, 5); err != nil {
+		return err
+	}
+
+	log.Info(ctx, "Network restarted from exported genesis")
+
+	return nil
+}
+
+// resetNodes replaces the genesis of all nodes with the exporter's exported genesis
+// and deletes their consensus and application databases.
+func resetNodes(ctx context.Context, dir string, exporter string, nodes []string) error {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return errors.Wrap(err, "abs dir")
+	}
+
+	var cmds []string
+	for _, node := range nodes {
+		cmds = append(cmds,
+			fmt.Sprintf("cp /mount/%s/config/%s /mount/%s/config/genesis.json", exporter, exportedGenesisFile, node),
+			fmt.Sprintf("rm -rf /mount/%s/data/*.db /mount/%s/data/cs.wal /mount/%s/data/snapshots", node, node, node),
+		)
+	}
+
+	// Local files in the volumes are owned by root, so reset them from within a container running as root, see CleanupDir.
+	err = docker.Exec(ctx, "run",
+		"--rm",             // Remove the container after it exits
+		"--entrypoint", "", // Clear the entrypoint so we can run a shell command
+		"-v", fmt.Sprintf("%v:/mount", absDir), // Mount the testnet dir into the container
+		"ethereum/client-go:latest", // Use the latest geth image (which runs as root)
+		"sh", "-c", strings.Join(cmds, " && "))
+	if err != nil {
+		return errors.Wrap(err, "exec reset nodes")
+	}
+
+	return nil
+}
@@ -242,6 +242,12 @@ func E2ETest(ctx context.Context, def Definition, cfg E2ETestConfig) error {
 		return errors.Wrap(err, "stop validator updates")
 	}
 
+	if def.Manifest.ExportRestart {
+		if err := exportRestart(ctx, def.Testnet); err != nil {
+			return errors.Wrap(err, "export restart")
+		}
+	}
+
 	// Start unit tests.
 	if err := Test(ctx, def, false); err != nil {
 		return err
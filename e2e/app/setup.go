@@ -48,6 +48,8 @@ const (
 
 	PrivvalKeyFile   = "config/priv_validator_key.json"
 	PrivvalStateFile = "data/priv_validator_state.json"
+
+	haloGRPCPort = "9090"
 )
 
 // Setup sets up the testnet configuration.
@@ -413,6 +415,7 @@ func writeHaloConfig(
 	cfg.EngineJWTFile = "/halo/config/jwtsecret"                    // Absolute path inside docker container
 	cfg.Tracer.Endpoint = defCfg.TracingEndpoint
 	cfg.Tracer.Headers = defCfg.TracingHeaders
+	cfg.GRPCAddress = net.JoinHostPort("0.0.0.0", haloGRPCPort)
 
 	if testCfg {
 		cfg.SnapshotInterval = 1   // Write snapshots each block in e2e tests
@@ -487,6 +490,7 @@ func writeRelayerConfig(ctx context.Context, def Definition, logCfg log.Config)
 	relayCfg.PrivateKey = privKeyFile
 	relayCfg.Network = def.Testnet.Network
 	relayCfg.HaloURL = archiveNode.AddressRPC()
+	relayCfg.HaloGRPCURL = net.JoinHostPort(archiveNode.InternalIP.String(), haloGRPCPort)
 	relayCfg.RPCEndpoints = endpoints
 
 	if err := relayapp.WriteConfigTOML(relayCfg, logCfg, filepath.Join(confRoot, configFile)); err != nil {
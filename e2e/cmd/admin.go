@@ -17,6 +17,8 @@ func newAdminCmd(def *app.Definition) *cobra.Command {
 		newPausePortalCmd(def),
 		newUnpausePortalCmd(def),
 		newUpgradePortalCmd(def),
+		newDeployAttestParamsCmd(def),
+		newDeployDistributionCmd(def),
 	)
 
 	return cmd
@@ -69,3 +71,27 @@ func newUpgradePortalCmd(def *app.Definition) *cobra.Command {
 
 	return cmd
 }
+
+func newDeployAttestParamsCmd(def *app.Definition) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy-attest-params",
+		Short: "Deploy the AttestParams predeploy on networks that predate it",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return admin.DeployAttestParams(cmd.Context(), *def)
+		},
+	}
+
+	return cmd
+}
+
+func newDeployDistributionCmd(def *app.Definition) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy-distribution",
+		Short: "Deploy the Distribution predeploy on networks that predate it",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return admin.DeployDistribution(cmd.Context(), *def)
+		},
+	}
+
+	return cmd
+}
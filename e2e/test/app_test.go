@@ -46,3 +46,33 @@ func TestApp_Hash(t *testing.T) {
 			"app hash does not match last block's app hash")
 	})
 }
+
+// Tests that networks restarted from an exported genesis progress past the exported height.
+func TestApp_ExportRestart(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	testnet, _, _, _ := loadEnv(t) //nolint:dogsled // Fine for testing.
+	if !testnet.Manifest.ExportRestart {
+		t.Skip("network not restarted from exported genesis")
+	}
+
+	testNode(t, func(t *testing.T, _ netconf.Network, node *e2e.Node, _ []Portal) {
+		t.Helper()
+		if node.Mode == e2e.ModeSeed {
+			return
+		}
+
+		client, err := node.Client()
+		require.NoError(t, err)
+
+		genesis, err := client.Genesis(ctx)
+		require.NoError(t, err)
+		require.Greater(t, genesis.Genesis.InitialHeight, node.Testnet.InitialHeight)
+
+		status, err := client.Status(ctx)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, status.SyncInfo.EarliestBlockHeight, genesis.Genesis.InitialHeight)
+		require.Greater(t, status.SyncInfo.LatestBlockHeight, genesis.Genesis.InitialHeight)
+	})
+}
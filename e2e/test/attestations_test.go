@@ -3,8 +3,11 @@ package e2e_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/omni-network/omni/e2e/types"
+	atypes "github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/anvil"
 	"github.com/omni-network/omni/lib/cchain"
 	"github.com/omni-network/omni/lib/cchain/provider"
 	"github.com/omni-network/omni/lib/k1util"
@@ -13,6 +16,7 @@ import (
 
 	e2e "github.com/cometbft/cometbft/test/e2e/pkg"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -109,6 +113,74 @@ func TestApprovedValUpdates(t *testing.T) {
 	})
 }
 
+// TestLivenessNotJailed tests that healthy genesis validators are not jailed for attestation downtime.
+func TestLivenessNotJailed(t *testing.T) {
+	t.Parallel()
+	testNode(t, func(t *testing.T, network netconf.Network, node *e2e.Node, _ []Portal) {
+		t.Helper()
+
+		// Only test healthy genesis validators, others may legitimately miss attestations.
+		if _, ok := node.Testnet.Validators[node]; !ok || node.StartAt > 0 || len(node.Perturbations) > 0 {
+			return
+		}
+
+		client, err := node.Client()
+		require.NoError(t, err)
+		cprov := provider.NewABCIProvider(client, network.ID, netconf.ChainVersionNamer(netconf.Simnet))
+
+		addr, err := k1util.PubKeyToAddress(node.PrivvalKey.PubKey())
+		require.NoError(t, err)
+
+		val, ok, err := cprov.Validator(context.Background(), addr)
+		require.NoError(t, err)
+		require.True(t, ok, "validator not found: %s", addr)
+		require.False(t, val.IsJailed(), "validator jailed: %s", addr)
+	})
+}
+
+// TestLivenessJailed tests that a validator without a node is jailed for downtime.
+// It isn't parallel, since it sends transactions from the genesis validator accounts, see TestUndelegate.
+func TestLivenessJailed(t *testing.T) { //nolint:paralleltest // Sends transactions from genesis validator accounts.
+	testNetwork(t, func(t *testing.T, network netconf.Network, endpoints xchain.RPCEndpoints) {
+		t.Helper()
+
+		// Only ephemeral networks have funded dev and genesis validator accounts.
+		if !network.ID.IsEphemeral() {
+			return
+		}
+
+		ctx := context.Background()
+		cprov := genesisValProvider(t, network)
+
+		// The test validator never votes or signs blocks, so first increase the genesis validators' power
+		// to ensure the test validator's power remains well below a third of the total power.
+		// Otherwise, neither blocks nor attestations reach a quorum and the chain halts.
+		selfDelegateGenesisValidators(t, ctx, network, endpoints, 500)
+
+		val := newTestValidator(t, ctx, network, endpoints, anvil.DevPrivateKey8())
+
+		// The validator is jailed once a full liveness window of attestations is approved.
+		require.Eventually(t, func() bool {
+			v, ok, err := cprov.Validator(ctx, val.Operator)
+			if err != nil {
+				t.Logf("Validator query failed: %v", err)
+				return false
+			}
+
+			return ok && v.IsJailed()
+		}, 10*time.Minute, 5*time.Second, "validator not jailed: %s", val.Operator)
+
+		// The validator also misses all blocks, so x/slashing may jail it for consensus downtime first.
+		status, ok, err := cprov.SlashingStatus(ctx, sdk.ConsAddress(val.ConsKey.PubKey().Address()))
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Contains(t, []atypes.JailReason{
+			atypes.JailReason_JAIL_REASON_ATTEST_DOWNTIME,
+			atypes.JailReason_JAIL_REASON_CONSENSUS_DOWNTIME,
+		}, status.Jail.GetReason())
+	})
+}
+
 func fetchAllAtts(ctx context.Context, cprov cchain.Provider, chainVer xchain.ChainVersion, nodeIsDelayed bool) ([]xchain.Attestation, error) {
 	fromOffset := uint64(1) // Start at initialXAttestOffset
 	var resp []xchain.Attestation
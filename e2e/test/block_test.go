@@ -58,6 +58,7 @@ func TestBlock_Header(t *testing.T) {
 func TestBlock_Range(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
+	testnet, _, _, _ := loadEnv(t) //nolint:dogsled // Fine for testing.
 
 	testNode(t, func(t *testing.T, _ netconf.Network, node *e2e.Node, _ []Portal) {
 		t.Helper()
@@ -78,6 +79,10 @@ func TestBlock_Range(t *testing.T) {
 			assert.Greater(t, first, node.Testnet.InitialHeight,
 				"state synced nodes should not contain network's initial height")
 
+		case testnet.Manifest.ExportRestart:
+			assert.Greater(t, first, node.Testnet.InitialHeight,
+				"nodes restarted from exported genesis should not contain network's initial height")
+
 		case node.RetainBlocks > 0 && int64(node.RetainBlocks) < (last-node.Testnet.InitialHeight+1):
 			// Delta handles race conditions in reading first/last heights.
 			assert.InDelta(t, node.RetainBlocks, last-first+1, 1,
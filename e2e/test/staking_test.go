@@ -0,0 +1,235 @@
+package e2e_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/halo/genutil/evm/predeploys"
+	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/cchain/provider"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/ethclient/ethbackend"
+	"github.com/omni-network/omni/lib/k1util"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/txmgr"
+	"github.com/omni-network/omni/lib/xchain"
+
+	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	e2e "github.com/cometbft/cometbft/test/e2e/pkg"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+
+	"cosmossdk.io/math"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestUndelegate tests that a validator can undelegate part of its self-delegation
+// via the Staking predeploy, which results in an unbonding delegation on the consensus chain.
+// It undelegates from a healthy genesis validator, since a new validator without a node never votes,
+// which halts the chain if its power exceeds a third of the total power.
+// It isn't parallel, since it sends transactions from the genesis validator accounts, see TestLivenessJailed.
+func TestUndelegate(t *testing.T) { //nolint:paralleltest // Sends transactions from genesis validator accounts.
+	testNetwork(t, func(t *testing.T, network netconf.Network, endpoints xchain.RPCEndpoints) {
+		t.Helper()
+
+		// Only ephemeral networks have funded genesis validator accounts.
+		if !network.ID.IsEphemeral() {
+			return
+		}
+
+		ctx := context.Background()
+		cprov := genesisValProvider(t, network)
+		backend, vals := genesisValBackend(t, network, endpoints)
+		val := vals[0]
+
+		staking, err := bindings.NewStaking(common.HexToAddress(predeploys.Staking), backend)
+		require.NoError(t, err)
+		fee, err := staking.Fee(&bind.CallOpts{Context: ctx})
+		require.NoError(t, err)
+		txOpts, err := backend.BindOpts(ctx, val)
+		require.NoError(t, err)
+		txOpts.Value = fee
+
+		amount := big.NewInt(params.Ether)
+		tx, err := staking.Undelegate(txOpts, val, amount)
+		require.NoError(t, err)
+		_, err = backend.WaitMined(ctx, tx)
+		require.NoError(t, err)
+
+		// Wait for the undelegation to be processed by halo.
+		require.Eventually(t, func() bool {
+			ubds, err := cprov.UnbondingDelegations(ctx, val)
+			if err != nil {
+				t.Logf("Unbonding delegations query failed: %v", err)
+				return false
+			}
+
+			for _, ubd := range ubds {
+				for _, entry := range ubd.Entries {
+					if entry.InitialBalance.BigInt().Cmp(amount) == 0 {
+						return true
+					}
+				}
+			}
+
+			return false
+		}, time.Minute, time.Second, "unbonding delegation not found: %s", val)
+	})
+}
+
+// selfDelegateGenesisValidators increases the self-delegation of all healthy genesis validators
+// by the provided amount of ether and waits for it to be processed by halo.
+func selfDelegateGenesisValidators(t *testing.T, ctx context.Context, network netconf.Network, endpoints xchain.RPCEndpoints, ether int64) {
+	t.Helper()
+
+	cprov := genesisValProvider(t, network)
+	backend, vals := genesisValBackend(t, network, endpoints)
+
+	staking, err := bindings.NewStaking(common.HexToAddress(predeploys.Staking), backend)
+	require.NoError(t, err)
+
+	amount := new(big.Int).Mul(big.NewInt(ether), big.NewInt(params.Ether))
+	for _, val := range vals {
+		before, ok, err := cprov.Validator(ctx, val)
+		require.NoError(t, err)
+		require.True(t, ok, "validator not found: %s", val)
+
+		txOpts, err := backend.BindOpts(ctx, val)
+		require.NoError(t, err)
+		txOpts.Value = amount
+		tx, err := staking.Delegate(txOpts, val)
+		require.NoError(t, err)
+		_, err = backend.WaitMined(ctx, tx)
+		require.NoError(t, err)
+
+		// Wait for the delegation to be processed by halo.
+		expected := before.Tokens.Add(math.NewIntFromBigInt(amount))
+		require.Eventually(t, func() bool {
+			v, ok, err := cprov.Validator(ctx, val)
+			if err != nil {
+				t.Logf("Validator query failed: %v", err)
+				return false
+			}
+
+			return ok && v.Tokens.GTE(expected)
+		}, time.Minute, time.Second, "delegation not processed: %s", val)
+	}
+}
+
+// testValidator is a new validator created by a test.
+type testValidator struct {
+	Operator common.Address
+	ConsKey  k1.PrivKey
+	Backend  *ethbackend.Backend
+	Staking  *bindings.Staking
+}
+
+// newTestValidator creates a new validator via the Staking predeploy with the minimum deposit.
+// The validator operator is a new account funded by the dev account, and its consensus key isn't used by any node,
+// so the validator never votes.
+func newTestValidator(t *testing.T, ctx context.Context, network netconf.Network, endpoints xchain.RPCEndpoints, funderKey *ecdsa.PrivateKey) testValidator {
+	t.Helper()
+
+	omniEVM, ok := network.OmniEVMChain()
+	require.True(t, ok)
+	rpc, err := endpoints.ByNameOrID(omniEVM.Name, omniEVM.ID)
+	require.NoError(t, err)
+	ethCl, err := ethclient.Dial(omniEVM.Name, rpc)
+	require.NoError(t, err)
+
+	operatorKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	consKey := k1.GenPrivKey()
+
+	backend, err := ethbackend.NewBackend(omniEVM.Name, omniEVM.ID, omniEVM.BlockPeriod, ethCl, funderKey, operatorKey)
+	require.NoError(t, err)
+
+	deposit := new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether)) // Minimum deposit
+	funder, operator := crypto.PubkeyToAddress(funderKey.PublicKey), crypto.PubkeyToAddress(operatorKey.PublicKey)
+	_, _, err = backend.Send(ctx, funder, txmgr.TxCandidate{
+		To:       &operator,
+		GasLimit: 100_000,
+		Value:    new(big.Int).Add(deposit, big.NewInt(params.Ether)), // Deposit plus gas
+	})
+	require.NoError(t, err)
+
+	staking, err := bindings.NewStaking(common.HexToAddress(predeploys.Staking), backend)
+	require.NoError(t, err)
+	txOpts, err := backend.BindOpts(ctx, operator)
+	require.NoError(t, err)
+	txOpts.Value = deposit
+	tx, err := staking.CreateValidator(txOpts, consKey.PubKey().Bytes())
+	require.NoError(t, err)
+	_, err = backend.WaitMined(ctx, tx)
+	require.NoError(t, err)
+
+	return testValidator{
+		Operator: operator,
+		ConsKey:  consKey,
+		Backend:  backend,
+		Staking:  staking,
+	}
+}
+
+// healthyGenesisValidators returns the genesis validator nodes without perturbations.
+func healthyGenesisValidators(t *testing.T) []*e2e.Node {
+	t.Helper()
+
+	testnet, _, _, _ := loadEnv(t)
+
+	var nodes []*e2e.Node
+	for _, n := range testnet.Nodes {
+		if _, ok := testnet.Validators[n]; ok && n.StartAt == 0 && len(n.Perturbations) == 0 {
+			nodes = append(nodes, n)
+		}
+	}
+	require.NotEmpty(t, nodes, "no healthy genesis validator")
+
+	return nodes
+}
+
+// genesisValProvider returns a consensus chain provider connected to a healthy genesis validator node.
+func genesisValProvider(t *testing.T, network netconf.Network) cchain.Provider {
+	t.Helper()
+
+	client, err := healthyGenesisValidators(t)[0].Client()
+	require.NoError(t, err)
+
+	return provider.NewABCIProvider(client, network.ID, netconf.ChainVersionNamer(network.ID))
+}
+
+// genesisValBackend returns an omni EVM backend and the operator addresses of the healthy genesis validators.
+// Their operator accounts are funded in ephemeral networks, see app.FundValidatorsForTesting.
+func genesisValBackend(t *testing.T, network netconf.Network, endpoints xchain.RPCEndpoints) (*ethbackend.Backend, []common.Address) {
+	t.Helper()
+
+	var privkeys []*ecdsa.PrivateKey
+	var addrs []common.Address
+	for _, node := range healthyGenesisValidators(t) {
+		pk, err := k1util.StdPrivKeyFromComet(node.PrivvalKey)
+		require.NoError(t, err)
+
+		privkeys = append(privkeys, pk)
+		addrs = append(addrs, crypto.PubkeyToAddress(pk.PublicKey))
+	}
+
+	omniEVM, ok := network.OmniEVMChain()
+	require.True(t, ok)
+	rpc, err := endpoints.ByNameOrID(omniEVM.Name, omniEVM.ID)
+	require.NoError(t, err)
+	ethCl, err := ethclient.Dial(omniEVM.Name, rpc)
+	require.NoError(t, err)
+
+	backend, err := ethbackend.NewBackend(omniEVM.Name, omniEVM.ID, omniEVM.BlockPeriod, ethCl, privkeys...)
+	require.NoError(t, err)
+
+	return backend, addrs
+}
@@ -100,6 +100,10 @@ type Manifest struct {
 	// Perturb defines additional (non-cometBFT) perturbations by service name.
 	Perturb map[string][]Perturb `json:"perturb"`
 
+	// ExportRestart defines whether to restart the network from an exported genesis (see `halo export`)
+	// after the e2e test workload completes, but before running the e2e tests.
+	ExportRestart bool `toml:"export_restart"`
+
 	// PinnedHaloTag defines the pinned halo docker image tag.
 	// This allows source code defined versions for protected networks.
 	// This overrides the --omni-image-tag if non-empty.
@@ -4,6 +4,8 @@ import (
 	attestkeeper "github.com/omni-network/omni/halo/attest/keeper"
 	atypes "github.com/omni-network/omni/halo/attest/types"
 	"github.com/omni-network/omni/halo/comet"
+	"github.com/omni-network/omni/halo/evmattest"
+	"github.com/omni-network/omni/halo/evmdistribution"
 	"github.com/omni-network/omni/halo/evmslashing"
 	"github.com/omni-network/omni/halo/evmstaking"
 	"github.com/omni-network/omni/halo/evmupgrade"
@@ -26,6 +28,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
@@ -74,9 +77,11 @@ type App struct {
 	EvidenceKeeper        evidencekeeper.Keeper
 	UpgradeKeeper         *upgradekeeper.Keeper
 
-	SlashingEventProc evmslashing.EventProcessor
-	StakingEventProc  evmstaking.EventProcessor
-	UpgradeEventProc  evmupgrade.EventProcessor
+	AttestEventProc       evmattest.EventProcessor
+	DistributionEventProc evmdistribution.EventProcessor
+	SlashingEventProc     evmslashing.EventProcessor
+	StakingEventProc      evmstaking.EventProcessor
+	UpgradeEventProc      evmupgrade.EventProcessor
 }
 
 // newApp returns a reference to an initialized App.
@@ -126,6 +131,8 @@ func newApp(
 		&app.RegistryKeeper,
 		&app.EvidenceKeeper,
 		&app.UpgradeKeeper,
+		&app.AttestEventProc,
+		&app.DistributionEventProc,
 		&app.SlashingEventProc,
 		&app.StakingEventProc,
 		&app.UpgradeEventProc,
@@ -135,6 +142,7 @@ func newApp(
 
 	// Wire provider.
 	app.EVMEngKeeper.SetVoteProvider(app.AttestKeeper)
+	app.DistributionEventProc.SetWithdrawalQueue(app.EVMEngKeeper)
 	app.AttestKeeper.SetValidatorProvider(app.ValSyncKeeper)
 	app.AttestKeeper.SetPortalRegistry(app.RegistryKeeper)
 
@@ -154,10 +162,15 @@ func newApp(
 
 	app.App = appBuilder.Build(db, nil, baseAppOpts...)
 
+	// Network upgrades must be registered before loading the app, since the upgrade module
+	// panics on start if the last applied upgrade has no handler.
+	app.setUpgradeHandlers()
+	app.SetInitChainer(app.initChainer)
+
 	// Workaround for official endblockers since valsync replaces staking endblocker, but cosmos panics if it's not there.
 	{
 		app.ModuleManager.OrderEndBlockers = endBlockers
-		app.SetEndBlocker(app.EndBlocker)
+		app.SetEndBlocker(app.endBlocker)
 	}
 
 	if err := app.Load(true); err != nil {
@@ -167,12 +180,20 @@ func newApp(
 	return app, nil
 }
 
-func (App) LegacyAmino() *codec.LegacyAmino {
-	return nil
+// endBlocker wraps the module manager end blockers, withdrawing completed x/staking unbondings to the EVM.
+func (a *App) endBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
+	resp, err := a.EndBlocker(ctx)
+	if err != nil {
+		return sdk.EndBlock{}, err
+	}
+
+	a.StakingEventProc.HandleCompletedUnbondings(ctx, resp.Events, a.EVMEngKeeper)
+
+	return resp, nil
 }
 
-func (App) ExportAppStateAndValidators(_ bool, _, _ []string) (servertypes.ExportedApp, error) {
-	return servertypes.ExportedApp{}, errors.New("not implemented")
+func (App) LegacyAmino() *codec.LegacyAmino {
+	return nil
 }
 
 // SimulationManager implements the SimulationApp interface.
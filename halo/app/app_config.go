@@ -1,8 +1,12 @@
 package app
 
 import (
+	"time"
+
 	attestmodule "github.com/omni-network/omni/halo/attest/module"
 	attesttypes "github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/halo/evmattest"
+	"github.com/omni-network/omni/halo/evmdistribution"
 	"github.com/omni-network/omni/halo/evmslashing"
 	"github.com/omni-network/omni/halo/evmstaking"
 	"github.com/omni-network/omni/halo/evmupgrade"
@@ -42,17 +46,15 @@ import (
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 const (
 	// Bech32HRP is the human-readable-part of the Bech32 address format.
 	Bech32HRP = "omni"
 
-	// TODO(corver): Maybe move these to genesis itself.
-	genesisVoteWindow   = 64
-	genesisVoteExtLimit = 256
-	genesisTrimLag      = 1      // Delete attestations state after each epoch, only storing the very latest attestations.
-	genesisCTrimLag     = 72_000 // Delete consensus attestations state after +-1 day (given a period of 1.2s).
+	genesisDoubleSignSlashFraction = "0.05"         // Slash 5% of stake for double signing attestations.
+	genesisDoubleSignJailDuration  = 24 * time.Hour // Jail for a day for double signing attestations.
 )
 
 // init initializes the Cosmos SDK configuration.
@@ -90,6 +92,9 @@ var (
 		upgradetypes.ModuleName,
 		valsynctypes.ModuleName,
 		engevmtypes.ModuleName,
+		attesttypes.ModuleName,
+		registrytypes.ModuleName,
+		portaltypes.ModuleName,
 	}
 
 	beginBlockers = []string{
@@ -113,6 +118,7 @@ var (
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
 		evmstaking.ModuleName,
+		evmdistribution.ModuleName,
 	}
 
 	moduleAccPerms = []*authmodulev1.ModuleAccountPermission{
@@ -121,6 +127,7 @@ var (
 		{Account: stakingtypes.BondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
 		{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
 		{Account: evmstaking.ModuleName, Permissions: []string{authtypes.Burner, authtypes.Minter}},
+		{Account: evmdistribution.ModuleName, Permissions: []string{authtypes.Burner}},
 	}
 
 	// appConfig application configuration (used by depinject).
@@ -199,10 +206,8 @@ var (
 			{
 				Name: attesttypes.ModuleName,
 				Config: appconfig.WrapAny(&attestmodule.Module{
-					VoteWindow:         genesisVoteWindow,
-					VoteExtensionLimit: genesisVoteExtLimit,
-					TrimLag:            genesisTrimLag,
-					ConsensusTrimLag:   genesisCTrimLag,
+					DoubleSignSlashFraction: genesisDoubleSignSlashFraction,
+					DoubleSignJailDuration:  durationpb.New(genesisDoubleSignJailDuration),
 				}),
 			},
 			{
@@ -223,6 +228,8 @@ var (
 	// diProviders defines a list of depinject provider functions.
 	// These are non-cosmos module constructors used in halo's app wiring.
 	diProviders = []any{
+		evmattest.DIProvide,
+		evmdistribution.DIProvide,
 		evmslashing.DIProvide,
 		evmstaking.DIProvide,
 		evmupgrade.DIProvide,
@@ -0,0 +1,222 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/omni-network/omni/lib/buildinfo"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+	evmengtypes "github.com/omni-network/omni/octane/evmengine/types"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+
+	dbm "github.com/cosmos/cosmos-db"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	gtypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// ExportConfig defines the config for exporting the application state as a new genesis file.
+type ExportConfig struct {
+	// Output is the exported genesis file path.
+	Output string
+	// ChainID optionally overrides the consensus chain ID, e.g., when forking a testnet.
+	ChainID string
+}
+
+// ExportAppStateAndValidators exports the state of all modules at the latest committed height,
+// along with the genesis validators and consensus params.
+// Zero height exports are not supported, exported networks restart at the next height instead.
+func (a App) ExportAppStateAndValidators(forZeroHeight bool, _, modulesToExport []string) (servertypes.ExportedApp, error) {
+	if forZeroHeight {
+		return servertypes.ExportedApp{}, errors.New("zero height export not supported")
+	}
+
+	height := a.LastBlockHeight()
+	if height == 0 {
+		return servertypes.ExportedApp{}, errors.New("no committed state to export")
+	}
+
+	ctx := a.NewContextLegacy(true, cmtproto.Header{Height: height})
+
+	if err := a.ValSyncKeeper.VerifyExport(ctx, uint64(height)); err != nil {
+		return servertypes.ExportedApp{}, errors.Wrap(err, "verify valsync export")
+	}
+
+	genState, err := a.ModuleManager.ExportGenesisForModules(ctx, a.appCodec, modulesToExport)
+	if err != nil {
+		return servertypes.ExportedApp{}, errors.Wrap(err, "export genesis")
+	}
+
+	appState, err := json.MarshalIndent(genState, "", " ")
+	if err != nil {
+		return servertypes.ExportedApp{}, errors.Wrap(err, "marshal app state")
+	}
+
+	validators, err := staking.WriteValidators(ctx, a.StakingKeeper)
+	if err != nil {
+		return servertypes.ExportedApp{}, errors.Wrap(err, "export validators")
+	}
+
+	return servertypes.ExportedApp{
+		AppState:        appState,
+		Validators:      validators,
+		Height:          height,
+		ConsensusParams: a.GetConsensusParams(ctx),
+	}, nil
+}
+
+// Export exports the application state at the latest committed height as a new genesis file.
+// The network can then be restarted at the next height from the exported genesis.
+// The node must be stopped before exporting.
+func Export(ctx context.Context, cfg Config, eCfg ExportConfig) error {
+	if eCfg.Output == "" {
+		return errors.New("output file required")
+	}
+
+	db, err := dbm.NewDB("application", cfg.BackendType(), cfg.DataDir())
+	if err != nil {
+		return errors.Wrap(err, "create db")
+	}
+	defer db.Close()
+
+	baseAppOpts, err := makeBaseAppOpts(cfg)
+	if err != nil {
+		return errors.Wrap(err, "make base app opts")
+	}
+
+	privVal, err := loadPrivVal(cfg)
+	if err != nil {
+		return errors.Wrap(err, "load validator key")
+	}
+
+	engineCl, err := newEngineClient(ctx, cfg, cfg.Network, privVal.Key.PubKey)
+	if err != nil {
+		return err
+	}
+
+	voter, err := newVoterLoader(privVal.Key.PrivKey)
+	if err != nil {
+		return errors.Wrap(err, "new voter loader")
+	}
+
+	//nolint:contextcheck // False positive.
+	app, err := newApp(
+		newSDKLogger(ctx),
+		db,
+		engineCl,
+		voter,
+		netconf.ChainVersionNamer(cfg.Network),
+		netconf.ChainNamer(cfg.Network),
+		burnEVMFees{},
+		serverAppOptsFromCfg(cfg),
+		baseAppOpts...,
+	)
+	if err != nil {
+		return errors.Wrap(err, "new app")
+	}
+
+	exported, err := app.ExportAppStateAndValidators(false, nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "export app state")
+	}
+
+	head, err := exportedExecutionHead(app, exported.AppState)
+	if err != nil {
+		return err
+	}
+
+	// The simnet engine mock doesn't persist its chain, so there is nothing to verify.
+	if cfg.Network != netconf.Simnet {
+		if err := verifyExecutionChain(ctx, cfg, engineCl, head); err != nil {
+			return err
+		}
+	}
+
+	appGen, err := gtypes.AppGenesisFromFile(cfg.Comet.GenesisFile())
+	if err != nil {
+		return errors.Wrap(err, "read genesis file")
+	}
+
+	cmtParams := cmttypes.ConsensusParamsFromProto(exported.ConsensusParams)
+
+	appGen.AppVersion = buildinfo.Version()
+	appGen.AppState = exported.AppState
+	appGen.InitialHeight = exported.Height + 1
+	appGen.Consensus = &gtypes.ConsensusGenesis{
+		Validators: exported.Validators,
+		Params:     &cmtParams,
+	}
+	if eCfg.ChainID != "" {
+		appGen.ChainID = eCfg.ChainID
+	}
+
+	if err := genutil.ExportGenesisFile(appGen, eCfg.Output); err != nil {
+		return errors.Wrap(err, "export genesis file")
+	}
+
+	log.Info(ctx, "Exported application state",
+		"height", exported.Height,
+		"initial_height", appGen.InitialHeight,
+		"chain_id", appGen.ChainID,
+		"validators", len(exported.Validators),
+		"execution_head", head,
+		"file", eCfg.Output,
+	)
+
+	return nil
+}
+
+// exportedExecutionHead returns the execution head hash of the exported evmengine genesis state.
+func exportedExecutionHead(app *App, appState json.RawMessage) (common.Hash, error) {
+	var genState map[string]json.RawMessage
+	if err := json.Unmarshal(appState, &genState); err != nil {
+		return common.Hash{}, errors.Wrap(err, "unmarshal app state")
+	}
+
+	var evmengGenesis evmengtypes.GenesisState
+	if err := app.appCodec.UnmarshalJSON(genState[evmengtypes.ModuleName], &evmengGenesis); err != nil {
+		return common.Hash{}, errors.Wrap(err, "unmarshal evmengine genesis")
+	} else if len(evmengGenesis.GetExecutionBlockHash()) != common.HashLength {
+		return common.Hash{}, errors.New("invalid exported execution block hash")
+	}
+
+	return common.BytesToHash(evmengGenesis.GetExecutionBlockHash()), nil
+}
+
+// verifyExecutionChain returns an error if the execution chain is inconsistent with the exported state.
+// I.e., if the execution genesis doesn't match the network's, or if the exported execution head isn't known.
+func verifyExecutionChain(ctx context.Context, cfg Config, engineCl ethclient.EngineClient, head common.Hash) error {
+	genesisJSON, err := executionGenesis(cfg)
+	if err != nil {
+		return err
+	} else if len(genesisJSON) > 0 {
+		var genesis core.Genesis
+		if err := json.Unmarshal(genesisJSON, &genesis); err != nil {
+			return errors.Wrap(err, "unmarshal execution genesis")
+		}
+
+		genesisHeader, err := engineCl.HeaderByNumber(ctx, big.NewInt(0))
+		if err != nil {
+			return errors.Wrap(err, "get execution genesis block")
+		} else if genesisHeader.Hash() != genesis.ToBlock().Hash() {
+			return errors.New("execution genesis mismatch",
+				"expected", genesis.ToBlock().Hash(), "actual", genesisHeader.Hash())
+		}
+	}
+
+	if _, err := engineCl.HeaderByHash(ctx, head); err != nil {
+		return errors.Wrap(err, "exported execution head not found in execution chain", "head", head)
+	}
+
+	return nil
+}
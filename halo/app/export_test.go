@@ -0,0 +1,92 @@
+//nolint:paralleltest // CosmosSDK dependency prevents parallel execution
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	haloapp "github.com/omni-network/omni/halo/app"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/tutil"
+	evmengtypes "github.com/omni-network/omni/octane/evmengine/types"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/cometbft/cometbft/types"
+
+	db "github.com/cosmos/cosmos-db"
+	gtypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	ctx, err := log.Init(context.Background(), log.Config{Color: log.ColorForce, Level: "debug", Format: log.FormatConsole})
+	require.NoError(t, err)
+
+	startCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cfg := setupSimnet(t)
+	cfg.Config.BackendType = string(db.GoLevelDBBackend) // Export reads the application state from disk.
+
+	// Start the server async
+	async, stopfunc, err := haloapp.Start(startCtx, cfg)
+	require.NoError(t, err)
+	go func() {
+		tutil.RequireNoError(t, <-async)
+	}()
+
+	// Connect to the server.
+	cl, err := rpchttp.New(cfg.Comet.RPC.ListenAddress, "/websocket")
+	require.NoError(t, err)
+
+	genSet, err := cl.Validators(ctx, int64Ptr(1), nil, nil)
+	require.NoError(t, err)
+	getSetHash := types.NewValidatorSet(genSet.Validators).Hash()
+
+	// Wait for cometBFT validator set to change, since export requires the latest validator set to be active.
+	var height int64
+	require.Eventually(t, func() bool {
+		set, err := cl.Validators(ctx, nil, nil, nil)
+		require.NoError(t, err)
+		height = set.BlockHeight
+
+		return !bytes.Equal(getSetHash, types.NewValidatorSet(set.Validators).Hash())
+	}, time.Second*20, time.Millisecond*100)
+
+	// Stop the server, with a fresh context
+	cancel()
+	require.NoError(t, stopfunc(context.Background()))
+
+	output := filepath.Join(t.TempDir(), "genesis.json")
+	err = haloapp.Export(ctx, cfg, haloapp.ExportConfig{Output: output, ChainID: "exported"})
+	require.NoError(t, err)
+
+	appGen, err := gtypes.AppGenesisFromFile(output)
+	require.NoError(t, err)
+	require.NoError(t, appGen.ValidateAndComplete())
+	require.Equal(t, "exported", appGen.ChainID)
+	require.GreaterOrEqual(t, appGen.InitialHeight, height)
+	require.Len(t, appGen.Consensus.Validators, 1)
+
+	var genState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(appGen.AppState, &genState))
+
+	// Ensure the exported ORM module states are included.
+	for _, module := range []string{"attest", "evmengine", "portal", "registry", "valsync"} {
+		var state struct {
+			Tables []byte `json:"tables"`
+		}
+		require.NoError(t, json.Unmarshal(genState[module], &state), module)
+		require.NotEmpty(t, state.Tables, module)
+	}
+
+	var evmengGenesis struct {
+		ExecutionBlockHash []byte `json:"execution_block_hash"`
+	}
+	require.NoError(t, json.Unmarshal(genState[evmengtypes.ModuleName], &evmengGenesis))
+	require.Len(t, evmengGenesis.ExecutionBlockHash, 32)
+}
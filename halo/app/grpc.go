@@ -0,0 +1,92 @@
+package app
+
+import (
+	"context"
+	"net"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+)
+
+// startGRPCServer starts the halo gRPC query server if configured.
+// It serves all query services registered on the app's GRPCQueryRouter, i.e., the same services
+// exposed via cometBFT ABCI queries. Historical heights are supported via the `x-cosmos-block-height` header.
+//
+// It returns a stop function which gracefully stops the server. Serve errors are sent to the async channel.
+func startGRPCServer(ctx context.Context, cfg Config, app *App, async chan<- error) (func(), error) {
+	if cfg.GRPCAddress == "" {
+		return func() {}, nil
+	}
+
+	clientCtx := client.Context{}.
+		WithInterfaceRegistry(app.interfaceRegistry).
+		WithTxConfig(app.txConfig).
+		WithCodec(app.appCodec).
+		WithChainID(app.ChainID())
+
+	grpcCfg := srvconfig.DefaultConfig().GRPC
+	grpcCfg.Address = cfg.GRPCAddress
+
+	srv, err := servergrpc.NewGRPCServer(clientCtx, app, grpcCfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "new grpc server")
+	}
+
+	lis, err := net.Listen("tcp", cfg.GRPCAddress)
+	if err != nil {
+		return nil, errors.Wrap(err, "listen grpc", "address", cfg.GRPCAddress)
+	}
+
+	log.Info(ctx, "Starting gRPC query server", "address", lis.Addr().String())
+
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			async <- errors.Wrap(err, "serve grpc")
+		}
+	}()
+
+	return srv.GracefulStop, nil
+}
+
+// startAPIServer starts the halo REST API server if configured.
+// It serves the gRPC-gateway REST endpoints of all modules, proxying requests as ABCI queries via the provided cometBFT client.
+//
+// It returns a stop function which stops the server. Serve errors are sent to the async channel.
+func startAPIServer(ctx context.Context, cfg Config, app *App, cmtCl rpcclient.Client, async chan<- error) func() {
+	if cfg.APIAddress == "" {
+		return func() {}
+	}
+
+	clientCtx := client.Context{}.
+		WithInterfaceRegistry(app.interfaceRegistry).
+		WithTxConfig(app.txConfig).
+		WithCodec(app.appCodec).
+		WithChainID(app.ChainID()).
+		WithClient(cmtCl)
+
+	srvCfg := srvconfig.DefaultConfig()
+	srvCfg.API.Enable = true
+	srvCfg.API.Address = "tcp://" + cfg.APIAddress
+	srvCfg.GRPCWeb.Enable = false // gRPC-web wraps a gRPC server, but REST queries are proxied via ABCI.
+
+	srv := api.New(clientCtx, newSDKLogger(ctx), nil)
+	app.RegisterAPIRoutes(srv, srvCfg.API)
+
+	log.Info(ctx, "Starting REST API server", "address", cfg.APIAddress)
+
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		if err := srv.Start(ctx, *srvCfg); err != nil {
+			async <- errors.Wrap(err, "serve api")
+		}
+	}()
+
+	return cancel
+}
@@ -22,6 +22,8 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 
+	dbm "github.com/cosmos/cosmos-db"
+
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -42,6 +44,7 @@ type voterLoader struct {
 	proposed   []*atypes.AttestHeader
 	committed  []*atypes.AttestHeader
 	lastValSet *vtypes.ValidatorSetResponse
+	db         dbm.DB
 	isVal      bool
 	localAddr  common.Address
 }
@@ -68,7 +71,8 @@ func (l *voterLoader) LazyLoad(
 	endpoints xchain.RPCEndpoints,
 	cprov cprovider.Provider,
 	privKey crypto.PrivKey,
-	voterStateFile string,
+	voterStateDir string,
+	legacyStateFile string,
 	cmtAPI comet.API,
 ) error {
 	if len(endpoints) == 0 {
@@ -151,14 +155,22 @@ func (l *voterLoader) LazyLoad(
 		Provider: cprov,
 	}
 
-	v, err := voter.LoadVoter(privKey, voterStateFile, xprov, deps, network)
+	db, err := voter.OpenState(voterStateDir, legacyStateFile)
+	if err != nil {
+		return errors.Wrap(err, "open voter state")
+	}
+
+	v, err := voter.LoadVoter(privKey, db, xprov, deps, network)
 	if err != nil {
+		_ = db.Close()
 		return errors.Wrap(err, "create voter")
 	}
 
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
+	l.db = db
+
 	// Process all cached values
 	if err := v.SetProposed(l.proposed); err != nil {
 		return errors.Wrap(err, "set cached proposed")
@@ -270,9 +282,19 @@ func (l *voterLoader) UpdateValidatorSet(valset *vtypes.ValidatorSetResponse) er
 	return nil
 }
 
+// WaitDone waits for the voter to stop and then closes its state database.
 func (l *voterLoader) WaitDone() {
-	if v, ok := l.getVoter(); ok {
-		v.WaitDone()
+	v, ok := l.getVoter()
+	if !ok {
 		return
 	}
+
+	v.WaitDone()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.db.Close(); err != nil {
+		log.Warn(context.Background(), "Failed closing voter state db", err)
+	}
 }
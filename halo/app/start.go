@@ -5,6 +5,9 @@ import (
 	"os"
 	"time"
 
+	"github.com/omni-network/omni/halo/attest/archive"
+	atypes "github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/halo/attest/voter/remote"
 	"github.com/omni-network/omni/halo/comet"
 	halocfg "github.com/omni-network/omni/halo/config"
 	"github.com/omni-network/omni/halo/genutil/genserve"
@@ -12,6 +15,7 @@ import (
 	cprovider "github.com/omni-network/omni/lib/cchain/provider"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/expbackoff"
 	"github.com/omni-network/omni/lib/log"
 	"github.com/omni-network/omni/lib/netconf"
 	"github.com/omni-network/omni/lib/tracer"
@@ -40,6 +44,7 @@ import (
 	sdktelemetry "github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 // Config wraps the halo (app) and comet (client) configurations.
@@ -129,12 +134,17 @@ func Start(ctx context.Context, cfg Config) (<-chan error, func(context.Context)
 		return nil, nil, err
 	}
 
+	appVoter, closeVoter, err := maybeRemoteVoter(ctx, cfg, voter)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	//nolint:contextcheck // False positive
 	app, err := newApp(
 		newSDKLogger(ctx),
 		db,
 		engineCl,
-		voter,
+		appVoter,
 		netconf.ChainVersionNamer(cfg.Network),
 		netconf.ChainNamer(cfg.Network),
 		burnEVMFees{},
@@ -152,6 +162,13 @@ func Start(ctx context.Context, cfg Config) (<-chan error, func(context.Context)
 	app.EVMEngKeeper.SetBuildDelay(cfg.EVMBuildDelay)
 	app.EVMEngKeeper.SetBuildOptimistic(cfg.EVMBuildOptimistic)
 
+	app.EVMEngKeeper.SetPragueTime(evmPragueTime(cfg))
+
+	closeArchive, err := setAttestArchiver(ctx, cfg, app)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	cmtNode, err := newCometNode(ctx, &cfg.Comet, app, privVal)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "create comet node")
@@ -165,6 +182,10 @@ func Start(ctx context.Context, cfg Config) (<-chan error, func(context.Context)
 
 	async := make(chan error, 1)
 	go func() {
+		if cfg.VoterAddress != "" {
+			return // Remote voter sidecar loads itself.
+		}
+
 		err := voter.LazyLoad(
 			ctx,
 			cfg.Network,
@@ -172,6 +193,7 @@ func Start(ctx context.Context, cfg Config) (<-chan error, func(context.Context)
 			cfg.RPCEndpoints,
 			cProvider,
 			privVal.Key.PrivKey,
+			cfg.DataDir(),
 			cfg.VoterStateFile(),
 			cmtAPI,
 		)
@@ -186,6 +208,18 @@ func Start(ctx context.Context, cfg Config) (<-chan error, func(context.Context)
 		return nil, nil, errors.Wrap(err, "start comet node")
 	}
 
+	stopGRPC, err := startGRPCServer(ctx, cfg, app, async)
+	if err != nil {
+		if err := cmtNode.Stop(); err != nil {
+			log.Error(ctx, "Failed stopping comet node", err)
+		}
+		cmtNode.Wait()
+
+		return nil, nil, errors.Wrap(err, "start grpc server")
+	}
+
+	stopAPI := startAPIServer(ctx, cfg, app, rpcClient, async)
+
 	go monitorCometForever(ctx, cfg.Network, rpcClient, cmtNode.ConsensusReactor().WaitSync, cfg.DataDir())
 	go monitorEVMForever(ctx, cfg, engineCl)
 
@@ -194,12 +228,25 @@ func Start(ctx context.Context, cfg Config) (<-chan error, func(context.Context)
 	// And a fresh context should be passed into the stop function.
 	return async, func(ctx context.Context) error {
 		voter.WaitDone()
+		if err := closeVoter(); err != nil {
+			return errors.Wrap(err, "close remote voter")
+		}
+		stopAPI()
+		stopGRPC()
 
 		if err := cmtNode.Stop(); err != nil {
 			return errors.Wrap(err, "stop comet node")
 		}
 		cmtNode.Wait()
 
+		if err := closeArchive(); err != nil {
+			return errors.Wrap(err, "close attestation archive")
+		}
+
+		if err := app.Close(); err != nil {
+			return errors.Wrap(err, "close app")
+		}
+
 		// Note that cometBFT doesn't shut down cleanly. It leaves a bunch of goroutines running...
 
 		if err := stopTracer(ctx); err != nil {
@@ -212,6 +259,81 @@ func Start(ctx context.Context, cfg Config) (<-chan error, func(context.Context)
 	}, nil
 }
 
+// maybeRemoteVoter returns a remote voter client if configured, or the local lazy voter loader otherwise.
+// It returns a function to close the remote voter connection.
+func maybeRemoteVoter(ctx context.Context, cfg Config, local *voterLoader) (atypes.Voter, func() error, error) {
+	if cfg.VoterAddress == "" {
+		return local, func() error { return nil }, nil
+	}
+
+	token, err := remote.LoadToken(cfg.VoterAuthFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tlsCreds credentials.TransportCredentials
+	if cfg.VoterTLSCAFile != "" {
+		tlsCreds, err = remote.LoadClientTLS(cfg.VoterTLSCAFile)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	cl, err := remote.Dial(ctx, cfg.VoterAddress, token, tlsCreds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Ensure the sidecar votes with this validator's key, since votes of another key are rejected on-chain.
+	if err := verifyRemoteVoterAddress(ctx, cl, local.localAddr); err != nil {
+		_ = cl.Close()
+		return nil, nil, err
+	}
+
+	log.Info(ctx, "Using remote voter sidecar", "address", cfg.VoterAddress)
+
+	return cl, cl.Close, nil
+}
+
+// verifyRemoteVoterAddress returns an error if the remote voter's address doesn't match the expected
+// privval address. It waits a bounded period for the remote voter to become available.
+func verifyRemoteVoterAddress(ctx context.Context, cl *remote.Client, expected common.Address) error {
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	backoff := expbackoff.New(ctx, expbackoff.WithPeriodicConfig(time.Second))
+	for {
+		if addr := cl.LocalAddress(); addr == expected {
+			return nil
+		} else if addr != (common.Address{}) {
+			return errors.New("remote voter address mismatches validator address", "remote", addr, "expected", expected)
+		}
+
+		backoff()
+		if ctx.Err() != nil {
+			return errors.New("remote voter unavailable, ensure the voter sidecar is running", "timeout", timeout)
+		}
+	}
+}
+
+// setAttestArchiver sets the attestation archiver if enabled. It returns a function to close the archive.
+func setAttestArchiver(ctx context.Context, cfg Config, app *App) (func() error, error) {
+	if !cfg.AttestArchive {
+		return func() error { return nil }, nil
+	}
+
+	writer, err := archive.NewWriter(cfg.AttestArchiveDir(), archive.DefaultMaxFileSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "new attestation archive")
+	}
+
+	app.AttestKeeper.SetArchiver(writer)
+	log.Info(ctx, "Archiving approved attestations before pruning", "dir", cfg.AttestArchiveDir())
+
+	return writer.Close, nil
+}
+
 func newCometNode(ctx context.Context, cfg *cmtcfg.Config, app *App, privVal cmttypes.PrivValidator,
 ) (*node.Node, error) {
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
@@ -321,7 +443,22 @@ func newEngineClient(ctx context.Context, cfg Config, network netconf.ID, pubkey
 		return nil, errors.Wrap(err, "create engine client")
 	}
 
-	return engineCl, nil
+	if len(cfg.EngineSecondaries) == 0 {
+		return engineCl, nil
+	}
+
+	var secondaries []ethclient.EngineClient
+	for _, endpoint := range cfg.EngineSecondaries {
+		secondary, err := ethclient.NewAuthClient(ctx, endpoint, jwtBytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "create secondary engine client", "endpoint", endpoint)
+		}
+		secondaries = append(secondaries, secondary)
+	}
+
+	log.Info(ctx, "Using secondary execution clients for failover", "primary", cfg.EngineEndpoint, "secondaries", cfg.EngineSecondaries)
+
+	return ethclient.NewFailoverEngineClient(engineCl, secondaries...), nil
 }
 
 // enableSDKTelemetry enables prometheus based cosmos-sdk telemetry.
@@ -393,6 +530,30 @@ func registerGenesisServer(ctx context.Context, s grpc1.Server, cfg Config) erro
 	return nil
 }
 
+// executionGenesis returns the execution genesis JSON from the execution genesis file if it exists,
+// or from the static network config otherwise. It returns empty bytes if neither are defined.
+func executionGenesis(cfg Config) ([]byte, error) {
+	execution, err := os.ReadFile(cfg.ExecutionGenesisFile())
+	if os.IsNotExist(err) {
+		return cfg.Network.Static().ExecutionGenesisJSON, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "read execution genesis file")
+	}
+
+	return execution, nil
+}
+
+// evmPragueTime returns the EVM Prague fork timestamp or nil if not scheduled.
+// The configured override takes precedence over the network's static config.
+func evmPragueTime(cfg Config) *uint64 {
+	if cfg.EVMPragueTime != 0 {
+		t := cfg.EVMPragueTime
+		return &t
+	}
+
+	return cfg.Network.Static().ExecutionPragueTime
+}
+
 var _ sdkservertypes.AppOptions = serverAppOpts{}
 
 // serverAppOpts implements the cosmos-sdk server app options interface.
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	haloapp "github.com/omni-network/omni/halo/app"
+	"github.com/omni-network/omni/halo/app/upgrades"
 	halocmd "github.com/omni-network/omni/halo/cmd"
 	halocfg "github.com/omni-network/omni/halo/config"
 	cprovider "github.com/omni-network/omni/lib/cchain/provider"
@@ -21,6 +22,7 @@ import (
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	"github.com/cometbft/cometbft/types"
 
+	utypes "cosmossdk.io/x/upgrade/types"
 	db "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 )
@@ -60,6 +62,7 @@ func TestSmoke(t *testing.T) {
 	}, time.Second*time.Duration(target*2), time.Millisecond*100)
 
 	testCProvider(t, ctx, cprov)
+	testGenesisUpgrades(t, ctx, cl)
 
 	genSet, err := cl.Validators(ctx, int64Ptr(1), nil, nil)
 	require.NoError(t, err)
@@ -140,6 +143,24 @@ func testCProvider(t *testing.T, ctx context.Context, cprov cprovider.Provider)
 	}, time.Second*5, time.Millisecond*100)
 }
 
+// testGenesisUpgrades ensures all network upgrades are applied at genesis of new networks.
+func testGenesisUpgrades(t *testing.T, ctx context.Context, cl *rpchttp.HTTP) {
+	t.Helper()
+
+	for _, name := range upgrades.All() {
+		req, err := (&utypes.QueryAppliedPlanRequest{Name: name}).Marshal()
+		require.NoError(t, err)
+
+		resp, err := cl.ABCIQuery(ctx, "/cosmos.upgrade.v1beta1.Query/AppliedPlan", req)
+		require.NoError(t, err)
+		require.True(t, resp.Response.IsOK(), resp.Response.Log)
+
+		var applied utypes.QueryAppliedPlanResponse
+		require.NoError(t, applied.Unmarshal(resp.Response.Value))
+		require.EqualValues(t, 1, applied.Height, name)
+	}
+}
+
 func setupSimnet(t *testing.T) haloapp.Config {
 	t.Helper()
 	homeDir := t.TempDir()
@@ -0,0 +1,84 @@
+package app
+
+import (
+	"context"
+
+	"github.com/omni-network/omni/halo/app/upgrades"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/netconf"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// setUpgradeHandlers registers the handlers of all network upgrades supported by this binary.
+func (a *App) setUpgradeHandlers() {
+	handlers := map[string]upgradetypes.UpgradeHandler{
+		upgrades.V1: a.upgradeV1,
+	}
+
+	for _, name := range upgrades.All() {
+		a.UpgradeKeeper.SetUpgradeHandler(name, handlers[name])
+	}
+}
+
+// upgradeV1 migrates the module states for the V1 network upgrade.
+// Like all upgrade handlers, it must be idempotent, since it is also applied at genesis, see initChainer.
+func (a *App) upgradeV1(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+	if err := a.AttestKeeper.MigrateV1(ctx); err != nil {
+		return nil, errors.Wrap(err, "migrate attest")
+	}
+
+	if err := a.StakingEventProc.MigrateV1(ctx); err != nil {
+		return nil, errors.Wrap(err, "migrate evmstaking")
+	}
+
+	return fromVM, nil
+}
+
+// initChainer wraps the module manager InitChainer, applying all network upgrades at genesis if required.
+func (a *App) initChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
+	resp, err := a.InitChainer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !applyUpgradesAtGenesis(req) {
+		return resp, nil
+	}
+
+	// Upgrades are marked as done at the header height, which isn't populated during InitChain.
+	height := max(req.InitialHeight, 1)
+	headerInfo := ctx.HeaderInfo()
+	headerInfo.Height = height
+	ctx = ctx.WithHeaderInfo(headerInfo)
+
+	for _, name := range upgrades.All() {
+		plan := upgradetypes.Plan{Name: name, Height: height}
+		if err := a.UpgradeKeeper.ApplyUpgrade(ctx, plan); err != nil {
+			return nil, errors.Wrap(err, "apply genesis upgrade", "upgrade", name)
+		}
+	}
+
+	return resp, nil
+}
+
+// applyUpgradesAtGenesis returns true if all network upgrades must be applied at genesis.
+// This is the case for new networks and networks restarted from an exported genesis.
+// Protected networks starting from their original genesis replay upgrades at the heights they were scheduled.
+func applyUpgradesAtGenesis(req *abci.RequestInitChain) bool {
+	if req.InitialHeight > 1 {
+		return true
+	}
+
+	for _, network := range []netconf.ID{netconf.Omega, netconf.Mainnet} {
+		if req.ChainId == network.Static().OmniConsensusChainIDStr() {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,40 @@
+// Package upgrades defines the named network upgrades supported by halo.
+//
+// Network upgrades are scheduled on protected networks via the Upgrade predeploy (see evmupgrade)
+// and are applied at genesis of new networks. Scheduled upgrades follow the x/upgrade model:
+// nodes halt at the upgrade height and are restarted with a binary supporting the upgrade,
+// so new consensus-breaking behaviour (and new state) is introduced by the binary swap at
+// that height, and historical blocks must be synced with the previous binary.
+//
+// IsActive is only required where behaviour must remain disabled until after the upgrade handler
+// ran, e.g., when it depends on state migrated by the upgrade handler.
+package upgrades
+
+import (
+	"context"
+
+	"github.com/omni-network/omni/lib/errors"
+)
+
+// V1 is the first halo network upgrade.
+const V1 = "v1"
+
+// All returns the names of all network upgrades supported by this binary, in order.
+func All() []string {
+	return []string{V1}
+}
+
+// Keeper is the subset of the x/upgrade keeper required to query upgrade activation.
+type Keeper interface {
+	GetDoneHeight(ctx context.Context, name string) (int64, error)
+}
+
+// IsActive returns true if the named network upgrade has been applied.
+func IsActive(ctx context.Context, k Keeper, name string) (bool, error) {
+	height, err := k.GetDoneHeight(ctx, name)
+	if err != nil {
+		return false, errors.Wrap(err, "get upgrade done height", "upgrade", name)
+	}
+
+	return height > 0, nil
+}
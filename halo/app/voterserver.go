@@ -0,0 +1,197 @@
+package app
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/omni-network/omni/halo/attest/voter"
+	"github.com/omni-network/omni/halo/attest/voter/remote"
+	"github.com/omni-network/omni/halo/comet"
+	cprovider "github.com/omni-network/omni/lib/cchain/provider"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/xchain"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	voterPrivKeyFile = "priv_validator_key.json"
+	voterAuthFile    = "voter_auth_token"
+	voterStateFile   = "voter_state.json"
+)
+
+// DefaultVoterConfig returns the default voter sidecar config.
+func DefaultVoterConfig() VoterConfig {
+	return VoterConfig{
+		HomeDir:       "./voter",
+		ListenAddress: "127.0.0.1:26660",
+		HaloRPC:       "http://127.0.0.1:26657",
+		OmniEVMRPC:    "http://127.0.0.1:8545",
+	}
+}
+
+// VoterConfig is the config for running the voter as a separate sidecar process.
+type VoterConfig struct {
+	HomeDir       string
+	Network       netconf.ID
+	RPCEndpoints  xchain.RPCEndpoints
+	ListenAddress string // gRPC address that halo connects to, either TCP host:port or unix:///path.
+	TLSCertFile   string // TLS certificate, required if ListenAddress isn't local.
+	TLSKeyFile    string // TLS private key, required if ListenAddress isn't local.
+	HaloRPC       string // CometBFT RPC address of the halo node.
+	OmniEVMRPC    string // RPC address of the omni execution client.
+}
+
+// PrivKeyFile returns the path to the voter's CometBFT privval key file.
+func (c VoterConfig) PrivKeyFile() string {
+	return filepath.Join(c.HomeDir, voterPrivKeyFile)
+}
+
+// AuthFile returns the path to the auth token file shared with halo.
+func (c VoterConfig) AuthFile() string {
+	return filepath.Join(c.HomeDir, voterAuthFile)
+}
+
+// StateFile returns the path to the legacy voter state file.
+// It is migrated to the voter state database in the home directory on startup.
+func (c VoterConfig) StateFile() string {
+	return filepath.Join(c.HomeDir, voterStateFile)
+}
+
+func (c VoterConfig) Verify() error {
+	if c.Network == "" {
+		return errors.New("flag --network is empty")
+	} else if err := c.Network.Verify(); err != nil {
+		return err
+	} else if c.ListenAddress == "" {
+		return errors.New("flag --listen-address is empty")
+	} else if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		return errors.New("flags --tls-cert-file and --tls-key-file must be set together")
+	} else if c.TLSCertFile == "" && !remote.IsLocal(c.ListenAddress) {
+		return errors.New("flag --tls-cert-file required for non-local --listen-address, use a loopback address or unix socket otherwise")
+	} else if c.HaloRPC == "" {
+		return errors.New("flag --halo-rpc is empty")
+	} else if c.OmniEVMRPC == "" {
+		return errors.New("flag --omni-evm-rpc is empty")
+	}
+
+	return nil
+}
+
+// RunVoter runs the voter as a sidecar process until the context is canceled.
+// It serves the voter to halo via an authenticated gRPC API. The voter is lazy loaded
+// from the halo node's on-chain registry, identical to the voter running inside halo.
+func RunVoter(ctx context.Context, cfg VoterConfig) error {
+	log.Info(ctx, "Starting voter sidecar")
+
+	if err := cfg.Verify(); err != nil {
+		return errors.Wrap(err, "verify voter config")
+	}
+
+	privKey, err := LoadCometFilePV(cfg.PrivKeyFile())
+	if err != nil {
+		return errors.Wrap(err, "load voter key")
+	}
+
+	token, err := remote.LoadOrGenerateToken(cfg.AuthFile())
+	if err != nil {
+		return err
+	}
+
+	if err := initVoterState(ctx, cfg); err != nil {
+		return err
+	}
+
+	cmtCl, err := rpchttp.New(cfg.HaloRPC, "/websocket")
+	if err != nil {
+		return errors.Wrap(err, "new halo rpc client")
+	}
+
+	omniEVMCl, err := ethclient.Dial("omni_evm", cfg.OmniEVMRPC)
+	if err != nil {
+		return err
+	}
+
+	voter, err := newVoterLoader(privKey)
+	if err != nil {
+		return err
+	}
+
+	var tlsCreds credentials.TransportCredentials
+	if cfg.TLSCertFile != "" {
+		tlsCreds, err = remote.LoadServerTLS(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	lis, err := remote.Listen(cfg.ListenAddress)
+	if err != nil {
+		return err
+	}
+
+	srv := remote.NewServer(voter, token, tlsCreds)
+
+	async := make(chan error, 2)
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			async <- errors.Wrap(err, "serve voter grpc")
+		}
+	}()
+
+	go func() {
+		err := voter.LazyLoad(
+			ctx,
+			cfg.Network,
+			omniEVMCl,
+			cfg.RPCEndpoints,
+			cprovider.NewABCIProvider(cmtCl, cfg.Network, netconf.ChainVersionNamer(cfg.Network)),
+			privKey,
+			cfg.HomeDir,
+			cfg.StateFile(),
+			comet.NewAPI(cmtCl),
+		)
+		if err != nil {
+			async <- err
+		}
+	}()
+
+	log.Info(ctx, "Serving voter gRPC API", "listen", lis.Addr().String(), "tls", tlsCreds != nil, "address", voter.localAddr)
+
+	var runErr error
+	select {
+	case <-ctx.Done():
+		log.Info(ctx, "Shutdown detected, stopping...")
+	case runErr = <-async:
+	}
+
+	srv.GracefulStop()
+	voter.WaitDone()
+
+	return runErr
+}
+
+// initVoterState generates an empty voter state database in the home directory
+// if neither it nor the legacy voter state file exists, similar to 'halo init' for halo.
+func initVoterState(ctx context.Context, cfg VoterConfig) error {
+	if dbm.FileExists(cfg.StateFile()) {
+		log.Info(ctx, "Found legacy voter state file, will be migrated", "path", cfg.StateFile())
+		return nil
+	} else if voter.StateDBExists(cfg.HomeDir) {
+		return nil
+	}
+
+	if err := voter.GenEmptyState(cfg.HomeDir); err != nil {
+		return err
+	}
+
+	log.Info(ctx, "Generated voter state database", "dir", cfg.HomeDir)
+
+	return nil
+}
@@ -0,0 +1,287 @@
+// Package archive provides a node-local append-only archive of approved attestations.
+// Attestations are written to rolling JSONL files before they are pruned from state,
+// so history can be exported to indexers and audited after it is gone from the chain.
+package archive
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/k1util"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	filePrefix = "attestations-"
+	fileSuffix = ".jsonl"
+
+	// DefaultMaxFileSize is the default size after which a new archive file is started.
+	DefaultMaxFileSize = 64 << 20 // 64MiB
+
+	// maxLineSize is the maximum size of a single JSONL record.
+	maxLineSize = 16 << 20 // 16MiB
+)
+
+// Record is a single archived attestation.
+type Record struct {
+	// Height is the consensus chain height at which the attestation was archived (pruned).
+	Height      uint64             `json:"height"`
+	Attestation *types.Attestation `json:"attestation"`
+}
+
+// Key uniquely identifies the attestation of the record.
+func (r Record) Key() Key {
+	return Key{
+		ChainVersion: r.Attestation.AttestHeader.XChainVersion(),
+		AttestOffset: r.Attestation.AttestHeader.GetAttestOffset(),
+	}
+}
+
+// Verify returns an error if the attestation is invalid, any of its signatures is not valid,
+// or its signers don't have quorum power of the validator set that approved it.
+// The provided powers must be those of the validator set with the attestation's ValidatorSetId.
+func (r Record) Verify(powers map[common.Address]int64) error {
+	if err := r.Attestation.Verify(); err != nil {
+		return err
+	}
+
+	attRoot, err := r.Attestation.AttestationRoot()
+	if err != nil {
+		return err
+	}
+
+	var total, signed int64
+	for _, power := range powers {
+		total += power
+	}
+
+	signers := make(map[common.Address]bool)
+	for _, sig := range r.Attestation.GetSignatures() {
+		addr := common.Address(sig.ValidatorAddress)
+		if signers[addr] {
+			return errors.New("duplicate attestation signature", "validator", addr)
+		}
+		signers[addr] = true
+
+		power, ok := powers[addr]
+		if !ok {
+			return errors.New("attestation signer not in validator set", "validator", addr, "valset_id", r.Attestation.GetValidatorSetId())
+		}
+
+		ok, err := k1util.Verify(addr, attRoot, xchain.Signature65(sig.Signature))
+		if err != nil {
+			return errors.Wrap(err, "verify signature")
+		} else if !ok {
+			return errors.New("invalid attestation signature", "validator", addr)
+		}
+
+		signed += power
+	}
+
+	// Quorum is more than two thirds of the total power, see keeper.ValSet.QuorumPower.
+	if signed < total*2/3+1 {
+		return errors.New("attestation signers without quorum", "signed", signed, "total", total, "valset_id", r.Attestation.GetValidatorSetId())
+	}
+
+	return nil
+}
+
+// Key uniquely identifies an attestation.
+type Key struct {
+	ChainVersion xchain.ChainVersion
+	AttestOffset uint64
+}
+
+// Writer appends records to rolling archive files in a directory.
+// It is safe for concurrent use.
+type Writer struct {
+	mu          sync.Mutex
+	dir         string
+	maxFileSize int64
+	file        *os.File
+	size        int64
+}
+
+// NewWriter returns a new writer appending to files in the provided directory.
+// A new file is started on first write and whenever the current file exceeds maxFileSize.
+func NewWriter(dir string, maxFileSize int64) (*Writer, error) {
+	if maxFileSize <= 0 {
+		return nil, errors.New("invalid max file size")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errors.Wrap(err, "create archive dir")
+	}
+
+	return &Writer{
+		dir:         dir,
+		maxFileSize: maxFileSize,
+	}, nil
+}
+
+// Archive appends the approved attestation pruned at the provided height.
+// It implements types.Archiver.
+func (w *Writer) Archive(_ context.Context, height uint64, att *types.Attestation) error {
+	return w.Write(Record{Height: height, Attestation: att})
+}
+
+// Write appends the record to the current archive file.
+func (w *Writer) Write(record Record) error {
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "marshal record")
+	}
+	bz = append(bz, '\n')
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil || w.size >= w.maxFileSize {
+		if err := w.rollUnsafe(); err != nil {
+			return err
+		}
+	}
+
+	n, err := w.file.Write(bz)
+	w.size += int64(n)
+	if err != nil {
+		return errors.Wrap(err, "write record")
+	}
+
+	return nil
+}
+
+// Close closes the current archive file.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return nil
+	}
+
+	err := w.file.Close()
+	w.file = nil
+	if err != nil {
+		return errors.Wrap(err, "close file")
+	}
+
+	return nil
+}
+
+// rollUnsafe closes the current file (if any) and starts a new one.
+// It is unsafe since it assumes the lock is held.
+func (w *Writer) rollUnsafe() error {
+	if w.file != nil {
+		if err := w.file.Close(); err != nil {
+			return errors.Wrap(err, "close file")
+		}
+	}
+
+	// Use a sortable timestamp so files are read in the order they were written.
+	name := filePrefix + time.Now().UTC().Format("20060102T150405.000000000") + fileSuffix
+	file, err := os.OpenFile(filepath.Join(w.dir, name), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return errors.Wrap(err, "open file")
+	}
+
+	w.file = file
+	w.size = 0
+
+	return nil
+}
+
+// Files returns the archive files in the provided directory in the order they were written.
+func Files(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "read archive dir")
+	}
+
+	var resp []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, filePrefix) || !strings.HasSuffix(name, fileSuffix) {
+			continue
+		}
+		resp = append(resp, filepath.Join(dir, name))
+	}
+
+	sort.Strings(resp)
+
+	return resp, nil
+}
+
+// ReadDir calls fn for each unique record in all archive files in the provided directory.
+// Duplicate records (e.g. due to re-executed blocks after a crash) are skipped.
+func ReadDir(dir string, fn func(Record) error) error {
+	files, err := Files(dir)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[Key]bool)
+	for _, file := range files {
+		err := ReadFile(file, func(record Record) error {
+			if seen[record.Key()] {
+				return nil
+			}
+			seen[record.Key()] = true
+
+			return fn(record)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReadFile calls fn for each record in the provided JSONL file.
+func ReadFile(file string, fn func(Record) error) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return errors.Wrap(err, "open file")
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, maxLineSize)
+
+	var line int
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return errors.Wrap(err, "unmarshal record", "file", file, "line", line)
+		} else if record.Attestation.GetAttestHeader() == nil {
+			return errors.New("missing attestation", "file", file, "line", line)
+		}
+
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return errors.Wrap(err, "scan file", "file", file)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package archive_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/omni-network/omni/halo/attest/archive"
+	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/k1util"
+
+	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchive(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	key := k1.GenPrivKey()
+	addr, err := k1util.PubKeyToAddress(key.PubKey())
+	require.NoError(t, err)
+	powers := map[common.Address]int64{addr: 10}
+
+	// Use a tiny max file size to roll files on each write.
+	w, err := archive.NewWriter(dir, 1)
+	require.NoError(t, err)
+
+	const chainID = 100
+	var expected []archive.Record
+	for offset := uint64(1); offset <= 3; offset++ {
+		record := archive.Record{Height: offset * 10, Attestation: signedAtt(t, key, chainID, offset)}
+		require.NoError(t, record.Verify(powers))
+		require.NoError(t, w.Archive(context.Background(), record.Height, record.Attestation))
+		expected = append(expected, record)
+	}
+
+	// Duplicate records (e.g. re-executed blocks) are skipped when reading.
+	require.NoError(t, w.Write(expected[0]))
+	require.NoError(t, w.Close())
+
+	files, err := archive.Files(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 4)
+
+	var actual []archive.Record
+	require.NoError(t, archive.ReadDir(dir, func(record archive.Record) error {
+		actual = append(actual, record)
+		return nil
+	}))
+	require.Equal(t, expected, actual)
+	require.Equal(t, archive.Key{ChainVersion: expected[0].Attestation.AttestHeader.XChainVersion(), AttestOffset: 1}, actual[0].Key())
+
+	// Invalid signatures fail verification.
+	invalid := signedAtt(t, key, chainID, 1)
+	invalid.MsgRoot = common.HexToHash("0x02").Bytes()
+	require.ErrorContains(t, archive.Record{Attestation: invalid}.Verify(powers), "invalid attestation signature")
+
+	// Signers must be in the validator set.
+	other := common.HexToAddress("0x04")
+	require.ErrorContains(t, expected[0].Verify(map[common.Address]int64{other: 10}), "signer not in validator set")
+
+	// Signers must have quorum power of the validator set.
+	require.ErrorContains(t, expected[0].Verify(map[common.Address]int64{addr: 10, other: 10}), "without quorum")
+	require.NoError(t, expected[0].Verify(map[common.Address]int64{addr: 10, other: 4}))
+
+	// Duplicate signatures are rejected.
+	duplicate := signedAtt(t, key, chainID, 1)
+	duplicate.Signatures = append(duplicate.Signatures, duplicate.Signatures[0])
+	require.ErrorContains(t, archive.Record{Attestation: duplicate}.Verify(powers), "duplicate attestation signature")
+}
+
+func signedAtt(t *testing.T, key k1.PrivKey, chainID uint64, offset uint64) *types.Attestation {
+	t.Helper()
+
+	addr, err := k1util.PubKeyToAddress(key.PubKey())
+	require.NoError(t, err)
+
+	att := &types.Attestation{
+		AttestHeader: &types.AttestHeader{
+			ConsensusChainId: 1,
+			SourceChainId:    chainID,
+			ConfLevel:        1,
+			AttestOffset:     offset,
+		},
+		BlockHeader: &types.BlockHeader{
+			ChainId:     chainID,
+			BlockHeight: offset,
+			BlockHash:   common.HexToHash("0x03").Bytes(),
+		},
+		ValidatorSetId: 1,
+		MsgRoot:        common.HexToHash("0x01").Bytes(),
+	}
+
+	root, err := att.AttestationRoot()
+	require.NoError(t, err)
+
+	sig, err := k1util.Sign(key, root)
+	require.NoError(t, err)
+
+	att.Signatures = []*types.SigTuple{{ValidatorAddress: addr.Bytes(), Signature: sig[:]}}
+
+	return att
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/omni-network/omni/halo/attest/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestKeeper_Archive(t *testing.T) {
+	t.Parallel()
+
+	valset := newValSet(1, val1, val2)
+
+	k, ctx := setupKeeper(t, mockDefaultExpectations, trimBehindCalled(), noFuzzyDeps(), func(_ sdk.Context, m mocks) {
+		m.valProvider.EXPECT().ValidatorSet(gomock.Any(), gomock.Any()).Return(valset, nil).AnyTimes()
+	})
+
+	archiver := new(testArchiver)
+	k.SetArchiver(archiver)
+
+	// Offsets 1 and 2 are approved, offset 3 remains pending with only val1's signature.
+	vote1 := defaultAggVote().Vote()
+	vote2 := defaultAggVote().WithAttestOfset(defaultOffset + 1).WithBlockHeight(defaultHeight + 1).Vote()
+	vote3 := defaultAggVote().WithAttestOfset(defaultOffset + 2).WithBlockHeight(defaultHeight + 2).WithSignatures(sigsTuples(val1)...).Vote()
+	require.NoError(t, k.Add(ctx, defaultMsg().WithVotes(vote1, vote2, vote3).Msg()))
+	require.NoError(t, k.Approve(ctx, toValSet(valset)))
+
+	// Only offset 1 is deleted (not the latest approved), so only it is archived.
+	deleteHeight := ctx.BlockHeight() + trimLag + 1
+	require.NoError(t, k.BeginBlock(ctx.WithBlockHeight(deleteHeight)))
+
+	require.Len(t, archiver.atts, 1)
+	require.EqualValues(t, deleteHeight, archiver.heights[0])
+
+	att := archiver.atts[0]
+	require.Equal(t, defaultOffset, att.GetAttestHeader().GetAttestOffset())
+	require.Len(t, att.GetSignatures(), 2)
+
+	root, err := att.AttestationRoot()
+	require.NoError(t, err)
+	expected, err := vote1.AttestationRoot()
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+}
+
+func TestKeeper_DeleteLimit(t *testing.T) {
+	t.Parallel()
+
+	valset := newValSet(1, val1, val2)
+
+	k, ctx := setupKeeper(t, mockDefaultExpectations, trimBehindCalled(), func(_ sdk.Context, m mocks) {
+		m.registry.EXPECT().ConfLevels(gomock.Any()).Return(nil, nil).Times(3)
+		m.valProvider.EXPECT().ValidatorSet(gomock.Any(), gomock.Any()).Return(valset, nil).AnyTimes()
+	})
+
+	archiver := new(testArchiver)
+	k.SetArchiver(archiver)
+	k.SetDeleteLimit(2)
+
+	// Offsets 1-5 are approved, so offsets 1-4 can be deleted.
+	var votes []*types.AggVote
+	for i := uint64(0); i < 5; i++ {
+		votes = append(votes, defaultAggVote().WithAttestOfset(defaultOffset+i).WithBlockHeight(defaultHeight+i).Vote())
+	}
+	require.NoError(t, k.Add(ctx, defaultMsg().WithVotes(votes...).Msg()))
+	require.NoError(t, k.Approve(ctx, toValSet(valset)))
+
+	// Deletes are limited per block, so the backlog is deleted over subsequent blocks, oldest first.
+	deleteHeight := ctx.BlockHeight() + trimLag + 1
+	for i, expected := range []int{2, 4, 4} {
+		require.NoError(t, k.BeginBlock(ctx.WithBlockHeight(deleteHeight+int64(i))))
+		require.Len(t, archiver.atts, expected)
+	}
+
+	for i, att := range archiver.atts {
+		require.Equal(t, defaultOffset+uint64(i), att.GetAttestHeader().GetAttestOffset())
+	}
+}
+
+type testArchiver struct {
+	heights []uint64
+	atts    []*types.Attestation
+}
+
+func (a *testArchiver) Archive(_ context.Context, height uint64, att *types.Attestation) error {
+	a.heights = append(a.heights, height)
+	a.atts = append(a.atts, att)
+
+	return nil
+}
@@ -116,6 +116,29 @@ func (this AttestationCreatedHeightIndexKey) WithCreatedHeight(created_height ui
 	return this
 }
 
+type AttestationChainIdConfLevelBlockHeightIndexKey struct {
+	vs []interface{}
+}
+
+func (x AttestationChainIdConfLevelBlockHeightIndexKey) id() uint32            { return 4 }
+func (x AttestationChainIdConfLevelBlockHeightIndexKey) values() []interface{} { return x.vs }
+func (x AttestationChainIdConfLevelBlockHeightIndexKey) attestationIndexKey()  {}
+
+func (this AttestationChainIdConfLevelBlockHeightIndexKey) WithChainId(chain_id uint64) AttestationChainIdConfLevelBlockHeightIndexKey {
+	this.vs = []interface{}{chain_id}
+	return this
+}
+
+func (this AttestationChainIdConfLevelBlockHeightIndexKey) WithChainIdConfLevel(chain_id uint64, conf_level uint32) AttestationChainIdConfLevelBlockHeightIndexKey {
+	this.vs = []interface{}{chain_id, conf_level}
+	return this
+}
+
+func (this AttestationChainIdConfLevelBlockHeightIndexKey) WithChainIdConfLevelBlockHeight(chain_id uint64, conf_level uint32, block_height uint64) AttestationChainIdConfLevelBlockHeightIndexKey {
+	this.vs = []interface{}{chain_id, conf_level, block_height}
+	return this
+}
+
 type attestationTable struct {
 	table ormtable.AutoIncrementTable
 }
@@ -436,37 +459,971 @@ func NewSignatureTable(db ormtable.Schema) (SignatureTable, error) {
 	return signatureTable{table.(ormtable.AutoIncrementTable)}, nil
 }
 
-type AttestationStore interface {
-	AttestationTable() AttestationTable
-	SignatureTable() SignatureTable
+type OffsetHeightTable interface {
+	Insert(ctx context.Context, offsetHeight *OffsetHeight) error
+	Update(ctx context.Context, offsetHeight *OffsetHeight) error
+	Save(ctx context.Context, offsetHeight *OffsetHeight) error
+	Delete(ctx context.Context, offsetHeight *OffsetHeight) error
+	Has(ctx context.Context, chain_id uint64, conf_level uint32, from_offset uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, chain_id uint64, conf_level uint32, from_offset uint64) (*OffsetHeight, error)
+	List(ctx context.Context, prefixKey OffsetHeightIndexKey, opts ...ormlist.Option) (OffsetHeightIterator, error)
+	ListRange(ctx context.Context, from, to OffsetHeightIndexKey, opts ...ormlist.Option) (OffsetHeightIterator, error)
+	DeleteBy(ctx context.Context, prefixKey OffsetHeightIndexKey) error
+	DeleteRange(ctx context.Context, from, to OffsetHeightIndexKey) error
 
 	doNotImplement()
 }
 
-type attestationStore struct {
-	attestation AttestationTable
-	signature   SignatureTable
+type OffsetHeightIterator struct {
+	ormtable.Iterator
 }
 
-func (x attestationStore) AttestationTable() AttestationTable {
-	return x.attestation
+func (i OffsetHeightIterator) Value() (*OffsetHeight, error) {
+	var offsetHeight OffsetHeight
+	err := i.UnmarshalMessage(&offsetHeight)
+	return &offsetHeight, err
 }
 
-func (x attestationStore) SignatureTable() SignatureTable {
-	return x.signature
+type OffsetHeightIndexKey interface {
+	id() uint32
+	values() []interface{}
+	offsetHeightIndexKey()
 }
 
-func (attestationStore) doNotImplement() {}
+// primary key starting index..
+type OffsetHeightPrimaryKey = OffsetHeightChainIdConfLevelFromOffsetIndexKey
 
-var _ AttestationStore = attestationStore{}
+type OffsetHeightChainIdConfLevelFromOffsetIndexKey struct {
+	vs []interface{}
+}
 
-func NewAttestationStore(db ormtable.Schema) (AttestationStore, error) {
-	attestationTable, err := NewAttestationTable(db)
+func (x OffsetHeightChainIdConfLevelFromOffsetIndexKey) id() uint32            { return 0 }
+func (x OffsetHeightChainIdConfLevelFromOffsetIndexKey) values() []interface{} { return x.vs }
+func (x OffsetHeightChainIdConfLevelFromOffsetIndexKey) offsetHeightIndexKey() {}
+
+func (this OffsetHeightChainIdConfLevelFromOffsetIndexKey) WithChainId(chain_id uint64) OffsetHeightChainIdConfLevelFromOffsetIndexKey {
+	this.vs = []interface{}{chain_id}
+	return this
+}
+
+func (this OffsetHeightChainIdConfLevelFromOffsetIndexKey) WithChainIdConfLevel(chain_id uint64, conf_level uint32) OffsetHeightChainIdConfLevelFromOffsetIndexKey {
+	this.vs = []interface{}{chain_id, conf_level}
+	return this
+}
+
+func (this OffsetHeightChainIdConfLevelFromOffsetIndexKey) WithChainIdConfLevelFromOffset(chain_id uint64, conf_level uint32, from_offset uint64) OffsetHeightChainIdConfLevelFromOffsetIndexKey {
+	this.vs = []interface{}{chain_id, conf_level, from_offset}
+	return this
+}
+
+type OffsetHeightApprovedHeightIndexKey struct {
+	vs []interface{}
+}
+
+func (x OffsetHeightApprovedHeightIndexKey) id() uint32            { return 1 }
+func (x OffsetHeightApprovedHeightIndexKey) values() []interface{} { return x.vs }
+func (x OffsetHeightApprovedHeightIndexKey) offsetHeightIndexKey() {}
+
+func (this OffsetHeightApprovedHeightIndexKey) WithApprovedHeight(approved_height uint64) OffsetHeightApprovedHeightIndexKey {
+	this.vs = []interface{}{approved_height}
+	return this
+}
+
+type offsetHeightTable struct {
+	table ormtable.Table
+}
+
+func (this offsetHeightTable) Insert(ctx context.Context, offsetHeight *OffsetHeight) error {
+	return this.table.Insert(ctx, offsetHeight)
+}
+
+func (this offsetHeightTable) Update(ctx context.Context, offsetHeight *OffsetHeight) error {
+	return this.table.Update(ctx, offsetHeight)
+}
+
+func (this offsetHeightTable) Save(ctx context.Context, offsetHeight *OffsetHeight) error {
+	return this.table.Save(ctx, offsetHeight)
+}
+
+func (this offsetHeightTable) Delete(ctx context.Context, offsetHeight *OffsetHeight) error {
+	return this.table.Delete(ctx, offsetHeight)
+}
+
+func (this offsetHeightTable) Has(ctx context.Context, chain_id uint64, conf_level uint32, from_offset uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, chain_id, conf_level, from_offset)
+}
+
+func (this offsetHeightTable) Get(ctx context.Context, chain_id uint64, conf_level uint32, from_offset uint64) (*OffsetHeight, error) {
+	var offsetHeight OffsetHeight
+	found, err := this.table.PrimaryKey().Get(ctx, &offsetHeight, chain_id, conf_level, from_offset)
 	if err != nil {
 		return nil, err
 	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &offsetHeight, nil
+}
 
-	signatureTable, err := NewSignatureTable(db)
+func (this offsetHeightTable) List(ctx context.Context, prefixKey OffsetHeightIndexKey, opts ...ormlist.Option) (OffsetHeightIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return OffsetHeightIterator{it}, err
+}
+
+func (this offsetHeightTable) ListRange(ctx context.Context, from, to OffsetHeightIndexKey, opts ...ormlist.Option) (OffsetHeightIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return OffsetHeightIterator{it}, err
+}
+
+func (this offsetHeightTable) DeleteBy(ctx context.Context, prefixKey OffsetHeightIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this offsetHeightTable) DeleteRange(ctx context.Context, from, to OffsetHeightIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this offsetHeightTable) doNotImplement() {}
+
+var _ OffsetHeightTable = offsetHeightTable{}
+
+func NewOffsetHeightTable(db ormtable.Schema) (OffsetHeightTable, error) {
+	table := db.GetTable(&OffsetHeight{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&OffsetHeight{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return offsetHeightTable{table}, nil
+}
+
+type VoteStatsTable interface {
+	Insert(ctx context.Context, voteStats *VoteStats) error
+	Update(ctx context.Context, voteStats *VoteStats) error
+	Save(ctx context.Context, voteStats *VoteStats) error
+	Delete(ctx context.Context, voteStats *VoteStats) error
+	Has(ctx context.Context, validator_address []byte, chain_id uint64, conf_level uint32, bucket uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, validator_address []byte, chain_id uint64, conf_level uint32, bucket uint64) (*VoteStats, error)
+	List(ctx context.Context, prefixKey VoteStatsIndexKey, opts ...ormlist.Option) (VoteStatsIterator, error)
+	ListRange(ctx context.Context, from, to VoteStatsIndexKey, opts ...ormlist.Option) (VoteStatsIterator, error)
+	DeleteBy(ctx context.Context, prefixKey VoteStatsIndexKey) error
+	DeleteRange(ctx context.Context, from, to VoteStatsIndexKey) error
+
+	doNotImplement()
+}
+
+type VoteStatsIterator struct {
+	ormtable.Iterator
+}
+
+func (i VoteStatsIterator) Value() (*VoteStats, error) {
+	var voteStats VoteStats
+	err := i.UnmarshalMessage(&voteStats)
+	return &voteStats, err
+}
+
+type VoteStatsIndexKey interface {
+	id() uint32
+	values() []interface{}
+	voteStatsIndexKey()
+}
+
+// primary key starting index..
+type VoteStatsPrimaryKey = VoteStatsValidatorAddressChainIdConfLevelBucketIndexKey
+
+type VoteStatsValidatorAddressChainIdConfLevelBucketIndexKey struct {
+	vs []interface{}
+}
+
+func (x VoteStatsValidatorAddressChainIdConfLevelBucketIndexKey) id() uint32            { return 0 }
+func (x VoteStatsValidatorAddressChainIdConfLevelBucketIndexKey) values() []interface{} { return x.vs }
+func (x VoteStatsValidatorAddressChainIdConfLevelBucketIndexKey) voteStatsIndexKey()    {}
+
+func (this VoteStatsValidatorAddressChainIdConfLevelBucketIndexKey) WithValidatorAddress(validator_address []byte) VoteStatsValidatorAddressChainIdConfLevelBucketIndexKey {
+	this.vs = []interface{}{validator_address}
+	return this
+}
+
+func (this VoteStatsValidatorAddressChainIdConfLevelBucketIndexKey) WithValidatorAddressChainId(validator_address []byte, chain_id uint64) VoteStatsValidatorAddressChainIdConfLevelBucketIndexKey {
+	this.vs = []interface{}{validator_address, chain_id}
+	return this
+}
+
+func (this VoteStatsValidatorAddressChainIdConfLevelBucketIndexKey) WithValidatorAddressChainIdConfLevel(validator_address []byte, chain_id uint64, conf_level uint32) VoteStatsValidatorAddressChainIdConfLevelBucketIndexKey {
+	this.vs = []interface{}{validator_address, chain_id, conf_level}
+	return this
+}
+
+func (this VoteStatsValidatorAddressChainIdConfLevelBucketIndexKey) WithValidatorAddressChainIdConfLevelBucket(validator_address []byte, chain_id uint64, conf_level uint32, bucket uint64) VoteStatsValidatorAddressChainIdConfLevelBucketIndexKey {
+	this.vs = []interface{}{validator_address, chain_id, conf_level, bucket}
+	return this
+}
+
+type VoteStatsBucketIndexKey struct {
+	vs []interface{}
+}
+
+func (x VoteStatsBucketIndexKey) id() uint32            { return 1 }
+func (x VoteStatsBucketIndexKey) values() []interface{} { return x.vs }
+func (x VoteStatsBucketIndexKey) voteStatsIndexKey()    {}
+
+func (this VoteStatsBucketIndexKey) WithBucket(bucket uint64) VoteStatsBucketIndexKey {
+	this.vs = []interface{}{bucket}
+	return this
+}
+
+type voteStatsTable struct {
+	table ormtable.Table
+}
+
+func (this voteStatsTable) Insert(ctx context.Context, voteStats *VoteStats) error {
+	return this.table.Insert(ctx, voteStats)
+}
+
+func (this voteStatsTable) Update(ctx context.Context, voteStats *VoteStats) error {
+	return this.table.Update(ctx, voteStats)
+}
+
+func (this voteStatsTable) Save(ctx context.Context, voteStats *VoteStats) error {
+	return this.table.Save(ctx, voteStats)
+}
+
+func (this voteStatsTable) Delete(ctx context.Context, voteStats *VoteStats) error {
+	return this.table.Delete(ctx, voteStats)
+}
+
+func (this voteStatsTable) Has(ctx context.Context, validator_address []byte, chain_id uint64, conf_level uint32, bucket uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, validator_address, chain_id, conf_level, bucket)
+}
+
+func (this voteStatsTable) Get(ctx context.Context, validator_address []byte, chain_id uint64, conf_level uint32, bucket uint64) (*VoteStats, error) {
+	var voteStats VoteStats
+	found, err := this.table.PrimaryKey().Get(ctx, &voteStats, validator_address, chain_id, conf_level, bucket)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &voteStats, nil
+}
+
+func (this voteStatsTable) List(ctx context.Context, prefixKey VoteStatsIndexKey, opts ...ormlist.Option) (VoteStatsIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return VoteStatsIterator{it}, err
+}
+
+func (this voteStatsTable) ListRange(ctx context.Context, from, to VoteStatsIndexKey, opts ...ormlist.Option) (VoteStatsIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return VoteStatsIterator{it}, err
+}
+
+func (this voteStatsTable) DeleteBy(ctx context.Context, prefixKey VoteStatsIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this voteStatsTable) DeleteRange(ctx context.Context, from, to VoteStatsIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this voteStatsTable) doNotImplement() {}
+
+var _ VoteStatsTable = voteStatsTable{}
+
+func NewVoteStatsTable(db ormtable.Schema) (VoteStatsTable, error) {
+	table := db.GetTable(&VoteStats{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&VoteStats{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return voteStatsTable{table}, nil
+}
+
+type DoubleSignTable interface {
+	Insert(ctx context.Context, doubleSign *DoubleSign) error
+	InsertReturningId(ctx context.Context, doubleSign *DoubleSign) (uint64, error)
+	LastInsertedSequence(ctx context.Context) (uint64, error)
+	Update(ctx context.Context, doubleSign *DoubleSign) error
+	Save(ctx context.Context, doubleSign *DoubleSign) error
+	Delete(ctx context.Context, doubleSign *DoubleSign) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*DoubleSign, error)
+	HasByValidatorAddressChainIdConfLevelAttestOffset(ctx context.Context, validator_address []byte, chain_id uint64, conf_level uint32, attest_offset uint64) (found bool, err error)
+	// GetByValidatorAddressChainIdConfLevelAttestOffset returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	GetByValidatorAddressChainIdConfLevelAttestOffset(ctx context.Context, validator_address []byte, chain_id uint64, conf_level uint32, attest_offset uint64) (*DoubleSign, error)
+	List(ctx context.Context, prefixKey DoubleSignIndexKey, opts ...ormlist.Option) (DoubleSignIterator, error)
+	ListRange(ctx context.Context, from, to DoubleSignIndexKey, opts ...ormlist.Option) (DoubleSignIterator, error)
+	DeleteBy(ctx context.Context, prefixKey DoubleSignIndexKey) error
+	DeleteRange(ctx context.Context, from, to DoubleSignIndexKey) error
+
+	doNotImplement()
+}
+
+type DoubleSignIterator struct {
+	ormtable.Iterator
+}
+
+func (i DoubleSignIterator) Value() (*DoubleSign, error) {
+	var doubleSign DoubleSign
+	err := i.UnmarshalMessage(&doubleSign)
+	return &doubleSign, err
+}
+
+type DoubleSignIndexKey interface {
+	id() uint32
+	values() []interface{}
+	doubleSignIndexKey()
+}
+
+// primary key starting index..
+type DoubleSignPrimaryKey = DoubleSignIdIndexKey
+
+type DoubleSignIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x DoubleSignIdIndexKey) id() uint32            { return 0 }
+func (x DoubleSignIdIndexKey) values() []interface{} { return x.vs }
+func (x DoubleSignIdIndexKey) doubleSignIndexKey()   {}
+
+func (this DoubleSignIdIndexKey) WithId(id uint64) DoubleSignIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type DoubleSignValidatorAddressChainIdConfLevelAttestOffsetIndexKey struct {
+	vs []interface{}
+}
+
+func (x DoubleSignValidatorAddressChainIdConfLevelAttestOffsetIndexKey) id() uint32 { return 1 }
+func (x DoubleSignValidatorAddressChainIdConfLevelAttestOffsetIndexKey) values() []interface{} {
+	return x.vs
+}
+func (x DoubleSignValidatorAddressChainIdConfLevelAttestOffsetIndexKey) doubleSignIndexKey() {}
+
+func (this DoubleSignValidatorAddressChainIdConfLevelAttestOffsetIndexKey) WithValidatorAddress(validator_address []byte) DoubleSignValidatorAddressChainIdConfLevelAttestOffsetIndexKey {
+	this.vs = []interface{}{validator_address}
+	return this
+}
+
+func (this DoubleSignValidatorAddressChainIdConfLevelAttestOffsetIndexKey) WithValidatorAddressChainId(validator_address []byte, chain_id uint64) DoubleSignValidatorAddressChainIdConfLevelAttestOffsetIndexKey {
+	this.vs = []interface{}{validator_address, chain_id}
+	return this
+}
+
+func (this DoubleSignValidatorAddressChainIdConfLevelAttestOffsetIndexKey) WithValidatorAddressChainIdConfLevel(validator_address []byte, chain_id uint64, conf_level uint32) DoubleSignValidatorAddressChainIdConfLevelAttestOffsetIndexKey {
+	this.vs = []interface{}{validator_address, chain_id, conf_level}
+	return this
+}
+
+func (this DoubleSignValidatorAddressChainIdConfLevelAttestOffsetIndexKey) WithValidatorAddressChainIdConfLevelAttestOffset(validator_address []byte, chain_id uint64, conf_level uint32, attest_offset uint64) DoubleSignValidatorAddressChainIdConfLevelAttestOffsetIndexKey {
+	this.vs = []interface{}{validator_address, chain_id, conf_level, attest_offset}
+	return this
+}
+
+type DoubleSignValidatorAddressHeightIndexKey struct {
+	vs []interface{}
+}
+
+func (x DoubleSignValidatorAddressHeightIndexKey) id() uint32            { return 2 }
+func (x DoubleSignValidatorAddressHeightIndexKey) values() []interface{} { return x.vs }
+func (x DoubleSignValidatorAddressHeightIndexKey) doubleSignIndexKey()   {}
+
+func (this DoubleSignValidatorAddressHeightIndexKey) WithValidatorAddress(validator_address []byte) DoubleSignValidatorAddressHeightIndexKey {
+	this.vs = []interface{}{validator_address}
+	return this
+}
+
+func (this DoubleSignValidatorAddressHeightIndexKey) WithValidatorAddressHeight(validator_address []byte, height uint64) DoubleSignValidatorAddressHeightIndexKey {
+	this.vs = []interface{}{validator_address, height}
+	return this
+}
+
+type doubleSignTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this doubleSignTable) Insert(ctx context.Context, doubleSign *DoubleSign) error {
+	return this.table.Insert(ctx, doubleSign)
+}
+
+func (this doubleSignTable) Update(ctx context.Context, doubleSign *DoubleSign) error {
+	return this.table.Update(ctx, doubleSign)
+}
+
+func (this doubleSignTable) Save(ctx context.Context, doubleSign *DoubleSign) error {
+	return this.table.Save(ctx, doubleSign)
+}
+
+func (this doubleSignTable) Delete(ctx context.Context, doubleSign *DoubleSign) error {
+	return this.table.Delete(ctx, doubleSign)
+}
+
+func (this doubleSignTable) InsertReturningId(ctx context.Context, doubleSign *DoubleSign) (uint64, error) {
+	return this.table.InsertReturningPKey(ctx, doubleSign)
+}
+
+func (this doubleSignTable) LastInsertedSequence(ctx context.Context) (uint64, error) {
+	return this.table.LastInsertedSequence(ctx)
+}
+
+func (this doubleSignTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this doubleSignTable) Get(ctx context.Context, id uint64) (*DoubleSign, error) {
+	var doubleSign DoubleSign
+	found, err := this.table.PrimaryKey().Get(ctx, &doubleSign, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &doubleSign, nil
+}
+
+func (this doubleSignTable) HasByValidatorAddressChainIdConfLevelAttestOffset(ctx context.Context, validator_address []byte, chain_id uint64, conf_level uint32, attest_offset uint64) (found bool, err error) {
+	return this.table.GetIndexByID(1).(ormtable.UniqueIndex).Has(ctx,
+		validator_address,
+		chain_id,
+		conf_level,
+		attest_offset,
+	)
+}
+
+func (this doubleSignTable) GetByValidatorAddressChainIdConfLevelAttestOffset(ctx context.Context, validator_address []byte, chain_id uint64, conf_level uint32, attest_offset uint64) (*DoubleSign, error) {
+	var doubleSign DoubleSign
+	found, err := this.table.GetIndexByID(1).(ormtable.UniqueIndex).Get(ctx, &doubleSign,
+		validator_address,
+		chain_id,
+		conf_level,
+		attest_offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &doubleSign, nil
+}
+
+func (this doubleSignTable) List(ctx context.Context, prefixKey DoubleSignIndexKey, opts ...ormlist.Option) (DoubleSignIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return DoubleSignIterator{it}, err
+}
+
+func (this doubleSignTable) ListRange(ctx context.Context, from, to DoubleSignIndexKey, opts ...ormlist.Option) (DoubleSignIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return DoubleSignIterator{it}, err
+}
+
+func (this doubleSignTable) DeleteBy(ctx context.Context, prefixKey DoubleSignIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this doubleSignTable) DeleteRange(ctx context.Context, from, to DoubleSignIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this doubleSignTable) doNotImplement() {}
+
+var _ DoubleSignTable = doubleSignTable{}
+
+func NewDoubleSignTable(db ormtable.Schema) (DoubleSignTable, error) {
+	table := db.GetTable(&DoubleSign{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&DoubleSign{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return doubleSignTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+// singleton store
+type ParamsTable interface {
+	Get(ctx context.Context) (*Params, error)
+	Save(ctx context.Context, params *Params) error
+}
+
+type paramsTable struct {
+	table ormtable.Table
+}
+
+var _ ParamsTable = paramsTable{}
+
+func (x paramsTable) Get(ctx context.Context) (*Params, error) {
+	params := &Params{}
+	_, err := x.table.Get(ctx, params)
+	return params, err
+}
+
+func (x paramsTable) Save(ctx context.Context, params *Params) error {
+	return x.table.Save(ctx, params)
+}
+
+func NewParamsTable(db ormtable.Schema) (ParamsTable, error) {
+	table := db.GetTable(&Params{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Params{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return &paramsTable{table}, nil
+}
+
+type LivenessInfoTable interface {
+	Insert(ctx context.Context, livenessInfo *LivenessInfo) error
+	Update(ctx context.Context, livenessInfo *LivenessInfo) error
+	Save(ctx context.Context, livenessInfo *LivenessInfo) error
+	Delete(ctx context.Context, livenessInfo *LivenessInfo) error
+	Has(ctx context.Context, validator_address []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, validator_address []byte) (*LivenessInfo, error)
+	List(ctx context.Context, prefixKey LivenessInfoIndexKey, opts ...ormlist.Option) (LivenessInfoIterator, error)
+	ListRange(ctx context.Context, from, to LivenessInfoIndexKey, opts ...ormlist.Option) (LivenessInfoIterator, error)
+	DeleteBy(ctx context.Context, prefixKey LivenessInfoIndexKey) error
+	DeleteRange(ctx context.Context, from, to LivenessInfoIndexKey) error
+
+	doNotImplement()
+}
+
+type LivenessInfoIterator struct {
+	ormtable.Iterator
+}
+
+func (i LivenessInfoIterator) Value() (*LivenessInfo, error) {
+	var livenessInfo LivenessInfo
+	err := i.UnmarshalMessage(&livenessInfo)
+	return &livenessInfo, err
+}
+
+type LivenessInfoIndexKey interface {
+	id() uint32
+	values() []interface{}
+	livenessInfoIndexKey()
+}
+
+// primary key starting index..
+type LivenessInfoPrimaryKey = LivenessInfoValidatorAddressIndexKey
+
+type LivenessInfoValidatorAddressIndexKey struct {
+	vs []interface{}
+}
+
+func (x LivenessInfoValidatorAddressIndexKey) id() uint32            { return 0 }
+func (x LivenessInfoValidatorAddressIndexKey) values() []interface{} { return x.vs }
+func (x LivenessInfoValidatorAddressIndexKey) livenessInfoIndexKey() {}
+
+func (this LivenessInfoValidatorAddressIndexKey) WithValidatorAddress(validator_address []byte) LivenessInfoValidatorAddressIndexKey {
+	this.vs = []interface{}{validator_address}
+	return this
+}
+
+type livenessInfoTable struct {
+	table ormtable.Table
+}
+
+func (this livenessInfoTable) Insert(ctx context.Context, livenessInfo *LivenessInfo) error {
+	return this.table.Insert(ctx, livenessInfo)
+}
+
+func (this livenessInfoTable) Update(ctx context.Context, livenessInfo *LivenessInfo) error {
+	return this.table.Update(ctx, livenessInfo)
+}
+
+func (this livenessInfoTable) Save(ctx context.Context, livenessInfo *LivenessInfo) error {
+	return this.table.Save(ctx, livenessInfo)
+}
+
+func (this livenessInfoTable) Delete(ctx context.Context, livenessInfo *LivenessInfo) error {
+	return this.table.Delete(ctx, livenessInfo)
+}
+
+func (this livenessInfoTable) Has(ctx context.Context, validator_address []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, validator_address)
+}
+
+func (this livenessInfoTable) Get(ctx context.Context, validator_address []byte) (*LivenessInfo, error) {
+	var livenessInfo LivenessInfo
+	found, err := this.table.PrimaryKey().Get(ctx, &livenessInfo, validator_address)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &livenessInfo, nil
+}
+
+func (this livenessInfoTable) List(ctx context.Context, prefixKey LivenessInfoIndexKey, opts ...ormlist.Option) (LivenessInfoIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return LivenessInfoIterator{it}, err
+}
+
+func (this livenessInfoTable) ListRange(ctx context.Context, from, to LivenessInfoIndexKey, opts ...ormlist.Option) (LivenessInfoIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return LivenessInfoIterator{it}, err
+}
+
+func (this livenessInfoTable) DeleteBy(ctx context.Context, prefixKey LivenessInfoIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this livenessInfoTable) DeleteRange(ctx context.Context, from, to LivenessInfoIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this livenessInfoTable) doNotImplement() {}
+
+var _ LivenessInfoTable = livenessInfoTable{}
+
+func NewLivenessInfoTable(db ormtable.Schema) (LivenessInfoTable, error) {
+	table := db.GetTable(&LivenessInfo{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&LivenessInfo{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return livenessInfoTable{table}, nil
+}
+
+type LivenessMissedTable interface {
+	Insert(ctx context.Context, livenessMissed *LivenessMissed) error
+	Update(ctx context.Context, livenessMissed *LivenessMissed) error
+	Save(ctx context.Context, livenessMissed *LivenessMissed) error
+	Delete(ctx context.Context, livenessMissed *LivenessMissed) error
+	Has(ctx context.Context, validator_address []byte, index uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, validator_address []byte, index uint64) (*LivenessMissed, error)
+	List(ctx context.Context, prefixKey LivenessMissedIndexKey, opts ...ormlist.Option) (LivenessMissedIterator, error)
+	ListRange(ctx context.Context, from, to LivenessMissedIndexKey, opts ...ormlist.Option) (LivenessMissedIterator, error)
+	DeleteBy(ctx context.Context, prefixKey LivenessMissedIndexKey) error
+	DeleteRange(ctx context.Context, from, to LivenessMissedIndexKey) error
+
+	doNotImplement()
+}
+
+type LivenessMissedIterator struct {
+	ormtable.Iterator
+}
+
+func (i LivenessMissedIterator) Value() (*LivenessMissed, error) {
+	var livenessMissed LivenessMissed
+	err := i.UnmarshalMessage(&livenessMissed)
+	return &livenessMissed, err
+}
+
+type LivenessMissedIndexKey interface {
+	id() uint32
+	values() []interface{}
+	livenessMissedIndexKey()
+}
+
+// primary key starting index..
+type LivenessMissedPrimaryKey = LivenessMissedValidatorAddressIndexIndexKey
+
+type LivenessMissedValidatorAddressIndexIndexKey struct {
+	vs []interface{}
+}
+
+func (x LivenessMissedValidatorAddressIndexIndexKey) id() uint32              { return 0 }
+func (x LivenessMissedValidatorAddressIndexIndexKey) values() []interface{}   { return x.vs }
+func (x LivenessMissedValidatorAddressIndexIndexKey) livenessMissedIndexKey() {}
+
+func (this LivenessMissedValidatorAddressIndexIndexKey) WithValidatorAddress(validator_address []byte) LivenessMissedValidatorAddressIndexIndexKey {
+	this.vs = []interface{}{validator_address}
+	return this
+}
+
+func (this LivenessMissedValidatorAddressIndexIndexKey) WithValidatorAddressIndex(validator_address []byte, index uint64) LivenessMissedValidatorAddressIndexIndexKey {
+	this.vs = []interface{}{validator_address, index}
+	return this
+}
+
+type livenessMissedTable struct {
+	table ormtable.Table
+}
+
+func (this livenessMissedTable) Insert(ctx context.Context, livenessMissed *LivenessMissed) error {
+	return this.table.Insert(ctx, livenessMissed)
+}
+
+func (this livenessMissedTable) Update(ctx context.Context, livenessMissed *LivenessMissed) error {
+	return this.table.Update(ctx, livenessMissed)
+}
+
+func (this livenessMissedTable) Save(ctx context.Context, livenessMissed *LivenessMissed) error {
+	return this.table.Save(ctx, livenessMissed)
+}
+
+func (this livenessMissedTable) Delete(ctx context.Context, livenessMissed *LivenessMissed) error {
+	return this.table.Delete(ctx, livenessMissed)
+}
+
+func (this livenessMissedTable) Has(ctx context.Context, validator_address []byte, index uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, validator_address, index)
+}
+
+func (this livenessMissedTable) Get(ctx context.Context, validator_address []byte, index uint64) (*LivenessMissed, error) {
+	var livenessMissed LivenessMissed
+	found, err := this.table.PrimaryKey().Get(ctx, &livenessMissed, validator_address, index)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &livenessMissed, nil
+}
+
+func (this livenessMissedTable) List(ctx context.Context, prefixKey LivenessMissedIndexKey, opts ...ormlist.Option) (LivenessMissedIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return LivenessMissedIterator{it}, err
+}
+
+func (this livenessMissedTable) ListRange(ctx context.Context, from, to LivenessMissedIndexKey, opts ...ormlist.Option) (LivenessMissedIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return LivenessMissedIterator{it}, err
+}
+
+func (this livenessMissedTable) DeleteBy(ctx context.Context, prefixKey LivenessMissedIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this livenessMissedTable) DeleteRange(ctx context.Context, from, to LivenessMissedIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this livenessMissedTable) doNotImplement() {}
+
+var _ LivenessMissedTable = livenessMissedTable{}
+
+func NewLivenessMissedTable(db ormtable.Schema) (LivenessMissedTable, error) {
+	table := db.GetTable(&LivenessMissed{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&LivenessMissed{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return livenessMissedTable{table}, nil
+}
+
+type JailTable interface {
+	Insert(ctx context.Context, jail *Jail) error
+	Update(ctx context.Context, jail *Jail) error
+	Save(ctx context.Context, jail *Jail) error
+	Delete(ctx context.Context, jail *Jail) error
+	Has(ctx context.Context, cons_address []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, cons_address []byte) (*Jail, error)
+	List(ctx context.Context, prefixKey JailIndexKey, opts ...ormlist.Option) (JailIterator, error)
+	ListRange(ctx context.Context, from, to JailIndexKey, opts ...ormlist.Option) (JailIterator, error)
+	DeleteBy(ctx context.Context, prefixKey JailIndexKey) error
+	DeleteRange(ctx context.Context, from, to JailIndexKey) error
+
+	doNotImplement()
+}
+
+type JailIterator struct {
+	ormtable.Iterator
+}
+
+func (i JailIterator) Value() (*Jail, error) {
+	var jail Jail
+	err := i.UnmarshalMessage(&jail)
+	return &jail, err
+}
+
+type JailIndexKey interface {
+	id() uint32
+	values() []interface{}
+	jailIndexKey()
+}
+
+// primary key starting index..
+type JailPrimaryKey = JailConsAddressIndexKey
+
+type JailConsAddressIndexKey struct {
+	vs []interface{}
+}
+
+func (x JailConsAddressIndexKey) id() uint32            { return 0 }
+func (x JailConsAddressIndexKey) values() []interface{} { return x.vs }
+func (x JailConsAddressIndexKey) jailIndexKey()         {}
+
+func (this JailConsAddressIndexKey) WithConsAddress(cons_address []byte) JailConsAddressIndexKey {
+	this.vs = []interface{}{cons_address}
+	return this
+}
+
+type jailTable struct {
+	table ormtable.Table
+}
+
+func (this jailTable) Insert(ctx context.Context, jail *Jail) error {
+	return this.table.Insert(ctx, jail)
+}
+
+func (this jailTable) Update(ctx context.Context, jail *Jail) error {
+	return this.table.Update(ctx, jail)
+}
+
+func (this jailTable) Save(ctx context.Context, jail *Jail) error {
+	return this.table.Save(ctx, jail)
+}
+
+func (this jailTable) Delete(ctx context.Context, jail *Jail) error {
+	return this.table.Delete(ctx, jail)
+}
+
+func (this jailTable) Has(ctx context.Context, cons_address []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, cons_address)
+}
+
+func (this jailTable) Get(ctx context.Context, cons_address []byte) (*Jail, error) {
+	var jail Jail
+	found, err := this.table.PrimaryKey().Get(ctx, &jail, cons_address)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &jail, nil
+}
+
+func (this jailTable) List(ctx context.Context, prefixKey JailIndexKey, opts ...ormlist.Option) (JailIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return JailIterator{it}, err
+}
+
+func (this jailTable) ListRange(ctx context.Context, from, to JailIndexKey, opts ...ormlist.Option) (JailIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return JailIterator{it}, err
+}
+
+func (this jailTable) DeleteBy(ctx context.Context, prefixKey JailIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this jailTable) DeleteRange(ctx context.Context, from, to JailIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this jailTable) doNotImplement() {}
+
+var _ JailTable = jailTable{}
+
+func NewJailTable(db ormtable.Schema) (JailTable, error) {
+	table := db.GetTable(&Jail{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Jail{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return jailTable{table}, nil
+}
+
+type AttestationStore interface {
+	AttestationTable() AttestationTable
+	SignatureTable() SignatureTable
+	OffsetHeightTable() OffsetHeightTable
+	VoteStatsTable() VoteStatsTable
+	DoubleSignTable() DoubleSignTable
+	ParamsTable() ParamsTable
+	LivenessInfoTable() LivenessInfoTable
+	LivenessMissedTable() LivenessMissedTable
+	JailTable() JailTable
+
+	doNotImplement()
+}
+
+type attestationStore struct {
+	attestation    AttestationTable
+	signature      SignatureTable
+	offsetHeight   OffsetHeightTable
+	voteStats      VoteStatsTable
+	doubleSign     DoubleSignTable
+	params         ParamsTable
+	livenessInfo   LivenessInfoTable
+	livenessMissed LivenessMissedTable
+	jail           JailTable
+}
+
+func (x attestationStore) AttestationTable() AttestationTable {
+	return x.attestation
+}
+
+func (x attestationStore) SignatureTable() SignatureTable {
+	return x.signature
+}
+
+func (x attestationStore) OffsetHeightTable() OffsetHeightTable {
+	return x.offsetHeight
+}
+
+func (x attestationStore) VoteStatsTable() VoteStatsTable {
+	return x.voteStats
+}
+
+func (x attestationStore) DoubleSignTable() DoubleSignTable {
+	return x.doubleSign
+}
+
+func (x attestationStore) ParamsTable() ParamsTable {
+	return x.params
+}
+
+func (x attestationStore) LivenessInfoTable() LivenessInfoTable {
+	return x.livenessInfo
+}
+
+func (x attestationStore) LivenessMissedTable() LivenessMissedTable {
+	return x.livenessMissed
+}
+
+func (x attestationStore) JailTable() JailTable {
+	return x.jail
+}
+
+func (attestationStore) doNotImplement() {}
+
+var _ AttestationStore = attestationStore{}
+
+func NewAttestationStore(db ormtable.Schema) (AttestationStore, error) {
+	attestationTable, err := NewAttestationTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureTable, err := NewSignatureTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	offsetHeightTable, err := NewOffsetHeightTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	voteStatsTable, err := NewVoteStatsTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	doubleSignTable, err := NewDoubleSignTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	paramsTable, err := NewParamsTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	livenessInfoTable, err := NewLivenessInfoTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	livenessMissedTable, err := NewLivenessMissedTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	jailTable, err := NewJailTable(db)
 	if err != nil {
 		return nil, err
 	}
@@ -474,5 +1431,12 @@ func NewAttestationStore(db ormtable.Schema) (AttestationStore, error) {
 	return attestationStore{
 		attestationTable,
 		signatureTable,
+		offsetHeightTable,
+		voteStatsTable,
+		doubleSignTable,
+		paramsTable,
+		livenessInfoTable,
+		livenessMissedTable,
+		jailTable,
 	}, nil
 }
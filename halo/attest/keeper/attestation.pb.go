@@ -10,6 +10,8 @@ import (
 	_ "cosmossdk.io/api/cosmos/orm/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -301,6 +303,627 @@ func (x *Signature) GetAttestOffset() uint64 {
 	return 0
 }
 
+// OffsetHeight indexes the consensus height at which a range of attestations were approved.
+// A row is only inserted for the first attestation offset approved per chain version per consensus block,
+// so the height of any approved offset is that of the row with the highest from_offset less than or equal to it.
+type OffsetHeight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId        uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`                      // Chain ID as per https://chainlist.org
+	ConfLevel      uint32 `protobuf:"varint,2,opt,name=conf_level,json=confLevel,proto3" json:"conf_level,omitempty"`                // Confirmation level of the cross-chain block
+	FromOffset     uint64 `protobuf:"varint,3,opt,name=from_offset,json=fromOffset,proto3" json:"from_offset,omitempty"`             // First attestation offset approved at approved_height.
+	ApprovedHeight uint64 `protobuf:"varint,4,opt,name=approved_height,json=approvedHeight,proto3" json:"approved_height,omitempty"` // Consensus height at which the attestations were approved.
+}
+
+func (x *OffsetHeight) Reset() {
+	*x = OffsetHeight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_halo_attest_keeper_attestation_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OffsetHeight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffsetHeight) ProtoMessage() {}
+
+func (x *OffsetHeight) ProtoReflect() protoreflect.Message {
+	mi := &file_halo_attest_keeper_attestation_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffsetHeight.ProtoReflect.Descriptor instead.
+func (*OffsetHeight) Descriptor() ([]byte, []int) {
+	return file_halo_attest_keeper_attestation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OffsetHeight) GetChainId() uint64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *OffsetHeight) GetConfLevel() uint32 {
+	if x != nil {
+		return x.ConfLevel
+	}
+	return 0
+}
+
+func (x *OffsetHeight) GetFromOffset() uint64 {
+	if x != nil {
+		return x.FromOffset
+	}
+	return 0
+}
+
+func (x *OffsetHeight) GetApprovedHeight() uint64 {
+	if x != nil {
+		return x.ApprovedHeight
+	}
+	return 0
+}
+
+// VoteStats tracks the vote participation of a validator per chain version per bucket of consensus blocks.
+// Buckets outside the rolling vote stats window are deleted.
+type VoteStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorAddress []byte `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"` // Validator ethereum address; 20 bytes.
+	ChainId          uint64 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`                           // Chain ID as per https://chainlist.org
+	ConfLevel        uint32 `protobuf:"varint,3,opt,name=conf_level,json=confLevel,proto3" json:"conf_level,omitempty"`                     // Confirmation level of the cross-chain block
+	Included         uint64 `protobuf:"varint,4,opt,name=included,proto3" json:"included,omitempty"`                                        // Number of votes included on-chain.
+	Approved         uint64 `protobuf:"varint,5,opt,name=approved,proto3" json:"approved,omitempty"`                                        // Number of votes included in attestations at time of approval.
+	Discarded        uint64 `protobuf:"varint,6,opt,name=discarded,proto3" json:"discarded,omitempty"`                                      // Number of votes included after approval (late), by non-members at approval, or in deleted non-quorum or overridden attestations.
+	Missed           uint64 `protobuf:"varint,7,opt,name=missed,proto3" json:"missed,omitempty"`                                            // Number of approved attestations missing a vote at time of approval.
+	LastVoteHeight   uint64 `protobuf:"varint,8,opt,name=last_vote_height,json=lastVoteHeight,proto3" json:"last_vote_height,omitempty"`    // Consensus height at which a vote was last included.
+	Bucket           uint64 `protobuf:"varint,9,opt,name=bucket,proto3" json:"bucket,omitempty"`                                            // Consensus height divided by the bucket size.
+}
+
+func (x *VoteStats) Reset() {
+	*x = VoteStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_halo_attest_keeper_attestation_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoteStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteStats) ProtoMessage() {}
+
+func (x *VoteStats) ProtoReflect() protoreflect.Message {
+	mi := &file_halo_attest_keeper_attestation_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteStats.ProtoReflect.Descriptor instead.
+func (*VoteStats) Descriptor() ([]byte, []int) {
+	return file_halo_attest_keeper_attestation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VoteStats) GetValidatorAddress() []byte {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return nil
+}
+
+func (x *VoteStats) GetChainId() uint64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *VoteStats) GetConfLevel() uint32 {
+	if x != nil {
+		return x.ConfLevel
+	}
+	return 0
+}
+
+func (x *VoteStats) GetIncluded() uint64 {
+	if x != nil {
+		return x.Included
+	}
+	return 0
+}
+
+func (x *VoteStats) GetApproved() uint64 {
+	if x != nil {
+		return x.Approved
+	}
+	return 0
+}
+
+func (x *VoteStats) GetDiscarded() uint64 {
+	if x != nil {
+		return x.Discarded
+	}
+	return 0
+}
+
+func (x *VoteStats) GetMissed() uint64 {
+	if x != nil {
+		return x.Missed
+	}
+	return 0
+}
+
+func (x *VoteStats) GetLastVoteHeight() uint64 {
+	if x != nil {
+		return x.LastVoteHeight
+	}
+	return 0
+}
+
+func (x *VoteStats) GetBucket() uint64 {
+	if x != nil {
+		return x.Bucket
+	}
+	return 0
+}
+
+// DoubleSign is evidence of a validator signing two different attestations for the same attest offset.
+type DoubleSign struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                    // Auto-incremented ID
+	ValidatorAddress           []byte `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`                                 // Validator ethereum address; 20 bytes.
+	ChainId                    uint64 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`                                                           // Chain ID as per https://chainlist.org
+	ConfLevel                  uint32 `protobuf:"varint,4,opt,name=conf_level,json=confLevel,proto3" json:"conf_level,omitempty"`                                                     // Confirmation level of the cross-chain block
+	AttestOffset               uint64 `protobuf:"varint,5,opt,name=attest_offset,json=attestOffset,proto3" json:"attest_offset,omitempty"`                                            // Attest offset of both attestations
+	AttestationRoot            []byte `protobuf:"bytes,6,opt,name=attestation_root,json=attestationRoot,proto3" json:"attestation_root,omitempty"`                                    // Root of the attestation signed by the rejected vote.
+	Signature                  []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`                                                                       // Signature of the rejected vote; 65 bytes.
+	ConflictingAttestationRoot []byte `protobuf:"bytes,8,opt,name=conflicting_attestation_root,json=conflictingAttestationRoot,proto3" json:"conflicting_attestation_root,omitempty"` // Root of the attestation signed by the previously included vote.
+	ConflictingSignature       []byte `protobuf:"bytes,9,opt,name=conflicting_signature,json=conflictingSignature,proto3" json:"conflicting_signature,omitempty"`                     // Signature of the previously included vote; 65 bytes.
+	Height                     uint64 `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`                                                                           // Consensus height at which the double sign was detected.
+	Penalized                  bool   `protobuf:"varint,11,opt,name=penalized,proto3" json:"penalized,omitempty"`                                                                     // Whether the validator was slashed and jailed.
+}
+
+func (x *DoubleSign) Reset() {
+	*x = DoubleSign{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_halo_attest_keeper_attestation_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoubleSign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleSign) ProtoMessage() {}
+
+func (x *DoubleSign) ProtoReflect() protoreflect.Message {
+	mi := &file_halo_attest_keeper_attestation_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleSign.ProtoReflect.Descriptor instead.
+func (*DoubleSign) Descriptor() ([]byte, []int) {
+	return file_halo_attest_keeper_attestation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DoubleSign) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DoubleSign) GetValidatorAddress() []byte {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return nil
+}
+
+func (x *DoubleSign) GetChainId() uint64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *DoubleSign) GetConfLevel() uint32 {
+	if x != nil {
+		return x.ConfLevel
+	}
+	return 0
+}
+
+func (x *DoubleSign) GetAttestOffset() uint64 {
+	if x != nil {
+		return x.AttestOffset
+	}
+	return 0
+}
+
+func (x *DoubleSign) GetAttestationRoot() []byte {
+	if x != nil {
+		return x.AttestationRoot
+	}
+	return nil
+}
+
+func (x *DoubleSign) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *DoubleSign) GetConflictingAttestationRoot() []byte {
+	if x != nil {
+		return x.ConflictingAttestationRoot
+	}
+	return nil
+}
+
+func (x *DoubleSign) GetConflictingSignature() []byte {
+	if x != nil {
+		return x.ConflictingSignature
+	}
+	return nil
+}
+
+func (x *DoubleSign) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *DoubleSign) GetPenalized() bool {
+	if x != nil {
+		return x.Penalized
+	}
+	return false
+}
+
+// Params are the attest module parameters stored in state.
+// It is not populated for networks that existed before it was introduced; liveness tracking is then disabled.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LivenessWindow           uint64               `protobuf:"varint,1,opt,name=liveness_window,json=livenessWindow,proto3" json:"liveness_window,omitempty"`                                   // Number of approved attestations liveness is tracked over; zero disables it.
+	LivenessMaxMissedPercent uint32               `protobuf:"varint,2,opt,name=liveness_max_missed_percent,json=livenessMaxMissedPercent,proto3" json:"liveness_max_missed_percent,omitempty"` // Maximum percentage of missed attestations in the window before jailing.
+	LivenessJailDuration     *durationpb.Duration `protobuf:"bytes,3,opt,name=liveness_jail_duration,json=livenessJailDuration,proto3" json:"liveness_jail_duration,omitempty"`                // Duration a validator is jailed for downtime.
+	VoteExtensionEncoding    uint32               `protobuf:"varint,4,opt,name=vote_extension_encoding,json=voteExtensionEncoding,proto3" json:"vote_extension_encoding,omitempty"`            // Encoding of vote extensions, see types.VoteExtensionEncoding.
+	VoteWindow               uint64               `protobuf:"varint,5,opt,name=vote_window,json=voteWindow,proto3" json:"vote_window,omitempty"`                                               // Vote window; zero defaults to the value prior to the V1 network upgrade.
+	VoteExtensionLimit       uint64               `protobuf:"varint,6,opt,name=vote_extension_limit,json=voteExtensionLimit,proto3" json:"vote_extension_limit,omitempty"`                     // Vote extension limit; zero defaults to the value prior to the V1 network upgrade.
+	TrimLag                  uint64               `protobuf:"varint,7,opt,name=trim_lag,json=trimLag,proto3" json:"trim_lag,omitempty"`                                                        // Non-consensus-chain trim lag; zero defaults to the value prior to the V1 network upgrade.
+	ConsensusTrimLag         uint64               `protobuf:"varint,8,opt,name=consensus_trim_lag,json=consensusTrimLag,proto3" json:"consensus_trim_lag,omitempty"`                           // Consensus-chain trim lag; zero defaults to the value prior to the V1 network upgrade.
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_halo_attest_keeper_attestation_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	mi := &file_halo_attest_keeper_attestation_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_halo_attest_keeper_attestation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Params) GetLivenessWindow() uint64 {
+	if x != nil {
+		return x.LivenessWindow
+	}
+	return 0
+}
+
+func (x *Params) GetLivenessMaxMissedPercent() uint32 {
+	if x != nil {
+		return x.LivenessMaxMissedPercent
+	}
+	return 0
+}
+
+func (x *Params) GetLivenessJailDuration() *durationpb.Duration {
+	if x != nil {
+		return x.LivenessJailDuration
+	}
+	return nil
+}
+
+func (x *Params) GetVoteExtensionEncoding() uint32 {
+	if x != nil {
+		return x.VoteExtensionEncoding
+	}
+	return 0
+}
+
+func (x *Params) GetVoteWindow() uint64 {
+	if x != nil {
+		return x.VoteWindow
+	}
+	return 0
+}
+
+func (x *Params) GetVoteExtensionLimit() uint64 {
+	if x != nil {
+		return x.VoteExtensionLimit
+	}
+	return 0
+}
+
+func (x *Params) GetTrimLag() uint64 {
+	if x != nil {
+		return x.TrimLag
+	}
+	return 0
+}
+
+func (x *Params) GetConsensusTrimLag() uint64 {
+	if x != nil {
+		return x.ConsensusTrimLag
+	}
+	return 0
+}
+
+// LivenessInfo tracks a validator's missed attestations over a sliding window of approved attestations.
+type LivenessInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorAddress []byte `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"` // Validator ethereum address; 20 bytes.
+	Window           uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`                                            // Liveness window size at the time tracking started.
+	IndexOffset      uint64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`               // Number of approved attestations tracked since tracking started.
+	MissedCount      uint64 `protobuf:"varint,4,opt,name=missed_count,json=missedCount,proto3" json:"missed_count,omitempty"`               // Number of missed attestations in the window.
+}
+
+func (x *LivenessInfo) Reset() {
+	*x = LivenessInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_halo_attest_keeper_attestation_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LivenessInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LivenessInfo) ProtoMessage() {}
+
+func (x *LivenessInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_halo_attest_keeper_attestation_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LivenessInfo.ProtoReflect.Descriptor instead.
+func (*LivenessInfo) Descriptor() ([]byte, []int) {
+	return file_halo_attest_keeper_attestation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LivenessInfo) GetValidatorAddress() []byte {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return nil
+}
+
+func (x *LivenessInfo) GetWindow() uint64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *LivenessInfo) GetIndexOffset() uint64 {
+	if x != nil {
+		return x.IndexOffset
+	}
+	return 0
+}
+
+func (x *LivenessInfo) GetMissedCount() uint64 {
+	if x != nil {
+		return x.MissedCount
+	}
+	return 0
+}
+
+// LivenessMissed marks a missed attestation at an index in a validator's liveness window.
+type LivenessMissed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorAddress []byte `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"` // Validator ethereum address; 20 bytes.
+	Index            uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`                                              // Index in the liveness window.
+}
+
+func (x *LivenessMissed) Reset() {
+	*x = LivenessMissed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_halo_attest_keeper_attestation_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LivenessMissed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LivenessMissed) ProtoMessage() {}
+
+func (x *LivenessMissed) ProtoReflect() protoreflect.Message {
+	mi := &file_halo_attest_keeper_attestation_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LivenessMissed.ProtoReflect.Descriptor instead.
+func (*LivenessMissed) Descriptor() ([]byte, []int) {
+	return file_halo_attest_keeper_attestation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LivenessMissed) GetValidatorAddress() []byte {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return nil
+}
+
+func (x *LivenessMissed) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+// Jail records the latest jailing of a validator by the attest module, i.e., for attestation double signing or downtime.
+// Jailings by x/slashing and x/evidence are not recorded, see Keeper.JailStatus.
+type Jail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsAddress []byte                 `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"` // Validator consensus address; 20 bytes.
+	Reason      int32                  `protobuf:"varint,2,opt,name=reason,proto3" json:"reason,omitempty"`                             // Jail reason, see types.JailReason.
+	Height      uint64                 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`                             // Consensus height at which the validator was jailed.
+	JailedUntil *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"` // Time until which the validator is jailed.
+}
+
+func (x *Jail) Reset() {
+	*x = Jail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_halo_attest_keeper_attestation_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Jail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Jail) ProtoMessage() {}
+
+func (x *Jail) ProtoReflect() protoreflect.Message {
+	mi := &file_halo_attest_keeper_attestation_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Jail.ProtoReflect.Descriptor instead.
+func (*Jail) Descriptor() ([]byte, []int) {
+	return file_halo_attest_keeper_attestation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Jail) GetConsAddress() []byte {
+	if x != nil {
+		return x.ConsAddress
+	}
+	return nil
+}
+
+func (x *Jail) GetReason() int32 {
+	if x != nil {
+		return x.Reason
+	}
+	return 0
+}
+
+func (x *Jail) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Jail) GetJailedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JailedUntil
+	}
+	return nil
+}
+
 var File_halo_attest_keeper_attestation_proto protoreflect.FileDescriptor
 
 var file_halo_attest_keeper_attestation_proto_rawDesc = []byte{
@@ -309,76 +932,208 @@ var file_halo_attest_keeper_attestation_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x68, 0x61, 0x6c, 0x6f, 0x2e, 0x61, 0x74, 0x74,
 	0x65, 0x73, 0x74, 0x2e, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2f, 0x6f, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x6d, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x83, 0x04, 0x0a, 0x0b, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x23, 0x0a,
-	0x0d, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x52, 0x6f, 0x6f, 0x74, 0x12,
-	0x29, 0x0a, 0x10, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
-	0x6f, 0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x61, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
-	0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66,
-	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x74, 0x74, 0x49, 0x64, 0x3a, 0x6a, 0xf2,
-	0x9e, 0xd3, 0x8e, 0x03, 0x64, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x16, 0x0a,
-	0x10, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x6f, 0x6f,
-	0x74, 0x10, 0x01, 0x18, 0x01, 0x12, 0x2c, 0x0a, 0x28, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2c,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x2c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x10, 0x03, 0x18, 0x01, 0x22, 0xc9, 0x02, 0x0a, 0x09, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x74, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x05, 0x61, 0x74, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x4c, 0x65,
-	0x76, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65,
-	0x73, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x3a, 0x6b, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x65,
-	0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x18, 0x61, 0x74, 0x74, 0x5f,
-	0x69, 0x64, 0x2c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x10, 0x01, 0x18, 0x01, 0x12, 0x39, 0x0a, 0x33, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x2c,
-	0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x2c, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x10,
-	0x02, 0x18, 0x01, 0x18, 0x02, 0x2a, 0x30, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
-	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x65, 0x64, 0x10, 0x02, 0x42, 0xc5, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e,
-	0x68, 0x61, 0x6c, 0x6f, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x6b, 0x65, 0x65, 0x70,
-	0x65, 0x72, 0x42, 0x10, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
-	0x6f, 0x6d, 0x6e, 0x69, 0x2f, 0x68, 0x61, 0x6c, 0x6f, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x2f, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x48, 0x41, 0x4b, 0xaa, 0x02, 0x12,
-	0x48, 0x61, 0x6c, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4b, 0x65, 0x65, 0x70,
-	0x65, 0x72, 0xca, 0x02, 0x12, 0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x5c, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0xe2, 0x02, 0x1e, 0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x41,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x5c, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x48, 0x61, 0x6c, 0x6f, 0x3a,
-	0x3a, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x3a, 0x3a, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x04, 0x0a, 0x0b, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x52, 0x6f, 0x6f, 0x74,
+	0x12, 0x29, 0x0a, 0x10, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x61, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x74, 0x74, 0x49, 0x64, 0x3a, 0x91,
+	0x01, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x8a, 0x01, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01,
+	0x12, 0x16, 0x0a, 0x10, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x10, 0x01, 0x18, 0x01, 0x12, 0x2c, 0x0a, 0x28, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x2c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x63, 0x6f, 0x6e, 0x66,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x2c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x2c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x10, 0x04,
+	0x18, 0x01, 0x22, 0xc9, 0x02, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2b,
+	0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x61,
+	0x74, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x74, 0x74,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x3a, 0x6b, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x65, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10,
+	0x01, 0x12, 0x1e, 0x0a, 0x18, 0x61, 0x74, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x10, 0x01, 0x18,
+	0x01, 0x12, 0x39, 0x0a, 0x33, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x63, 0x6f,
+	0x6e, 0x66, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x2c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5f,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x2c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x10, 0x02, 0x18, 0x01, 0x18, 0x02, 0x22, 0xd4,
+	0x01, 0x0a, 0x0c, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x66, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x66, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x66, 0x72, 0x6f, 0x6d, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x3a, 0x40, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x3a, 0x0a, 0x21, 0x0a, 0x1f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x2c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x13,
+	0x0a, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x10, 0x01, 0x18, 0x03, 0x22, 0xe8, 0x02, 0x0a, 0x09, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x66, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x63, 0x6f, 0x6e, 0x66, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x3a, 0x44, 0xf2, 0x9e, 0xd3, 0x8e,
+	0x03, 0x3e, 0x0a, 0x2e, 0x0a, 0x2c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x2c, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x2c, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x12, 0x0a, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x10, 0x01, 0x18, 0x04,
+	0x22, 0x89, 0x04, 0x0a, 0x0a, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x66, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x40, 0x0a, 0x1c, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1a, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69,
+	0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x3a, 0x69, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x63, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10,
+	0x01, 0x12, 0x39, 0x0a, 0x33, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x2c,
+	0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x2c, 0x61, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x10, 0x01, 0x18, 0x01, 0x12, 0x1c, 0x0a, 0x18,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x2c, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x10, 0x02, 0x18, 0x05, 0x22, 0x9f, 0x03, 0x0a,
+	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x69, 0x76, 0x65, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x3d, 0x0a, 0x1b, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x4d,
+	0x61, 0x78, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12,
+	0x4f, 0x0a, 0x16, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6a, 0x61, 0x69, 0x6c,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x6c, 0x69, 0x76, 0x65,
+	0x6e, 0x65, 0x73, 0x73, 0x4a, 0x61, 0x69, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x36, 0x0a, 0x17, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x15, 0x76, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x74, 0x65,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x76,
+	0x6f, 0x74, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x6f, 0x74,
+	0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x76, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x72, 0x69, 0x6d, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74,
+	0x72, 0x69, 0x6d, 0x4c, 0x61, 0x67, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x5f, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x54, 0x72, 0x69,
+	0x6d, 0x4c, 0x61, 0x67, 0x3a, 0x08, 0xfa, 0x9e, 0xd3, 0x8e, 0x03, 0x02, 0x08, 0x06, 0x22, 0xb8,
+	0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d,
+	0x69, 0x73, 0x73, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x1d, 0xf2, 0x9e, 0xd3, 0x8e,
+	0x03, 0x17, 0x0a, 0x13, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x22, 0x78, 0x0a, 0x0e, 0x4c, 0x69, 0x76,
+	0x65, 0x6e, 0x65, 0x73, 0x73, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x23,
+	0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x1d, 0x0a, 0x19, 0x0a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2c, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x08, 0x22, 0xb2, 0x01, 0x0a, 0x04, 0x4a, 0x61, 0x69, 0x6c, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x3d, 0x0a, 0x0c, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x3a, 0x18,
+	0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x12, 0x0a, 0x0e, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x2a, 0x30, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x10, 0x02, 0x42, 0xc5, 0x01, 0x0a, 0x16, 0x63,
+	0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x6c, 0x6f, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x6b,
+	0x65, 0x65, 0x70, 0x65, 0x72, 0x42, 0x10, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2f, 0x68, 0x61, 0x6c, 0x6f, 0x2f, 0x61, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x2f, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x48, 0x41, 0x4b,
+	0xaa, 0x02, 0x12, 0x48, 0x61, 0x6c, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4b,
+	0x65, 0x65, 0x70, 0x65, 0x72, 0xca, 0x02, 0x12, 0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x41, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x5c, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0xe2, 0x02, 0x1e, 0x48, 0x61, 0x6c,
+	0x6f, 0x5c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5c, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x48, 0x61,
+	0x6c, 0x6f, 0x3a, 0x3a, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x3a, 0x3a, 0x4b, 0x65, 0x65, 0x70,
+	0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -394,18 +1149,29 @@ func file_halo_attest_keeper_attestation_proto_rawDescGZIP() []byte {
 }
 
 var file_halo_attest_keeper_attestation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_halo_attest_keeper_attestation_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_halo_attest_keeper_attestation_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_halo_attest_keeper_attestation_proto_goTypes = []any{
-	(Status)(0),         // 0: halo.attest.keeper.Status
-	(*Attestation)(nil), // 1: halo.attest.keeper.Attestation
-	(*Signature)(nil),   // 2: halo.attest.keeper.Signature
+	(Status)(0),                   // 0: halo.attest.keeper.Status
+	(*Attestation)(nil),           // 1: halo.attest.keeper.Attestation
+	(*Signature)(nil),             // 2: halo.attest.keeper.Signature
+	(*OffsetHeight)(nil),          // 3: halo.attest.keeper.OffsetHeight
+	(*VoteStats)(nil),             // 4: halo.attest.keeper.VoteStats
+	(*DoubleSign)(nil),            // 5: halo.attest.keeper.DoubleSign
+	(*Params)(nil),                // 6: halo.attest.keeper.Params
+	(*LivenessInfo)(nil),          // 7: halo.attest.keeper.LivenessInfo
+	(*LivenessMissed)(nil),        // 8: halo.attest.keeper.LivenessMissed
+	(*Jail)(nil),                  // 9: halo.attest.keeper.Jail
+	(*durationpb.Duration)(nil),   // 10: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
 }
 var file_halo_attest_keeper_attestation_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	10, // 0: halo.attest.keeper.Params.liveness_jail_duration:type_name -> google.protobuf.Duration
+	11, // 1: halo.attest.keeper.Jail.jailed_until:type_name -> google.protobuf.Timestamp
+	2,  // [2:2] is the sub-list for method output_type
+	2,  // [2:2] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_halo_attest_keeper_attestation_proto_init() }
@@ -438,6 +1204,90 @@ func file_halo_attest_keeper_attestation_proto_init() {
 				return nil
 			}
 		}
+		file_halo_attest_keeper_attestation_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*OffsetHeight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_halo_attest_keeper_attestation_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*VoteStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_halo_attest_keeper_attestation_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*DoubleSign); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_halo_attest_keeper_attestation_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_halo_attest_keeper_attestation_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*LivenessInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_halo_attest_keeper_attestation_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*LivenessMissed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_halo_attest_keeper_attestation_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*Jail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -445,7 +1295,7 @@ func file_halo_attest_keeper_attestation_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_halo_attest_keeper_attestation_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
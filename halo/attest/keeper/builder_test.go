@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"github.com/omni-network/omni/halo/attest/types"
 	vtypes "github.com/omni-network/omni/halo/valsync/types"
 	"github.com/omni-network/omni/lib/netconf"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	sdkmath "cosmossdk.io/math"
 	fuzz "github.com/google/gofuzz"
 )
 
@@ -25,8 +28,12 @@ const (
 	defaultConfLevel = uint32(xchain.ConfFinalized)
 	defaultOffset    = uint64(1)
 	defaultHeight    = uint64(700)
+	voteWindow       = 1
+	voteLimit        = 4
 	trimLag          = 1
 	cTrimLag         = 5
+
+	doubleSignJailDuration = time.Hour
 )
 
 //nolint:gochecknoglobals // Hard-coded test data.
@@ -38,6 +45,8 @@ var (
 	val3        = newValidator(vals[2].PubKey(), 15)
 	msgRoot     = common.BytesToHash([]byte("test message root"))
 
+	doubleSignSlashFraction = sdkmath.LegacyNewDecWithPrec(5, 2)
+
 	defaultChainVer = xchain.ChainVersion{ID: defaultChainID, ConfLevel: xchain.ConfLevel(defaultConfLevel)}
 	consensusID     = netconf.Simnet.Static().OmniConsensusChainIDUint64()
 )
@@ -1,7 +1,10 @@
 package keeper_test
 
 import (
+	"context"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/omni-network/omni/halo/attest/keeper"
 	"github.com/omni-network/omni/halo/attest/testutil"
@@ -15,12 +18,15 @@ import (
 	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	sltypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
 type mocks struct {
 	skeeper     *testutil.MockStakingKeeper
+	slashKeeper *testutil.MockSlashingKeeper
 	voter       *testutil.MockVoter
 	namer       *testutil.MockChainNamer
 	valProvider *testutil.MockValProvider
@@ -73,6 +79,29 @@ func trimBehindCalled() expectation {
 	}
 }
 
+// doubleSignPenalized returns an expectation that the provided validators are slashed and jailed once for double signing.
+func doubleSignPenalized(vals ...*vtypes.Validator) expectation {
+	return func(ctx sdk.Context, m mocks) {
+		m.valProvider.EXPECT().ActiveSetByHeight(gomock.Any(), uint64(ctx.BlockHeight())).
+			Return(newValSet(1, val1, val2, val3), nil).
+			AnyTimes()
+
+		for _, val := range vals {
+			cmtAddr, err := val.CometAddress()
+			if err != nil {
+				panic(err)
+			}
+			consAddr := sdk.ConsAddress(cmtAddr)
+
+			m.slashKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, doubleSignSlashFraction, val.GetPower(),
+				ctx.BlockHeight()-sdk.ValidatorUpdateDelay, stypes.Infraction_INFRACTION_DOUBLE_SIGN).Times(1)
+			m.slashKeeper.EXPECT().Jail(gomock.Any(), consAddr).Times(1)
+			m.slashKeeper.EXPECT().GetValidatorSigningInfo(gomock.Any(), consAddr).Return(sltypes.ValidatorSigningInfo{}, nil).Times(1)
+			m.slashKeeper.EXPECT().JailUntil(gomock.Any(), consAddr, ctx.BlockTime().Add(doubleSignJailDuration)).Times(1)
+		}
+	}
+}
+
 func activeSetQueried(height uint64) expectation {
 	return func(_ sdk.Context, m mocks) {
 		m.valProvider.EXPECT().ActiveSetByHeight(gomock.Any(), height).
@@ -94,6 +123,7 @@ func setupKeeper(t *testing.T, expectations ...expectation) (*keeper.Keeper, sdk
 	ctrl := gomock.NewController(t)
 	m := mocks{
 		skeeper:     testutil.NewMockStakingKeeper(ctrl),
+		slashKeeper: testutil.NewMockSlashingKeeper(ctrl),
 		voter:       testutil.NewMockVoter(ctrl),
 		namer:       testutil.NewMockChainNamer(ctrl),
 		valProvider: testutil.NewMockValProvider(ctrl),
@@ -108,11 +138,13 @@ func setupKeeper(t *testing.T, expectations ...expectation) (*keeper.Keeper, sdk
 		}
 	}
 
-	const voteWindow = 1
-	const voteLimit = 4
-	k, err := keeper.New(codec, storeSvc, m.skeeper, m.namer.ChainName, m.voter, voteWindow, voteLimit, trimLag, cTrimLag)
+	k, err := keeper.New(codec, storeSvc, m.skeeper, m.namer.ChainName, m.voter,
+		m.slashKeeper, doubleSignSlashFraction, doubleSignJailDuration)
 	require.NoError(t, err, "new keeper")
 
+	// Store the test vote params, without liveness params.
+	require.NoError(t, k.UpdateVoteParams(ctx, voteWindow, voteLimit, trimLag, cTrimLag))
+
 	k.SetValidatorProvider(m.valProvider)
 	k.SetPortalRegistry(m.registry)
 
@@ -146,3 +178,61 @@ func dumpTables(t *testing.T, ctx sdk.Context, k *keeper.Keeper) ([]*keeper.Atte
 
 	return atts, sigs
 }
+
+// fakeJails is a stateful fake of the x/slashing jailing behaviour.
+type fakeJails struct {
+	mu          sync.Mutex
+	jailed      map[string]bool
+	jailedUntil map[string]time.Time
+}
+
+func newFakeJails() *fakeJails {
+	return &fakeJails{
+		jailed:      make(map[string]bool),
+		jailedUntil: make(map[string]time.Time),
+	}
+}
+
+// Expectations returns an expectation routing all slashing keeper calls to the fake.
+func (f *fakeJails) Expectations(_ sdk.Context, m mocks) {
+	m.slashKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).AnyTimes()
+	m.slashKeeper.EXPECT().Jail(gomock.Any(), gomock.Any()).DoAndReturn(f.Jail).AnyTimes()
+	m.slashKeeper.EXPECT().JailUntil(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(f.JailUntil).AnyTimes()
+	m.slashKeeper.EXPECT().GetValidatorSigningInfo(gomock.Any(), gomock.Any()).DoAndReturn(f.GetValidatorSigningInfo).AnyTimes()
+}
+
+func (f *fakeJails) Jail(_ context.Context, consAddr sdk.ConsAddress) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.jailed[consAddr.String()] {
+		return stypes.ErrValidatorJailed // Same as x/staking.
+	}
+	f.jailed[consAddr.String()] = true
+
+	return nil
+}
+
+func (f *fakeJails) JailUntil(_ context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.jailedUntil[consAddr.String()] = jailTime
+
+	return nil
+}
+
+func (f *fakeJails) GetValidatorSigningInfo(_ context.Context, consAddr sdk.ConsAddress) (sltypes.ValidatorSigningInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return sltypes.ValidatorSigningInfo{JailedUntil: f.jailedUntil[consAddr.String()]}, nil
+}
+
+func (f *fakeJails) IsJailed(consAddr sdk.ConsAddress) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.jailed[consAddr.String()]
+}
@@ -19,7 +19,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	"github.com/cosmos/gogoproto/proto"
 )
 
 var _ evmenginetypes.VoteExtensionProvider = (*Keeper)(nil)
@@ -36,6 +35,11 @@ func (k *Keeper) PrepareVotes(ctx context.Context, commit abci.ExtendedCommitInf
 		return nil, nil
 	}
 
+	return k.prepareVotes(ctx, commit)
+}
+
+// prepareVotes returns the MsgAddVotes transaction of the votes included in the already validated commit.
+func (k *Keeper) prepareVotes(ctx context.Context, commit abci.ExtendedCommitInfo) ([]sdk.Msg, error) {
 	// Adapt portal registry to the supportedChainFunc signature.
 	supportedChainFunc := func(ctx context.Context, chainVersion xchain.ChainVersion) (bool, error) {
 		chainVersions, err := k.portalRegistry.ConfLevels(ctx)
@@ -180,8 +184,8 @@ func votesFromExtension(voteExtension []byte) (*types.Votes, bool, error) {
 		return nil, false, nil
 	}
 
-	resp := new(types.Votes)
-	if err := proto.Unmarshal(voteExtension, resp); err != nil {
+	resp, err := types.DecodeVotes(voteExtension)
+	if err != nil {
 		return nil, false, errors.Wrap(err, "decode vote extension")
 	}
 
@@ -15,7 +15,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
-	"github.com/cosmos/gogoproto/proto"
 	fuzz "github.com/google/gofuzz"
 	"github.com/stretchr/testify/require"
 )
@@ -134,9 +133,9 @@ func TestVotesFromCommit(t *testing.T) {
 					votes = append(votes, vote)
 				}
 
-				bz, err := proto.Marshal(&types.Votes{
-					Votes: votes,
-				})
+				// Mix vote extension encodings, as during an upgrade.
+				encoding := types.VoteExtensionEncoding(i % len(types.VoteExtensionEncoding_name))
+				bz, err := types.EncodeVotes(votes, encoding)
 				require.NoError(t, err)
 
 				evotes = append(evotes, abci.ExtendedVoteInfo{
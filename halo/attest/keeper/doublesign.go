@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"github.com/omni-network/omni/halo/attest/types"
+	vtypes "github.com/omni-network/omni/halo/valsync/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// recordDoubleSign stores evidence of the double signed vote and penalizes the validator.
+// Only the first double sign per validator per attest offset is recorded.
+func (k *Keeper) recordDoubleSign(ctx context.Context, agg *types.AggVote, sig *types.SigTuple) error {
+	header := agg.AttestHeader
+	if exists, err := k.doubleSignTable.HasByValidatorAddressChainIdConfLevelAttestOffset(ctx, sig.ValidatorAddress, header.SourceChainId, header.ConfLevel, header.AttestOffset); err != nil {
+		return errors.Wrap(err, "check existing evidence")
+	} else if exists {
+		return nil
+	}
+
+	conflicting, err := k.sigTable.GetByChainIdConfLevelAttestOffsetValidatorAddress(ctx, header.SourceChainId, header.ConfLevel, header.AttestOffset, sig.ValidatorAddress)
+	if err != nil {
+		return errors.Wrap(err, "get conflicting signature")
+	}
+
+	conflictingAtt, err := k.attTable.Get(ctx, conflicting.GetAttId())
+	if err != nil {
+		return errors.Wrap(err, "get conflicting attestation")
+	}
+
+	attRoot, err := agg.AttestationRoot()
+	if err != nil {
+		return errors.Wrap(err, "attestation root")
+	}
+
+	addr := common.BytesToAddress(sig.ValidatorAddress)
+	penalized, err := k.penalizeDoubleSign(ctx, addr)
+	if err != nil {
+		return errors.Wrap(err, "penalize double sign")
+	}
+
+	err = k.doubleSignTable.Insert(ctx, &DoubleSign{
+		ValidatorAddress:           sig.ValidatorAddress,
+		ChainId:                    header.SourceChainId,
+		ConfLevel:                  header.ConfLevel,
+		AttestOffset:               header.AttestOffset,
+		AttestationRoot:            attRoot[:],
+		Signature:                  sig.Signature,
+		ConflictingAttestationRoot: conflictingAtt.GetAttestationRoot(),
+		ConflictingSignature:       conflicting.GetSignature(),
+		Height:                     uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()),
+		Penalized:                  penalized,
+	})
+	if err != nil {
+		return errors.Wrap(err, "insert evidence")
+	}
+
+	return nil
+}
+
+// penalizeDoubleSign slashes and jails the double signing validator.
+// It returns false if the validator is not in the active set or was already penalized for this incident.
+func (k *Keeper) penalizeDoubleSign(ctx context.Context, addr common.Address) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	val, ok, err := k.activeValidator(ctx, addr)
+	if err != nil {
+		return false, err
+	} else if !ok {
+		log.Warn(ctx, "Not penalizing double sign by inactive validator", nil, "validator", addr)
+		return false, nil
+	}
+
+	cmtAddr, err := val.CometAddress()
+	if err != nil {
+		return false, err
+	}
+	consAddr := sdk.ConsAddress(cmtAddr)
+
+	if ok, err := k.alreadyPenalized(ctx, addr, consAddr); err != nil {
+		return false, err
+	} else if ok {
+		return false, nil
+	}
+
+	if k.doubleSignSlashFraction.IsPositive() {
+		// Slash stake bonded at the time of the infraction, see x/evidence.
+		distributionHeight := height - sdk.ValidatorUpdateDelay
+		err := k.slashKeeper.SlashWithInfractionReason(ctx, consAddr, k.doubleSignSlashFraction, val.GetPower(), distributionHeight, stypes.Infraction_INFRACTION_DOUBLE_SIGN)
+		if err != nil {
+			return false, errors.Wrap(err, "slash")
+		}
+	}
+
+	if err := k.jail(ctx, consAddr, k.doubleSignJailDuration, types.JailReason_JAIL_REASON_ATTEST_DOUBLE_SIGN); err != nil {
+		return false, err
+	}
+
+	doubleSignPenaltyCounter.WithLabelValues(addr.Hex()).Inc()
+	log.Warn(ctx, "🚨 Slashed and jailed validator for double signing attestation", nil,
+		"validator", addr,
+		"fraction", k.doubleSignSlashFraction,
+		"jail_duration", k.doubleSignJailDuration,
+	)
+
+	return true, nil
+}
+
+// alreadyPenalized returns true if the validator's latest double sign penalty is still in effect,
+// i.e., it was penalized at this height or is still jailed since then.
+// The active set is only updated a few blocks after jailing, so this ensures that
+// double signs in those blocks (at other offsets) are part of the same incident and only penalized once.
+func (k *Keeper) alreadyPenalized(ctx context.Context, addr common.Address, consAddr sdk.ConsAddress) (bool, error) {
+	latest, ok, err := k.latestPenalty(ctx, addr)
+	if err != nil {
+		return false, err
+	} else if !ok {
+		return false, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if latest.GetHeight() == uint64(sdkCtx.BlockHeight()) {
+		return true, nil
+	}
+
+	info, err := k.slashKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	if err != nil {
+		return false, errors.Wrap(err, "get signing info")
+	}
+
+	return sdkCtx.BlockTime().Before(info.JailedUntil), nil
+}
+
+// latestPenalty returns the validator's latest penalized double sign evidence.
+func (k *Keeper) latestPenalty(ctx context.Context, addr common.Address) (*DoubleSign, bool, error) {
+	idx := DoubleSignValidatorAddressHeightIndexKey{}.WithValidatorAddress(addr.Bytes())
+	iter, err := k.doubleSignTable.List(ctx, idx, ormlist.Reverse())
+	if err != nil {
+		return nil, false, errors.Wrap(err, "list evidence")
+	}
+	defer iter.Close()
+
+	for iter.Next() {
+		evidence, err := iter.Value()
+		if err != nil {
+			return nil, false, errors.Wrap(err, "value evidence")
+		} else if evidence.GetPenalized() {
+			return evidence, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// listDoubleSigns returns the double sign evidence of the validator, or of all validators if addr is empty.
+func (k *Keeper) listDoubleSigns(ctx context.Context, addr []byte) ([]*types.DoubleSignEvidence, error) {
+	idx := DoubleSignValidatorAddressChainIdConfLevelAttestOffsetIndexKey{}
+	if len(addr) > 0 {
+		idx = idx.WithValidatorAddress(addr)
+	}
+
+	iter, err := k.doubleSignTable.List(ctx, idx)
+	if err != nil {
+		return nil, errors.Wrap(err, "list evidence")
+	}
+	defer iter.Close()
+
+	var resp []*types.DoubleSignEvidence
+	for iter.Next() {
+		evidence, err := iter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "value evidence")
+		}
+
+		resp = append(resp, &types.DoubleSignEvidence{
+			ValidatorAddress:           evidence.GetValidatorAddress(),
+			ChainId:                    evidence.GetChainId(),
+			ConfLevel:                  evidence.GetConfLevel(),
+			AttestOffset:               evidence.GetAttestOffset(),
+			AttestationRoot:            evidence.GetAttestationRoot(),
+			Signature:                  evidence.GetSignature(),
+			ConflictingAttestationRoot: evidence.GetConflictingAttestationRoot(),
+			ConflictingSignature:       evidence.GetConflictingSignature(),
+			Height:                     evidence.GetHeight(),
+			Penalized:                  evidence.GetPenalized(),
+		})
+	}
+
+	return resp, nil
+}
+
+// activeValidator returns the validator with the provided ethereum address if it is in the current active set.
+func (k *Keeper) activeValidator(ctx context.Context, addr common.Address) (*vtypes.Validator, bool, error) {
+	valset, err := k.valProvider.ActiveSetByHeight(ctx, uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()))
+	if err != nil {
+		return nil, false, errors.Wrap(err, "active set")
+	}
+
+	for _, val := range valset.Validators {
+		ethAddr, err := val.EthereumAddress()
+		if err != nil {
+			return nil, false, err
+		} else if ethAddr == addr {
+			return val, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// jail jails the validator for the provided duration and records the reason, see JailStatus.
+// Jailing an already jailed validator never shortens its existing jail time, in which case
+// the reason isn't recorded, since the existing jailing still determines when it can unjail.
+func (k *Keeper) jail(ctx context.Context, consAddr sdk.ConsAddress, duration time.Duration, reason types.JailReason) error {
+	// Validators may already be jailed in this block, e.g. by x/slashing or for another infraction.
+	if err := k.slashKeeper.Jail(ctx, consAddr); err != nil && !errors.Is(err, stypes.ErrValidatorJailed) {
+		return errors.Wrap(err, "jail")
+	}
+
+	info, err := k.slashKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	if err != nil {
+		return errors.Wrap(err, "get signing info")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	jailUntil := sdkCtx.BlockTime().Add(duration)
+	if !jailUntil.After(info.JailedUntil) {
+		return nil
+	}
+
+	if err := k.slashKeeper.JailUntil(ctx, consAddr, jailUntil); err != nil {
+		return errors.Wrap(err, "jail until")
+	}
+
+	err = k.jailTable.Save(ctx, &Jail{
+		ConsAddress: consAddr,
+		Reason:      int32(reason),
+		Height:      uint64(sdkCtx.BlockHeight()),
+		JailedUntil: timestamppb.New(jailUntil),
+	})
+	if err != nil {
+		return errors.Wrap(err, "save jail")
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/halo/attest/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestKeeper_DoubleSign(t *testing.T) {
+	t.Parallel()
+
+	// Only val1 is penalized (once), even though it double signs two offsets.
+	k, ctx := setupKeeper(t, mockDefaultExpectations, doubleSignPenalized(val1))
+
+	// Votes included in the previous block's vote extensions.
+	vote1 := defaultAggVote().Vote()
+	vote2 := defaultAggVote().WithAttestOfset(defaultOffset + 1).WithBlockHeight(defaultHeight + 1).Vote()
+	require.NoError(t, k.Add(ctx, defaultMsg().WithVotes(vote1, vote2).Msg()))
+
+	// val1 then votes for conflicting blocks at the same offsets (e.g. after a reorg).
+	conflict1 := defaultAggVote().WithBlockHash(common.HexToHash("0x01")).WithSignatures(sigsTuples(val1)...).Vote()
+	conflict2 := defaultAggVote().WithAttestOfset(defaultOffset + 1).WithBlockHeight(defaultHeight + 1).WithBlockHash(common.HexToHash("0x02")).WithSignatures(sigsTuples(val1)...).Vote()
+	require.NoError(t, k.Add(ctx, defaultMsg().WithVotes(conflict1, conflict2).Msg()))
+
+	// Including the same conflicting vote again doesn't result in more evidence.
+	require.NoError(t, k.Add(ctx, defaultMsg().WithVotes(conflict1).Msg()))
+
+	resp, err := k.DoubleSignEvidence(ctx, &types.DoubleSignEvidenceRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetEvidence(), 2)
+
+	root1, err := vote1.AttestationRoot()
+	require.NoError(t, err)
+	conflictRoot1, err := conflict1.AttestationRoot()
+	require.NoError(t, err)
+
+	addr1, err := val1.EthereumAddress()
+	require.NoError(t, err)
+
+	evidence := resp.GetEvidence()[0]
+	require.Equal(t, addr1.Bytes(), evidence.GetValidatorAddress())
+	require.Equal(t, defaultChainID, evidence.GetChainId())
+	require.Equal(t, defaultConfLevel, evidence.GetConfLevel())
+	require.Equal(t, defaultOffset, evidence.GetAttestOffset())
+	require.Equal(t, conflictRoot1[:], evidence.GetAttestationRoot())
+	require.Equal(t, conflict1.Signatures[0].GetSignature(), evidence.GetSignature())
+	require.Equal(t, root1[:], evidence.GetConflictingAttestationRoot())
+	require.Equal(t, vote1.Signatures[0].GetSignature(), evidence.GetConflictingSignature())
+	require.EqualValues(t, ctx.BlockHeight(), evidence.GetHeight())
+	require.True(t, evidence.GetPenalized())
+
+	// The second double sign in the same block is recorded, but not penalized again.
+	require.Equal(t, defaultOffset+1, resp.GetEvidence()[1].GetAttestOffset())
+	require.False(t, resp.GetEvidence()[1].GetPenalized())
+
+	// Query by validator address.
+	addr2, err := val2.EthereumAddress()
+	require.NoError(t, err)
+
+	resp, err = k.DoubleSignEvidence(ctx, &types.DoubleSignEvidenceRequest{ValidatorAddress: addr1.Bytes()})
+	require.NoError(t, err)
+	require.Len(t, resp.GetEvidence(), 2)
+
+	resp, err = k.DoubleSignEvidence(ctx, &types.DoubleSignEvidenceRequest{ValidatorAddress: addr2.Bytes()})
+	require.NoError(t, err)
+	require.Empty(t, resp.GetEvidence())
+
+	_, err = k.DoubleSignEvidence(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestKeeper_DoubleSignIncident(t *testing.T) {
+	t.Parallel()
+
+	cmtAddr, err := val1.CometAddress()
+	require.NoError(t, err)
+	consAddr := sdk.ConsAddress(cmtAddr)
+
+	// val1 is slashed twice; for the first incident and again after its jail time expired.
+	jails := newFakeJails()
+	k, ctx := setupKeeper(t, mockDefaultExpectations, func(_ sdk.Context, m mocks) {
+		m.valProvider.EXPECT().ActiveSetByHeight(gomock.Any(), gomock.Any()).Return(newValSet(1, val1, val2, val3), nil).AnyTimes()
+		m.slashKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
+		m.slashKeeper.EXPECT().Jail(gomock.Any(), gomock.Any()).DoAndReturn(jails.Jail).AnyTimes()
+		m.slashKeeper.EXPECT().JailUntil(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(jails.JailUntil).AnyTimes()
+		m.slashKeeper.EXPECT().GetValidatorSigningInfo(gomock.Any(), gomock.Any()).DoAndReturn(jails.GetValidatorSigningInfo).AnyTimes()
+	})
+
+	offset := func(i uint64) *types.AggVote {
+		return defaultAggVote().WithAttestOfset(defaultOffset + i).WithBlockHeight(defaultHeight + i).Vote()
+	}
+	conflict := func(i uint64) *types.AggVote {
+		return defaultAggVote().WithAttestOfset(defaultOffset + i).WithBlockHeight(defaultHeight + i).
+			WithBlockHash(common.BytesToHash([]byte{byte(i + 1)})).WithSignatures(sigsTuples(val1)...).Vote()
+	}
+
+	require.NoError(t, k.Add(ctx, defaultMsg().WithVotes(offset(0), offset(1), offset(2)).Msg()))
+
+	// val1 double signs and is penalized.
+	require.NoError(t, k.Add(ctx, defaultMsg().WithVotes(conflict(0)).Msg()))
+
+	// It remains in the active set for a few blocks, double signing again is part of the same incident.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Second))
+	require.NoError(t, k.Add(ctx, defaultMsg().WithVotes(conflict(1)).Msg()))
+
+	// Double signing after its jail time expired is a new incident.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(doubleSignJailDuration))
+	require.NoError(t, k.Add(ctx, defaultMsg().WithVotes(conflict(2)).Msg()))
+
+	resp, err := k.DoubleSignEvidence(ctx, &types.DoubleSignEvidenceRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetEvidence(), 3)
+	require.True(t, resp.GetEvidence()[0].GetPenalized())
+	require.False(t, resp.GetEvidence()[1].GetPenalized())
+	require.True(t, resp.GetEvidence()[2].GetPenalized())
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/k1util"
+	"github.com/omni-network/omni/lib/xchain"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// TestKeeper_JailDoubleSign tests the path from conflicting vote extensions to a jailed validator.
+func TestKeeper_JailDoubleSign(t *testing.T) {
+	t.Parallel()
+
+	jails := newFakeJails()
+	k, ctx := setupKeeper(t, mockDefaultExpectations, jails.Expectations, func(_ sdk.Context, m mocks) {
+		m.valProvider.EXPECT().ActiveSetByHeight(gomock.Any(), gomock.Any()).
+			Return(newValSet(1, val1, val2, val3), nil).AnyTimes()
+		m.registry.EXPECT().ConfLevels(gomock.Any()).
+			Return(map[uint64][]xchain.ConfLevel{defaultChainID: {xchain.ConfFinalized}}, nil).AnyTimes()
+	})
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0).UTC())
+
+	consAddr := func(i int) sdk.ConsAddress {
+		return sdk.ConsAddress(vals[i].PubKey().Address())
+	}
+
+	// val1 is already jailed (e.g. by x/slashing for downtime) for longer than the double sign jail duration.
+	existingJail := ctx.BlockTime().Add(10 * doubleSignJailDuration)
+	require.NoError(t, jails.Jail(ctx, consAddr(0)))
+	require.NoError(t, jails.JailUntil(ctx, consAddr(0), existingJail))
+
+	// val1 and val2 vote for conflicting blocks at the same offset in subsequent blocks.
+	for _, blockHash := range []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02")} {
+		var commit abci.ExtendedCommitInfo
+		for i := range 2 {
+			ext := signedVoteExtension(t, vals[i], blockHash)
+
+			resp, err := k.VerifyVoteExtension(ctx, &abci.RequestVerifyVoteExtension{
+				ValidatorAddress: consAddr(i),
+				VoteExtension:    ext,
+			})
+			require.NoError(t, err)
+			require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, resp.GetStatus())
+
+			commit.Votes = append(commit.Votes, abci.ExtendedVoteInfo{
+				Validator:     abci.Validator{Address: consAddr(i)},
+				VoteExtension: ext,
+				BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+			})
+		}
+
+		msgs, err := k.PrepareVotesUnverified(ctx, commit)
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+
+		msg, ok := msgs[0].(*types.MsgAddVotes)
+		require.True(t, ok)
+		require.NoError(t, k.Add(ctx, msg))
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	}
+
+	// val2 is jailed for double signing.
+	require.True(t, jails.IsJailed(consAddr(1)))
+	status, err := k.JailStatus(ctx, &types.JailStatusRequest{ConsAddress: consAddr(1)})
+	require.NoError(t, err)
+	require.Equal(t, types.JailReason_JAIL_REASON_ATTEST_DOUBLE_SIGN, status.GetReason())
+	require.Equal(t, ctx.BlockTime().Add(doubleSignJailDuration), status.GetJailedUntil())
+
+	// val1 remains jailed, without shortening its existing jail time.
+	require.True(t, jails.IsJailed(consAddr(0)))
+	status, err = k.JailStatus(ctx, &types.JailStatusRequest{ConsAddress: consAddr(0)})
+	require.NoError(t, err)
+	require.Equal(t, types.JailReason_JAIL_REASON_CONSENSUS_DOWNTIME, status.GetReason())
+	require.Equal(t, existingJail, status.GetJailedUntil())
+
+	// val3 didn't vote, so isn't jailed.
+	require.False(t, jails.IsJailed(consAddr(2)))
+
+	evidence, err := k.DoubleSignEvidence(ctx, &types.DoubleSignEvidenceRequest{})
+	require.NoError(t, err)
+	require.Len(t, evidence.GetEvidence(), 2)
+}
+
+// signedVoteExtension returns a vote extension containing the validator's signed vote for the default offset.
+func signedVoteExtension(t *testing.T, key k1.PrivKey, blockHash common.Hash) []byte {
+	t.Helper()
+
+	addr, err := k1util.PubKeyToAddress(key.PubKey())
+	require.NoError(t, err)
+
+	vote := &types.Vote{
+		AttestHeader: &types.AttestHeader{
+			ConsensusChainId: consensusID,
+			SourceChainId:    defaultChainID,
+			ConfLevel:        defaultConfLevel,
+			AttestOffset:     defaultOffset,
+		},
+		BlockHeader: &types.BlockHeader{
+			ChainId:     defaultChainID,
+			BlockHeight: defaultHeight,
+			BlockHash:   blockHash[:],
+		},
+		MsgRoot: msgRoot[:],
+	}
+
+	root, err := vote.AttestationRoot()
+	require.NoError(t, err)
+
+	sig, err := k1util.Sign(key, root)
+	require.NoError(t, err)
+
+	vote.Signature = &types.SigTuple{
+		ValidatorAddress: addr[:],
+		Signature:        sig[:],
+	}
+
+	bz, err := types.EncodeVotes([]*types.Vote{vote}, types.VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_PROTO)
+	require.NoError(t, err)
+
+	return bz
+}
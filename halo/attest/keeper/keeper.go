@@ -5,7 +5,9 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strconv"
+	"time"
 
 	"github.com/omni-network/omni/halo/attest/types"
 	rtypes "github.com/omni-network/omni/halo/registry/types"
@@ -22,6 +24,7 @@ import (
 
 	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
 	"cosmossdk.io/core/store"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/orm/model/ormdb"
 	"cosmossdk.io/orm/model/ormlist"
 	"cosmossdk.io/orm/types/ormerrors"
@@ -29,32 +32,49 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
-	"github.com/cosmos/gogoproto/proto"
 )
 
 // initialAttestOffset is the first attest offset to attest to for all chains.
 const initialAttestOffset uint64 = 1
 
+// offsetHeightTrimLag is the number of consensus blocks the offset height index is retained.
+const offsetHeightTrimLag uint64 = 7 * 72_000 // +-1 week (given a period of 1.2s).
+
+// maxDeletesPerBlock is the maximum number of attestations deleted per block.
+// This bounds block processing time when the trim lags are lowered, since the whole
+// backlog of attestations before the new trim lags is then deleted over multiple blocks.
+const maxDeletesPerBlock = 1_000
+
 var _ sdk.ExtendVoteHandler = (*Keeper)(nil).ExtendVote
 var _ sdk.VerifyVoteExtensionHandler = (*Keeper)(nil).VerifyVoteExtension
 
 // Keeper is the attestation keeper.
 // It keeps tracks of all attestations included on-chain and detects when they are approved.
 type Keeper struct {
-	attTable       AttestationTable
-	sigTable       SignatureTable
-	cdc            codec.BinaryCodec
-	storeService   store.KVStoreService
-	skeeper        baseapp.ValidatorStore
-	valProvider    vtypes.ValidatorProvider
-	portalRegistry rtypes.PortalRegistry
-	namer          types.ChainVerNameFunc
-	voter          types.Voter
-
-	voteWindow   uint64
-	voteExtLimit uint64
-	trimLag      uint64 // Non-consensus chain trim lag
-	cTrimLag     uint64 // Consensus chain trim lag
+	modDB           ormdb.ModuleDB
+	attTable        AttestationTable
+	sigTable        SignatureTable
+	offsetTable     OffsetHeightTable
+	statsTable      VoteStatsTable
+	doubleSignTable DoubleSignTable
+	paramsTable     ParamsTable
+	livenessTable   LivenessInfoTable
+	missedTable     LivenessMissedTable
+	jailTable       JailTable
+	cdc             codec.BinaryCodec
+	storeService    store.KVStoreService
+	skeeper         baseapp.ValidatorStore
+	slashKeeper     types.SlashingKeeper
+	valProvider     vtypes.ValidatorProvider
+	portalRegistry  rtypes.PortalRegistry
+	namer           types.ChainVerNameFunc
+	voter           types.Voter
+	archiver        types.Archiver // Optional node-local archive of pruned attestations.
+
+	deleteLimit int // Maximum number of attestations deleted per block
+
+	doubleSignSlashFraction sdkmath.LegacyDec
+	doubleSignJailDuration  time.Duration
 
 	valAddrCache *valAddrCache
 }
@@ -66,18 +86,13 @@ func New(
 	skeeper baseapp.ValidatorStore,
 	namer types.ChainVerNameFunc,
 	voter types.Voter,
-	voteWindow uint64,
-	voteExtLimit uint64,
-	trimLag uint64,
-	cTrimLag uint64,
+	slashKeeper types.SlashingKeeper,
+	doubleSignSlashFraction sdkmath.LegacyDec,
+	doubleSignJailDuration time.Duration,
 ) (*Keeper, error) {
-	schema := &ormv1alpha1.ModuleSchemaDescriptor{SchemaFile: []*ormv1alpha1.ModuleSchemaDescriptor_FileEntry{
-		{Id: 1, ProtoFileName: File_halo_attest_keeper_attestation_proto.Path()},
-	}}
-
-	modDB, err := ormdb.NewModuleDB(schema, ormdb.ModuleDBOptions{KVStoreService: storeSvc})
+	modDB, err := newModuleDB(storeSvc)
 	if err != nil {
-		return nil, errors.Wrap(err, "create module db")
+		return nil, err
 	}
 
 	attstore, err := NewAttestationStore(modDB)
@@ -85,29 +100,69 @@ func New(
 		return nil, errors.Wrap(err, "create attestation store")
 	}
 
-	if cTrimLag < trimLag {
-		return nil, errors.New("consensus trim lag must be greater than or equal to trim lag")
+	if doubleSignSlashFraction.IsNil() || doubleSignSlashFraction.IsNegative() || doubleSignSlashFraction.GT(sdkmath.LegacyOneDec()) {
+		return nil, errors.New("double sign slash fraction must be between 0 and 1")
+	} else if doubleSignJailDuration < 0 {
+		return nil, errors.New("double sign jail duration must not be negative")
 	}
 
 	k := &Keeper{
-		attTable:       attstore.AttestationTable(),
-		sigTable:       attstore.SignatureTable(),
-		cdc:            cdc,
-		storeService:   storeSvc,
-		skeeper:        skeeper,
-		namer:          namer,
-		voter:          voter,
-		voteWindow:     voteWindow,
-		voteExtLimit:   voteExtLimit,
-		trimLag:        trimLag,
-		cTrimLag:       cTrimLag,
-		portalRegistry: stubPortalRegistry{},
-		valAddrCache:   new(valAddrCache),
+		modDB:                   modDB,
+		attTable:                attstore.AttestationTable(),
+		sigTable:                attstore.SignatureTable(),
+		offsetTable:             attstore.OffsetHeightTable(),
+		statsTable:              attstore.VoteStatsTable(),
+		doubleSignTable:         attstore.DoubleSignTable(),
+		paramsTable:             attstore.ParamsTable(),
+		livenessTable:           attstore.LivenessInfoTable(),
+		missedTable:             attstore.LivenessMissedTable(),
+		jailTable:               attstore.JailTable(),
+		cdc:                     cdc,
+		storeService:            storeSvc,
+		skeeper:                 skeeper,
+		slashKeeper:             slashKeeper,
+		namer:                   namer,
+		voter:                   voter,
+		deleteLimit:             maxDeletesPerBlock,
+		doubleSignSlashFraction: doubleSignSlashFraction,
+		doubleSignJailDuration:  doubleSignJailDuration,
+		portalRegistry:          stubPortalRegistry{},
+		valAddrCache:            new(valAddrCache),
 	}
 
 	return k, nil
 }
 
+// NewORMStore returns the attest module ORM store backed by the provided KV store service.
+// It is also used outside the module to read (and verify) raw attest module state.
+func NewORMStore(storeSvc store.KVStoreService) (AttestationStore, error) {
+	modDB, err := newModuleDB(storeSvc)
+	if err != nil {
+		return nil, err
+	}
+
+	attstore, err := NewAttestationStore(modDB)
+	if err != nil {
+		return nil, errors.Wrap(err, "create attestation store")
+	}
+
+	return attstore, nil
+}
+
+// newModuleDB returns the attest module ORM database backed by the provided KV store service.
+func newModuleDB(storeSvc store.KVStoreService) (ormdb.ModuleDB, error) {
+	schema := &ormv1alpha1.ModuleSchemaDescriptor{SchemaFile: []*ormv1alpha1.ModuleSchemaDescriptor_FileEntry{
+		{Id: 1, ProtoFileName: File_halo_attest_keeper_attestation_proto.Path()},
+	}}
+
+	modDB, err := ormdb.NewModuleDB(schema, ormdb.ModuleDBOptions{KVStoreService: storeSvc})
+	if err != nil {
+		return nil, errors.Wrap(err, "create module db")
+	}
+
+	return modDB, nil
+}
+
 // SetValidatorProvider sets the validator provider.
 func (k *Keeper) SetValidatorProvider(valProvider vtypes.ValidatorProvider) {
 	k.valProvider = valProvider
@@ -118,6 +173,12 @@ func (k *Keeper) SetPortalRegistry(portalRegistry rtypes.PortalRegistry) {
 	k.portalRegistry = portalRegistry
 }
 
+// SetArchiver sets the optional node-local archiver of approved attestations.
+// If set, approved attestations are archived before they are pruned from state.
+func (k *Keeper) SetArchiver(archiver types.Archiver) {
+	k.archiver = archiver
+}
+
 // RegisterProposalService registers the proposal service on the provided router.
 // This implements abci.ProcessProposal verification of new proposals.
 func (k *Keeper) RegisterProposalService(server grpc1.Server) {
@@ -235,6 +296,10 @@ func (k *Keeper) addOne(ctx context.Context, agg *types.AggVote, valSetID uint64
 			} else if ok {
 				doubleSignCounter.WithLabelValues(common.BytesToAddress(sig.ValidatorAddress).Hex()).Inc()
 				msg = "🚨 Ignoring duplicate slashable vote"
+
+				if err := k.recordDoubleSign(ctx, agg, sig); err != nil {
+					return errors.Wrap(err, "record double sign")
+				}
 			}
 
 			log.Warn(ctx, msg, nil,
@@ -245,6 +310,13 @@ func (k *Keeper) addOne(ctx context.Context, agg *types.AggVote, valSetID uint64
 			)
 		} else if err != nil {
 			return errors.Wrap(err, "insert signature")
+		} else if err := k.voteIncluded(ctx, common.BytesToAddress(sig.ValidatorAddress), header.XChainVersion()); err != nil {
+			return err
+		} else if existing.GetStatus() == uint32(Status_Approved) {
+			// Votes of approved attestations are instrumented at approval, so this vote is late.
+			if err := k.instrumentDiscarded(ctx, common.BytesToAddress(sig.ValidatorAddress), header.XChainVersion()); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -255,6 +327,11 @@ func (k *Keeper) addOne(ctx context.Context, agg *types.AggVote, valSetID uint64
 func (k *Keeper) Approve(ctx context.Context, valset ValSet) error {
 	defer latency("approve")()
 
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return err
+	}
+
 	pendingIdx := AttestationStatusChainIdConfLevelAttestOffsetIndexKey{}.WithStatus(uint32(Status_Pending))
 	iter, err := k.attTable.List(ctx, pendingIdx)
 	if err != nil {
@@ -263,6 +340,7 @@ func (k *Keeper) Approve(ctx context.Context, valset ValSet) error {
 	defer iter.Close()
 
 	approvedByChain := make(map[xchain.ChainVersion]uint64) // Cache the latest approved attestation offset by chain version.
+	firstApproved := make(map[xchain.ChainVersion]uint64)   // First attestation offset approved in this block by chain version.
 	for iter.Next() {
 		att, err := iter.Value()
 		if err != nil {
@@ -300,6 +378,9 @@ func (k *Keeper) Approve(ctx context.Context, valset ValSet) error {
 		setMetrics := func(att *Attestation) {
 			approvedHeight.WithLabelValues(chainVerName).Set(float64(att.GetBlockHeight()))
 			approvedOffset.WithLabelValues(chainVerName).Set(float64(att.GetAttestOffset()))
+			if _, ok := firstApproved[chainVer]; !ok {
+				firstApproved[chainVer] = att.GetAttestOffset()
+			}
 		}
 
 		toDelete, ok := isApproved(sigs, valset)
@@ -310,13 +391,19 @@ func (k *Keeper) Approve(ctx context.Context, valset ValSet) error {
 			} else if ok {
 				setMetrics(att)
 				approvedByChain[chainVer] = att.GetAttestOffset()
+				if err := emitApproved(ctx, att); err != nil {
+					return err
+				}
+
+				if err := k.updatePrevApprovedLiveness(ctx, params, valset, att); err != nil {
+					return errors.Wrap(err, "update liveness")
+				}
 			}
 
 			continue
 		}
 
 		for _, sig := range toDelete {
-			discardedVotesCounter.WithLabelValues(common.BytesToAddress(sig.GetValidatorAddress()).Hex(), chainVerName).Inc()
 			err := k.sigTable.Delete(ctx, sig)
 			if err != nil {
 				return errors.Wrap(err, "delete sig")
@@ -333,6 +420,17 @@ func (k *Keeper) Approve(ctx context.Context, valset ValSet) error {
 
 		setMetrics(att)
 		approvedByChain[chainVer] = att.GetAttestOffset()
+		if err := emitApproved(ctx, att); err != nil {
+			return err
+		}
+
+		if err := k.updatePrevApprovedLiveness(ctx, params, valset, att); err != nil {
+			return errors.Wrap(err, "update liveness")
+		}
+
+		if err := k.instrumentApproved(ctx, att, valset, sigs); err != nil {
+			return errors.Wrap(err, "instrument approved votes")
+		}
 
 		log.Debug(ctx, "📬 Approved attestation",
 			"chain", chainVerName,
@@ -342,10 +440,14 @@ func (k *Keeper) Approve(ctx context.Context, valset ValSet) error {
 		)
 	}
 
+	if err := k.indexOffsetHeights(ctx, firstApproved); err != nil {
+		return err
+	}
+
 	// Trim votes behind minimum vote-window
 	minVoteWindows := make(map[xchain.ChainVersion]uint64)
 	for chainVer, head := range approvedByChain {
-		minVoteWindows[chainVer] = uintSub(head, k.voteWindow)
+		minVoteWindows[chainVer] = uintSub(head, params.GetVoteWindow())
 	}
 
 	count := k.voter.TrimBehind(minVoteWindows)
@@ -356,6 +458,71 @@ func (k *Keeper) Approve(ctx context.Context, valset ValSet) error {
 	return nil
 }
 
+// indexOffsetHeights inserts an offset height row per chain version for the first offsets approved in the current block.
+func (k *Keeper) indexOffsetHeights(ctx context.Context, firstApproved map[xchain.ChainVersion]uint64) error {
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	for chainVer, offset := range firstApproved {
+		err := k.offsetTable.Insert(ctx, &OffsetHeight{
+			ChainId:        chainVer.ID,
+			ConfLevel:      uint32(chainVer.ConfLevel),
+			FromOffset:     offset,
+			ApprovedHeight: height,
+		})
+		if err != nil {
+			return errors.Wrap(err, "insert offset height")
+		}
+	}
+
+	return nil
+}
+
+// offsetHeight returns the consensus height at which the approved attestation with the provided offset was approved.
+// It returns false if the offset isn't approved yet or if it isn't indexed (anymore).
+func (k *Keeper) offsetHeight(ctx context.Context, version xchain.ChainVersion, offset uint64) (uint64, bool, error) {
+	latest, ok, err := k.latestAttestation(ctx, version)
+	if err != nil {
+		return 0, false, err
+	} else if !ok || offset > latest.GetAttestOffset() {
+		return 0, false, nil
+	}
+
+	// Find the row with the highest from_offset less than or equal to offset.
+	start := OffsetHeightChainIdConfLevelFromOffsetIndexKey{}.WithChainIdConfLevelFromOffset(version.ID, uint32(version.ConfLevel), 0)
+	end := OffsetHeightChainIdConfLevelFromOffsetIndexKey{}.WithChainIdConfLevelFromOffset(version.ID, uint32(version.ConfLevel), offset)
+	iter, err := k.offsetTable.ListRange(ctx, start, end, ormlist.Reverse(), ormlist.DefaultLimit(1))
+	if err != nil {
+		return 0, false, errors.Wrap(err, "list offset heights")
+	}
+	defer iter.Close()
+
+	if !iter.Next() {
+		return 0, false, nil
+	}
+
+	row, err := iter.Value()
+	if err != nil {
+		return 0, false, errors.Wrap(err, "value offset height")
+	}
+
+	return row.GetApprovedHeight(), true, nil
+}
+
+// emitApproved emits a typed EventAttestationApproved for the provided approved attestation.
+// This allows cprovider streams to subscribe to approvals instead of polling.
+func emitApproved(ctx context.Context, att *Attestation) error {
+	err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventAttestationApproved{
+		ChainId:         att.GetChainId(),
+		ConfLevel:       att.GetConfLevel(),
+		AttestOffset:    att.GetAttestOffset(),
+		AttestationRoot: att.GetAttestationRoot(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "emit approved event")
+	}
+
+	return nil
+}
+
 // ListAttestationsFrom returns the subsequent approved attestations from the provided offset (inclusive).
 func (k *Keeper) ListAttestationsFrom(ctx context.Context, chainID uint64, confLevel uint32, offset uint64, max uint64) ([]*types.Attestation, error) {
 	defer latency("attestations_from")()
@@ -460,8 +627,25 @@ func (k *Keeper) maybeOverrideFinalized(ctx context.Context, att *Attestation) (
 func (k *Keeper) latestAttestation(ctx context.Context, version xchain.ChainVersion) (*Attestation, bool, error) {
 	defer latency("latest_attestation")()
 
+	return latestApproved(ctx, k.attTable, version)
+}
+
+// LatestApprovedOffset returns the attest offset of the latest approved attestation for the given chain
+// or false if none is found. It reads the store directly, e.g. from the halo application database of a stopped node.
+func LatestApprovedOffset(ctx context.Context, attStore AttestationStore, version xchain.ChainVersion) (uint64, bool, error) {
+	att, ok, err := latestApproved(ctx, attStore.AttestationTable(), version)
+	if err != nil || !ok {
+		return 0, false, err
+	}
+
+	return att.GetAttestOffset(), true, nil
+}
+
+// latestApproved returns the latest approved attestation for the given chain from the table or
+// false if none is found.
+func latestApproved(ctx context.Context, attTable AttestationTable, version xchain.ChainVersion) (*Attestation, bool, error) {
 	idx := AttestationStatusChainIdConfLevelAttestOffsetIndexKey{}.WithStatusChainIdConfLevel(uint32(Status_Approved), version.ID, uint32(version.ConfLevel))
-	iter, err := k.attTable.List(ctx, idx, ormlist.Reverse(), ormlist.DefaultLimit(1))
+	iter, err := attTable.List(ctx, idx, ormlist.Reverse(), ormlist.DefaultLimit(1))
 	if err != nil {
 		return nil, false, errors.Wrap(err, "list")
 	}
@@ -482,7 +666,7 @@ func (k *Keeper) latestAttestation(ctx context.Context, version xchain.ChainVers
 
 	// If this attestation is overridden by a finalized attestation, return that instead.
 	if att.GetFinalizedAttId() != 0 {
-		att, err := k.attTable.Get(ctx, att.GetFinalizedAttId())
+		att, err := attTable.Get(ctx, att.GetFinalizedAttId())
 		if err != nil {
 			return nil, false, errors.Wrap(err, "get finalized attestation")
 		}
@@ -612,11 +796,24 @@ func (k *Keeper) BeginBlock(ctx context.Context) error {
 		return errors.Wrap(err, "parse chain id")
 	}
 
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return err
+	}
+
 	head := uint64(sdkCtx.BlockHeight())
-	before := umath.SubtractOrZero(head, k.trimLag)
-	cBefore := umath.SubtractOrZero(head, k.cTrimLag)
+	before := umath.SubtractOrZero(head, params.GetTrimLag())
+	cBefore := umath.SubtractOrZero(head, params.GetConsensusTrimLag())
+
+	if err := k.deleteBefore(ctx, before, consensusID, cBefore); err != nil {
+		return err
+	}
+
+	if err := k.deleteOffsetHeightsBefore(ctx, umath.SubtractOrZero(head, offsetHeightTrimLag)); err != nil {
+		return err
+	}
 
-	return k.deleteBefore(ctx, before, consensusID, cBefore)
+	return k.deleteExpiredVoteStats(ctx)
 }
 
 func (k *Keeper) EndBlock(ctx context.Context) error {
@@ -640,10 +837,14 @@ func (k *Keeper) ExtendVote(ctx sdk.Context, _ *abci.RequestExtendVote) (*abci.R
 		return nil, errors.Wrap(err, "parse chain id")
 	}
 
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	votes := k.voter.GetAvailable()
 
 	// Filter by vote window and if limited exceeded.
-	countsByChainVer := make(map[xchain.ChainVersion]int)
 	duplicate := make(map[xchain.AttestHeader]bool)
 	var filtered []*types.Vote
 	for _, vote := range votes {
@@ -668,19 +869,23 @@ func (k *Keeper) ExtendVote(ctx sdk.Context, _ *abci.RequestExtendVote) (*abci.R
 			// Skip votes no in the window
 			continue
 		}
-		countsByChainVer[vote.AttestHeader.XChainVersion()]++
 		filtered = append(filtered, vote)
 
-		if len(filtered) >= int(k.voteExtLimit) {
+		if len(filtered) >= int(params.GetVoteExtensionLimit()) {
 			break
 		}
 	}
 
-	bz, err := proto.Marshal(&types.Votes{
-		Votes: filtered,
-	})
+	bz, n, err := types.EncodeVotesLimited(filtered, params.GetVoteExtensionEncoding(), types.MaxVoteExtensionSize)
 	if err != nil {
-		return nil, errors.Wrap(err, "marshal atts")
+		return nil, errors.Wrap(err, "encode votes")
+	} else if n < len(filtered) {
+		log.Warn(ctx, "Dropped votes exceeding vote extension size limit", nil, "dropped", len(filtered)-n, "limit", types.MaxVoteExtensionSize)
+	}
+
+	countsByChainVer := make(map[xchain.ChainVersion]int)
+	for _, vote := range filtered[:n] {
+		countsByChainVer[vote.AttestHeader.XChainVersion()]++
 	}
 
 	for chainVer, count := range countsByChainVer {
@@ -740,17 +945,27 @@ func (k *Keeper) VerifyVoteExtension(ctx sdk.Context, req *abci.RequestVerifyVot
 		return nil, err // This error should never occur
 	}
 
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Adding logging attributes to sdk context is a bit tricky
 	ctx = ctx.WithContext(log.WithCtx(ctx, log.Hex7("validator", req.ValidatorAddress)))
 
+	if len(req.VoteExtension) > types.MaxVoteExtensionSize {
+		log.Warn(ctx, "Rejecting vote extension exceeding size limit", nil, "size", len(req.VoteExtension), "limit", types.MaxVoteExtensionSize)
+		return respReject, nil
+	}
+
 	votes, ok, err := votesFromExtension(req.VoteExtension)
 	if err != nil {
 		log.Warn(ctx, "Rejecting invalid vote extension", err)
 		return respReject, nil
 	} else if !ok {
 		return respAccept, nil
-	} else if len(votes.Votes) > int(k.voteExtLimit) {
-		log.Warn(ctx, "Rejecting vote extension exceeding limit", nil, "count", len(votes.Votes), "limit", k.voteExtLimit)
+	} else if len(votes.Votes) > int(params.GetVoteExtensionLimit()) {
+		log.Warn(ctx, "Rejecting vote extension exceeding limit", nil, "count", len(votes.Votes), "limit", params.GetVoteExtensionLimit())
 		return respReject, nil
 	}
 
@@ -810,6 +1025,11 @@ func (s ValSet) TotalPower() int64 {
 	return resp
 }
 
+// QuorumPower returns the minimum power required for approval, i.e., more than 2/3 of the total power.
+func (s ValSet) QuorumPower() int64 {
+	return s.TotalPower()*2/3 + 1
+}
+
 // prevBlockValSet returns the previous blocks active validator set.
 // Previous block is used since vote extensions are delayed by one block.
 func (k *Keeper) prevBlockValSet(ctx context.Context) (ValSet, error) {
@@ -819,6 +1039,11 @@ func (k *Keeper) prevBlockValSet(ctx context.Context) (ValSet, error) {
 		return ValSet{}, err
 	}
 
+	return valSetFromResponse(resp)
+}
+
+// valSetFromResponse returns the validator set from the valsync response.
+func valSetFromResponse(resp *vtypes.ValidatorSetResponse) (ValSet, error) {
 	valsByPower := make(map[common.Address]int64)
 	for _, val := range resp.Validators {
 		ethAddr, err := val.EthereumAddress()
@@ -845,7 +1070,12 @@ func (k *Keeper) windowCompare(ctx context.Context, chainVer xchain.ChainVersion
 		latestOffset = latest.GetAttestOffset()
 	}
 
-	return windowCompare(k.voteWindow, latestOffset, offset), nil
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return windowCompare(params.GetVoteWindow(), latestOffset, offset), nil
 }
 
 // verifyAggVotes verifies the given aggregates votes:
@@ -860,6 +1090,7 @@ func (k *Keeper) verifyAggVotes(
 	cChainID uint64,
 	valset ValSet,
 	aggs []*types.AggVote,
+	voteExtLimit uint64,
 	windowCompareFunc windowCompareFunc, // Aliased for testing
 ) error {
 	duplicate := make(map[common.Hash]bool)         // Detects duplicate aggregate votes.
@@ -892,7 +1123,7 @@ func (k *Keeper) verifyAggVotes(
 			}
 
 			countsPerVal[addr]++
-			if countsPerVal[addr] > k.voteExtLimit {
+			if countsPerVal[addr] > voteExtLimit {
 				return errors.New("vote extension limit exceeded", append(errAttrs, "validator", addr)...)
 			}
 		}
@@ -933,7 +1164,15 @@ func (k *Keeper) deleteBefore(ctx context.Context, height uint64, consensusID ui
 		return errors.Wrap(err, "list atts")
 	}
 	defer iter.Close()
+
+	var deleted int
 	for iter.Next() {
+		if deleted >= k.deleteLimit {
+			// Continue deleting the oldest remaining attestations in the next block.
+			deleteLimitedCounter.Inc()
+			break
+		}
+
 		att, err := iter.Value()
 		if err != nil {
 			return errors.Wrap(err, "value att")
@@ -972,8 +1211,12 @@ func (k *Keeper) deleteBefore(ctx context.Context, height uint64, consensusID ui
 			continue // Skip deleting finalized attestation.
 		}
 
-		if err := k.instrumentVotes(ctx, att); err != nil {
-			return errors.Wrap(err, "instrument votes")
+		if err := k.instrumentDeleted(ctx, att); err != nil {
+			return errors.Wrap(err, "instrument deleted votes")
+		}
+
+		if err := k.archive(ctx, att, consensusID); err != nil {
+			return errors.Wrap(err, "archive")
 		}
 
 		// Delete signatures
@@ -986,52 +1229,132 @@ func (k *Keeper) deleteBefore(ctx context.Context, height uint64, consensusID ui
 		if err != nil {
 			return errors.Wrap(err, "delete att")
 		}
+		deleted++
 	}
 
 	return nil
 }
 
-// instrumentVotes tracks basic voter performance by instrumenting votes.
-// It tracks whether validators are voting vs voting late vs not voting.
-func (k *Keeper) instrumentVotes(ctx context.Context, att *Attestation) error {
-	// Votes only count towards rewards if attestation approved and not overridden.
-	rewardVoters := att.GetStatus() == uint32(Status_Approved) && att.GetFinalizedAttId() == 0
+// archive archives the approved attestation (with its signatures) if an archiver is configured.
+// Archive failures are logged and otherwise ignored, since the archive is node-local and may not halt consensus.
+func (k *Keeper) archive(ctx context.Context, att *Attestation, consensusID uint64) error {
+	if k.archiver == nil || att.GetStatus() != uint32(Status_Approved) {
+		return nil
+	}
 
-	chainVerName := k.namer(att.XChainVersion())
 	sigs, err := k.getSigs(ctx, att.GetId())
 	if err != nil {
 		return errors.Wrap(err, "get att sigs")
 	}
 
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	if err := k.archiver.Archive(ctx, height, AttestationFromDB(att, consensusID, sigs)); err != nil {
+		archiveErrCounter.Inc()
+		log.Warn(ctx, "Failed archiving attestation (will continue)", err,
+			"chain", k.namer(att.XChainVersion()),
+			"attest_offset", att.GetAttestOffset(),
+		)
+
+		return nil
+	}
+
+	archivedCounter.WithLabelValues(k.namer(att.XChainVersion())).Inc()
+
+	return nil
+}
+
+// deleteOffsetHeightsBefore deletes all offset height rows approved before the given height (inclusive).
+func (k *Keeper) deleteOffsetHeightsBefore(ctx context.Context, height uint64) error {
+	if height == 0 {
+		return nil
+	}
+
+	start := OffsetHeightApprovedHeightIndexKey{}
+	end := OffsetHeightApprovedHeightIndexKey{}.WithApprovedHeight(height)
+	if err := k.offsetTable.DeleteRange(ctx, start, end); err != nil {
+		return errors.Wrap(err, "delete offset heights")
+	}
+
+	return nil
+}
+
+// instrumentApproved tracks basic voter performance by instrumenting votes and updating vote stats
+// when the attestation is approved by the validator set.
+// Votes of set members are approved, votes of non-members are discarded and set members without votes missed.
+// Votes included after approval are discarded (late), see instrumentDiscarded.
+func (k *Keeper) instrumentApproved(ctx context.Context, att *Attestation, valset ValSet, sigs []*Signature) error {
+	chainVer := att.XChainVersion()
+	chainVerName := k.namer(chainVer)
+
 	included := make(map[common.Address]bool)
-	// Mark attestation on-chain votes as either approved or discarded (based on rewardVoters).
 	for _, sig := range sigs {
 		addr := common.BytesToAddress(sig.GetValidatorAddress())
 		included[addr] = true
-		if rewardVoters {
+
+		approved := valset.Contains(addr)
+		if approved {
 			approvedVotesCounter.WithLabelValues(addr.Hex(), chainVerName).Inc()
 		} else {
 			discardedVotesCounter.WithLabelValues(addr.Hex(), chainVerName).Inc()
 		}
+
+		err := k.updateVoteStats(ctx, addr, chainVer, func(stats *VoteStats) {
+			if approved {
+				stats.Approved++
+			} else {
+				stats.Discarded++
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Iterate deterministically for consistent metrics and state access.
+	addrs := make([]common.Address, 0, len(valset.Vals))
+	for addr := range valset.Vals {
+		addrs = append(addrs, addr)
 	}
+	slices.SortFunc(addrs, func(a, b common.Address) int { return bytes.Compare(a[:], b[:]) })
 
-	// Mark missing votes if attestation is approved (and not overridden).
-	if rewardVoters {
-		if att.GetValidatorSetId() == 0 { // Sanity check
-			return errors.New("missing validator set id for approved non-overridden attestation [BUG]", "att_id", att.GetId())
+	for _, addr := range addrs {
+		if included[addr] {
+			continue
 		}
 
-		valset, err := k.valProvider.ValidatorSet(ctx, &vtypes.ValidatorSetRequest{Id: att.GetValidatorSetId()})
-		if err != nil {
-			return errors.Wrap(err, "validator set", "id", att.GetValidatorSetId())
+		missingVotesCounter.WithLabelValues(addr.Hex(), chainVerName).Inc()
+		if err := k.updateVoteStats(ctx, addr, chainVer, func(stats *VoteStats) { stats.Missed++ }); err != nil {
+			return err
 		}
+	}
 
-		for _, val := range valset.Validators {
-			if addr, err := val.EthereumAddress(); err != nil {
-				return errors.Wrap(err, "validator address")
-			} else if !included[addr] {
-				missingVotesCounter.WithLabelValues(addr.Hex(), chainVerName).Inc()
-			}
+	return nil
+}
+
+// instrumentDiscarded marks a vote as discarded, i.e., included for a previously approved attestation (late),
+// or for an attestation that was never approved.
+func (k *Keeper) instrumentDiscarded(ctx context.Context, addr common.Address, chainVer xchain.ChainVersion) error {
+	discardedVotesCounter.WithLabelValues(addr.Hex(), k.namer(chainVer)).Inc()
+
+	return k.updateVoteStats(ctx, addr, chainVer, func(stats *VoteStats) { stats.Discarded++ })
+}
+
+// instrumentDeleted marks the votes of a deleted attestation that was never approved
+// (non-quorum or overridden by a finalized attestation) as discarded.
+// Votes of approved attestations are instrumented at approval, see instrumentApproved.
+func (k *Keeper) instrumentDeleted(ctx context.Context, att *Attestation) error {
+	if att.GetStatus() == uint32(Status_Approved) {
+		return nil
+	}
+
+	sigs, err := k.getSigs(ctx, att.GetId())
+	if err != nil {
+		return errors.Wrap(err, "get att sigs")
+	}
+
+	for _, sig := range sigs {
+		if err := k.instrumentDiscarded(ctx, common.BytesToAddress(sig.GetValidatorAddress()), att.XChainVersion()); err != nil {
+			return err
 		}
 	}
 
@@ -1077,7 +1400,7 @@ func isApproved(sigs []*Signature, valset ValSet) ([]*Signature, bool) {
 		sum += power
 	}
 
-	return toDelete, sum > valset.TotalPower()*2/3
+	return toDelete, sum >= valset.QuorumPower()
 }
 
 func verifyHeaderChains(ctx context.Context, cChainID uint64, registry rtypes.PortalRegistry, attHeader *types.AttestHeader, blockHeader *types.BlockHeader) error {
@@ -13,9 +13,12 @@ import (
 	"github.com/omni-network/omni/lib/tutil"
 	"github.com/omni-network/omni/lib/xchain"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,6 +32,35 @@ func (k *Keeper) SignatureTable() SignatureTable {
 	return k.sigTable
 }
 
+// ParamsTable returns the params ORM table.
+func (k *Keeper) ParamsTable() ParamsTable {
+	return k.paramsTable
+}
+
+// DeleteParams deletes the stored params, like networks that predate attest module genesis.
+func (k *Keeper) DeleteParams(ctx context.Context) error {
+	return k.modDB.GetTable(&Params{}).Delete(ctx, &Params{})
+}
+
+// SetDeleteLimit sets the maximum number of attestations deleted per block.
+func (k *Keeper) SetDeleteLimit(limit int) {
+	k.deleteLimit = limit
+}
+
+// VoteStatsWindow is the number of consensus blocks in the rolling vote stats window.
+const VoteStatsWindow = int64(voteStatsBuckets * voteStatsBucketSize)
+
+// DeleteExpiredVoteStats deletes all vote stats buckets outside the rolling window.
+func (k *Keeper) DeleteExpiredVoteStats(ctx context.Context) error {
+	return k.deleteExpiredVoteStats(ctx)
+}
+
+// PrepareVotesUnverified returns the MsgAddVotes transaction of the votes included in the commit,
+// without verifying the cometBFT vote extension signatures.
+func (k *Keeper) PrepareVotesUnverified(ctx context.Context, commit abci.ExtendedCommitInfo) ([]sdk.Msg, error) {
+	return k.prepareVotes(ctx, commit)
+}
+
 func TestWindowCompose(t *testing.T) {
 	t.Parallel()
 	const window = 64
@@ -169,15 +201,13 @@ func TestVerifyAggVotes(t *testing.T) {
 				portalRegistry: portalReg,
 				namer:          netconf.SimnetNetwork().ChainVersionName,
 				voter:          nil,
-				voteWindow:     0,
-				voteExtLimit:   voteExtLimit,
 			}
 
 			for i := 0; i < len(test.aggs); i++ {
 				test.aggs[i].Signatures = sign(t, test.aggs[i], vals)
 			}
 
-			err := keeper.verifyAggVotes(ctx, cChainID, valset, test.aggs, windowCompareFunc)
+			err := keeper.verifyAggVotes(ctx, cChainID, valset, test.aggs, voteExtLimit, windowCompareFunc)
 			if test.errStr == "" {
 				require.NoError(t, err)
 			} else {
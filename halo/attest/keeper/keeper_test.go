@@ -9,6 +9,8 @@ import (
 	"github.com/omni-network/omni/lib/umath"
 	"github.com/omni-network/omni/lib/xchain"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,6 +18,8 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestKeeper_Add(t *testing.T) {
@@ -174,6 +178,10 @@ func TestKeeper_Add(t *testing.T) {
 		},
 		{
 			name: "skip_mismatching_att_root_same_block_and_vals",
+			expectations: []expectation{
+				mockDefaultExpectations,
+				doubleSignPenalized(val1, val2),
+			},
 			args: args{
 				msg: defaultMsg().
 					WithVotes(
@@ -208,6 +216,15 @@ func TestKeeper_Add(t *testing.T) {
 					// Update agg vote's signatures are not added, since they are double signs
 				},
 			},
+			postrequisites: []postrequisite{
+				func(t *testing.T, k *keeper.Keeper, ctx sdk.Context) {
+					t.Helper()
+					// Double signs are recorded as evidence
+					resp, err := k.DoubleSignEvidence(ctx, &types.DoubleSignEvidenceRequest{})
+					require.NoError(t, err)
+					require.Len(t, resp.GetEvidence(), 2)
+				},
+			},
 		},
 		{
 			name: "mismatching_att_root_same_block_diff_vals",
@@ -956,6 +973,86 @@ func TestKeeper_Approve(t *testing.T) {
 	}
 }
 
+func TestKeeper_ApproveEvents(t *testing.T) {
+	t.Parallel()
+
+	valset := newValSet(1, val1, val2)
+	k, ctx := setupKeeper(t, mockDefaultExpectations, trimBehindCalled())
+
+	for i := uint64(0); i < 3; i++ {
+		vote := defaultAggVote().WithAttestOfset(defaultOffset + i).Vote()
+		if i == 2 {
+			vote = defaultAggVote().WithAttestOfset(defaultOffset + i).WithSignatures(sigsTuples(val3)...).Vote()
+		}
+		err := k.Add(ctx, defaultMsg().Default().WithVotes(vote).Msg())
+		require.NoError(t, err)
+	}
+
+	// Only the first two attestations are approved.
+	err := k.Approve(ctx, toValSet(valset))
+	require.NoError(t, err)
+
+	atts, _ := dumpTables(t, ctx, k)
+
+	var events []*types.EventAttestationApproved
+	for _, event := range ctx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		e, ok := msg.(*types.EventAttestationApproved)
+		require.True(t, ok)
+		events = append(events, e)
+	}
+
+	require.Len(t, events, 2)
+	for i, e := range events {
+		require.Equal(t, defaultChainID, e.GetChainId())
+		require.Equal(t, defaultConfLevel, e.GetConfLevel())
+		require.Equal(t, defaultOffset+uint64(i), e.GetAttestOffset())
+		require.Equal(t, atts[i].GetAttestationRoot(), e.GetAttestationRoot())
+	}
+}
+
+func TestKeeper_OffsetHeight(t *testing.T) {
+	t.Parallel()
+
+	valset := newValSet(1, val1, val2)
+	k, ctx := setupKeeper(t, mockDefaultExpectations, trimBehindCalled(), trimBehindCalled(), trimBehindCalled())
+
+	// Approve offsets [1,2] at height 10, [3] at height 11 and [4,5] at height 12.
+	approve := func(height int64, offsets ...uint64) {
+		for _, offset := range offsets {
+			vote := defaultAggVote().WithAttestOfset(offset).Vote()
+			err := k.Add(ctx, defaultMsg().Default().WithVotes(vote).Msg())
+			require.NoError(t, err)
+		}
+		err := k.Approve(ctx.WithBlockHeight(height), toValSet(valset))
+		require.NoError(t, err)
+	}
+	approve(10, 1, 2)
+	approve(11, 3)
+	approve(12, 4, 5)
+
+	expected := map[uint64]uint64{1: 10, 2: 10, 3: 11, 4: 12, 5: 12}
+	for offset, height := range expected {
+		resp, err := k.OffsetHeight(ctx, &types.OffsetHeightRequest{
+			ChainId:      defaultChainID,
+			ConfLevel:    defaultConfLevel,
+			AttestOffset: offset,
+		})
+		require.NoError(t, err)
+		require.Equal(t, height, resp.GetHeight(), "offset %d", offset)
+	}
+
+	// Offsets not approved yet or of other chains are not found.
+	for _, req := range []*types.OffsetHeightRequest{
+		{ChainId: defaultChainID, ConfLevel: defaultConfLevel, AttestOffset: 6},
+		{ChainId: defaultChainID + 1, ConfLevel: defaultConfLevel, AttestOffset: 1},
+	} {
+		_, err := k.OffsetHeight(ctx, req)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	}
+}
+
 func toValSet(valset *vtypes.ValidatorSetResponse) keeper.ValSet {
 	if valset == nil {
 		return keeper.ValSet{}
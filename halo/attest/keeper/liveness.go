@@ -0,0 +1,311 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/ormgenesis"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// InitGenesis stores the genesis params and imports the exported tables, if any.
+func (k *Keeper) InitGenesis(ctx context.Context, genesis *types.GenesisState) error {
+	if err := genesis.Validate(); err != nil {
+		return errors.Wrap(err, "validate genesis")
+	}
+
+	// Exported genesis states include all tables (which includes the params).
+	if err := ormgenesis.Import(ctx, k.modDB, genesis.GetTables()); err != nil {
+		return errors.Wrap(err, "import tables")
+	}
+
+	return k.setParams(ctx, genesis.GetParams())
+}
+
+// ExportGenesis returns the genesis state containing the current params and all tables.
+func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := ormgenesis.Export(ctx, k.modDB)
+	if err != nil {
+		return nil, errors.Wrap(err, "export tables")
+	}
+
+	return &types.GenesisState{Params: params, Tables: tables}, nil
+}
+
+// getParams returns the params stored in state.
+// Zero params are returned if none were stored, which disables liveness tracking.
+// Zero vote params default to the previously hardcoded values, since networks that predate
+// governable vote params only store them from the V1 network upgrade, see MigrateV1.
+func (k *Keeper) getParams(ctx context.Context) (types.Params, error) {
+	params, err := k.paramsTable.Get(ctx)
+	if err != nil {
+		return types.Params{}, errors.Wrap(err, "get params")
+	}
+
+	defaults := types.DefaultParams()
+
+	orDefault := func(val uint64, def uint64) uint64 {
+		if val == 0 {
+			return def
+		}
+
+		return val
+	}
+
+	return types.Params{
+		LivenessWindow:           params.GetLivenessWindow(),
+		LivenessMaxMissedPercent: params.GetLivenessMaxMissedPercent(),
+		LivenessJailDuration:     params.GetLivenessJailDuration().AsDuration(),
+		VoteExtensionEncoding:    types.VoteExtensionEncoding(params.GetVoteExtensionEncoding()),
+		VoteWindow:               orDefault(params.GetVoteWindow(), defaults.GetVoteWindow()),
+		VoteExtensionLimit:       orDefault(params.GetVoteExtensionLimit(), defaults.GetVoteExtensionLimit()),
+		TrimLag:                  orDefault(params.GetTrimLag(), defaults.GetTrimLag()),
+		ConsensusTrimLag:         orDefault(params.GetConsensusTrimLag(), defaults.GetConsensusTrimLag()),
+	}, nil
+}
+
+// UpdateVoteParams updates the vote params stored in state.
+// It is called when the vote params are updated via the EVM predeploy.
+func (k *Keeper) UpdateVoteParams(ctx context.Context, voteWindow, voteExtLimit, trimLag, cTrimLag uint64) error {
+	if voteWindow == 0 || voteExtLimit == 0 || trimLag == 0 || cTrimLag == 0 {
+		return errors.New("zero vote params")
+	}
+
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.VoteWindow = voteWindow
+	params.VoteExtensionLimit = voteExtLimit
+	params.TrimLag = trimLag
+	params.ConsensusTrimLag = cTrimLag
+
+	if err := params.Validate(); err != nil {
+		return errors.Wrap(err, "validate params")
+	}
+
+	return k.setParams(ctx, params)
+}
+
+// UpdateVoteExtensionEncoding updates the vote extension encoding stored in state.
+// It is called when the encoding is updated via the EVM predeploy.
+// Switching encodings is safe at any height, since vote extensions are decoded in any of the supported encodings.
+func (k *Keeper) UpdateVoteExtensionEncoding(ctx context.Context, encoding types.VoteExtensionEncoding) error {
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.VoteExtensionEncoding = encoding
+
+	if err := params.Validate(); err != nil {
+		return errors.Wrap(err, "validate params")
+	}
+
+	return k.setParams(ctx, params)
+}
+
+// setParams stores the params in state.
+func (k *Keeper) setParams(ctx context.Context, params types.Params) error {
+	err := k.paramsTable.Save(ctx, &Params{
+		LivenessWindow:           params.GetLivenessWindow(),
+		LivenessMaxMissedPercent: params.GetLivenessMaxMissedPercent(),
+		LivenessJailDuration:     durationpb.New(params.GetLivenessJailDuration()),
+		VoteExtensionEncoding:    uint32(params.GetVoteExtensionEncoding()),
+		VoteWindow:               params.GetVoteWindow(),
+		VoteExtensionLimit:       params.GetVoteExtensionLimit(),
+		TrimLag:                  params.GetTrimLag(),
+		ConsensusTrimLag:         params.GetConsensusTrimLag(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "save params")
+	}
+
+	return nil
+}
+
+// updatePrevApprovedLiveness updates the liveness of the attestation approved before the provided (just approved) attestation.
+//
+// Liveness of an attestation is only updated once the next attestation is approved, since votes are still
+// included after quorum is reached. Otherwise, validators whose votes are usually included a block after
+// quorum (common for small validator sets) would be counted as missed.
+// Note the previous attestation is never deleted before the next is approved, see deleteBefore.
+func (k *Keeper) updatePrevApprovedLiveness(ctx context.Context, params types.Params, valset ValSet, att *Attestation) error {
+	if params.GetLivenessWindow() == 0 {
+		return nil // Liveness tracking disabled.
+	} else if att.GetAttestOffset() <= initialAttestOffset {
+		return nil // No previous attestation.
+	}
+
+	prevIdx := AttestationStatusChainIdConfLevelAttestOffsetIndexKey{}.WithStatusChainIdConfLevelAttestOffset(
+		uint32(Status_Approved), att.GetChainId(), att.GetConfLevel(), att.GetAttestOffset()-1)
+	iter, err := k.attTable.List(ctx, prevIdx)
+	if err != nil {
+		return errors.Wrap(err, "list previous")
+	}
+	defer iter.Close()
+
+	if !iter.Next() {
+		return nil // Previous attestation already deleted.
+	}
+
+	prev, err := iter.Value()
+	if err != nil {
+		return errors.Wrap(err, "value previous")
+	} else if prev.GetFinalizedAttId() != 0 {
+		return nil // Overridden by a finalized attestation, so not approved by quorum.
+	}
+
+	if prev.GetValidatorSetId() != valset.ID {
+		if valset, err = k.attestationValSet(ctx, prev); err != nil {
+			return err
+		}
+	}
+
+	sigs, err := k.getSigs(ctx, prev.GetId())
+	if err != nil {
+		return errors.Wrap(err, "get previous signatures")
+	}
+
+	return k.updateApprovedLiveness(ctx, params, valset, sigs)
+}
+
+// updateApprovedLiveness updates the liveness of all validators in the approving set of an attestation.
+// Validators without a signature are counted as missed.
+func (k *Keeper) updateApprovedLiveness(ctx context.Context, params types.Params, valset ValSet, sigs []*Signature) error {
+
+	signed := make(map[common.Address]bool)
+	for _, sig := range sigs {
+		signed[common.BytesToAddress(sig.GetValidatorAddress())] = true
+	}
+
+	// Iterate deterministically, since jailing emits events.
+	addrs := make([]common.Address, 0, len(valset.Vals))
+	for addr := range valset.Vals {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+
+	for _, addr := range addrs {
+		if err := k.updateLiveness(ctx, params, addr, !signed[addr]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateLiveness adds an approved attestation to the validator's sliding liveness window.
+// It jails the validator if it missed more than the maximum percentage of attestations in a full window.
+func (k *Keeper) updateLiveness(ctx context.Context, params types.Params, addr common.Address, missed bool) error {
+	window := params.GetLivenessWindow()
+	if window == 0 {
+		return nil // Liveness tracking disabled.
+	}
+
+	info, err := k.livenessTable.Get(ctx, addr.Bytes())
+	if ormerrors.IsNotFound(err) {
+		info = &LivenessInfo{ValidatorAddress: addr.Bytes(), Window: window}
+	} else if err != nil {
+		return errors.Wrap(err, "get liveness info")
+	} else if info.GetWindow() != window {
+		// Restart tracking if the window size changed, since the indexes are no longer valid.
+		if info, err = k.resetLiveness(ctx, addr, window); err != nil {
+			return err
+		}
+	}
+
+	// Update the missed attestation at the current index in the window, overwriting the previous value.
+	index := info.GetIndexOffset() % window
+	prevMissed, err := k.missedTable.Has(ctx, addr.Bytes(), index)
+	if err != nil {
+		return errors.Wrap(err, "has missed")
+	}
+
+	if missed && !prevMissed {
+		if err := k.missedTable.Insert(ctx, &LivenessMissed{ValidatorAddress: addr.Bytes(), Index: index}); err != nil {
+			return errors.Wrap(err, "insert missed")
+		}
+		info.MissedCount++
+	} else if !missed && prevMissed {
+		if err := k.missedTable.Delete(ctx, &LivenessMissed{ValidatorAddress: addr.Bytes(), Index: index}); err != nil {
+			return errors.Wrap(err, "delete missed")
+		}
+		info.MissedCount--
+	}
+	info.IndexOffset++
+
+	// Only jail once a full window has been tracked.
+	if info.GetIndexOffset() >= window && info.GetMissedCount()*100 > window*uint64(params.GetLivenessMaxMissedPercent()) {
+		if err := k.jailForDowntime(ctx, params, addr, info); err != nil {
+			return err
+		}
+
+		// Restart tracking after jailing (or if not active anymore).
+		if info, err = k.resetLiveness(ctx, addr, window); err != nil {
+			return err
+		}
+	}
+
+	if err := k.livenessTable.Save(ctx, info); err != nil {
+		return errors.Wrap(err, "save liveness info")
+	}
+
+	return nil
+}
+
+// jailForDowntime jails the validator for missing too many attestations if it is still in the active set.
+func (k *Keeper) jailForDowntime(ctx context.Context, params types.Params, addr common.Address, info *LivenessInfo) error {
+	val, ok, err := k.activeValidator(ctx, addr)
+	if err != nil {
+		return err
+	} else if !ok {
+		log.Debug(ctx, "Not jailing inactive validator for attestation downtime", "validator", addr)
+		return nil
+	}
+
+	cmtAddr, err := val.CometAddress()
+	if err != nil {
+		return err
+	}
+
+	if err := k.jail(ctx, sdk.ConsAddress(cmtAddr), params.GetLivenessJailDuration(), types.JailReason_JAIL_REASON_ATTEST_DOWNTIME); err != nil {
+		return err
+	}
+
+	livenessJailCounter.WithLabelValues(addr.Hex()).Inc()
+	log.Warn(ctx, "Jailed validator for attestation downtime", nil,
+		"validator", addr,
+		"missed", info.GetMissedCount(),
+		"window", info.GetWindow(),
+		"jail_duration", params.GetLivenessJailDuration(),
+	)
+
+	return nil
+}
+
+// resetLiveness deletes all missed attestations of the validator and returns new liveness info.
+func (k *Keeper) resetLiveness(ctx context.Context, addr common.Address, window uint64) (*LivenessInfo, error) {
+	if err := k.missedTable.DeleteBy(ctx, LivenessMissedPrimaryKey{}.WithValidatorAddress(addr.Bytes())); err != nil {
+		return nil, errors.Wrap(err, "delete missed")
+	}
+
+	return &LivenessInfo{ValidatorAddress: addr.Bytes(), Window: window}, nil
+}
@@ -0,0 +1,268 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/halo/attest/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sltypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestKeeper_Liveness(t *testing.T) {
+	t.Parallel()
+
+	const window = 4
+	const jailDuration = time.Hour
+	const deleteHeight = 1 + trimLag + 1
+
+	// val3 has little power, so attestations are approved without its votes.
+	lazyVal := newValidator(vals[2].PubKey(), 1)
+	valset := newValSet(1, val1, val2, lazyVal)
+
+	k, ctx := setupKeeper(t, mockDefaultExpectations, trimBehindCalled(), noFuzzyDeps(), func(ctx sdk.Context, m mocks) {
+		m.valProvider.EXPECT().ValidatorSet(gomock.Any(), gomock.Any()).Return(valset, nil).AnyTimes()
+		m.valProvider.EXPECT().ActiveSetByHeight(gomock.Any(), uint64(ctx.BlockHeight())).Return(valset, nil).AnyTimes()
+
+		// val3 never votes, so it is jailed once a full window has been approved.
+		cmtAddr, err := lazyVal.CometAddress()
+		require.NoError(t, err)
+		m.slashKeeper.EXPECT().Jail(gomock.Any(), sdk.ConsAddress(cmtAddr)).Times(1)
+		m.slashKeeper.EXPECT().JailUntil(gomock.Any(), sdk.ConsAddress(cmtAddr), ctx.BlockTime().Add(jailDuration)).Times(1)
+		gomock.InOrder(
+			m.slashKeeper.EXPECT().GetValidatorSigningInfo(gomock.Any(), sdk.ConsAddress(cmtAddr)).
+				Return(sltypes.ValidatorSigningInfo{}, nil), // Not jailed before.
+			m.slashKeeper.EXPECT().GetValidatorSigningInfo(gomock.Any(), sdk.ConsAddress(cmtAddr)).
+				Return(sltypes.ValidatorSigningInfo{JailedUntil: ctx.BlockTime().Add(jailDuration)}, nil),
+		)
+	})
+
+	// Liveness is disabled without liveness params (i.e. networks without attest module genesis).
+	params, err := k.Params(ctx, &types.ParamsRequest{})
+	require.NoError(t, err)
+	require.Zero(t, params.Params.LivenessWindow)
+
+	genesis := &types.GenesisState{Params: types.Params{
+		LivenessWindow:           window,
+		LivenessMaxMissedPercent: 50,
+		LivenessJailDuration:     jailDuration,
+		VoteWindow:               voteWindow,
+		VoteExtensionLimit:       voteLimit,
+		TrimLag:                  trimLag,
+		ConsensusTrimLag:         cTrimLag,
+	}}
+	require.NoError(t, k.InitGenesis(ctx, genesis))
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, genesis.Params, exported.Params)
+	require.NotEmpty(t, exported.Tables)
+
+	// Approve a full window of attestations signed by val1 and val2.
+	// Liveness of an attestation is updated when the next is approved, so approve one more.
+	var votes []*types.AggVote
+	for i := uint64(0); i < window+1; i++ {
+		votes = append(votes, defaultAggVote().WithAttestOfset(defaultOffset+i).WithBlockHeight(defaultHeight+i).Vote())
+	}
+	require.NoError(t, k.Add(ctx, defaultMsg().WithVotes(votes...).Msg()))
+	require.NoError(t, k.Approve(ctx, toValSet(valset)))
+
+	// Deleting the approved attestations doesn't update liveness again.
+	require.NoError(t, k.BeginBlock(ctx.WithBlockHeight(deleteHeight)))
+
+	livenessOf := func(addr []byte) *types.LivenessResponse {
+		t.Helper()
+		resp, err := k.Liveness(ctx, &types.LivenessRequest{ValidatorAddress: addr})
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	addr1, err := val1.EthereumAddress()
+	require.NoError(t, err)
+	live1 := livenessOf(addr1.Bytes())
+	require.EqualValues(t, window, live1.GetWindow())
+	require.EqualValues(t, window, live1.GetIndexOffset())
+	require.Zero(t, live1.GetMissedCount())
+
+	// val3's liveness is reset after being jailed.
+	addr3, err := lazyVal.EthereumAddress()
+	require.NoError(t, err)
+	live3 := livenessOf(addr3.Bytes())
+	require.EqualValues(t, window, live3.GetWindow())
+	require.Zero(t, live3.GetIndexOffset())
+	require.Zero(t, live3.GetMissedCount())
+
+	// val3's jail status reports the attest downtime jailing.
+	cmtAddr3, err := lazyVal.CometAddress()
+	require.NoError(t, err)
+	jail3, err := k.JailStatus(ctx, &types.JailStatusRequest{ConsAddress: cmtAddr3.Bytes()})
+	require.NoError(t, err)
+	require.Equal(t, types.JailReason_JAIL_REASON_ATTEST_DOWNTIME, jail3.GetReason())
+	require.Equal(t, ctx.BlockTime().Add(jailDuration), jail3.GetJailedUntil())
+	require.EqualValues(t, ctx.BlockHeight(), jail3.GetHeight()) // Jailed when the window is approved.
+	require.False(t, jail3.GetTombstoned())
+
+	_, err = k.Liveness(ctx, &types.LivenessRequest{})
+	require.Error(t, err)
+}
+
+func TestKeeper_LivenessLateVotes(t *testing.T) {
+	t.Parallel()
+
+	const window = 4
+
+	// lateVal has little power, so attestations are approved before its votes are included.
+	lateVal := newValidator(vals[2].PubKey(), 1)
+	valset := newValSet(1, val1, val2, lateVal)
+
+	k, ctx := setupKeeper(t, mockDefaultExpectations, func(ctx sdk.Context, m mocks) {
+		m.voter.EXPECT().TrimBehind(gomock.Any()).Return(0).AnyTimes()
+		m.valProvider.EXPECT().ActiveSetByHeight(gomock.Any(), uint64(ctx.BlockHeight())).Return(valset, nil).AnyTimes()
+		// No jailing expected.
+	})
+
+	require.NoError(t, k.InitGenesis(ctx, &types.GenesisState{Params: types.Params{
+		LivenessWindow:           window,
+		LivenessMaxMissedPercent: 50,
+		LivenessJailDuration:     time.Hour,
+		VoteWindow:               voteWindow,
+		VoteExtensionLimit:       voteLimit,
+		TrimLag:                  trimLag,
+		ConsensusTrimLag:         cTrimLag,
+	}}))
+
+	// Each block approves the next attestation, and includes lateVal's vote of the previous attestation.
+	const count = 2 * window
+	for i := uint64(0); i < count; i++ {
+		votes := []*types.AggVote{
+			defaultAggVote().WithAttestOfset(defaultOffset + i).WithBlockHeight(defaultHeight + i).Vote(),
+		}
+		if i > 0 {
+			votes = append(votes, defaultAggVote().
+				WithAttestOfset(defaultOffset+i-1).
+				WithBlockHeight(defaultHeight+i-1).
+				WithSignatures(sigsTuples(lateVal)...).
+				Vote())
+		}
+
+		require.NoError(t, k.Add(ctx, defaultMsg().WithVotes(votes...).Msg()))
+		require.NoError(t, k.Approve(ctx, toValSet(valset)))
+	}
+
+	addr, err := lateVal.EthereumAddress()
+	require.NoError(t, err)
+	live, err := k.Liveness(ctx, &types.LivenessRequest{ValidatorAddress: addr.Bytes()})
+	require.NoError(t, err)
+	require.EqualValues(t, count-1, live.GetIndexOffset()) // Not reset, so not jailed.
+	require.Zero(t, live.GetMissedCount())
+}
+
+func TestKeeper_UpdateVoteParams(t *testing.T) {
+	t.Parallel()
+
+	k, ctx := setupKeeper(t, mockDefaultExpectations)
+
+	// Vote params are stored by the test setup.
+	params, err := k.Params(ctx, &types.ParamsRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, voteWindow, params.Params.VoteWindow)
+	require.EqualValues(t, voteLimit, params.Params.VoteExtensionLimit)
+	require.EqualValues(t, trimLag, params.Params.TrimLag)
+	require.EqualValues(t, cTrimLag, params.Params.ConsensusTrimLag)
+
+	require.NoError(t, k.UpdateVoteParams(ctx, 64, 256, 2, 10))
+
+	params, err = k.Params(ctx, &types.ParamsRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 64, params.Params.VoteWindow)
+	require.EqualValues(t, 256, params.Params.VoteExtensionLimit)
+	require.EqualValues(t, 2, params.Params.TrimLag)
+	require.EqualValues(t, 10, params.Params.ConsensusTrimLag)
+
+	// Invalid params are rejected and leave state unchanged.
+	require.ErrorContains(t, k.UpdateVoteParams(ctx, 0, 256, 2, 10), "zero vote params")
+	require.ErrorContains(t, k.UpdateVoteParams(ctx, 64, 256, 10, 2), "consensus trim lag")
+	require.ErrorContains(t, k.UpdateVoteParams(ctx, 64, 1<<20, 2, 10), "vote extension limit")
+
+	params, err = k.Params(ctx, &types.ParamsRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 64, params.Params.VoteWindow)
+	require.EqualValues(t, 10, params.Params.ConsensusTrimLag)
+}
+
+func TestKeeper_MigrateLivenessParams(t *testing.T) {
+	t.Parallel()
+
+	k, ctx := setupKeeper(t, mockDefaultExpectations)
+
+	// Networks that predate attest module genesis don't store params.
+	require.NoError(t, k.DeleteParams(ctx))
+	params, err := k.Params(ctx, &types.ParamsRequest{})
+	require.NoError(t, err)
+	require.Zero(t, params.Params.LivenessWindow)
+
+	require.NoError(t, k.MigrateV1(ctx))
+
+	defaults := types.DefaultParams()
+	params, err = k.Params(ctx, &types.ParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, defaults.LivenessWindow, params.Params.LivenessWindow)
+	require.Equal(t, defaults.LivenessMaxMissedPercent, params.Params.LivenessMaxMissedPercent)
+	require.Equal(t, defaults.LivenessJailDuration, params.Params.LivenessJailDuration)
+	require.Equal(t, defaults.TrimLag, params.Params.TrimLag)
+
+	// Existing liveness params are retained.
+	genesis := &types.GenesisState{Params: types.Params{
+		LivenessWindow:           10,
+		LivenessMaxMissedPercent: 20,
+		LivenessJailDuration:     time.Hour,
+	}}
+	require.NoError(t, k.InitGenesis(ctx, genesis))
+	require.NoError(t, k.MigrateV1(ctx))
+
+	params, err = k.Params(ctx, &types.ParamsRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 10, params.Params.LivenessWindow)
+	require.EqualValues(t, 20, params.Params.LivenessMaxMissedPercent)
+	require.Equal(t, time.Hour, params.Params.LivenessJailDuration)
+
+	// Stored zero liveness params remain disabled.
+	require.NoError(t, k.InitGenesis(ctx, &types.GenesisState{}))
+	require.NoError(t, k.MigrateV1(ctx))
+
+	params, err = k.Params(ctx, &types.ParamsRequest{})
+	require.NoError(t, err)
+	require.Zero(t, params.Params.LivenessWindow)
+}
+
+func TestKeeper_MigrateVoteParams(t *testing.T) {
+	t.Parallel()
+
+	k, ctx := setupKeeper(t, mockDefaultExpectations)
+
+	// Networks that predate governable vote params have zero vote params,
+	// which default to the previously hardcoded values.
+	require.NoError(t, k.InitGenesis(ctx, &types.GenesisState{}))
+	stored, err := k.ParamsTable().Get(ctx)
+	require.NoError(t, err)
+	require.Zero(t, stored.GetVoteWindow())
+
+	defaults := types.DefaultParams()
+	params, err := k.Params(ctx, &types.ParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, defaults.VoteWindow, params.Params.VoteWindow)
+
+	// Migrating stores the previously hardcoded vote params.
+	require.NoError(t, k.MigrateV1(ctx))
+
+	stored, err = k.ParamsTable().Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, defaults.VoteWindow, stored.GetVoteWindow())
+	require.Equal(t, defaults.VoteExtensionLimit, stored.GetVoteExtensionLimit())
+	require.Equal(t, defaults.TrimLag, stored.GetTrimLag())
+	require.Equal(t, defaults.ConsensusTrimLag, stored.GetConsensusTrimLag())
+}
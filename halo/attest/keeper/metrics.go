@@ -53,12 +53,26 @@ var (
 		Help:      "Total number of double sign votes detected per validator",
 	}, []string{"validator"})
 
+	doubleSignPenaltyCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "halo",
+		Subsystem: "attest",
+		Name:      "double_sign_penalties_total",
+		Help:      "Total number of times a validator was slashed and jailed for double signing attestations",
+	}, []string{"validator"})
+
+	livenessJailCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "halo",
+		Subsystem: "attest",
+		Name:      "liveness_jails_total",
+		Help:      "Total number of times a validator was jailed for missing too many approved attestations",
+	}, []string{"validator"})
+
 	approvedVotesCounter = promauto.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "halo",
 		Subsystem: "attest",
 		Name:      "votes_approved_total",
 		Help: "Total number of votes included in approved attestations per validator per stream. " +
-			"Approved votes were present in approved attestations at time of deletion. They count towards rewards",
+			"Approved votes were present in attestations at time of approval. They count towards rewards",
 	}, []string{"validator", "stream"})
 
 	discardedVotesCounter = promauto.NewCounterVec(prometheus.CounterOpts{
@@ -70,14 +84,49 @@ var (
 			"for non-quorum attestations (wrong). They don't count towards rewards",
 	}, []string{"validator", "stream"})
 
+	includedVotesCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "halo",
+		Subsystem: "attest",
+		Name:      "votes_included_total",
+		Help:      "Total number of votes included on-chain per validator per stream",
+	}, []string{"validator", "stream"})
+
+	lastVoteHeight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "halo",
+		Subsystem: "attest",
+		Name:      "last_vote_height",
+		Help:      "The consensus height at which a vote was last included on-chain per validator per stream",
+	}, []string{"validator", "stream"})
+
 	missingVotesCounter = promauto.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "halo",
 		Subsystem: "attest",
 		Name:      "votes_missing_total",
 		Help: "Total number of votes missing from approved attestations per validator per stream. " +
-			"Missing votes were missing from approved attestations at time of deletion. " +
+			"Missing votes were missing from attestations at time of approval. " +
 			"They may be late or never included on-chain. missing-discarded==not-voting",
 	}, []string{"validator", "stream"})
+
+	archivedCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "halo",
+		Subsystem: "attest",
+		Name:      "archived_total",
+		Help:      "Total number of approved attestations archived before pruning per source chain version",
+	}, []string{"chain_version"})
+
+	archiveErrCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "halo",
+		Subsystem: "attest",
+		Name:      "archive_errors_total",
+		Help:      "Total number of errors archiving approved attestations before pruning. Alert if growing.",
+	})
+
+	deleteLimitedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "halo",
+		Subsystem: "attest",
+		Name:      "delete_limited_total",
+		Help:      "Total number of blocks that reached the attestation delete limit, deferring deletes to the next block",
+	})
 )
 
 func latency(method string) func() {
@@ -24,11 +24,16 @@ func (s proposalServer) AddVotes(ctx context.Context, msg *types.MsgAddVotes,
 		return nil, errors.Wrap(err, "parse chain id")
 	}
 
+	params, err := s.getParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Verify proposed msg
 	valset, err := s.prevBlockValSet(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "fetch validators")
-	} else if err := s.verifyAggVotes(ctx, consensusID, valset, msg.Votes, s.windowCompare); err != nil {
+	} else if err := s.verifyAggVotes(ctx, consensusID, valset, msg.Votes, params.GetVoteExtensionLimit(), s.windowCompare); err != nil {
 		return nil, errors.Wrap(err, "verify votes")
 	}
 
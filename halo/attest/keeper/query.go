@@ -1,28 +1,49 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"math"
+	"slices"
+	"time"
 
 	"github.com/omni-network/omni/halo/attest/types"
+	vtypes "github.com/omni-network/omni/halo/valsync/types"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/netconf"
 	"github.com/omni-network/omni/lib/xchain"
 
+	"github.com/cometbft/cometbft/crypto"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/orm/model/ormlist"
+	"cosmossdk.io/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sltypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
 
-const approvedFromLimit = 100
+// ApprovedFromLimit is the maximum number of attestations returned by the AttestationsFrom query.
+const ApprovedFromLimit = 100
+
+const (
+	attestationsLimit = 100 // Default and maximum number of attestations returned by list queries.
+	// attestationsScanLimit is the maximum number of attestations scanned by a list query, bounding its cost.
+	// Including offset pagination and count total.
+	attestationsScanLimit = 10_000
+)
 
 func (k *Keeper) AttestationsFrom(ctx context.Context, req *types.AttestationsFromRequest) (*types.AttestationsFromResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	atts, err := k.ListAttestationsFrom(ctx, req.ChainId, req.ConfLevel, req.FromOffset, approvedFromLimit)
+	atts, err := k.ListAttestationsFrom(ctx, req.ChainId, req.ConfLevel, req.FromOffset, ApprovedFromLimit)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -116,6 +137,437 @@ func (k *Keeper) WindowCompare(ctx context.Context, req *types.WindowCompareRequ
 	return &types.WindowCompareResponse{Cmp: int32(cmp)}, nil
 }
 
+func (k *Keeper) OffsetHeight(ctx context.Context, req *types.OffsetHeightRequest) (*types.OffsetHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	height, ok, err := k.offsetHeight(ctx, req.XChainVersion(), req.GetAttestOffset())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if !ok {
+		return nil, status.Error(codes.NotFound, "attest offset not indexed")
+	}
+
+	return &types.OffsetHeightResponse{Height: height}, nil
+}
+
+func (k *Keeper) AttestationByRoot(ctx context.Context, req *types.AttestationByRootRequest) (*types.AttestationByRootResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	att, err := k.attTable.GetByAttestationRoot(ctx, req.GetAttestationRoot())
+	if ormerrors.IsNotFound(err) {
+		return nil, status.Error(codes.NotFound, "attestation not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	info, err := k.formAttestationInfo(ctx, att)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.AttestationByRootResponse{Attestation: info}, nil
+}
+
+func (k *Keeper) AttestationsByBlock(ctx context.Context, req *types.AttestationsByBlockRequest) (*types.AttestationsByBlockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	idx := AttestationChainIdConfLevelBlockHeightIndexKey{}.WithChainIdConfLevelBlockHeight(req.GetChainId(), req.GetConfLevel(), req.GetBlockHeight())
+	iter, err := k.attTable.List(ctx, idx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	var resp []*types.AttestationInfo
+	for iter.Next() {
+		att, err := iter.Value()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if len(req.GetBlockHash()) > 0 && !bytes.Equal(att.GetBlockHash(), req.GetBlockHash()) {
+			continue
+		}
+
+		info, err := k.formAttestationInfo(ctx, att)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		resp = append(resp, info)
+	}
+
+	return &types.AttestationsByBlockResponse{Attestations: resp}, nil
+}
+
+func (k *Keeper) Attestations(ctx context.Context, req *types.AttestationsRequest) (*types.AttestationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	page := req.GetPagination()
+	if len(page.GetKey()) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "key pagination not supported")
+	}
+
+	limit := page.GetLimit()
+	if limit == 0 || limit > attestationsLimit {
+		limit = attestationsLimit
+	}
+
+	maxHeight := req.GetMaxCreatedHeight()
+	if maxHeight == 0 {
+		maxHeight = math.MaxUint64
+	} else if maxHeight < req.GetMinCreatedHeight() {
+		return nil, status.Error(codes.InvalidArgument, "max created height below min created height")
+	}
+
+	if req.GetChainId() != 0 && req.GetConfLevel() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "conf level required with chain id")
+	}
+
+	iter, err := k.listAttestations(ctx, req, maxHeight, page.GetReverse())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	var scanned, total uint64
+	var resp []*types.AttestationInfo
+	for iter.Next() {
+		scanned++
+		if scanned > attestationsScanLimit {
+			return nil, status.Error(codes.ResourceExhausted, "too many attestations to scan, narrow the filters or disable count total")
+		}
+
+		att, err := iter.Value()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if att.GetCreatedHeight() < req.GetMinCreatedHeight() || att.GetCreatedHeight() > maxHeight {
+			continue
+		} else if req.GetStatus() != 0 && att.GetStatus() != req.GetStatus() {
+			continue
+		}
+
+		total++
+		if total <= page.GetOffset() {
+			continue
+		} else if uint64(len(resp)) == limit {
+			if !page.GetCountTotal() {
+				break
+			}
+
+			continue
+		}
+
+		info, err := k.formAttestationInfo(ctx, att)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		resp = append(resp, info)
+	}
+
+	pageResp := new(query.PageResponse)
+	if page.GetCountTotal() {
+		pageResp.Total = total
+	}
+
+	return &types.AttestationsResponse{Attestations: resp, Pagination: pageResp}, nil
+}
+
+// listAttestations returns an iterator over the attestations using the most selective index for the request.
+// Chain filtered results are ordered by attest offset (with status) or source block height, otherwise by created height.
+// Note the returned attestations must still be filtered by created height and status.
+func (k *Keeper) listAttestations(ctx context.Context, req *types.AttestationsRequest, maxHeight uint64, reverse bool) (AttestationIterator, error) {
+	var opts []ormlist.Option
+	if reverse {
+		opts = append(opts, ormlist.Reverse())
+	}
+
+	if req.GetChainId() != 0 && req.GetStatus() != 0 {
+		idx := AttestationStatusChainIdConfLevelAttestOffsetIndexKey{}.WithStatusChainIdConfLevel(req.GetStatus(), req.GetChainId(), req.GetConfLevel())
+		return k.attTable.List(ctx, idx, opts...)
+	} else if req.GetChainId() != 0 {
+		idx := AttestationChainIdConfLevelBlockHeightIndexKey{}.WithChainIdConfLevel(req.GetChainId(), req.GetConfLevel())
+		return k.attTable.List(ctx, idx, opts...)
+	}
+
+	start := AttestationCreatedHeightIndexKey{}.WithCreatedHeight(req.GetMinCreatedHeight())
+	end := AttestationCreatedHeightIndexKey{}.WithCreatedHeight(maxHeight)
+
+	return k.attTable.ListRange(ctx, start, end, opts...)
+}
+
+func (k *Keeper) AttestationSignatures(ctx context.Context, req *types.AttestationSignaturesRequest) (*types.AttestationSignaturesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	att, err := k.attTable.GetByAttestationRoot(ctx, req.GetAttestationRoot())
+	if ormerrors.IsNotFound(err) {
+		return nil, status.Error(codes.NotFound, "attestation not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	valset, err := k.attestationValSet(ctx, att)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	sigs, err := k.getSigs(ctx, att.GetId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	sigsByAddr := make(map[common.Address][]byte)
+	for _, sig := range sigs {
+		sigsByAddr[common.BytesToAddress(sig.GetValidatorAddress())] = sig.GetSignature()
+	}
+
+	// Include all validators of the set (sorted by address), followed by any other signers.
+	addrs := make([]common.Address, 0, len(valset.Vals))
+	for addr := range valset.Vals {
+		addrs = append(addrs, addr)
+	}
+	slices.SortFunc(addrs, func(a, b common.Address) int { return bytes.Compare(a[:], b[:]) })
+
+	var signedPower int64
+	var votes []*types.ValidatorVote
+	for _, addr := range addrs {
+		sig, ok := sigsByAddr[addr]
+		if ok {
+			signedPower += valset.Vals[addr]
+		}
+
+		votes = append(votes, &types.ValidatorVote{
+			ValidatorAddress: addr.Bytes(),
+			Power:            valset.Vals[addr],
+			Signature:        sig,
+		})
+	}
+
+	for _, sig := range sigs {
+		if valset.Contains(common.BytesToAddress(sig.GetValidatorAddress())) {
+			continue
+		}
+
+		votes = append(votes, &types.ValidatorVote{
+			ValidatorAddress: sig.GetValidatorAddress(),
+			Signature:        sig.GetSignature(),
+		})
+	}
+
+	return &types.AttestationSignaturesResponse{
+		ValidatorSetId: valset.ID,
+		TotalPower:     valset.TotalPower(),
+		SignedPower:    signedPower,
+		Votes:          votes,
+	}, nil
+}
+
+func (k *Keeper) PendingAttestations(ctx context.Context, req *types.PendingAttestationsRequest) (*types.PendingAttestationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valset, err := k.approvingValSet(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	idx := AttestationStatusChainIdConfLevelAttestOffsetIndexKey{}.WithStatusChainIdConfLevel(uint32(Status_Pending), req.GetChainId(), req.GetConfLevel())
+	iter, err := k.attTable.List(ctx, idx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	var resp []*types.PendingAttestation
+	for iter.Next() && len(resp) < attestationsLimit {
+		att, err := iter.Value()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		sigs, err := k.getSigs(ctx, att.GetId())
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		var signedPower int64
+		for _, sig := range sigs {
+			signedPower += valset.Vals[common.BytesToAddress(sig.GetValidatorAddress())]
+		}
+
+		attResp, err := k.formAttestationResponse(ctx, att)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		resp = append(resp, &types.PendingAttestation{
+			Attestation:    attResp,
+			CreatedHeight:  att.GetCreatedHeight(),
+			ValidatorSetId: valset.ID,
+			SignedPower:    signedPower,
+			TotalPower:     valset.TotalPower(),
+			QuorumPower:    valset.QuorumPower(),
+		})
+	}
+
+	return &types.PendingAttestationsResponse{Attestations: resp}, nil
+}
+
+func (k *Keeper) VoteStats(ctx context.Context, req *types.VoteStatsRequest) (*types.VoteStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	stats, err := k.listVoteStats(ctx, req.GetValidatorAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.VoteStatsResponse{Stats: stats}, nil
+}
+
+func (k *Keeper) DoubleSignEvidence(ctx context.Context, req *types.DoubleSignEvidenceRequest) (*types.DoubleSignEvidenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	evidence, err := k.listDoubleSigns(ctx, req.GetValidatorAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.DoubleSignEvidenceResponse{Evidence: evidence}, nil
+}
+
+func (k *Keeper) Params(ctx context.Context, req *types.ParamsRequest) (*types.ParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.ParamsResponse{Params: params}, nil
+}
+
+func (k *Keeper) Liveness(ctx context.Context, req *types.LivenessRequest) (*types.LivenessResponse, error) {
+	if req == nil || len(req.GetValidatorAddress()) != common.AddressLength {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	info, err := k.livenessTable.Get(ctx, req.GetValidatorAddress())
+	if ormerrors.IsNotFound(err) {
+		return &types.LivenessResponse{}, nil
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.LivenessResponse{
+		Window:      info.GetWindow(),
+		IndexOffset: info.GetIndexOffset(),
+		MissedCount: info.GetMissedCount(),
+	}, nil
+}
+
+// JailStatus returns the reason and duration of the latest jailing of the validator.
+//
+// Only attest jailings are recorded, see Jail. Other jailings are inferred from the x/slashing signing info:
+// tombstoned validators were jailed by x/evidence for double signing consensus blocks, otherwise by x/slashing for downtime.
+// An attest jailing only applies if it matches the signing info's jailed until time, i.e., it wasn't superseded.
+func (k *Keeper) JailStatus(ctx context.Context, req *types.JailStatusRequest) (*types.JailStatusResponse, error) {
+	if req == nil || len(req.GetConsAddress()) != crypto.AddressSize {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	info, err := k.slashKeeper.GetValidatorSigningInfo(ctx, req.GetConsAddress())
+	if errors.Is(err, sltypes.ErrNoSigningInfoFound) {
+		return nil, status.Error(codes.NotFound, "no signing info for validator")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &types.JailStatusResponse{
+		JailedUntil: info.JailedUntil,
+		Tombstoned:  info.Tombstoned,
+	}
+
+	jail, err := k.jailTable.Get(ctx, req.GetConsAddress())
+	if err != nil && !ormerrors.IsNotFound(err) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	switch {
+	case info.Tombstoned:
+		resp.Reason = types.JailReason_JAIL_REASON_CONSENSUS_DOUBLE_SIGN
+	case jail != nil && jail.GetJailedUntil().AsTime().Equal(info.JailedUntil):
+		resp.Reason = types.JailReason(jail.GetReason())
+		resp.Height = jail.GetHeight()
+	case info.JailedUntil.After(time.Unix(0, 0)):
+		resp.Reason = types.JailReason_JAIL_REASON_CONSENSUS_DOWNTIME
+	default:
+		resp.Reason = types.JailReason_JAIL_REASON_UNSPECIFIED // Never jailed.
+	}
+
+	return resp, nil
+}
+
+// formAttestationInfo returns the attestation with its status and created height.
+func (k *Keeper) formAttestationInfo(ctx context.Context, att *Attestation) (*types.AttestationInfo, error) {
+	resp, err := k.formAttestationResponse(ctx, att)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AttestationInfo{
+		Attestation:   resp,
+		Status:        att.GetStatus(),
+		CreatedHeight: att.GetCreatedHeight(),
+	}, nil
+}
+
+// approvingValSet returns the validator set approving pending attestations in the next block.
+// That is the active set at the current height, see prevBlockValSet.
+func (k *Keeper) approvingValSet(ctx context.Context) (ValSet, error) {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	resp, err := k.valProvider.ActiveSetByHeight(ctx, uint64(height))
+	if err != nil {
+		return ValSet{}, errors.Wrap(err, "active set")
+	}
+
+	return valSetFromResponse(resp)
+}
+
+// attestationValSet returns the validator set that approved the attestation,
+// or the approving validator set if it is still pending.
+func (k *Keeper) attestationValSet(ctx context.Context, att *Attestation) (ValSet, error) {
+	if att.GetStatus() != uint32(Status_Approved) {
+		return k.approvingValSet(ctx)
+	}
+
+	resp, err := k.valProvider.ValidatorSet(ctx, &vtypes.ValidatorSetRequest{Id: att.GetValidatorSetId()})
+	if err != nil {
+		return ValSet{}, errors.Wrap(err, "validator set")
+	}
+
+	return valSetFromResponse(resp)
+}
+
 func getConsensusChainID(ctx context.Context) (uint64, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	return netconf.ConsensusChainIDStr2Uint64(sdkCtx.ChainID())
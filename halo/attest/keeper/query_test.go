@@ -0,0 +1,292 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/halo/attest/keeper"
+	"github.com/omni-network/omni/halo/attest/types"
+	vtypes "github.com/omni-network/omni/halo/valsync/types"
+
+	"github.com/cometbft/cometbft/crypto"
+
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sltypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestKeeper_AttestationQueries(t *testing.T) {
+	t.Parallel()
+
+	approvedSet := newValSet(1, val1, val2)
+	approvingSet := newValSet(2, val1, val2, val3)
+
+	k, ctx := setupKeeper(t, mockDefaultExpectations, trimBehindCalled(), func(_ sdk.Context, m mocks) {
+		m.valProvider.EXPECT().ActiveSetByHeight(gomock.Any(), uint64(1)).Return(approvingSet, nil).AnyTimes()
+		m.valProvider.EXPECT().ValidatorSet(gomock.Any(), gomock.Any()).Return(approvedSet, nil).AnyTimes()
+	})
+
+	// Offset 1 is approved, offset 2 is pending with only val1's signature.
+	vote1 := defaultAggVote().Vote()
+	vote2 := defaultAggVote().WithAttestOfset(defaultOffset + 1).WithBlockHeight(defaultHeight + 1).WithSignatures(sigsTuples(val1)...).Vote()
+	require.NoError(t, k.Add(ctx, defaultMsg().WithVotes(vote1, vote2).Msg()))
+	require.NoError(t, k.Approve(ctx, toValSet(approvedSet)))
+
+	atts, _ := dumpTables(t, ctx, k)
+	require.Len(t, atts, 2)
+	approved, pending := atts[0], atts[1]
+	require.EqualValues(t, keeper.Status_Approved, approved.GetStatus())
+	require.EqualValues(t, keeper.Status_Pending, pending.GetStatus())
+
+	// Subtests share the keeper state, so they are run sequentially.
+	t.Run("by root", func(t *testing.T) {
+		resp, err := k.AttestationByRoot(ctx, &types.AttestationByRootRequest{AttestationRoot: approved.GetAttestationRoot()})
+		require.NoError(t, err)
+		require.Equal(t, approved.GetStatus(), resp.GetAttestation().GetStatus())
+		require.Equal(t, approved.GetCreatedHeight(), resp.GetAttestation().GetCreatedHeight())
+		require.Equal(t, defaultOffset, resp.GetAttestation().GetAttestation().GetAttestHeader().GetAttestOffset())
+
+		_, err = k.AttestationByRoot(ctx, &types.AttestationByRootRequest{AttestationRoot: make([]byte, 32)})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("by block", func(t *testing.T) {
+		req := &types.AttestationsByBlockRequest{ChainId: defaultChainID, ConfLevel: defaultConfLevel, BlockHeight: defaultHeight + 1}
+		resp, err := k.AttestationsByBlock(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, resp.GetAttestations(), 1)
+		require.EqualValues(t, keeper.Status_Pending, resp.GetAttestations()[0].GetStatus())
+
+		req.BlockHash = make([]byte, 32)
+		resp, err = k.AttestationsByBlock(ctx, req)
+		require.NoError(t, err)
+		require.Empty(t, resp.GetAttestations())
+	})
+
+	t.Run("list", func(t *testing.T) {
+		resp, err := k.Attestations(ctx, &types.AttestationsRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.GetAttestations(), 2)
+
+		resp, err = k.Attestations(ctx, &types.AttestationsRequest{Status: uint32(keeper.Status_Pending)})
+		require.NoError(t, err)
+		require.Len(t, resp.GetAttestations(), 1)
+		require.Equal(t, defaultOffset+1, resp.GetAttestations()[0].GetAttestation().GetAttestHeader().GetAttestOffset())
+
+		resp, err = k.Attestations(ctx, &types.AttestationsRequest{MinCreatedHeight: 2})
+		require.NoError(t, err)
+		require.Empty(t, resp.GetAttestations())
+
+		resp, err = k.Attestations(ctx, &types.AttestationsRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true}})
+		require.NoError(t, err)
+		require.Len(t, resp.GetAttestations(), 1)
+		require.Equal(t, defaultOffset+1, resp.GetAttestations()[0].GetAttestation().GetAttestHeader().GetAttestOffset())
+		require.EqualValues(t, 2, resp.GetPagination().GetTotal())
+
+		_, err = k.Attestations(ctx, &types.AttestationsRequest{Pagination: &query.PageRequest{Key: []byte{1}}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// Chain filters use the chain indexes.
+		resp, err = k.Attestations(ctx, &types.AttestationsRequest{ChainId: defaultChainID, ConfLevel: defaultConfLevel})
+		require.NoError(t, err)
+		require.Len(t, resp.GetAttestations(), 2)
+
+		resp, err = k.Attestations(ctx, &types.AttestationsRequest{ChainId: defaultChainID, ConfLevel: defaultConfLevel, Status: uint32(keeper.Status_Approved)})
+		require.NoError(t, err)
+		require.Len(t, resp.GetAttestations(), 1)
+		require.Equal(t, defaultOffset, resp.GetAttestations()[0].GetAttestation().GetAttestHeader().GetAttestOffset())
+
+		resp, err = k.Attestations(ctx, &types.AttestationsRequest{ChainId: defaultChainID, ConfLevel: defaultConfLevel, MinCreatedHeight: 2})
+		require.NoError(t, err)
+		require.Empty(t, resp.GetAttestations())
+
+		_, err = k.Attestations(ctx, &types.AttestationsRequest{ChainId: defaultChainID})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("signatures", func(t *testing.T) {
+		// Approved attestations use the approving validator set.
+		resp, err := k.AttestationSignatures(ctx, &types.AttestationSignaturesRequest{AttestationRoot: approved.GetAttestationRoot()})
+		require.NoError(t, err)
+		require.Equal(t, approvedSet.Id, resp.GetValidatorSetId())
+		require.EqualValues(t, 25, resp.GetTotalPower())
+		require.EqualValues(t, 25, resp.GetSignedPower())
+		require.Len(t, resp.GetVotes(), 2)
+
+		// Pending attestations use the current validator set.
+		resp, err = k.AttestationSignatures(ctx, &types.AttestationSignaturesRequest{AttestationRoot: pending.GetAttestationRoot()})
+		require.NoError(t, err)
+		require.Equal(t, approvingSet.Id, resp.GetValidatorSetId())
+		require.EqualValues(t, 40, resp.GetTotalPower())
+		require.EqualValues(t, 10, resp.GetSignedPower())
+		require.Len(t, resp.GetVotes(), 3)
+
+		var signed int
+		for _, vote := range resp.GetVotes() {
+			if len(vote.GetSignature()) > 0 {
+				signed++
+			}
+		}
+		require.Equal(t, 1, signed)
+	})
+
+	t.Run("pending", func(t *testing.T) {
+		resp, err := k.PendingAttestations(ctx, &types.PendingAttestationsRequest{ChainId: defaultChainID, ConfLevel: defaultConfLevel})
+		require.NoError(t, err)
+		require.Len(t, resp.GetAttestations(), 1)
+
+		pending := resp.GetAttestations()[0]
+		require.Equal(t, defaultOffset+1, pending.GetAttestation().GetAttestHeader().GetAttestOffset())
+		require.Equal(t, approvingSet.Id, pending.GetValidatorSetId())
+		require.EqualValues(t, 10, pending.GetSignedPower())
+		require.EqualValues(t, 40, pending.GetTotalPower())
+		require.EqualValues(t, 27, pending.GetQuorumPower())
+	})
+
+	t.Run("reindex", func(t *testing.T) {
+		// Reindexing is idempotent and retains attestation IDs.
+		for i := 0; i < 2; i++ {
+			require.NoError(t, k.MigrateV1(ctx))
+			reindexed, _ := dumpTables(t, ctx, k)
+			require.Equal(t, atts, reindexed)
+		}
+
+		req := &types.AttestationsByBlockRequest{ChainId: defaultChainID, ConfLevel: defaultConfLevel, BlockHeight: defaultHeight}
+		resp, err := k.AttestationsByBlock(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, resp.GetAttestations(), 1)
+		require.EqualValues(t, keeper.Status_Approved, resp.GetAttestations()[0].GetStatus())
+	})
+}
+
+func TestKeeper_VoteStats(t *testing.T) {
+	t.Parallel()
+
+	approvedSet := newValSet(1, val1, val2, val3)
+
+	k, ctx := setupKeeper(t, mockDefaultExpectations, trimBehindCalled(), noFuzzyDeps())
+
+	// Offset 1 is approved by val2 and val3, offset 2 by all, offset 3 remains pending with only val1's signature.
+	vote1 := defaultAggVote().WithSignatures(sigsTuples(val2, val3)...).Vote()
+	vote2 := defaultAggVote().WithAttestOfset(defaultOffset + 1).WithBlockHeight(defaultHeight + 1).WithSignatures(sigsTuples(val1, val2, val3)...).Vote()
+	vote3 := defaultAggVote().WithAttestOfset(defaultOffset + 2).WithBlockHeight(defaultHeight + 2).WithSignatures(sigsTuples(val1)...).Vote()
+	require.NoError(t, k.Add(ctx, defaultMsg().WithVotes(vote1, vote2, vote3).Msg()))
+
+	// Approving marks votes as approved or missed.
+	require.NoError(t, k.Approve(ctx, toValSet(approvedSet)))
+
+	statsFor := func(val *vtypes.Validator) *types.ValidatorVoteStats {
+		t.Helper()
+		addr, err := val.EthereumAddress()
+		require.NoError(t, err)
+		resp, err := k.VoteStats(ctx, &types.VoteStatsRequest{ValidatorAddress: addr.Bytes()})
+		require.NoError(t, err)
+		require.Len(t, resp.GetStats(), 1)
+
+		return resp.GetStats()[0]
+	}
+
+	stats1 := statsFor(val1)
+	require.Equal(t, defaultChainID, stats1.GetChainId())
+	require.EqualValues(t, defaultConfLevel, stats1.GetConfLevel())
+	require.EqualValues(t, 2, stats1.GetIncluded())
+	require.EqualValues(t, 1, stats1.GetApproved())
+	require.EqualValues(t, 1, stats1.GetMissed())
+	require.EqualValues(t, 0, stats1.GetDiscarded())
+	require.EqualValues(t, ctx.BlockHeight(), stats1.GetLastVoteHeight())
+
+	stats2 := statsFor(val2)
+	require.EqualValues(t, 2, stats2.GetIncluded())
+	require.EqualValues(t, 2, stats2.GetApproved())
+	require.EqualValues(t, 0, stats2.GetMissed())
+
+	// Votes included after approval are discarded (late).
+	late := defaultAggVote().WithSignatures(sigsTuples(val1)...).Vote()
+	require.NoError(t, k.Add(ctx, defaultMsg().WithVotes(late).Msg()))
+
+	stats1 = statsFor(val1)
+	require.EqualValues(t, 3, stats1.GetIncluded())
+	require.EqualValues(t, 1, stats1.GetApproved())
+	require.EqualValues(t, 1, stats1.GetMissed())
+	require.EqualValues(t, 1, stats1.GetDiscarded())
+
+	// Deleting approved attestations doesn't update stats again.
+	require.NoError(t, k.BeginBlock(ctx.WithBlockHeight(ctx.BlockHeight()+trimLag+1)))
+	require.Equal(t, stats1, statsFor(val1))
+
+	resp, err := k.VoteStats(ctx, &types.VoteStatsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetStats(), 3)
+
+	_, err = k.VoteStats(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Stats outside the rolling window are excluded and deleted.
+	expiredCtx := ctx.WithBlockHeight(ctx.BlockHeight() + keeper.VoteStatsWindow)
+	resp, err = k.VoteStats(expiredCtx, &types.VoteStatsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.GetStats())
+
+	require.NoError(t, k.DeleteExpiredVoteStats(expiredCtx))
+	resp, err = k.VoteStats(ctx, &types.VoteStatsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.GetStats())
+}
+
+func TestKeeper_JailStatus(t *testing.T) {
+	t.Parallel()
+
+	consAddr := func(b byte) sdk.ConsAddress {
+		return bytes.Repeat([]byte{b}, crypto.AddressSize)
+	}
+
+	var (
+		tombstoned = consAddr(1)
+		downtime   = consAddr(2)
+		never      = consAddr(3)
+		unknown    = consAddr(4)
+		jailedAt   = time.Unix(1_000, 0).UTC()
+	)
+
+	k, ctx := setupKeeper(t, func(_ sdk.Context, m mocks) {
+		m.slashKeeper.EXPECT().GetValidatorSigningInfo(gomock.Any(), tombstoned).
+			Return(sltypes.ValidatorSigningInfo{JailedUntil: evidencetypes.DoubleSignJailEndTime, Tombstoned: true}, nil)
+		m.slashKeeper.EXPECT().GetValidatorSigningInfo(gomock.Any(), downtime).
+			Return(sltypes.ValidatorSigningInfo{JailedUntil: jailedAt}, nil)
+		m.slashKeeper.EXPECT().GetValidatorSigningInfo(gomock.Any(), never).
+			Return(sltypes.ValidatorSigningInfo{JailedUntil: time.Unix(0, 0).UTC()}, nil)
+		m.slashKeeper.EXPECT().GetValidatorSigningInfo(gomock.Any(), unknown).
+			Return(sltypes.ValidatorSigningInfo{}, sltypes.ErrNoSigningInfoFound)
+	})
+
+	jailStatus := func(consAddr sdk.ConsAddress) (*types.JailStatusResponse, error) {
+		return k.JailStatus(ctx, &types.JailStatusRequest{ConsAddress: consAddr})
+	}
+
+	resp, err := jailStatus(tombstoned)
+	require.NoError(t, err)
+	require.Equal(t, types.JailReason_JAIL_REASON_CONSENSUS_DOUBLE_SIGN, resp.GetReason())
+	require.True(t, resp.GetTombstoned())
+
+	resp, err = jailStatus(downtime)
+	require.NoError(t, err)
+	require.Equal(t, types.JailReason_JAIL_REASON_CONSENSUS_DOWNTIME, resp.GetReason())
+	require.Equal(t, jailedAt, resp.GetJailedUntil())
+	require.Zero(t, resp.GetHeight())
+
+	resp, err = jailStatus(never)
+	require.NoError(t, err)
+	require.Equal(t, types.JailReason_JAIL_REASON_UNSPECIFIED, resp.GetReason())
+
+	_, err = jailStatus(unknown)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = jailStatus(sdk.ConsAddress{1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/errors"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// MigrateV1 migrates the attest module state for the V1 network upgrade, see upgrades.V1.
+// It is idempotent, since it is also applied at genesis.
+func (k *Keeper) MigrateV1(ctx context.Context) error {
+	// Check before migrating vote params, since that stores the params.
+	hasParams, err := k.hasParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := k.reindexAttestations(ctx); err != nil {
+		return errors.Wrap(err, "reindex attestations")
+	}
+
+	if err := k.migrateVoteParams(ctx); err != nil {
+		return errors.Wrap(err, "migrate vote params")
+	}
+
+	// Stored zero liveness params disable liveness tracking, so only networks that
+	// predate attest module genesis (and therefore liveness params) are migrated.
+	if !hasParams {
+		if err := k.migrateLivenessParams(ctx); err != nil {
+			return errors.Wrap(err, "migrate liveness params")
+		}
+	}
+
+	return nil
+}
+
+// hasParams returns true if params are stored in state.
+func (k *Keeper) hasParams(ctx context.Context) (bool, error) {
+	ok, err := k.modDB.GetTable(&Params{}).Has(ctx, &Params{})
+	if err != nil {
+		return false, errors.Wrap(err, "has params")
+	}
+
+	return ok, nil
+}
+
+// migrateVoteParams stores the previously hardcoded vote params on networks that predate
+// governable vote params, since their stored params have zero vote params.
+// Thereafter, vote params are only updated via the AttestParams predeploy.
+func (k *Keeper) migrateVoteParams(ctx context.Context) error {
+	// getParams populates zero vote params with the previously hardcoded values.
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.setParams(ctx, params)
+}
+
+// migrateLivenessParams enables liveness tracking with the default params on networks
+// that predate it, retaining the other stored params.
+func (k *Keeper) migrateLivenessParams(ctx context.Context) error {
+	params, err := k.paramsTable.Get(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get params")
+	}
+
+	defaults := types.DefaultParams()
+	params.LivenessWindow = defaults.GetLivenessWindow()
+	params.LivenessMaxMissedPercent = defaults.GetLivenessMaxMissedPercent()
+	params.LivenessJailDuration = durationpb.New(defaults.GetLivenessJailDuration())
+
+	if err := k.paramsTable.Save(ctx, params); err != nil {
+		return errors.Wrap(err, "save params")
+	}
+
+	return nil
+}
+
+// reindexAttestations rebuilds all attestation table indexes.
+// This backfills indexes added after attestations were created, since the ORM only
+// populates indexes when rows are inserted or updated.
+func (k *Keeper) reindexAttestations(ctx context.Context) error {
+	table := k.modDB.GetTable(&Attestation{})
+
+	var buf bytes.Buffer
+	if err := table.ExportJSON(ctx, &buf); err != nil {
+		return errors.Wrap(err, "export attestations")
+	}
+
+	iter, err := k.attTable.List(ctx, AttestationIdIndexKey{})
+	if err != nil {
+		return errors.Wrap(err, "list attestations")
+	}
+
+	var atts []*Attestation
+	for iter.Next() {
+		att, err := iter.Value()
+		if err != nil {
+			iter.Close()
+			return errors.Wrap(err, "get attestation")
+		}
+		atts = append(atts, att)
+	}
+	iter.Close()
+
+	// Deleting rows also deletes their existing index entries.
+	for _, att := range atts {
+		if err := k.attTable.Delete(ctx, att); err != nil {
+			return errors.Wrap(err, "delete attestation")
+		}
+	}
+
+	// Importing retains the primary keys and auto-increment sequence, populating all indexes.
+	if err := table.ImportJSON(ctx, &buf); err != nil {
+		return errors.Wrap(err, "import attestations")
+	}
+
+	return nil
+}
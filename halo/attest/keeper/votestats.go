@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/umath"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// voteStatsBucketSize is the number of consensus blocks per vote stats bucket.
+	voteStatsBucketSize uint64 = 3_000 // +-1 hour (given a period of 1.2s).
+	// voteStatsBuckets is the number of buckets in the rolling vote stats window.
+	voteStatsBuckets uint64 = 24 // +-1 day.
+)
+
+// voteStatsBucket returns the vote stats bucket of the current consensus height.
+func voteStatsBucket(ctx context.Context) uint64 {
+	return uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) / voteStatsBucketSize
+}
+
+// firstVoteStatsBucket returns the first bucket in the rolling vote stats window.
+func firstVoteStatsBucket(ctx context.Context) uint64 {
+	return umath.SubtractOrZero(voteStatsBucket(ctx)+1, voteStatsBuckets)
+}
+
+// updateVoteStats applies the update function to the validator's vote stats of the chain version in the current bucket.
+func (k *Keeper) updateVoteStats(ctx context.Context, addr common.Address, chainVer xchain.ChainVersion, update func(*VoteStats)) error {
+	bucket := voteStatsBucket(ctx)
+	stats, err := k.statsTable.Get(ctx, addr.Bytes(), chainVer.ID, uint32(chainVer.ConfLevel), bucket)
+	if ormerrors.IsNotFound(err) {
+		stats = &VoteStats{
+			ValidatorAddress: addr.Bytes(),
+			ChainId:          chainVer.ID,
+			ConfLevel:        uint32(chainVer.ConfLevel),
+			Bucket:           bucket,
+		}
+	} else if err != nil {
+		return errors.Wrap(err, "get vote stats")
+	}
+
+	update(stats)
+
+	if err := k.statsTable.Save(ctx, stats); err != nil {
+		return errors.Wrap(err, "save vote stats")
+	}
+
+	return nil
+}
+
+// voteIncluded updates the validator's vote stats and metrics when its vote is included on-chain.
+func (k *Keeper) voteIncluded(ctx context.Context, addr common.Address, chainVer xchain.ChainVersion) error {
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+
+	chainVerName := k.namer(chainVer)
+	includedVotesCounter.WithLabelValues(addr.Hex(), chainVerName).Inc()
+	lastVoteHeight.WithLabelValues(addr.Hex(), chainVerName).Set(float64(height))
+
+	return k.updateVoteStats(ctx, addr, chainVer, func(stats *VoteStats) {
+		stats.Included++
+		stats.LastVoteHeight = height
+	})
+}
+
+// deleteExpiredVoteStats deletes all vote stats buckets outside the rolling window.
+func (k *Keeper) deleteExpiredVoteStats(ctx context.Context) error {
+	first := firstVoteStatsBucket(ctx)
+	if first == 0 {
+		return nil
+	}
+
+	start := VoteStatsBucketIndexKey{}
+	end := VoteStatsBucketIndexKey{}.WithBucket(first - 1)
+	if err := k.statsTable.DeleteRange(ctx, start, end); err != nil {
+		return errors.Wrap(err, "delete vote stats")
+	}
+
+	return nil
+}
+
+// listVoteStats returns the vote stats of the validator, or of all validators if addr is empty,
+// aggregated over the buckets in the rolling window.
+func (k *Keeper) listVoteStats(ctx context.Context, addr []byte) ([]*types.ValidatorVoteStats, error) {
+	idx := VoteStatsPrimaryKey{}
+	if len(addr) > 0 {
+		idx = idx.WithValidatorAddress(addr)
+	}
+
+	iter, err := k.statsTable.List(ctx, idx)
+	if err != nil {
+		return nil, errors.Wrap(err, "list vote stats")
+	}
+	defer iter.Close()
+
+	first := firstVoteStatsBucket(ctx)
+
+	var resp []*types.ValidatorVoteStats
+	for iter.Next() {
+		stats, err := iter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "value vote stats")
+		} else if stats.GetBucket() < first {
+			continue // Not deleted yet.
+		}
+
+		// Buckets are ordered by validator and chain version, so aggregate consecutive rows.
+		if len(resp) > 0 {
+			last := resp[len(resp)-1]
+			if bytes.Equal(last.GetValidatorAddress(), stats.GetValidatorAddress()) &&
+				last.GetChainId() == stats.GetChainId() && last.GetConfLevel() == stats.GetConfLevel() {
+				last.Included += stats.GetIncluded()
+				last.Approved += stats.GetApproved()
+				last.Discarded += stats.GetDiscarded()
+				last.Missed += stats.GetMissed()
+				last.LastVoteHeight = max(last.GetLastVoteHeight(), stats.GetLastVoteHeight())
+
+				continue
+			}
+		}
+
+		resp = append(resp, &types.ValidatorVoteStats{
+			ValidatorAddress: stats.GetValidatorAddress(),
+			ChainId:          stats.GetChainId(),
+			ConfLevel:        stats.GetConfLevel(),
+			Included:         stats.GetIncluded(),
+			Approved:         stats.GetApproved(),
+			Discarded:        stats.GetDiscarded(),
+			Missed:           stats.GetMissed(),
+			LastVoteHeight:   stats.GetLastVoteHeight(),
+		})
+	}
+
+	return resp, nil
+}
@@ -2,18 +2,23 @@ package module
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/omni-network/omni/halo/attest/keeper"
 	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/errors"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	skeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 )
@@ -22,6 +27,7 @@ const ConsensusVersion = 1
 
 var (
 	_ module.AppModuleBasic     = (*AppModule)(nil)
+	_ module.HasGenesis         = (*AppModule)(nil)
 	_ appmodule.AppModule       = (*AppModule)(nil)
 	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
 	_ appmodule.HasEndBlocker   = (*AppModule)(nil)
@@ -60,7 +66,11 @@ func (AppModuleBasic) RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(client.Context, *runtime.ServeMux) {}
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
 
 // ----------------------------------------------------------------------------
 // AppModule
@@ -83,6 +93,45 @@ func NewAppModule(
 	}
 }
 
+// InitGenesis stores the genesis params.
+// Networks created before attest module genesis was introduced do not contain it, so it is skipped if empty.
+func (m AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, raw json.RawMessage) {
+	if len(raw) == 0 {
+		return
+	}
+
+	var data types.GenesisState
+	cdc.MustUnmarshalJSON(raw, &data)
+
+	if err := m.keeper.InitGenesis(ctx, &data); err != nil {
+		panic(errors.Wrap(err, "init genesis"))
+	}
+}
+
+func (m AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	data, err := m.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(errors.Wrap(err, "export genesis"))
+	}
+
+	return cdc.MustMarshalJSON(data)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the attest module.
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the attest module.
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var data types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return errors.Wrap(err, "unmarshal genesis state")
+	}
+
+	return data.Validate()
+}
+
 func (m AppModule) BeginBlock(ctx context.Context) error {
 	return m.keeper.BeginBlock(ctx)
 }
@@ -126,6 +175,7 @@ type ModuleInputs struct {
 	Logger       log.Logger
 	TXConfig     client.TxConfig
 	SKeeper      *skeeper.Keeper
+	SlashKeeper  slashingkeeper.Keeper
 	Namer        types.ChainVerNameFunc
 	Voter        types.Voter
 }
@@ -138,16 +188,24 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) (ModuleOutputs, error) {
+	slashFraction := sdkmath.LegacyZeroDec()
+	if s := in.Config.GetDoubleSignSlashFraction(); s != "" {
+		var err error
+		slashFraction, err = sdkmath.LegacyNewDecFromStr(s)
+		if err != nil {
+			return ModuleOutputs{}, errors.Wrap(err, "parse double sign slash fraction")
+		}
+	}
+
 	k, err := keeper.New(
 		in.Cdc,
 		in.StoreService,
 		in.SKeeper,
 		in.Namer,
 		in.Voter,
-		in.Config.GetVoteWindow(),
-		in.Config.GetVoteExtensionLimit(),
-		in.Config.GetTrimLag(),
-		in.Config.GetConsensusTrimLag(),
+		in.SlashKeeper,
+		slashFraction,
+		in.Config.GetDoubleSignJailDuration().AsDuration(),
 	)
 	if err != nil {
 		return ModuleOutputs{}, err
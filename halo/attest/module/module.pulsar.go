@@ -8,18 +8,21 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
 var (
-	md_Module                      protoreflect.MessageDescriptor
-	fd_Module_authority            protoreflect.FieldDescriptor
-	fd_Module_vote_window          protoreflect.FieldDescriptor
-	fd_Module_vote_extension_limit protoreflect.FieldDescriptor
-	fd_Module_trim_lag             protoreflect.FieldDescriptor
-	fd_Module_consensus_trim_lag   protoreflect.FieldDescriptor
+	md_Module                            protoreflect.MessageDescriptor
+	fd_Module_authority                  protoreflect.FieldDescriptor
+	fd_Module_vote_window                protoreflect.FieldDescriptor
+	fd_Module_vote_extension_limit       protoreflect.FieldDescriptor
+	fd_Module_trim_lag                   protoreflect.FieldDescriptor
+	fd_Module_consensus_trim_lag         protoreflect.FieldDescriptor
+	fd_Module_double_sign_slash_fraction protoreflect.FieldDescriptor
+	fd_Module_double_sign_jail_duration  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -30,6 +33,8 @@ func init() {
 	fd_Module_vote_extension_limit = md_Module.Fields().ByName("vote_extension_limit")
 	fd_Module_trim_lag = md_Module.Fields().ByName("trim_lag")
 	fd_Module_consensus_trim_lag = md_Module.Fields().ByName("consensus_trim_lag")
+	fd_Module_double_sign_slash_fraction = md_Module.Fields().ByName("double_sign_slash_fraction")
+	fd_Module_double_sign_jail_duration = md_Module.Fields().ByName("double_sign_jail_duration")
 }
 
 var _ protoreflect.Message = (*fastReflection_Module)(nil)
@@ -127,6 +132,18 @@ func (x *fastReflection_Module) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.DoubleSignSlashFraction != "" {
+		value := protoreflect.ValueOfString(x.DoubleSignSlashFraction)
+		if !f(fd_Module_double_sign_slash_fraction, value) {
+			return
+		}
+	}
+	if x.DoubleSignJailDuration != nil {
+		value := protoreflect.ValueOfMessage(x.DoubleSignJailDuration.ProtoReflect())
+		if !f(fd_Module_double_sign_jail_duration, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -152,6 +169,10 @@ func (x *fastReflection_Module) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.TrimLag != uint64(0)
 	case "halo.attest.module.Module.consensus_trim_lag":
 		return x.ConsensusTrimLag != uint64(0)
+	case "halo.attest.module.Module.double_sign_slash_fraction":
+		return x.DoubleSignSlashFraction != ""
+	case "halo.attest.module.Module.double_sign_jail_duration":
+		return x.DoubleSignJailDuration != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: halo.attest.module.Module"))
@@ -178,6 +199,10 @@ func (x *fastReflection_Module) Clear(fd protoreflect.FieldDescriptor) {
 		x.TrimLag = uint64(0)
 	case "halo.attest.module.Module.consensus_trim_lag":
 		x.ConsensusTrimLag = uint64(0)
+	case "halo.attest.module.Module.double_sign_slash_fraction":
+		x.DoubleSignSlashFraction = ""
+	case "halo.attest.module.Module.double_sign_jail_duration":
+		x.DoubleSignJailDuration = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: halo.attest.module.Module"))
@@ -209,6 +234,12 @@ func (x *fastReflection_Module) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "halo.attest.module.Module.consensus_trim_lag":
 		value := x.ConsensusTrimLag
 		return protoreflect.ValueOfUint64(value)
+	case "halo.attest.module.Module.double_sign_slash_fraction":
+		value := x.DoubleSignSlashFraction
+		return protoreflect.ValueOfString(value)
+	case "halo.attest.module.Module.double_sign_jail_duration":
+		value := x.DoubleSignJailDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: halo.attest.module.Module"))
@@ -239,6 +270,10 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 		x.TrimLag = value.Uint()
 	case "halo.attest.module.Module.consensus_trim_lag":
 		x.ConsensusTrimLag = value.Uint()
+	case "halo.attest.module.Module.double_sign_slash_fraction":
+		x.DoubleSignSlashFraction = value.Interface().(string)
+	case "halo.attest.module.Module.double_sign_jail_duration":
+		x.DoubleSignJailDuration = value.Message().Interface().(*durationpb.Duration)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: halo.attest.module.Module"))
@@ -259,6 +294,11 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Module) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "halo.attest.module.Module.double_sign_jail_duration":
+		if x.DoubleSignJailDuration == nil {
+			x.DoubleSignJailDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.DoubleSignJailDuration.ProtoReflect())
 	case "halo.attest.module.Module.authority":
 		panic(fmt.Errorf("field authority of message halo.attest.module.Module is not mutable"))
 	case "halo.attest.module.Module.vote_window":
@@ -269,6 +309,8 @@ func (x *fastReflection_Module) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field trim_lag of message halo.attest.module.Module is not mutable"))
 	case "halo.attest.module.Module.consensus_trim_lag":
 		panic(fmt.Errorf("field consensus_trim_lag of message halo.attest.module.Module is not mutable"))
+	case "halo.attest.module.Module.double_sign_slash_fraction":
+		panic(fmt.Errorf("field double_sign_slash_fraction of message halo.attest.module.Module is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: halo.attest.module.Module"))
@@ -292,6 +334,11 @@ func (x *fastReflection_Module) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "halo.attest.module.Module.consensus_trim_lag":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "halo.attest.module.Module.double_sign_slash_fraction":
+		return protoreflect.ValueOfString("")
+	case "halo.attest.module.Module.double_sign_jail_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: halo.attest.module.Module"))
@@ -377,6 +424,14 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 		if x.ConsensusTrimLag != 0 {
 			n += 1 + runtime.Sov(uint64(x.ConsensusTrimLag))
 		}
+		l = len(x.DoubleSignSlashFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DoubleSignJailDuration != nil {
+			l = options.Size(x.DoubleSignJailDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -406,6 +461,27 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DoubleSignJailDuration != nil {
+			encoded, err := options.Marshal(x.DoubleSignJailDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.DoubleSignSlashFraction) > 0 {
+			i -= len(x.DoubleSignSlashFraction)
+			copy(dAtA[i:], x.DoubleSignSlashFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DoubleSignSlashFraction)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if x.ConsensusTrimLag != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ConsensusTrimLag))
 			i--
@@ -590,6 +666,74 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DoubleSignSlashFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DoubleSignSlashFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DoubleSignJailDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DoubleSignJailDuration == nil {
+					x.DoubleSignJailDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DoubleSignJailDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -648,13 +792,21 @@ type Module struct {
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// vote_window defines the number of blocks before and after the latest approved attestation
 	// that votes are allowed for.
+	// Deprecated: unused, vote params are stored in the module params.
 	VoteWindow uint64 `protobuf:"varint,2,opt,name=vote_window,json=voteWindow,proto3" json:"vote_window,omitempty"`
 	// vote_extension_limit defines the maximum number of votes that a validator may include in a single vote extension.
+	// Deprecated: unused, vote params are stored in the module params.
 	VoteExtensionLimit uint64 `protobuf:"varint,3,opt,name=vote_extension_limit,json=voteExtensionLimit,proto3" json:"vote_extension_limit,omitempty"`
 	// trim_lag defines the number of blocks after which non-consensus-chain attestations are deleted from the module state.
+	// Deprecated: unused, vote params are stored in the module params.
 	TrimLag uint64 `protobuf:"varint,4,opt,name=trim_lag,json=trimLag,proto3" json:"trim_lag,omitempty"`
 	// consensus_trim_lag defines the number of blocks after which consensus-chain attestations are deleted from the module state.
+	// Deprecated: unused, vote params are stored in the module params.
 	ConsensusTrimLag uint64 `protobuf:"varint,5,opt,name=consensus_trim_lag,json=consensusTrimLag,proto3" json:"consensus_trim_lag,omitempty"`
+	// double_sign_slash_fraction defines the fraction of stake slashed when a validator double signs an attestation.
+	DoubleSignSlashFraction string `protobuf:"bytes,6,opt,name=double_sign_slash_fraction,json=doubleSignSlashFraction,proto3" json:"double_sign_slash_fraction,omitempty"`
+	// double_sign_jail_duration defines the duration a validator is jailed for when double signing an attestation.
+	DoubleSignJailDuration *durationpb.Duration `protobuf:"bytes,7,opt,name=double_sign_jail_duration,json=doubleSignJailDuration,proto3" json:"double_sign_jail_duration,omitempty"`
 }
 
 func (x *Module) Reset() {
@@ -712,6 +864,20 @@ func (x *Module) GetConsensusTrimLag() uint64 {
 	return 0
 }
 
+func (x *Module) GetDoubleSignSlashFraction() string {
+	if x != nil {
+		return x.DoubleSignSlashFraction
+	}
+	return ""
+}
+
+func (x *Module) GetDoubleSignJailDuration() *durationpb.Duration {
+	if x != nil {
+		return x.DoubleSignJailDuration
+	}
+	return nil
+}
+
 var File_halo_attest_module_module_proto protoreflect.FileDescriptor
 
 var file_halo_attest_module_module_proto_rawDesc = []byte{
@@ -720,7 +886,9 @@ var file_halo_attest_module_module_proto_rawDesc = []byte{
 	0x6f, 0x12, 0x12, 0x68, 0x61, 0x6c, 0x6f, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x6d,
 	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x1a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x70,
 	0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf4, 0x01, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x87, 0x03, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75,
 	0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
 	0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
@@ -732,22 +900,31 @@ var file_halo_attest_module_module_proto_rawDesc = []byte{
 	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x72, 0x69, 0x6d, 0x4c, 0x61, 0x67, 0x12, 0x2c,
 	0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x74, 0x72, 0x69, 0x6d,
 	0x5f, 0x6c, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73,
-	0x65, 0x6e, 0x73, 0x75, 0x73, 0x54, 0x72, 0x69, 0x6d, 0x4c, 0x61, 0x67, 0x3a, 0x30, 0xba, 0xc0,
-	0x96, 0xda, 0x01, 0x2a, 0x0a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x6d,
-	0x6e, 0x69, 0x2f, 0x68, 0x61, 0x6c, 0x6f, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x42, 0xb4,
-	0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x6c, 0x6f, 0x2e, 0x61, 0x74, 0x74, 0x65,
-	0x73, 0x74, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x61, 0x6c, 0x6f, 0x2f,
-	0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0xa2, 0x02, 0x03,
-	0x48, 0x41, 0x4d, 0xaa, 0x02, 0x12, 0x48, 0x61, 0x6c, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0xca, 0x02, 0x12, 0x48, 0x61, 0x6c, 0x6f, 0x5c,
-	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0xe2, 0x02, 0x1e,
-	0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5c, 0x4d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x14, 0x48, 0x61, 0x6c, 0x6f, 0x3a, 0x3a, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x3a, 0x3a, 0x4d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x6e, 0x73, 0x75, 0x73, 0x54, 0x72, 0x69, 0x6d, 0x4c, 0x61, 0x67, 0x12, 0x3b, 0x0a, 0x1a,
+	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x17, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x19, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x4a, 0x61, 0x69, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a,
+	0x30, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x2a, 0x0a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2f, 0x68, 0x61, 0x6c, 0x6f, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x42, 0xb4, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x6c, 0x6f, 0x2e, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x0b, 0x4d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x61,
+	0x6c, 0x6f, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0xa2, 0x02, 0x03, 0x48, 0x41, 0x4d, 0xaa, 0x02, 0x12, 0x48, 0x61, 0x6c, 0x6f, 0x2e, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0xca, 0x02, 0x12, 0x48, 0x61,
+	0x6c, 0x6f, 0x5c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0xe2, 0x02, 0x1e, 0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5c, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x14, 0x48, 0x61, 0x6c, 0x6f, 0x3a, 0x3a, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x3a, 0x3a, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -764,14 +941,16 @@ func file_halo_attest_module_module_proto_rawDescGZIP() []byte {
 
 var file_halo_attest_module_module_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_halo_attest_module_module_proto_goTypes = []interface{}{
-	(*Module)(nil), // 0: halo.attest.module.Module
+	(*Module)(nil),              // 0: halo.attest.module.Module
+	(*durationpb.Duration)(nil), // 1: google.protobuf.Duration
 }
 var file_halo_attest_module_module_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: halo.attest.module.Module.double_sign_jail_duration:type_name -> google.protobuf.Duration
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_halo_attest_module_module_proto_init() }
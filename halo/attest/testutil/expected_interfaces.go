@@ -29,3 +29,7 @@ type ChainNamer interface {
 type Registry interface {
 	rtypes.PortalRegistry
 }
+
+type SlashingKeeper interface {
+	types.SlashingKeeper
+}
@@ -12,12 +12,16 @@ package testutil
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	math "cosmossdk.io/math"
 	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	types "github.com/cosmos/cosmos-sdk/types"
+	types0 "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	common "github.com/ethereum/go-ethereum/common"
-	types0 "github.com/omni-network/omni/halo/attest/types"
-	types1 "github.com/omni-network/omni/halo/valsync/types"
+	types2 "github.com/omni-network/omni/halo/attest/types"
+	types3 "github.com/omni-network/omni/halo/valsync/types"
 	xchain "github.com/omni-network/omni/lib/xchain"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -84,10 +88,10 @@ func (m *MockVoter) EXPECT() *MockVoterMockRecorder {
 }
 
 // GetAvailable mocks base method.
-func (m *MockVoter) GetAvailable() []*types0.Vote {
+func (m *MockVoter) GetAvailable() []*types2.Vote {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAvailable")
-	ret0, _ := ret[0].([]*types0.Vote)
+	ret0, _ := ret[0].([]*types2.Vote)
 	return ret0
 }
 
@@ -112,7 +116,7 @@ func (mr *MockVoterMockRecorder) LocalAddress() *gomock.Call {
 }
 
 // SetCommitted mocks base method.
-func (m *MockVoter) SetCommitted(headers []*types0.AttestHeader) error {
+func (m *MockVoter) SetCommitted(headers []*types2.AttestHeader) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetCommitted", headers)
 	ret0, _ := ret[0].(error)
@@ -126,7 +130,7 @@ func (mr *MockVoterMockRecorder) SetCommitted(headers any) *gomock.Call {
 }
 
 // SetProposed mocks base method.
-func (m *MockVoter) SetProposed(headers []*types0.AttestHeader) error {
+func (m *MockVoter) SetProposed(headers []*types2.AttestHeader) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetProposed", headers)
 	ret0, _ := ret[0].(error)
@@ -154,7 +158,7 @@ func (mr *MockVoterMockRecorder) TrimBehind(minsByChain any) *gomock.Call {
 }
 
 // UpdateValidatorSet mocks base method.
-func (m *MockVoter) UpdateValidatorSet(set *types1.ValidatorSetResponse) error {
+func (m *MockVoter) UpdateValidatorSet(set *types3.ValidatorSetResponse) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateValidatorSet", set)
 	ret0, _ := ret[0].(error)
@@ -191,10 +195,10 @@ func (m *MockValProvider) EXPECT() *MockValProviderMockRecorder {
 }
 
 // ActiveSetByHeight mocks base method.
-func (m *MockValProvider) ActiveSetByHeight(ctx context.Context, height uint64) (*types1.ValidatorSetResponse, error) {
+func (m *MockValProvider) ActiveSetByHeight(ctx context.Context, height uint64) (*types3.ValidatorSetResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ActiveSetByHeight", ctx, height)
-	ret0, _ := ret[0].(*types1.ValidatorSetResponse)
+	ret0, _ := ret[0].(*types3.ValidatorSetResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -206,10 +210,10 @@ func (mr *MockValProviderMockRecorder) ActiveSetByHeight(ctx, height any) *gomoc
 }
 
 // ValidatorSet mocks base method.
-func (m *MockValProvider) ValidatorSet(ctx context.Context, req *types1.ValidatorSetRequest) (*types1.ValidatorSetResponse, error) {
+func (m *MockValProvider) ValidatorSet(ctx context.Context, req *types3.ValidatorSetRequest) (*types3.ValidatorSetResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidatorSet", ctx, req)
-	ret0, _ := ret[0].(*types1.ValidatorSetResponse)
+	ret0, _ := ret[0].(*types3.ValidatorSetResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -294,3 +298,83 @@ func (mr *MockRegistryMockRecorder) ConfLevels(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfLevels", reflect.TypeOf((*MockRegistry)(nil).ConfLevels), ctx)
 }
+
+// MockSlashingKeeper is a mock of SlashingKeeper interface.
+type MockSlashingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlashingKeeperMockRecorder
+}
+
+// MockSlashingKeeperMockRecorder is the mock recorder for MockSlashingKeeper.
+type MockSlashingKeeperMockRecorder struct {
+	mock *MockSlashingKeeper
+}
+
+// NewMockSlashingKeeper creates a new mock instance.
+func NewMockSlashingKeeper(ctrl *gomock.Controller) *MockSlashingKeeper {
+	mock := &MockSlashingKeeper{ctrl: ctrl}
+	mock.recorder = &MockSlashingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlashingKeeper) EXPECT() *MockSlashingKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetValidatorSigningInfo mocks base method.
+func (m *MockSlashingKeeper) GetValidatorSigningInfo(ctx context.Context, consAddr types.ConsAddress) (types0.ValidatorSigningInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorSigningInfo", ctx, consAddr)
+	ret0, _ := ret[0].(types0.ValidatorSigningInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorSigningInfo indicates an expected call of GetValidatorSigningInfo.
+func (mr *MockSlashingKeeperMockRecorder) GetValidatorSigningInfo(ctx, consAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorSigningInfo", reflect.TypeOf((*MockSlashingKeeper)(nil).GetValidatorSigningInfo), ctx, consAddr)
+}
+
+// Jail mocks base method.
+func (m *MockSlashingKeeper) Jail(ctx context.Context, consAddr types.ConsAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Jail", ctx, consAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Jail indicates an expected call of Jail.
+func (mr *MockSlashingKeeperMockRecorder) Jail(ctx, consAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Jail", reflect.TypeOf((*MockSlashingKeeper)(nil).Jail), ctx, consAddr)
+}
+
+// JailUntil mocks base method.
+func (m *MockSlashingKeeper) JailUntil(ctx context.Context, consAddr types.ConsAddress, jailTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JailUntil", ctx, consAddr, jailTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JailUntil indicates an expected call of JailUntil.
+func (mr *MockSlashingKeeperMockRecorder) JailUntil(ctx, consAddr, jailTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailUntil", reflect.TypeOf((*MockSlashingKeeper)(nil).JailUntil), ctx, consAddr, jailTime)
+}
+
+// SlashWithInfractionReason mocks base method.
+func (m *MockSlashingKeeper) SlashWithInfractionReason(ctx context.Context, consAddr types.ConsAddress, fraction math.LegacyDec, power, distributionHeight int64, infraction types1.Infraction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashWithInfractionReason", ctx, consAddr, fraction, power, distributionHeight, infraction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SlashWithInfractionReason indicates an expected call of SlashWithInfractionReason.
+func (mr *MockSlashingKeeperMockRecorder) SlashWithInfractionReason(ctx, consAddr, fraction, power, distributionHeight, infraction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashWithInfractionReason", reflect.TypeOf((*MockSlashingKeeper)(nil).SlashWithInfractionReason), ctx, consAddr, fraction, power, distributionHeight, infraction)
+}
@@ -0,0 +1,427 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: halo/attest/types/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventAttestationApproved is emitted when an attestation is approved.
+// This includes fuzzy attestations overridden by finalized attestations.
+type EventAttestationApproved struct {
+	ChainId         uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ConfLevel       uint32 `protobuf:"varint,2,opt,name=conf_level,json=confLevel,proto3" json:"conf_level,omitempty"`
+	AttestOffset    uint64 `protobuf:"varint,3,opt,name=attest_offset,json=attestOffset,proto3" json:"attest_offset,omitempty"`
+	AttestationRoot []byte `protobuf:"bytes,4,opt,name=attestation_root,json=attestationRoot,proto3" json:"attestation_root,omitempty"`
+}
+
+func (m *EventAttestationApproved) Reset()         { *m = EventAttestationApproved{} }
+func (m *EventAttestationApproved) String() string { return proto.CompactTextString(m) }
+func (*EventAttestationApproved) ProtoMessage()    {}
+func (*EventAttestationApproved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b47530eec47bd5a, []int{0}
+}
+func (m *EventAttestationApproved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttestationApproved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttestationApproved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAttestationApproved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttestationApproved.Merge(m, src)
+}
+func (m *EventAttestationApproved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttestationApproved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttestationApproved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttestationApproved proto.InternalMessageInfo
+
+func (m *EventAttestationApproved) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *EventAttestationApproved) GetConfLevel() uint32 {
+	if m != nil {
+		return m.ConfLevel
+	}
+	return 0
+}
+
+func (m *EventAttestationApproved) GetAttestOffset() uint64 {
+	if m != nil {
+		return m.AttestOffset
+	}
+	return 0
+}
+
+func (m *EventAttestationApproved) GetAttestationRoot() []byte {
+	if m != nil {
+		return m.AttestationRoot
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventAttestationApproved)(nil), "halo.attest.types.EventAttestationApproved")
+}
+
+func init() { proto.RegisterFile("halo/attest/types/events.proto", fileDescriptor_9b47530eec47bd5a) }
+
+var fileDescriptor_9b47530eec47bd5a = []byte{
+	// 223 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x48, 0xcc, 0xc9,
+	0xd7, 0x4f, 0x2c, 0x29, 0x49, 0x2d, 0x2e, 0xd1, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x4f, 0x2d,
+	0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0xc9, 0xeb, 0x41,
+	0xe4, 0xf5, 0xc0, 0xf2, 0x4a, 0x4b, 0x18, 0xb9, 0x24, 0x5c, 0x41, 0x6a, 0x1c, 0xc1, 0xa2, 0x89,
+	0x25, 0x99, 0xf9, 0x79, 0x8e, 0x05, 0x05, 0x45, 0xf9, 0x65, 0xa9, 0x29, 0x42, 0x92, 0x5c, 0x1c,
+	0xc9, 0x19, 0x89, 0x99, 0x79, 0xf1, 0x99, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0xec,
+	0x60, 0xbe, 0x67, 0x8a, 0x90, 0x2c, 0x17, 0x57, 0x72, 0x7e, 0x5e, 0x5a, 0x7c, 0x4e, 0x6a, 0x59,
+	0x6a, 0x8e, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6f, 0x10, 0x27, 0x48, 0xc4, 0x07, 0x24, 0x20, 0xa4,
+	0xcc, 0xc5, 0x0b, 0xb1, 0x26, 0x3e, 0x3f, 0x2d, 0xad, 0x38, 0xb5, 0x44, 0x82, 0x19, 0xac, 0x9d,
+	0x07, 0x22, 0xe8, 0x0f, 0x16, 0x13, 0xd2, 0xe4, 0x12, 0x48, 0x44, 0xd8, 0x1a, 0x5f, 0x94, 0x9f,
+	0x5f, 0x22, 0xc1, 0xa2, 0xc0, 0xa8, 0xc1, 0x13, 0xc4, 0x8f, 0x24, 0x1e, 0x94, 0x9f, 0x5f, 0xe2,
+	0xa4, 0x7d, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
+	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x82, 0x18, 0x7e, 0x4e,
+	0x62, 0x03, 0xfb, 0xd6, 0x18, 0x30, 0x00, 0x0b, 0x58, 0x6d, 0xf8, 0x0f, 0x01, 0x00, 0x00,
+}
+
+func (m *EventAttestationApproved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttestationApproved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttestationApproved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AttestationRoot) > 0 {
+		i -= len(m.AttestationRoot)
+		copy(dAtA[i:], m.AttestationRoot)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.AttestationRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AttestOffset != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AttestOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConfLevel != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ConfLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventAttestationApproved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovEvents(uint64(m.ChainId))
+	}
+	if m.ConfLevel != 0 {
+		n += 1 + sovEvents(uint64(m.ConfLevel))
+	}
+	if m.AttestOffset != 0 {
+		n += 1 + sovEvents(uint64(m.AttestOffset))
+	}
+	l = len(m.AttestationRoot)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventAttestationApproved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttestationApproved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttestationApproved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestOffset", wireType)
+			}
+			m.AttestOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationRoot = append(m.AttestationRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttestationRoot == nil {
+				m.AttestationRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"time"
+
+	"github.com/omni-network/omni/lib/errors"
+)
+
+const (
+	defaultLivenessWindow           = 1000             // Track liveness over the last 1000 approved attestations.
+	defaultLivenessMaxMissedPercent = 50               // Jail validators missing more than half of the approved attestations.
+	defaultLivenessJailDuration     = 10 * time.Minute // Identical to the cosmos x/slashing downtime jail duration.
+	defaultVoteExtensionEncoding    = VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_COMPACT
+	defaultVoteWindow               = 64
+	defaultVoteExtensionLimit       = 256
+	defaultTrimLag                  = 1      // Delete attestations state after each epoch, only storing the very latest attestations.
+	defaultConsensusTrimLag         = 72_000 // Delete consensus attestations state after +-1 day (given a period of 1.2s).
+
+	// maxVoteExtensionLimit bounds the vote extension limit, since vote extensions are not metered.
+	maxVoteExtensionLimit = 4096
+)
+
+// DefaultGenesisState returns the default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// DefaultParams returns the default params.
+func DefaultParams() Params {
+	return Params{
+		LivenessWindow:           defaultLivenessWindow,
+		LivenessMaxMissedPercent: defaultLivenessMaxMissedPercent,
+		LivenessJailDuration:     defaultLivenessJailDuration,
+		VoteExtensionEncoding:    defaultVoteExtensionEncoding,
+		VoteWindow:               defaultVoteWindow,
+		VoteExtensionLimit:       defaultVoteExtensionLimit,
+		TrimLag:                  defaultTrimLag,
+		ConsensusTrimLag:         defaultConsensusTrimLag,
+	}
+}
+
+// Validate returns an error if the genesis state is invalid.
+func (g *GenesisState) Validate() error {
+	return g.Params.Validate()
+}
+
+// Validate returns an error if the params are invalid.
+func (p Params) Validate() error {
+	if p.LivenessMaxMissedPercent > 100 {
+		return errors.New("liveness max missed percent exceeds 100", "percent", p.LivenessMaxMissedPercent)
+	} else if p.LivenessJailDuration < 0 {
+		return errors.New("negative liveness jail duration", "duration", p.LivenessJailDuration)
+	} else if _, ok := VoteExtensionEncoding_name[int32(p.VoteExtensionEncoding)]; !ok {
+		return errors.New("unknown vote extension encoding", "encoding", p.VoteExtensionEncoding)
+	} else if p.VoteExtensionLimit > maxVoteExtensionLimit {
+		return errors.New("vote extension limit too high", "limit", p.VoteExtensionLimit, "max", maxVoteExtensionLimit)
+	} else if p.TrimLag != 0 && p.ConsensusTrimLag != 0 && p.ConsensusTrimLag < p.TrimLag {
+		return errors.New("consensus trim lag must be greater than or equal to trim lag", "trim_lag", p.TrimLag, "consensus_trim_lag", p.ConsensusTrimLag)
+	}
+
+	return nil
+}
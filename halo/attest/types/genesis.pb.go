@@ -0,0 +1,858 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: halo/attest/types/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VoteExtensionEncoding defines the encoding of vote extensions.
+type VoteExtensionEncoding int32
+
+const (
+	VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_PROTO         VoteExtensionEncoding = 0
+	VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_COMPACT       VoteExtensionEncoding = 1
+	VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_COMPACT_FLATE VoteExtensionEncoding = 2
+)
+
+var VoteExtensionEncoding_name = map[int32]string{
+	0: "VOTE_EXTENSION_ENCODING_PROTO",
+	1: "VOTE_EXTENSION_ENCODING_COMPACT",
+	2: "VOTE_EXTENSION_ENCODING_COMPACT_FLATE",
+}
+
+var VoteExtensionEncoding_value = map[string]int32{
+	"VOTE_EXTENSION_ENCODING_PROTO":         0,
+	"VOTE_EXTENSION_ENCODING_COMPACT":       1,
+	"VOTE_EXTENSION_ENCODING_COMPACT_FLATE": 2,
+}
+
+func (x VoteExtensionEncoding) String() string {
+	return proto.EnumName(VoteExtensionEncoding_name, int32(x))
+}
+
+func (VoteExtensionEncoding) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bd7dab6b5b63a53d, []int{0}
+}
+
+// GenesisState defines the attest module's genesis state.
+type GenesisState struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Tables []byte `protobuf:"bytes,2,opt,name=tables,proto3" json:"tables,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7dab6b5b63a53d, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetTables() []byte {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+// Params defines the attest module's parameters stored in state.
+type Params struct {
+	// liveness_window is the number of approved attestations a validator's liveness is tracked over.
+	// Zero disables liveness tracking.
+	LivenessWindow uint64 `protobuf:"varint,1,opt,name=liveness_window,json=livenessWindow,proto3" json:"liveness_window,omitempty"`
+	// liveness_max_missed_percent is the maximum percentage of approved attestations in the liveness window
+	// a validator may miss before being jailed.
+	LivenessMaxMissedPercent uint32 `protobuf:"varint,2,opt,name=liveness_max_missed_percent,json=livenessMaxMissedPercent,proto3" json:"liveness_max_missed_percent,omitempty"`
+	// liveness_jail_duration is the duration a validator is jailed for when exceeding the maximum missed attestations.
+	LivenessJailDuration time.Duration `protobuf:"bytes,3,opt,name=liveness_jail_duration,json=livenessJailDuration,proto3,stdduration" json:"liveness_jail_duration"`
+	// vote_extension_encoding is the encoding validators use for their vote extensions.
+	// All encodings are accepted when verifying vote extensions, so it can be updated via the AttestParams predeploy (after the V1 network upgrade).
+	VoteExtensionEncoding VoteExtensionEncoding `protobuf:"varint,4,opt,name=vote_extension_encoding,json=voteExtensionEncoding,proto3,enum=halo.attest.types.VoteExtensionEncoding" json:"vote_extension_encoding,omitempty"`
+	// vote_window is the number of attest offsets before and after the latest approved attestation that votes are allowed for.
+	// Zero defaults to the value prior to governable vote params, see DefaultParams.
+	VoteWindow uint64 `protobuf:"varint,5,opt,name=vote_window,json=voteWindow,proto3" json:"vote_window,omitempty"`
+	// vote_extension_limit is the maximum number of votes that a validator may include in a single vote extension.
+	// Zero defaults to the value prior to governable vote params, see DefaultParams.
+	VoteExtensionLimit uint64 `protobuf:"varint,6,opt,name=vote_extension_limit,json=voteExtensionLimit,proto3" json:"vote_extension_limit,omitempty"`
+	// trim_lag is the number of blocks after which non-consensus-chain attestations are deleted from state.
+	// Zero defaults to the value prior to governable vote params, see DefaultParams.
+	TrimLag uint64 `protobuf:"varint,7,opt,name=trim_lag,json=trimLag,proto3" json:"trim_lag,omitempty"`
+	// consensus_trim_lag is the number of blocks after which consensus-chain attestations are deleted from state.
+	// Zero defaults to the value prior to governable vote params, see DefaultParams.
+	ConsensusTrimLag uint64 `protobuf:"varint,8,opt,name=consensus_trim_lag,json=consensusTrimLag,proto3" json:"consensus_trim_lag,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7dab6b5b63a53d, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetLivenessWindow() uint64 {
+	if m != nil {
+		return m.LivenessWindow
+	}
+	return 0
+}
+
+func (m *Params) GetLivenessMaxMissedPercent() uint32 {
+	if m != nil {
+		return m.LivenessMaxMissedPercent
+	}
+	return 0
+}
+
+func (m *Params) GetLivenessJailDuration() time.Duration {
+	if m != nil {
+		return m.LivenessJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetVoteExtensionEncoding() VoteExtensionEncoding {
+	if m != nil {
+		return m.VoteExtensionEncoding
+	}
+	return VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_PROTO
+}
+
+func (m *Params) GetVoteWindow() uint64 {
+	if m != nil {
+		return m.VoteWindow
+	}
+	return 0
+}
+
+func (m *Params) GetVoteExtensionLimit() uint64 {
+	if m != nil {
+		return m.VoteExtensionLimit
+	}
+	return 0
+}
+
+func (m *Params) GetTrimLag() uint64 {
+	if m != nil {
+		return m.TrimLag
+	}
+	return 0
+}
+
+func (m *Params) GetConsensusTrimLag() uint64 {
+	if m != nil {
+		return m.ConsensusTrimLag
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("halo.attest.types.VoteExtensionEncoding", VoteExtensionEncoding_name, VoteExtensionEncoding_value)
+	proto.RegisterType((*GenesisState)(nil), "halo.attest.types.GenesisState")
+	proto.RegisterType((*Params)(nil), "halo.attest.types.Params")
+}
+
+func init() { proto.RegisterFile("halo/attest/types/genesis.proto", fileDescriptor_bd7dab6b5b63a53d) }
+
+var fileDescriptor_bd7dab6b5b63a53d = []byte{
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0x33, 0x6d, 0x48, 0xa3, 0x69, 0x29, 0xe9, 0x28, 0x2d, 0x6e, 0x11, 0x4e, 0x28, 0x42,
+	0x84, 0x1f, 0xd9, 0xa8, 0x2c, 0x58, 0xb1, 0x68, 0x52, 0x53, 0x15, 0x25, 0x71, 0xe4, 0x5a, 0xe5,
+	0x67, 0x33, 0x4c, 0x92, 0xc1, 0x0c, 0xb2, 0x67, 0x22, 0xcf, 0x24, 0x0d, 0xaf, 0xc0, 0x8a, 0x25,
+	0x8f, 0x54, 0x76, 0x5d, 0xb2, 0x02, 0x94, 0xbc, 0x08, 0xf2, 0xd8, 0x8e, 0x04, 0x0d, 0x62, 0xe7,
+	0x7b, 0xcf, 0x77, 0xef, 0xf1, 0x3d, 0x96, 0x61, 0xed, 0x03, 0x09, 0x85, 0x4d, 0x94, 0xa2, 0x52,
+	0xd9, 0xea, 0xd3, 0x88, 0x4a, 0x3b, 0xa0, 0x9c, 0x4a, 0x26, 0xad, 0x51, 0x2c, 0x94, 0x40, 0x5b,
+	0x09, 0x60, 0xa5, 0x80, 0xa5, 0x81, 0xbd, 0x6a, 0x20, 0x02, 0xa1, 0x55, 0x3b, 0x79, 0x4a, 0xc1,
+	0x3d, 0x33, 0x10, 0x22, 0x08, 0xa9, 0xad, 0xab, 0xfe, 0xf8, 0xbd, 0x3d, 0x1c, 0xc7, 0x44, 0x31,
+	0xc1, 0x53, 0x7d, 0x1f, 0xc3, 0x8d, 0xe3, 0x74, 0xf3, 0xa9, 0x22, 0x8a, 0xa2, 0x67, 0xb0, 0x34,
+	0x22, 0x31, 0x89, 0xa4, 0x01, 0xea, 0xa0, 0xb1, 0x7e, 0xb0, 0x6b, 0x5d, 0x71, 0xb2, 0x7a, 0x1a,
+	0x68, 0x16, 0x2f, 0x7e, 0xd4, 0x0a, 0x5e, 0x86, 0xa3, 0x1d, 0x58, 0x52, 0xa4, 0x1f, 0x52, 0x69,
+	0xac, 0xd4, 0x41, 0x63, 0xc3, 0xcb, 0xaa, 0xfd, 0x6f, 0xab, 0xb0, 0x94, 0x0e, 0xa0, 0xfb, 0xf0,
+	0x46, 0xc8, 0x26, 0x89, 0x9b, 0xc4, 0xe7, 0x8c, 0x0f, 0xc5, 0xb9, 0x36, 0x29, 0x7a, 0x9b, 0x79,
+	0xfb, 0x95, 0xee, 0xa2, 0xe7, 0xf0, 0xd6, 0x02, 0x8c, 0xc8, 0x14, 0x47, 0x4c, 0x4a, 0x3a, 0xc4,
+	0x23, 0x1a, 0x0f, 0x28, 0x57, 0xda, 0xe0, 0xba, 0x67, 0xe4, 0x48, 0x87, 0x4c, 0x3b, 0x1a, 0xe8,
+	0xa5, 0x3a, 0x7a, 0x03, 0x77, 0x16, 0xe3, 0x1f, 0x09, 0x0b, 0x71, 0x7e, 0xb3, 0xb1, 0x9a, 0xdd,
+	0x94, 0x86, 0x62, 0xe5, 0xa1, 0x58, 0x47, 0x19, 0xd0, 0x2c, 0x27, 0x37, 0x7d, 0xfd, 0x59, 0x03,
+	0x5e, 0x35, 0x5f, 0xf1, 0x92, 0xb0, 0x30, 0xd7, 0xd1, 0x3b, 0x78, 0x73, 0x22, 0x14, 0xc5, 0x74,
+	0xaa, 0x28, 0x97, 0x4c, 0x70, 0x4c, 0xf9, 0x40, 0x0c, 0x19, 0x0f, 0x8c, 0x62, 0x1d, 0x34, 0x36,
+	0x0f, 0x1a, 0x4b, 0xf2, 0x3a, 0x13, 0x8a, 0x3a, 0xf9, 0x80, 0x93, 0xf1, 0xde, 0xf6, 0x64, 0x59,
+	0x1b, 0xd5, 0xe0, 0xba, 0x76, 0xc8, 0x02, 0xba, 0xa6, 0x03, 0x82, 0x49, 0x2b, 0x0b, 0xe7, 0x09,
+	0xac, 0xfe, 0xf5, 0x0a, 0x21, 0x8b, 0x98, 0x32, 0x4a, 0x9a, 0x44, 0x7f, 0x6c, 0x6d, 0x27, 0x0a,
+	0xda, 0x85, 0x65, 0x15, 0xb3, 0x08, 0x87, 0x24, 0x30, 0xd6, 0x34, 0xb5, 0x96, 0xd4, 0x6d, 0x12,
+	0xa0, 0xc7, 0x10, 0x0d, 0x04, 0x97, 0x94, 0xcb, 0xb1, 0xc4, 0x0b, 0xa8, 0xac, 0xa1, 0xca, 0x42,
+	0xf1, 0x53, 0xfa, 0xe1, 0x67, 0x00, 0xb7, 0x97, 0x1e, 0x83, 0xee, 0xc0, 0xdb, 0x67, 0xae, 0xef,
+	0x60, 0xe7, 0xb5, 0xef, 0x74, 0x4f, 0x4f, 0xdc, 0x2e, 0x76, 0xba, 0x2d, 0xf7, 0xe8, 0xa4, 0x7b,
+	0x8c, 0x7b, 0x9e, 0xeb, 0xbb, 0x95, 0x02, 0xba, 0x0b, 0x6b, 0xff, 0x42, 0x5a, 0x6e, 0xa7, 0x77,
+	0xd8, 0xf2, 0x2b, 0x00, 0x3d, 0x80, 0xf7, 0xfe, 0x03, 0xe1, 0x17, 0xed, 0x43, 0xdf, 0xa9, 0xac,
+	0x34, 0x1f, 0x5d, 0xcc, 0x4c, 0x70, 0x39, 0x33, 0xc1, 0xaf, 0x99, 0x09, 0xbe, 0xcc, 0xcd, 0xc2,
+	0xe5, 0xdc, 0x2c, 0x7c, 0x9f, 0x9b, 0x85, 0xb7, 0x5b, 0x57, 0xfe, 0x9e, 0x7e, 0x49, 0x7f, 0xea,
+	0xa7, 0xbf, 0x07, 0x00, 0xcc, 0x0c, 0x5a, 0x0a, 0x59, 0x03, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tables) > 0 {
+		i -= len(m.Tables)
+		copy(dAtA[i:], m.Tables)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Tables)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsensusTrimLag != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ConsensusTrimLag))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TrimLag != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TrimLag))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.VoteExtensionLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VoteExtensionLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.VoteWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VoteWindow))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VoteExtensionEncoding != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VoteExtensionEncoding))
+		i--
+		dAtA[i] = 0x20
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LivenessJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LivenessJailDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.LivenessMaxMissedPercent != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LivenessMaxMissedPercent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LivenessWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LivenessWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.Tables)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LivenessWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.LivenessWindow))
+	}
+	if m.LivenessMaxMissedPercent != 0 {
+		n += 1 + sovGenesis(uint64(m.LivenessMaxMissedPercent))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LivenessJailDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.VoteExtensionEncoding != 0 {
+		n += 1 + sovGenesis(uint64(m.VoteExtensionEncoding))
+	}
+	if m.VoteWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.VoteWindow))
+	}
+	if m.VoteExtensionLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.VoteExtensionLimit))
+	}
+	if m.TrimLag != 0 {
+		n += 1 + sovGenesis(uint64(m.TrimLag))
+	}
+	if m.ConsensusTrimLag != 0 {
+		n += 1 + sovGenesis(uint64(m.ConsensusTrimLag))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tables = append(m.Tables[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tables == nil {
+				m.Tables = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessWindow", wireType)
+			}
+			m.LivenessWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LivenessWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessMaxMissedPercent", wireType)
+			}
+			m.LivenessMaxMissedPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LivenessMaxMissedPercent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LivenessJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtensionEncoding", wireType)
+			}
+			m.VoteExtensionEncoding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteExtensionEncoding |= VoteExtensionEncoding(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteWindow", wireType)
+			}
+			m.VoteWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtensionLimit", wireType)
+			}
+			m.VoteExtensionLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteExtensionLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimLag", wireType)
+			}
+			m.TrimLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrimLag |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusTrimLag", wireType)
+			}
+			m.ConsensusTrimLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsensusTrimLag |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,11 +2,17 @@ package types
 
 import (
 	"context"
+	"time"
 
 	vtypes "github.com/omni-network/omni/halo/valsync/types"
 	"github.com/omni-network/omni/lib/xchain"
 
 	"github.com/ethereum/go-ethereum/common"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sltypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Voter abstracts the validator duty of v∂oting for all
@@ -58,3 +64,17 @@ type ChainVerNameFunc func(xchain.ChainVersion) string
 type AttestKeeper interface {
 	ListAttestationsFrom(ctx context.Context, chainID uint64, confLevel uint32, offset uint64, max uint64) ([]*Attestation, error)
 }
+
+// SlashingKeeper abstracts the cosmos slashing keeper used to penalize validators that double sign attestations.
+type SlashingKeeper interface {
+	SlashWithInfractionReason(ctx context.Context, consAddr sdk.ConsAddress, fraction sdkmath.LegacyDec, power, distributionHeight int64, infraction stypes.Infraction) error
+	Jail(ctx context.Context, consAddr sdk.ConsAddress) error
+	JailUntil(ctx context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error
+	GetValidatorSigningInfo(ctx context.Context, consAddr sdk.ConsAddress) (sltypes.ValidatorSigningInfo, error)
+}
+
+// Archiver abstracts a node-local archive of approved attestations.
+// It is called with each approved attestation before it is pruned from state.
+type Archiver interface {
+	Archive(ctx context.Context, height uint64, att *Attestation) error
+}
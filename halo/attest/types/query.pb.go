@@ -6,20 +6,27 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,6 +34,41 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// JailReason defines the reason a validator was jailed.
+type JailReason int32
+
+const (
+	JailReason_JAIL_REASON_UNSPECIFIED           JailReason = 0
+	JailReason_JAIL_REASON_CONSENSUS_DOWNTIME    JailReason = 1
+	JailReason_JAIL_REASON_CONSENSUS_DOUBLE_SIGN JailReason = 2
+	JailReason_JAIL_REASON_ATTEST_DOWNTIME       JailReason = 3
+	JailReason_JAIL_REASON_ATTEST_DOUBLE_SIGN    JailReason = 4
+)
+
+var JailReason_name = map[int32]string{
+	0: "JAIL_REASON_UNSPECIFIED",
+	1: "JAIL_REASON_CONSENSUS_DOWNTIME",
+	2: "JAIL_REASON_CONSENSUS_DOUBLE_SIGN",
+	3: "JAIL_REASON_ATTEST_DOWNTIME",
+	4: "JAIL_REASON_ATTEST_DOUBLE_SIGN",
+}
+
+var JailReason_value = map[string]int32{
+	"JAIL_REASON_UNSPECIFIED":           0,
+	"JAIL_REASON_CONSENSUS_DOWNTIME":    1,
+	"JAIL_REASON_CONSENSUS_DOUBLE_SIGN": 2,
+	"JAIL_REASON_ATTEST_DOWNTIME":       3,
+	"JAIL_REASON_ATTEST_DOUBLE_SIGN":    4,
+}
+
+func (x JailReason) String() string {
+	return proto.EnumName(JailReason_name, int32(x))
+}
+
+func (JailReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{0}
+}
+
 // ApprovedFromRequest queries halo for approved attestations for the given chain_id
 // and from the given height (inclusive). The response will contain at most max attestations sequentially
 // following from_height.
@@ -542,829 +584,7562 @@ func (m *WindowCompareResponse) GetCmp() int32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*AttestationsFromRequest)(nil), "halo.attest.types.AttestationsFromRequest")
-	proto.RegisterType((*AttestationsFromResponse)(nil), "halo.attest.types.AttestationsFromResponse")
-	proto.RegisterType((*LatestAttestationRequest)(nil), "halo.attest.types.LatestAttestationRequest")
-	proto.RegisterType((*LatestAttestationResponse)(nil), "halo.attest.types.LatestAttestationResponse")
-	proto.RegisterType((*EarliestAttestationRequest)(nil), "halo.attest.types.EarliestAttestationRequest")
-	proto.RegisterType((*EarliestAttestationResponse)(nil), "halo.attest.types.EarliestAttestationResponse")
-	proto.RegisterType((*ListAllAttestationsRequest)(nil), "halo.attest.types.ListAllAttestationsRequest")
-	proto.RegisterType((*ListAllAttestationsResponse)(nil), "halo.attest.types.ListAllAttestationsResponse")
-	proto.RegisterType((*WindowCompareRequest)(nil), "halo.attest.types.WindowCompareRequest")
-	proto.RegisterType((*WindowCompareResponse)(nil), "halo.attest.types.WindowCompareResponse")
+type OffsetHeightRequest struct {
+	ChainId      uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ConfLevel    uint32 `protobuf:"varint,2,opt,name=conf_level,json=confLevel,proto3" json:"conf_level,omitempty"`
+	AttestOffset uint64 `protobuf:"varint,3,opt,name=attest_offset,json=attestOffset,proto3" json:"attest_offset,omitempty"`
 }
 
-func init() { proto.RegisterFile("halo/attest/types/query.proto", fileDescriptor_93d3f1745081aabb) }
-
-var fileDescriptor_93d3f1745081aabb = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0x8e, 0x69, 0x37, 0xe0, 0x94, 0x4a, 0xab, 0xf9, 0xcb, 0x3c, 0x2d, 0x54, 0xe1, 0x82, 0xc2,
-	0x20, 0x95, 0xc6, 0x0b, 0xb0, 0x21, 0x90, 0x90, 0x2a, 0x21, 0x22, 0x04, 0x12, 0x12, 0x54, 0xa6,
-	0x75, 0xb5, 0x48, 0x49, 0x9c, 0xc5, 0xee, 0x60, 0x4f, 0x01, 0x4f, 0xc4, 0x35, 0x97, 0xbb, 0xe4,
-	0x12, 0xb5, 0x2f, 0x82, 0xec, 0x84, 0xc9, 0x6d, 0xdc, 0xb5, 0x52, 0x7b, 0x17, 0x9f, 0x9f, 0xef,
-	0xfb, 0x8e, 0xf2, 0x1d, 0x1b, 0xf6, 0x4f, 0x68, 0xcc, 0xbb, 0x54, 0x4a, 0x26, 0x64, 0x57, 0x9e,
-	0x67, 0x4c, 0x74, 0x4f, 0xc7, 0x2c, 0x3f, 0x0f, 0xb2, 0x9c, 0x4b, 0x8e, 0x5b, 0x2a, 0x1d, 0x14,
-	0xe9, 0x40, 0xa7, 0x09, 0xa9, 0x76, 0xc8, 0xef, 0x45, 0xb9, 0x2f, 0xe1, 0xfe, 0x91, 0x4e, 0x50,
-	0x19, 0xf1, 0x54, 0xbc, 0xce, 0x79, 0x12, 0xb2, 0xd3, 0x31, 0x13, 0x12, 0xef, 0xc2, 0x8d, 0xc1,
-	0x09, 0x8d, 0xd2, 0x7e, 0x34, 0x74, 0x51, 0x1b, 0x75, 0xea, 0xe1, 0x75, 0x7d, 0x7e, 0x33, 0xc4,
-	0xfb, 0x00, 0x03, 0x9e, 0x8e, 0xfa, 0x31, 0x3b, 0x63, 0xb1, 0x7b, 0xad, 0x8d, 0x3a, 0xcd, 0xf0,
-	0xa6, 0x8a, 0xf4, 0x54, 0x00, 0x3f, 0x80, 0xc6, 0x28, 0xe7, 0x49, 0x9f, 0x8f, 0x46, 0x82, 0x49,
-	0xb7, 0xa6, 0x9b, 0x41, 0x85, 0xde, 0xea, 0x88, 0xff, 0x05, 0xdc, 0x2a, 0xab, 0xc8, 0x78, 0x2a,
-	0x18, 0x3e, 0x86, 0x5b, 0xd4, 0xc8, 0xb9, 0xa8, 0x5d, 0xeb, 0x34, 0x0e, 0xbd, 0xa0, 0x32, 0x57,
-	0x60, 0x40, 0x84, 0x33, 0x3d, 0xfe, 0x7b, 0x70, 0x7b, 0x54, 0x9d, 0xcd, 0x92, 0x75, 0xc7, 0xf2,
-	0x3f, 0xc3, 0xae, 0x05, 0xb5, 0x94, 0xfd, 0x02, 0x1a, 0x86, 0x04, 0x8d, 0xbc, 0x5c, 0xb5, 0xd9,
-	0xe2, 0x7f, 0x00, 0xf2, 0x8a, 0xe6, 0x71, 0xb4, 0x69, 0xd9, 0x7d, 0xd8, 0xb3, 0xe2, 0x6e, 0x4c,
-	0xf8, 0x0f, 0x04, 0xa4, 0x17, 0x09, 0x79, 0x14, 0xc7, 0xe6, 0x5f, 0x5d, 0xdf, 0x47, 0xf7, 0x60,
-	0x5b, 0x81, 0x8d, 0x85, 0xb6, 0x50, 0x33, 0x2c, 0x4f, 0xf3, 0xfe, 0xaa, 0x57, 0xfc, 0x45, 0x61,
-	0xcf, 0x2a, 0x68, 0x83, 0x16, 0x1b, 0xc3, 0x9d, 0x8f, 0x51, 0x3a, 0xe4, 0xdf, 0x5e, 0xf2, 0x24,
-	0xa3, 0x39, 0x5b, 0x7f, 0xda, 0x87, 0xd0, 0x2c, 0x18, 0x66, 0xf7, 0xa6, 0xa4, 0x2d, 0x27, 0x7b,
-	0x0c, 0x77, 0xe7, 0x68, 0xcb, 0x99, 0x76, 0xa0, 0x36, 0x48, 0x32, 0x4d, 0xb9, 0x15, 0xaa, 0xcf,
-	0xc3, 0x5f, 0x75, 0xd8, 0x7a, 0xa7, 0x6e, 0x06, 0x9c, 0xc0, 0xce, 0xfc, 0xba, 0xe1, 0x27, 0x57,
-	0x4f, 0x6b, 0xde, 0x04, 0xe4, 0x60, 0xa5, 0xda, 0x42, 0x88, 0xef, 0xe0, 0x0c, 0x5a, 0x95, 0x3d,
-	0xc1, 0x36, 0x8c, 0x45, 0x3b, 0x4a, 0x9e, 0xae, 0x56, 0x7c, 0xc9, 0x78, 0x06, 0xb7, 0x2d, 0x16,
-	0xc7, 0xcf, 0x2c, 0x30, 0x8b, 0x57, 0x8c, 0x04, 0xab, 0x96, 0x9b, 0xbc, 0x16, 0x9f, 0x59, 0x79,
-	0x17, 0x2f, 0x88, 0x95, 0xf7, 0x0a, 0xfb, 0xfa, 0x0e, 0x1e, 0x42, 0x73, 0xc6, 0x05, 0xf8, 0x91,
-	0x05, 0xc2, 0x66, 0x4f, 0xd2, 0x59, 0x5e, 0xf8, 0x9f, 0xe5, 0xf8, 0xe0, 0xf7, 0xc4, 0x43, 0x17,
-	0x13, 0x0f, 0xfd, 0x9d, 0x78, 0xe8, 0xe7, 0xd4, 0x73, 0x2e, 0xa6, 0x9e, 0xf3, 0x67, 0xea, 0x39,
-	0x9f, 0x5a, 0x95, 0x17, 0xe5, 0xeb, 0xb6, 0x7e, 0x4f, 0x9e, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff,
-	0x0a, 0xd8, 0xf1, 0x12, 0x9f, 0x06, 0x00, 0x00,
+func (m *OffsetHeightRequest) Reset()         { *m = OffsetHeightRequest{} }
+func (m *OffsetHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*OffsetHeightRequest) ProtoMessage()    {}
+func (*OffsetHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{10}
+}
+func (m *OffsetHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OffsetHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OffsetHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OffsetHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OffsetHeightRequest.Merge(m, src)
+}
+func (m *OffsetHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OffsetHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OffsetHeightRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_OffsetHeightRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *OffsetHeightRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// AttestationsFrom queries halo for approved attestations for the given chain_id
-	// and from the given height (inclusive). The response will contain at most max attestations sequentially
-	// following from_height.
-	AttestationsFrom(ctx context.Context, in *AttestationsFromRequest, opts ...grpc.CallOption) (*AttestationsFromResponse, error)
-	// LatestAttestation queries halo for the latest approved attestations for the given chain_id.
-	LatestAttestation(ctx context.Context, in *LatestAttestationRequest, opts ...grpc.CallOption) (*LatestAttestationResponse, error)
-	// EarliestAttestation queries halo for the earliest approved attestation in consensus chain state for the given chain_id.
-	EarliestAttestation(ctx context.Context, in *EarliestAttestationRequest, opts ...grpc.CallOption) (*EarliestAttestationResponse, error)
-	// ListAllAttestations queries halo for all approved attestations in consensus chain state for the given chain_id.
-	ListAllAttestations(ctx context.Context, in *ListAllAttestationsRequest, opts ...grpc.CallOption) (*ListAllAttestationsResponse, error)
-	// WindowCompare compares the given chain height to the vote window.
-	// It returns whether the request is behind (-1), or in (0), or after (1) the vote window.
-	// The vote window is a configured number of blocks around the latest approved attestation.
-	WindowCompare(ctx context.Context, in *WindowCompareRequest, opts ...grpc.CallOption) (*WindowCompareResponse, error)
+func (m *OffsetHeightRequest) GetConfLevel() uint32 {
+	if m != nil {
+		return m.ConfLevel
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *OffsetHeightRequest) GetAttestOffset() uint64 {
+	if m != nil {
+		return m.AttestOffset
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type OffsetHeightResponse struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (c *queryClient) AttestationsFrom(ctx context.Context, in *AttestationsFromRequest, opts ...grpc.CallOption) (*AttestationsFromResponse, error) {
-	out := new(AttestationsFromResponse)
-	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/AttestationsFrom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OffsetHeightResponse) Reset()         { *m = OffsetHeightResponse{} }
+func (m *OffsetHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*OffsetHeightResponse) ProtoMessage()    {}
+func (*OffsetHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{11}
+}
+func (m *OffsetHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OffsetHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OffsetHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *OffsetHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OffsetHeightResponse.Merge(m, src)
+}
+func (m *OffsetHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OffsetHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OffsetHeightResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LatestAttestation(ctx context.Context, in *LatestAttestationRequest, opts ...grpc.CallOption) (*LatestAttestationResponse, error) {
-	out := new(LatestAttestationResponse)
-	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/LatestAttestation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OffsetHeightResponse proto.InternalMessageInfo
+
+func (m *OffsetHeightResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) EarliestAttestation(ctx context.Context, in *EarliestAttestationRequest, opts ...grpc.CallOption) (*EarliestAttestationResponse, error) {
-	out := new(EarliestAttestationResponse)
-	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/EarliestAttestation", in, out, opts...)
-	if err != nil {
-		return nil, err
+// AttestationInfo is an attestation with its status and the consensus height at which it was created.
+type AttestationInfo struct {
+	Attestation   *Attestation `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	Status        uint32       `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedHeight uint64       `protobuf:"varint,3,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+}
+
+func (m *AttestationInfo) Reset()         { *m = AttestationInfo{} }
+func (m *AttestationInfo) String() string { return proto.CompactTextString(m) }
+func (*AttestationInfo) ProtoMessage()    {}
+func (*AttestationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{12}
+}
+func (m *AttestationInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AttestationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationInfo.Merge(m, src)
+}
+func (m *AttestationInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationInfo.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListAllAttestations(ctx context.Context, in *ListAllAttestationsRequest, opts ...grpc.CallOption) (*ListAllAttestationsResponse, error) {
-	out := new(ListAllAttestationsResponse)
-	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/ListAllAttestations", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AttestationInfo proto.InternalMessageInfo
+
+func (m *AttestationInfo) GetAttestation() *Attestation {
+	if m != nil {
+		return m.Attestation
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) WindowCompare(ctx context.Context, in *WindowCompareRequest, opts ...grpc.CallOption) (*WindowCompareResponse, error) {
-	out := new(WindowCompareResponse)
-	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/WindowCompare", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AttestationInfo) GetStatus() uint32 {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// AttestationsFrom queries halo for approved attestations for the given chain_id
-	// and from the given height (inclusive). The response will contain at most max attestations sequentially
-	// following from_height.
-	AttestationsFrom(context.Context, *AttestationsFromRequest) (*AttestationsFromResponse, error)
-	// LatestAttestation queries halo for the latest approved attestations for the given chain_id.
-	LatestAttestation(context.Context, *LatestAttestationRequest) (*LatestAttestationResponse, error)
-	// EarliestAttestation queries halo for the earliest approved attestation in consensus chain state for the given chain_id.
-	EarliestAttestation(context.Context, *EarliestAttestationRequest) (*EarliestAttestationResponse, error)
-	// ListAllAttestations queries halo for all approved attestations in consensus chain state for the given chain_id.
-	ListAllAttestations(context.Context, *ListAllAttestationsRequest) (*ListAllAttestationsResponse, error)
-	// WindowCompare compares the given chain height to the vote window.
-	// It returns whether the request is behind (-1), or in (0), or after (1) the vote window.
-	// The vote window is a configured number of blocks around the latest approved attestation.
-	WindowCompare(context.Context, *WindowCompareRequest) (*WindowCompareResponse, error)
+func (m *AttestationInfo) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type AttestationByRootRequest struct {
+	AttestationRoot []byte `protobuf:"bytes,1,opt,name=attestation_root,json=attestationRoot,proto3" json:"attestation_root,omitempty"`
 }
 
-func (*UnimplementedQueryServer) AttestationsFrom(ctx context.Context, req *AttestationsFromRequest) (*AttestationsFromResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AttestationsFrom not implemented")
+func (m *AttestationByRootRequest) Reset()         { *m = AttestationByRootRequest{} }
+func (m *AttestationByRootRequest) String() string { return proto.CompactTextString(m) }
+func (*AttestationByRootRequest) ProtoMessage()    {}
+func (*AttestationByRootRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{13}
 }
-func (*UnimplementedQueryServer) LatestAttestation(ctx context.Context, req *LatestAttestationRequest) (*LatestAttestationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestAttestation not implemented")
+func (m *AttestationByRootRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) EarliestAttestation(ctx context.Context, req *EarliestAttestationRequest) (*EarliestAttestationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EarliestAttestation not implemented")
+func (m *AttestationByRootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationByRootRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListAllAttestations(ctx context.Context, req *ListAllAttestationsRequest) (*ListAllAttestationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAllAttestations not implemented")
+func (m *AttestationByRootRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationByRootRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) WindowCompare(ctx context.Context, req *WindowCompareRequest) (*WindowCompareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WindowCompare not implemented")
+func (m *AttestationByRootRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *AttestationByRootRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationByRootRequest.DiscardUnknown(m)
 }
 
-func _Query_AttestationsFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AttestationsFromRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AttestationsFrom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/halo.attest.types.Query/AttestationsFrom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AttestationsFrom(ctx, req.(*AttestationsFromRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_AttestationByRootRequest proto.InternalMessageInfo
 
-func _Query_LatestAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LatestAttestationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestAttestation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/halo.attest.types.Query/LatestAttestation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestAttestation(ctx, req.(*LatestAttestationRequest))
+func (m *AttestationByRootRequest) GetAttestationRoot() []byte {
+	if m != nil {
+		return m.AttestationRoot
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_EarliestAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EarliestAttestationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EarliestAttestation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/halo.attest.types.Query/EarliestAttestation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EarliestAttestation(ctx, req.(*EarliestAttestationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type AttestationByRootResponse struct {
+	Attestation *AttestationInfo `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
 }
 
-func _Query_ListAllAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllAttestationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListAllAttestations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/halo.attest.types.Query/ListAllAttestations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListAllAttestations(ctx, req.(*ListAllAttestationsRequest))
+func (m *AttestationByRootResponse) Reset()         { *m = AttestationByRootResponse{} }
+func (m *AttestationByRootResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestationByRootResponse) ProtoMessage()    {}
+func (*AttestationByRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{14}
+}
+func (m *AttestationByRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationByRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationByRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *AttestationByRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationByRootResponse.Merge(m, src)
+}
+func (m *AttestationByRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationByRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationByRootResponse.DiscardUnknown(m)
 }
 
-func _Query_WindowCompare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WindowCompareRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).WindowCompare(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/halo.attest.types.Query/WindowCompare",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).WindowCompare(ctx, req.(*WindowCompareRequest))
+var xxx_messageInfo_AttestationByRootResponse proto.InternalMessageInfo
+
+func (m *AttestationByRootResponse) GetAttestation() *AttestationInfo {
+	if m != nil {
+		return m.Attestation
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "halo.attest.types.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AttestationsFrom",
-			Handler:    _Query_AttestationsFrom_Handler,
-		},
-		{
-			MethodName: "LatestAttestation",
-			Handler:    _Query_LatestAttestation_Handler,
-		},
-		{
-			MethodName: "EarliestAttestation",
-			Handler:    _Query_EarliestAttestation_Handler,
-		},
-		{
-			MethodName: "ListAllAttestations",
-			Handler:    _Query_ListAllAttestations_Handler,
-		},
-		{
-			MethodName: "WindowCompare",
-			Handler:    _Query_WindowCompare_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "halo/attest/types/query.proto",
+type AttestationsByBlockRequest struct {
+	ChainId     uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ConfLevel   uint32 `protobuf:"varint,2,opt,name=conf_level,json=confLevel,proto3" json:"conf_level,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHash   []byte `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
-func (m *AttestationsFromRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AttestationsByBlockRequest) Reset()         { *m = AttestationsByBlockRequest{} }
+func (m *AttestationsByBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*AttestationsByBlockRequest) ProtoMessage()    {}
+func (*AttestationsByBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{15}
+}
+func (m *AttestationsByBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationsByBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationsByBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *AttestationsFromRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AttestationsByBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationsByBlockRequest.Merge(m, src)
+}
+func (m *AttestationsByBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationsByBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationsByBlockRequest.DiscardUnknown(m)
 }
 
-func (m *AttestationsFromRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FromOffset != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FromOffset))
-		i--
-		dAtA[i] = 0x18
+var xxx_messageInfo_AttestationsByBlockRequest proto.InternalMessageInfo
+
+func (m *AttestationsByBlockRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	if m.ConfLevel != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ConfLevel))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *AttestationsByBlockRequest) GetConfLevel() uint32 {
+	if m != nil {
+		return m.ConfLevel
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *AttestationsByBlockRequest) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AttestationsFromResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AttestationsByBlockRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AttestationsFromResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type AttestationsByBlockResponse struct {
+	Attestations []*AttestationInfo `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
 }
 
-func (m *AttestationsFromResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Attestations) > 0 {
-		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *AttestationsByBlockResponse) Reset()         { *m = AttestationsByBlockResponse{} }
+func (m *AttestationsByBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestationsByBlockResponse) ProtoMessage()    {}
+func (*AttestationsByBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{16}
+}
+func (m *AttestationsByBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationsByBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationsByBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AttestationsByBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationsByBlockResponse.Merge(m, src)
+}
+func (m *AttestationsByBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationsByBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationsByBlockResponse.DiscardUnknown(m)
 }
 
-func (m *LatestAttestationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AttestationsByBlockResponse proto.InternalMessageInfo
+
+func (m *AttestationsByBlockResponse) GetAttestations() []*AttestationInfo {
+	if m != nil {
+		return m.Attestations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *LatestAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type AttestationsRequest struct {
+	ChainId          uint64             `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ConfLevel        uint32             `protobuf:"varint,2,opt,name=conf_level,json=confLevel,proto3" json:"conf_level,omitempty"`
+	Status           uint32             `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	MinCreatedHeight uint64             `protobuf:"varint,4,opt,name=min_created_height,json=minCreatedHeight,proto3" json:"min_created_height,omitempty"`
+	MaxCreatedHeight uint64             `protobuf:"varint,5,opt,name=max_created_height,json=maxCreatedHeight,proto3" json:"max_created_height,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *LatestAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConfLevel != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ConfLevel))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+func (m *AttestationsRequest) Reset()         { *m = AttestationsRequest{} }
+func (m *AttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*AttestationsRequest) ProtoMessage()    {}
+func (*AttestationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{17}
+}
+func (m *AttestationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationsRequest.Merge(m, src)
+}
+func (m *AttestationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationsRequest.DiscardUnknown(m)
 }
 
-func (m *LatestAttestationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AttestationsRequest proto.InternalMessageInfo
+
+func (m *AttestationsRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LatestAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AttestationsRequest) GetConfLevel() uint32 {
+	if m != nil {
+		return m.ConfLevel
+	}
+	return 0
 }
 
-func (m *LatestAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Attestation != nil {
-		{
-			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *AttestationsRequest) GetStatus() uint32 {
+	if m != nil {
+		return m.Status
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EarliestAttestationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AttestationsRequest) GetMinCreatedHeight() uint64 {
+	if m != nil {
+		return m.MinCreatedHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EarliestAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AttestationsRequest) GetMaxCreatedHeight() uint64 {
+	if m != nil {
+		return m.MaxCreatedHeight
+	}
+	return 0
 }
 
-func (m *EarliestAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConfLevel != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ConfLevel))
-		i--
-		dAtA[i] = 0x10
+func (m *AttestationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+type AttestationsResponse struct {
+	Attestations []*AttestationInfo  `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *AttestationsResponse) Reset()         { *m = AttestationsResponse{} }
+func (m *AttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestationsResponse) ProtoMessage()    {}
+func (*AttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{18}
+}
+func (m *AttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationsResponse.Merge(m, src)
+}
+func (m *AttestationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationsResponse.DiscardUnknown(m)
 }
 
-func (m *EarliestAttestationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AttestationsResponse proto.InternalMessageInfo
+
+func (m *AttestationsResponse) GetAttestations() []*AttestationInfo {
+	if m != nil {
+		return m.Attestations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EarliestAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AttestationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *EarliestAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Attestation != nil {
-		{
-			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type AttestationSignaturesRequest struct {
+	AttestationRoot []byte `protobuf:"bytes,1,opt,name=attestation_root,json=attestationRoot,proto3" json:"attestation_root,omitempty"`
+}
+
+func (m *AttestationSignaturesRequest) Reset()         { *m = AttestationSignaturesRequest{} }
+func (m *AttestationSignaturesRequest) String() string { return proto.CompactTextString(m) }
+func (*AttestationSignaturesRequest) ProtoMessage()    {}
+func (*AttestationSignaturesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{19}
+}
+func (m *AttestationSignaturesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationSignaturesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationSignaturesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AttestationSignaturesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationSignaturesRequest.Merge(m, src)
+}
+func (m *AttestationSignaturesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationSignaturesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationSignaturesRequest.DiscardUnknown(m)
 }
 
-func (m *ListAllAttestationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AttestationSignaturesRequest proto.InternalMessageInfo
+
+func (m *AttestationSignaturesRequest) GetAttestationRoot() []byte {
+	if m != nil {
+		return m.AttestationRoot
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ListAllAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorVote is a validator's power and signature (if any) for an attestation.
+type ValidatorVote struct {
+	ValidatorAddress []byte `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Power            int64  `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	Signature        []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (m *ListAllAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FromOffset != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FromOffset))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x18
+func (m *ValidatorVote) Reset()         { *m = ValidatorVote{} }
+func (m *ValidatorVote) String() string { return proto.CompactTextString(m) }
+func (*ValidatorVote) ProtoMessage()    {}
+func (*ValidatorVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{20}
+}
+func (m *ValidatorVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ConfLevel != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ConfLevel))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *ValidatorVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorVote.Merge(m, src)
+}
+func (m *ValidatorVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorVote proto.InternalMessageInfo
+
+func (m *ValidatorVote) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *ValidatorVote) GetPower() int64 {
+	if m != nil {
+		return m.Power
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ListAllAttestationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorVote) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ListAllAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type AttestationSignaturesResponse struct {
+	ValidatorSetId uint64           `protobuf:"varint,1,opt,name=validator_set_id,json=validatorSetId,proto3" json:"validator_set_id,omitempty"`
+	TotalPower     int64            `protobuf:"varint,2,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	SignedPower    int64            `protobuf:"varint,3,opt,name=signed_power,json=signedPower,proto3" json:"signed_power,omitempty"`
+	Votes          []*ValidatorVote `protobuf:"bytes,4,rep,name=votes,proto3" json:"votes,omitempty"`
 }
 
-func (m *ListAllAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Attestations) > 0 {
-		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *AttestationSignaturesResponse) Reset()         { *m = AttestationSignaturesResponse{} }
+func (m *AttestationSignaturesResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestationSignaturesResponse) ProtoMessage()    {}
+func (*AttestationSignaturesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{21}
+}
+func (m *AttestationSignaturesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationSignaturesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationSignaturesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AttestationSignaturesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationSignaturesResponse.Merge(m, src)
+}
+func (m *AttestationSignaturesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationSignaturesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationSignaturesResponse.DiscardUnknown(m)
 }
 
-func (m *WindowCompareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AttestationSignaturesResponse proto.InternalMessageInfo
+
+func (m *AttestationSignaturesResponse) GetValidatorSetId() uint64 {
+	if m != nil {
+		return m.ValidatorSetId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *WindowCompareRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AttestationSignaturesResponse) GetTotalPower() int64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
 }
 
-func (m *WindowCompareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AttestOffset != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AttestOffset))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ConfLevel != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ConfLevel))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+func (m *AttestationSignaturesResponse) GetSignedPower() int64 {
+	if m != nil {
+		return m.SignedPower
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *WindowCompareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AttestationSignaturesResponse) GetVotes() []*ValidatorVote {
+	if m != nil {
+		return m.Votes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *WindowCompareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PendingAttestationsRequest struct {
+	ChainId   uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ConfLevel uint32 `protobuf:"varint,2,opt,name=conf_level,json=confLevel,proto3" json:"conf_level,omitempty"`
 }
 
-func (m *WindowCompareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Cmp != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Cmp))
-		i--
-		dAtA[i] = 0x8
+func (m *PendingAttestationsRequest) Reset()         { *m = PendingAttestationsRequest{} }
+func (m *PendingAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingAttestationsRequest) ProtoMessage()    {}
+func (*PendingAttestationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{22}
+}
+func (m *PendingAttestationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAttestationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PendingAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAttestationsRequest.Merge(m, src)
+}
+func (m *PendingAttestationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAttestationsRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_PendingAttestationsRequest proto.InternalMessageInfo
+
+func (m *PendingAttestationsRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *AttestationsFromRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	if m.ConfLevel != 0 {
-		n += 1 + sovQuery(uint64(m.ConfLevel))
-	}
-	if m.FromOffset != 0 {
-		n += 1 + sovQuery(uint64(m.FromOffset))
+
+func (m *PendingAttestationsRequest) GetConfLevel() uint32 {
+	if m != nil {
+		return m.ConfLevel
 	}
-	return n
+	return 0
 }
 
-func (m *AttestationsFromResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Attestations) > 0 {
-		for _, e := range m.Attestations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+// PendingAttestation is a pending attestation with its current voting power versus quorum.
+type PendingAttestation struct {
+	Attestation    *Attestation `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	CreatedHeight  uint64       `protobuf:"varint,2,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	ValidatorSetId uint64       `protobuf:"varint,3,opt,name=validator_set_id,json=validatorSetId,proto3" json:"validator_set_id,omitempty"`
+	SignedPower    int64        `protobuf:"varint,4,opt,name=signed_power,json=signedPower,proto3" json:"signed_power,omitempty"`
+	TotalPower     int64        `protobuf:"varint,5,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	QuorumPower    int64        `protobuf:"varint,6,opt,name=quorum_power,json=quorumPower,proto3" json:"quorum_power,omitempty"`
+}
+
+func (m *PendingAttestation) Reset()         { *m = PendingAttestation{} }
+func (m *PendingAttestation) String() string { return proto.CompactTextString(m) }
+func (*PendingAttestation) ProtoMessage()    {}
+func (*PendingAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{23}
+}
+func (m *PendingAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *PendingAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAttestation.Merge(m, src)
+}
+func (m *PendingAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAttestation.DiscardUnknown(m)
 }
 
-func (m *LatestAttestationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+var xxx_messageInfo_PendingAttestation proto.InternalMessageInfo
+
+func (m *PendingAttestation) GetAttestation() *Attestation {
+	if m != nil {
+		return m.Attestation
 	}
-	if m.ConfLevel != 0 {
-		n += 1 + sovQuery(uint64(m.ConfLevel))
+	return nil
+}
+
+func (m *PendingAttestation) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
 	}
-	return n
+	return 0
 }
 
-func (m *LatestAttestationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingAttestation) GetValidatorSetId() uint64 {
+	if m != nil {
+		return m.ValidatorSetId
 	}
-	var l int
-	_ = l
-	if m.Attestation != nil {
-		l = m.Attestation.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *PendingAttestation) GetSignedPower() int64 {
+	if m != nil {
+		return m.SignedPower
 	}
-	return n
+	return 0
 }
 
-func (m *EarliestAttestationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingAttestation) GetTotalPower() int64 {
+	if m != nil {
+		return m.TotalPower
 	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	return 0
+}
+
+func (m *PendingAttestation) GetQuorumPower() int64 {
+	if m != nil {
+		return m.QuorumPower
 	}
-	if m.ConfLevel != 0 {
-		n += 1 + sovQuery(uint64(m.ConfLevel))
+	return 0
+}
+
+type PendingAttestationsResponse struct {
+	Attestations []*PendingAttestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+}
+
+func (m *PendingAttestationsResponse) Reset()         { *m = PendingAttestationsResponse{} }
+func (m *PendingAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingAttestationsResponse) ProtoMessage()    {}
+func (*PendingAttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{24}
+}
+func (m *PendingAttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAttestationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *PendingAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAttestationsResponse.Merge(m, src)
+}
+func (m *PendingAttestationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAttestationsResponse.DiscardUnknown(m)
 }
 
-func (m *EarliestAttestationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_PendingAttestationsResponse proto.InternalMessageInfo
+
+func (m *PendingAttestationsResponse) GetAttestations() []*PendingAttestation {
+	if m != nil {
+		return m.Attestations
 	}
-	var l int
-	_ = l
-	if m.Attestation != nil {
-		l = m.Attestation.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type VoteStatsRequest struct {
+	ValidatorAddress []byte `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *VoteStatsRequest) Reset()         { *m = VoteStatsRequest{} }
+func (m *VoteStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*VoteStatsRequest) ProtoMessage()    {}
+func (*VoteStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{25}
+}
+func (m *VoteStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *VoteStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteStatsRequest.Merge(m, src)
+}
+func (m *VoteStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteStatsRequest.DiscardUnknown(m)
 }
 
-func (m *ListAllAttestationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_VoteStatsRequest proto.InternalMessageInfo
+
+func (m *VoteStatsRequest) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	return nil
+}
+
+// ValidatorVoteStats is the vote participation of a validator for a chain version
+// over a rolling window of recent consensus blocks (+-1 day).
+// Approved and missed votes are counted when attestations are approved, late votes are counted as discarded.
+type ValidatorVoteStats struct {
+	ValidatorAddress []byte `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	ChainId          uint64 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ConfLevel        uint32 `protobuf:"varint,3,opt,name=conf_level,json=confLevel,proto3" json:"conf_level,omitempty"`
+	Included         uint64 `protobuf:"varint,4,opt,name=included,proto3" json:"included,omitempty"`
+	Approved         uint64 `protobuf:"varint,5,opt,name=approved,proto3" json:"approved,omitempty"`
+	Discarded        uint64 `protobuf:"varint,6,opt,name=discarded,proto3" json:"discarded,omitempty"`
+	Missed           uint64 `protobuf:"varint,7,opt,name=missed,proto3" json:"missed,omitempty"`
+	LastVoteHeight   uint64 `protobuf:"varint,8,opt,name=last_vote_height,json=lastVoteHeight,proto3" json:"last_vote_height,omitempty"`
+}
+
+func (m *ValidatorVoteStats) Reset()         { *m = ValidatorVoteStats{} }
+func (m *ValidatorVoteStats) String() string { return proto.CompactTextString(m) }
+func (*ValidatorVoteStats) ProtoMessage()    {}
+func (*ValidatorVoteStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{26}
+}
+func (m *ValidatorVoteStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorVoteStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorVoteStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ConfLevel != 0 {
-		n += 1 + sovQuery(uint64(m.ConfLevel))
+}
+func (m *ValidatorVoteStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorVoteStats.Merge(m, src)
+}
+func (m *ValidatorVoteStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorVoteStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorVoteStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorVoteStats proto.InternalMessageInfo
+
+func (m *ValidatorVoteStats) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	return nil
+}
+
+func (m *ValidatorVoteStats) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	if m.FromOffset != 0 {
-		n += 1 + sovQuery(uint64(m.FromOffset))
+	return 0
+}
+
+func (m *ValidatorVoteStats) GetConfLevel() uint32 {
+	if m != nil {
+		return m.ConfLevel
 	}
-	return n
+	return 0
 }
 
-func (m *ListAllAttestationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorVoteStats) GetIncluded() uint64 {
+	if m != nil {
+		return m.Included
 	}
-	var l int
-	_ = l
-	if len(m.Attestations) > 0 {
-		for _, e := range m.Attestations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return 0
+}
+
+func (m *ValidatorVoteStats) GetApproved() uint64 {
+	if m != nil {
+		return m.Approved
 	}
-	return n
+	return 0
 }
 
-func (m *WindowCompareRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorVoteStats) GetDiscarded() uint64 {
+	if m != nil {
+		return m.Discarded
 	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	return 0
+}
+
+func (m *ValidatorVoteStats) GetMissed() uint64 {
+	if m != nil {
+		return m.Missed
 	}
-	if m.ConfLevel != 0 {
-		n += 1 + sovQuery(uint64(m.ConfLevel))
+	return 0
+}
+
+func (m *ValidatorVoteStats) GetLastVoteHeight() uint64 {
+	if m != nil {
+		return m.LastVoteHeight
 	}
-	if m.AttestOffset != 0 {
-		n += 1 + sovQuery(uint64(m.AttestOffset))
+	return 0
+}
+
+type VoteStatsResponse struct {
+	Stats []*ValidatorVoteStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (m *VoteStatsResponse) Reset()         { *m = VoteStatsResponse{} }
+func (m *VoteStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*VoteStatsResponse) ProtoMessage()    {}
+func (*VoteStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{27}
+}
+func (m *VoteStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *VoteStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteStatsResponse.Merge(m, src)
+}
+func (m *VoteStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteStatsResponse.DiscardUnknown(m)
 }
 
-func (m *WindowCompareResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_VoteStatsResponse proto.InternalMessageInfo
+
+func (m *VoteStatsResponse) GetStats() []*ValidatorVoteStats {
+	if m != nil {
+		return m.Stats
 	}
-	var l int
-	_ = l
-	if m.Cmp != 0 {
-		n += 1 + sovQuery(uint64(m.Cmp))
+	return nil
+}
+
+type DoubleSignEvidenceRequest struct {
+	ValidatorAddress []byte `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *DoubleSignEvidenceRequest) Reset()         { *m = DoubleSignEvidenceRequest{} }
+func (m *DoubleSignEvidenceRequest) String() string { return proto.CompactTextString(m) }
+func (*DoubleSignEvidenceRequest) ProtoMessage()    {}
+func (*DoubleSignEvidenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{28}
+}
+func (m *DoubleSignEvidenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoubleSignEvidenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoubleSignEvidenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
+func (m *DoubleSignEvidenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleSignEvidenceRequest.Merge(m, src)
+}
+func (m *DoubleSignEvidenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoubleSignEvidenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleSignEvidenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoubleSignEvidenceRequest proto.InternalMessageInfo
+
+func (m *DoubleSignEvidenceRequest) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+// DoubleSignEvidence is evidence of a validator signing two different attestations for the same attest offset.
+type DoubleSignEvidence struct {
+	ValidatorAddress           []byte `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	ChainId                    uint64 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ConfLevel                  uint32 `protobuf:"varint,3,opt,name=conf_level,json=confLevel,proto3" json:"conf_level,omitempty"`
+	AttestOffset               uint64 `protobuf:"varint,4,opt,name=attest_offset,json=attestOffset,proto3" json:"attest_offset,omitempty"`
+	AttestationRoot            []byte `protobuf:"bytes,5,opt,name=attestation_root,json=attestationRoot,proto3" json:"attestation_root,omitempty"`
+	Signature                  []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	ConflictingAttestationRoot []byte `protobuf:"bytes,7,opt,name=conflicting_attestation_root,json=conflictingAttestationRoot,proto3" json:"conflicting_attestation_root,omitempty"`
+	ConflictingSignature       []byte `protobuf:"bytes,8,opt,name=conflicting_signature,json=conflictingSignature,proto3" json:"conflicting_signature,omitempty"`
+	Height                     uint64 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	Penalized                  bool   `protobuf:"varint,10,opt,name=penalized,proto3" json:"penalized,omitempty"`
+}
+
+func (m *DoubleSignEvidence) Reset()         { *m = DoubleSignEvidence{} }
+func (m *DoubleSignEvidence) String() string { return proto.CompactTextString(m) }
+func (*DoubleSignEvidence) ProtoMessage()    {}
+func (*DoubleSignEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{29}
+}
+func (m *DoubleSignEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoubleSignEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoubleSignEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoubleSignEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleSignEvidence.Merge(m, src)
+}
+func (m *DoubleSignEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoubleSignEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleSignEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoubleSignEvidence proto.InternalMessageInfo
+
+func (m *DoubleSignEvidence) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *DoubleSignEvidence) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *DoubleSignEvidence) GetConfLevel() uint32 {
+	if m != nil {
+		return m.ConfLevel
+	}
+	return 0
+}
+
+func (m *DoubleSignEvidence) GetAttestOffset() uint64 {
+	if m != nil {
+		return m.AttestOffset
+	}
+	return 0
+}
+
+func (m *DoubleSignEvidence) GetAttestationRoot() []byte {
+	if m != nil {
+		return m.AttestationRoot
+	}
+	return nil
+}
+
+func (m *DoubleSignEvidence) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *DoubleSignEvidence) GetConflictingAttestationRoot() []byte {
+	if m != nil {
+		return m.ConflictingAttestationRoot
+	}
+	return nil
+}
+
+func (m *DoubleSignEvidence) GetConflictingSignature() []byte {
+	if m != nil {
+		return m.ConflictingSignature
+	}
+	return nil
+}
+
+func (m *DoubleSignEvidence) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DoubleSignEvidence) GetPenalized() bool {
+	if m != nil {
+		return m.Penalized
+	}
+	return false
+}
+
+type DoubleSignEvidenceResponse struct {
+	Evidence []*DoubleSignEvidence `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (m *DoubleSignEvidenceResponse) Reset()         { *m = DoubleSignEvidenceResponse{} }
+func (m *DoubleSignEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*DoubleSignEvidenceResponse) ProtoMessage()    {}
+func (*DoubleSignEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{30}
+}
+func (m *DoubleSignEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoubleSignEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoubleSignEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoubleSignEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleSignEvidenceResponse.Merge(m, src)
+}
+func (m *DoubleSignEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoubleSignEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleSignEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoubleSignEvidenceResponse proto.InternalMessageInfo
+
+func (m *DoubleSignEvidenceResponse) GetEvidence() []*DoubleSignEvidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+type ParamsRequest struct {
+}
+
+func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
+func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*ParamsRequest) ProtoMessage()    {}
+func (*ParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{31}
+}
+func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsRequest.Merge(m, src)
+}
+func (m *ParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsRequest proto.InternalMessageInfo
+
+type ParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
+func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ParamsResponse) ProtoMessage()    {}
+func (*ParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{32}
+}
+func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsResponse.Merge(m, src)
+}
+func (m *ParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
+
+func (m *ParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type LivenessRequest struct {
+	ValidatorAddress []byte `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *LivenessRequest) Reset()         { *m = LivenessRequest{} }
+func (m *LivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*LivenessRequest) ProtoMessage()    {}
+func (*LivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{33}
+}
+func (m *LivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LivenessRequest.Merge(m, src)
+}
+func (m *LivenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LivenessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LivenessRequest proto.InternalMessageInfo
+
+func (m *LivenessRequest) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+type LivenessResponse struct {
+	Window      uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	IndexOffset uint64 `protobuf:"varint,2,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	MissedCount uint64 `protobuf:"varint,3,opt,name=missed_count,json=missedCount,proto3" json:"missed_count,omitempty"`
+}
+
+func (m *LivenessResponse) Reset()         { *m = LivenessResponse{} }
+func (m *LivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*LivenessResponse) ProtoMessage()    {}
+func (*LivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{34}
+}
+func (m *LivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LivenessResponse.Merge(m, src)
+}
+func (m *LivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LivenessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LivenessResponse proto.InternalMessageInfo
+
+func (m *LivenessResponse) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *LivenessResponse) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *LivenessResponse) GetMissedCount() uint64 {
+	if m != nil {
+		return m.MissedCount
+	}
+	return 0
+}
+
+type JailStatusRequest struct {
+	ConsAddress []byte `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+}
+
+func (m *JailStatusRequest) Reset()         { *m = JailStatusRequest{} }
+func (m *JailStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*JailStatusRequest) ProtoMessage()    {}
+func (*JailStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{35}
+}
+func (m *JailStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JailStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JailStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JailStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JailStatusRequest.Merge(m, src)
+}
+func (m *JailStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JailStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JailStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JailStatusRequest proto.InternalMessageInfo
+
+func (m *JailStatusRequest) GetConsAddress() []byte {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return nil
+}
+
+type JailStatusResponse struct {
+	Reason      JailReason `protobuf:"varint,1,opt,name=reason,proto3,enum=halo.attest.types.JailReason" json:"reason,omitempty"`
+	JailedUntil time.Time  `protobuf:"bytes,2,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+	Height      uint64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Tombstoned  bool       `protobuf:"varint,4,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+}
+
+func (m *JailStatusResponse) Reset()         { *m = JailStatusResponse{} }
+func (m *JailStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*JailStatusResponse) ProtoMessage()    {}
+func (*JailStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{36}
+}
+func (m *JailStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JailStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JailStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JailStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JailStatusResponse.Merge(m, src)
+}
+func (m *JailStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JailStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JailStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JailStatusResponse proto.InternalMessageInfo
+
+func (m *JailStatusResponse) GetReason() JailReason {
+	if m != nil {
+		return m.Reason
+	}
+	return JailReason_JAIL_REASON_UNSPECIFIED
+}
+
+func (m *JailStatusResponse) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
+func (m *JailStatusResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *JailStatusResponse) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("halo.attest.types.JailReason", JailReason_name, JailReason_value)
+	proto.RegisterType((*AttestationsFromRequest)(nil), "halo.attest.types.AttestationsFromRequest")
+	proto.RegisterType((*AttestationsFromResponse)(nil), "halo.attest.types.AttestationsFromResponse")
+	proto.RegisterType((*LatestAttestationRequest)(nil), "halo.attest.types.LatestAttestationRequest")
+	proto.RegisterType((*LatestAttestationResponse)(nil), "halo.attest.types.LatestAttestationResponse")
+	proto.RegisterType((*EarliestAttestationRequest)(nil), "halo.attest.types.EarliestAttestationRequest")
+	proto.RegisterType((*EarliestAttestationResponse)(nil), "halo.attest.types.EarliestAttestationResponse")
+	proto.RegisterType((*ListAllAttestationsRequest)(nil), "halo.attest.types.ListAllAttestationsRequest")
+	proto.RegisterType((*ListAllAttestationsResponse)(nil), "halo.attest.types.ListAllAttestationsResponse")
+	proto.RegisterType((*WindowCompareRequest)(nil), "halo.attest.types.WindowCompareRequest")
+	proto.RegisterType((*WindowCompareResponse)(nil), "halo.attest.types.WindowCompareResponse")
+	proto.RegisterType((*OffsetHeightRequest)(nil), "halo.attest.types.OffsetHeightRequest")
+	proto.RegisterType((*OffsetHeightResponse)(nil), "halo.attest.types.OffsetHeightResponse")
+	proto.RegisterType((*AttestationInfo)(nil), "halo.attest.types.AttestationInfo")
+	proto.RegisterType((*AttestationByRootRequest)(nil), "halo.attest.types.AttestationByRootRequest")
+	proto.RegisterType((*AttestationByRootResponse)(nil), "halo.attest.types.AttestationByRootResponse")
+	proto.RegisterType((*AttestationsByBlockRequest)(nil), "halo.attest.types.AttestationsByBlockRequest")
+	proto.RegisterType((*AttestationsByBlockResponse)(nil), "halo.attest.types.AttestationsByBlockResponse")
+	proto.RegisterType((*AttestationsRequest)(nil), "halo.attest.types.AttestationsRequest")
+	proto.RegisterType((*AttestationsResponse)(nil), "halo.attest.types.AttestationsResponse")
+	proto.RegisterType((*AttestationSignaturesRequest)(nil), "halo.attest.types.AttestationSignaturesRequest")
+	proto.RegisterType((*ValidatorVote)(nil), "halo.attest.types.ValidatorVote")
+	proto.RegisterType((*AttestationSignaturesResponse)(nil), "halo.attest.types.AttestationSignaturesResponse")
+	proto.RegisterType((*PendingAttestationsRequest)(nil), "halo.attest.types.PendingAttestationsRequest")
+	proto.RegisterType((*PendingAttestation)(nil), "halo.attest.types.PendingAttestation")
+	proto.RegisterType((*PendingAttestationsResponse)(nil), "halo.attest.types.PendingAttestationsResponse")
+	proto.RegisterType((*VoteStatsRequest)(nil), "halo.attest.types.VoteStatsRequest")
+	proto.RegisterType((*ValidatorVoteStats)(nil), "halo.attest.types.ValidatorVoteStats")
+	proto.RegisterType((*VoteStatsResponse)(nil), "halo.attest.types.VoteStatsResponse")
+	proto.RegisterType((*DoubleSignEvidenceRequest)(nil), "halo.attest.types.DoubleSignEvidenceRequest")
+	proto.RegisterType((*DoubleSignEvidence)(nil), "halo.attest.types.DoubleSignEvidence")
+	proto.RegisterType((*DoubleSignEvidenceResponse)(nil), "halo.attest.types.DoubleSignEvidenceResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "halo.attest.types.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "halo.attest.types.ParamsResponse")
+	proto.RegisterType((*LivenessRequest)(nil), "halo.attest.types.LivenessRequest")
+	proto.RegisterType((*LivenessResponse)(nil), "halo.attest.types.LivenessResponse")
+	proto.RegisterType((*JailStatusRequest)(nil), "halo.attest.types.JailStatusRequest")
+	proto.RegisterType((*JailStatusResponse)(nil), "halo.attest.types.JailStatusResponse")
+}
+
+func init() { proto.RegisterFile("halo/attest/types/query.proto", fileDescriptor_93d3f1745081aabb) }
+
+var fileDescriptor_93d3f1745081aabb = []byte{
+	// 2019 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xdd, 0x6f, 0x1b, 0x59,
+	0x15, 0xcf, 0xd8, 0x89, 0x37, 0x39, 0x71, 0x5a, 0xe7, 0x26, 0xed, 0x3a, 0x93, 0xc4, 0x49, 0x26,
+	0x1f, 0x4d, 0xdb, 0xd4, 0xa6, 0x5d, 0xb1, 0x3c, 0x20, 0xc1, 0x26, 0xa9, 0xdb, 0xf5, 0x2a, 0xa4,
+	0x61, 0x9c, 0x76, 0x25, 0x24, 0x18, 0xdd, 0x78, 0x6e, 0x9c, 0x81, 0xf1, 0x5c, 0xd7, 0x33, 0x4e,
+	0x9b, 0x7d, 0xe0, 0x01, 0x09, 0x1e, 0x40, 0x82, 0x15, 0x2b, 0xad, 0x10, 0x48, 0x20, 0xc1, 0x03,
+	0x12, 0xff, 0x01, 0xe2, 0x19, 0x69, 0x1f, 0x78, 0x58, 0x89, 0x17, 0x9e, 0x00, 0xb5, 0xfc, 0x21,
+	0xe8, 0x7e, 0x8c, 0x3d, 0x1f, 0x37, 0x8e, 0xb7, 0x89, 0xfa, 0xe6, 0x7b, 0xee, 0xf9, 0xf8, 0xdd,
+	0x73, 0xce, 0x3d, 0x73, 0xee, 0x31, 0x2c, 0x9e, 0x60, 0x97, 0x56, 0x70, 0x10, 0x10, 0x3f, 0xa8,
+	0x04, 0x67, 0x6d, 0xe2, 0x57, 0x9e, 0x77, 0x49, 0xe7, 0xac, 0xdc, 0xee, 0xd0, 0x80, 0xa2, 0x69,
+	0xb6, 0x5d, 0x16, 0xdb, 0x65, 0xbe, 0xad, 0x2f, 0x34, 0x29, 0x6d, 0xba, 0xa4, 0x82, 0xdb, 0x4e,
+	0x05, 0x7b, 0x1e, 0x0d, 0x70, 0xe0, 0x50, 0xcf, 0x17, 0x02, 0xfa, 0x9d, 0x06, 0xf5, 0x5b, 0xd4,
+	0xaf, 0x1c, 0x61, 0x9f, 0x08, 0x4d, 0x95, 0xd3, 0xfb, 0x47, 0x24, 0xc0, 0xf7, 0x2b, 0x6d, 0xdc,
+	0x74, 0x3c, 0xce, 0x2c, 0x79, 0xf5, 0xb4, 0xed, 0xe0, 0xa5, 0xdc, 0x5b, 0x4a, 0xef, 0x35, 0x89,
+	0x47, 0x7c, 0x27, 0x34, 0x34, 0xdb, 0xa4, 0x4d, 0xca, 0x7f, 0x56, 0xd8, 0xaf, 0x50, 0x4c, 0x82,
+	0xe3, 0xab, 0xa3, 0xee, 0x71, 0x25, 0x70, 0x5a, 0xc4, 0x0f, 0x70, 0xab, 0x2d, 0x18, 0x8c, 0x00,
+	0xde, 0xdd, 0xe6, 0x4a, 0x05, 0xea, 0x47, 0x1d, 0xda, 0x32, 0xc9, 0xf3, 0x2e, 0xf1, 0x03, 0x34,
+	0x07, 0xe3, 0x8d, 0x13, 0xec, 0x78, 0x96, 0x63, 0x17, 0xb5, 0x65, 0x6d, 0x73, 0xd4, 0x7c, 0x87,
+	0xaf, 0x6b, 0x36, 0x5a, 0x04, 0x68, 0x50, 0xef, 0xd8, 0x72, 0xc9, 0x29, 0x71, 0x8b, 0x99, 0x65,
+	0x6d, 0x73, 0xca, 0x9c, 0x60, 0x94, 0x3d, 0x46, 0x40, 0x4b, 0x30, 0x79, 0xdc, 0xa1, 0x2d, 0x8b,
+	0x1e, 0x1f, 0xfb, 0x24, 0x28, 0x66, 0xb9, 0x30, 0x30, 0xd2, 0x13, 0x4e, 0x31, 0x7e, 0x00, 0xc5,
+	0xb4, 0x55, 0xbf, 0x4d, 0x3d, 0x9f, 0xa0, 0x1d, 0xc8, 0xe3, 0xc8, 0x5e, 0x51, 0x5b, 0xce, 0x6e,
+	0x4e, 0x3e, 0x28, 0x95, 0x53, 0x9e, 0x2f, 0x47, 0x54, 0x98, 0x31, 0x19, 0xe3, 0x10, 0x8a, 0x7b,
+	0x98, 0xad, 0xa3, 0x2c, 0x97, 0x3d, 0x96, 0xf1, 0x7d, 0x98, 0x53, 0x68, 0x95, 0xb0, 0x3f, 0x80,
+	0xc9, 0x08, 0x04, 0xae, 0xf9, 0x62, 0xd4, 0x51, 0x11, 0xe3, 0x19, 0xe8, 0x55, 0xdc, 0x71, 0x9d,
+	0xab, 0x86, 0x6d, 0xc1, 0xbc, 0x52, 0xef, 0x95, 0x01, 0xff, 0x95, 0x06, 0xfa, 0x9e, 0xe3, 0x07,
+	0xdb, 0xae, 0x1b, 0x8d, 0xea, 0xe5, 0xf3, 0xe8, 0x26, 0xe4, 0x98, 0xb2, 0xae, 0xcf, 0x53, 0x68,
+	0xca, 0x94, 0xab, 0x64, 0x7e, 0x8d, 0xa6, 0xf2, 0x0b, 0xc3, 0xbc, 0x12, 0xd0, 0x15, 0xa6, 0x58,
+	0x17, 0x66, 0x3f, 0x76, 0x3c, 0x9b, 0xbe, 0xd8, 0xa5, 0xad, 0x36, 0xee, 0x90, 0xcb, 0x9f, 0x76,
+	0x15, 0xa6, 0x84, 0x85, 0xf8, 0xbd, 0x91, 0x66, 0xe5, 0xc9, 0x6e, 0xc3, 0x8d, 0x84, 0x59, 0x79,
+	0xa6, 0x02, 0x64, 0x1b, 0xad, 0x36, 0x37, 0x39, 0x66, 0xb2, 0x9f, 0x46, 0x00, 0x33, 0x42, 0xe8,
+	0x43, 0xe2, 0x34, 0x4f, 0x82, 0xb7, 0x04, 0xb0, 0x0c, 0xb3, 0x71, 0xab, 0x12, 0xdf, 0x4d, 0xc8,
+	0x9d, 0x70, 0x8a, 0x34, 0x2a, 0x57, 0xc6, 0xaf, 0x35, 0xb8, 0x1e, 0xf1, 0x72, 0xcd, 0x3b, 0xa6,
+	0x97, 0x4f, 0xc9, 0x48, 0xe6, 0x64, 0x62, 0x99, 0xb3, 0x0e, 0xd7, 0x1a, 0x1d, 0x82, 0x03, 0x62,
+	0x5b, 0x12, 0x8d, 0x38, 0xc3, 0x94, 0xa4, 0x0a, 0xd0, 0x46, 0x35, 0x56, 0x9f, 0x76, 0xce, 0x4c,
+	0x4a, 0x7b, 0xfe, 0xbb, 0x0d, 0x85, 0x88, 0x25, 0xab, 0x43, 0xa9, 0x38, 0x52, 0xde, 0xbc, 0x1e,
+	0xa1, 0x33, 0x09, 0x03, 0xc3, 0x9c, 0x42, 0x8d, 0x74, 0xc8, 0x43, 0xd5, 0x21, 0x8d, 0xc1, 0x87,
+	0x64, 0xde, 0x89, 0xdf, 0xbd, 0xdf, 0x68, 0xa0, 0x47, 0x18, 0xfc, 0x9d, 0xb3, 0x1d, 0x97, 0x36,
+	0x7e, 0x74, 0xf9, 0x60, 0xaf, 0x40, 0xfe, 0x88, 0x69, 0x8a, 0xfb, 0x69, 0x92, 0xd3, 0x84, 0x97,
+	0x98, 0x06, 0xc9, 0x82, 0xfd, 0x13, 0x7e, 0x0b, 0xf3, 0xe6, 0x84, 0x60, 0xc0, 0xfe, 0x89, 0x41,
+	0x60, 0x5e, 0x89, 0x4c, 0x9e, 0xff, 0x91, 0xf2, 0x12, 0x0e, 0xe3, 0x80, 0xf8, 0x45, 0xfc, 0x45,
+	0x06, 0x66, 0xde, 0x4e, 0xd9, 0xd9, 0x02, 0xd4, 0x72, 0x3c, 0x2b, 0x91, 0x40, 0xa2, 0xfa, 0x14,
+	0x5a, 0x8e, 0xb7, 0x1b, 0xcd, 0x21, 0xce, 0x8d, 0x5f, 0x26, 0xb9, 0xc7, 0x24, 0x37, 0x7e, 0x19,
+	0xe7, 0x7e, 0x04, 0xd0, 0xef, 0x07, 0x8a, 0x39, 0x9e, 0x0c, 0x1b, 0x65, 0xd1, 0x3c, 0x94, 0x59,
+	0xf3, 0x50, 0x16, 0x6d, 0x88, 0x6c, 0x1e, 0xca, 0x07, 0xb8, 0x19, 0x96, 0x1c, 0x33, 0x22, 0x69,
+	0xfc, 0x59, 0x83, 0x59, 0x65, 0xcd, 0xbb, 0x22, 0x77, 0xa3, 0xc7, 0x31, 0xa0, 0x19, 0x0e, 0xf4,
+	0xd6, 0x85, 0x40, 0x05, 0x88, 0x18, 0xd2, 0x1a, 0x2c, 0x44, 0x2c, 0xd5, 0x9d, 0xa6, 0x87, 0x83,
+	0x6e, 0x87, 0xf8, 0x6f, 0x70, 0xcf, 0xda, 0x30, 0xf5, 0x0c, 0xbb, 0x8e, 0x8d, 0x03, 0xda, 0x79,
+	0x46, 0x03, 0x82, 0xee, 0xc2, 0xf4, 0x69, 0x48, 0xb0, 0xb0, 0x6d, 0x77, 0x88, 0xef, 0x4b, 0xe1,
+	0x42, 0x6f, 0x63, 0x5b, 0xd0, 0xd1, 0x2c, 0x8c, 0xb5, 0xe9, 0x0b, 0xd2, 0xe1, 0x87, 0xc9, 0x9a,
+	0x62, 0x81, 0x16, 0x60, 0xc2, 0x0f, 0x31, 0xf1, 0x3c, 0xc8, 0x9b, 0x7d, 0x82, 0xf1, 0x77, 0x0d,
+	0x16, 0xcf, 0x41, 0x2f, 0xfd, 0xbd, 0x09, 0x7d, 0x4b, 0x96, 0x4f, 0x82, 0x7e, 0x1a, 0x5e, 0xeb,
+	0xd1, 0xeb, 0x24, 0xa8, 0xd9, 0xec, 0x6b, 0x16, 0xd0, 0x00, 0xbb, 0x56, 0x14, 0x05, 0x70, 0xd2,
+	0x01, 0x87, 0xb2, 0x02, 0x79, 0x66, 0x99, 0xd8, 0x92, 0x23, 0xcb, 0x39, 0x26, 0x05, 0x4d, 0xb0,
+	0xbc, 0x0f, 0x63, 0xa7, 0x34, 0x20, 0x7e, 0x71, 0x94, 0x87, 0x75, 0x59, 0x11, 0xd6, 0x98, 0x87,
+	0x4c, 0xc1, 0xce, 0x7a, 0x8e, 0x03, 0xe2, 0xd9, 0x8e, 0xd7, 0xbc, 0xd2, 0x2b, 0x64, 0xfc, 0x32,
+	0x03, 0x28, 0xad, 0xf8, 0x0a, 0x0a, 0x7b, 0xba, 0x80, 0x67, 0x14, 0x05, 0x5c, 0xe9, 0xfd, 0xac,
+	0xd2, 0xfb, 0x49, 0xe7, 0x8e, 0xa6, 0x9d, 0x9b, 0x08, 0xd0, 0x98, 0x2a, 0x40, 0xcf, 0xbb, 0xb4,
+	0xd3, 0x6d, 0x49, 0x8e, 0x9c, 0xd0, 0x21, 0x68, 0x9c, 0xc5, 0x38, 0x81, 0x79, 0xa5, 0xa3, 0x65,
+	0xb6, 0xd4, 0x94, 0xb7, 0x73, 0x5d, 0xe1, 0x99, 0xb4, 0x96, 0x44, 0x3d, 0xfc, 0x36, 0x14, 0x58,
+	0x84, 0xeb, 0x01, 0x0e, 0x7a, 0x81, 0xfc, 0x2a, 0xf7, 0xc1, 0xf8, 0x2c, 0x03, 0x28, 0x96, 0x2c,
+	0x5c, 0xd5, 0x57, 0xbb, 0x53, 0xd1, 0xcc, 0xc9, 0x0c, 0xca, 0x9c, 0x6c, 0xb2, 0xf8, 0xea, 0x30,
+	0xee, 0x78, 0x0d, 0xb7, 0x6b, 0x13, 0x5b, 0x96, 0xd6, 0xde, 0x9a, 0xed, 0xe1, 0x76, 0xbb, 0x43,
+	0x4f, 0x89, 0x2d, 0x0b, 0x69, 0x6f, 0xcd, 0xee, 0xab, 0xed, 0xf8, 0x0d, 0xdc, 0x61, 0x82, 0x39,
+	0xbe, 0xd9, 0x27, 0xb0, 0x92, 0xde, 0x72, 0x7c, 0x9f, 0xd8, 0xc5, 0x77, 0x44, 0xf7, 0x21, 0x56,
+	0x2c, 0x4f, 0x5c, 0xec, 0x07, 0x16, 0xbb, 0x0d, 0x61, 0x42, 0x8d, 0x8b, 0x3c, 0x61, 0x74, 0x76,
+	0x7a, 0xd9, 0x12, 0x1c, 0xc0, 0x74, 0xc4, 0xad, 0x32, 0x6c, 0xdf, 0x84, 0x31, 0x9f, 0x11, 0x06,
+	0xc4, 0x2b, 0xed, 0x49, 0x53, 0xc8, 0x18, 0x1f, 0xc2, 0xdc, 0x43, 0xda, 0x3d, 0x72, 0x09, 0xab,
+	0x1e, 0xd5, 0x53, 0xc7, 0x26, 0x5e, 0x83, 0xbc, 0x51, 0xc4, 0x3e, 0xcf, 0x02, 0x4a, 0xab, 0x7a,
+	0x5b, 0x11, 0x4b, 0xb5, 0x85, 0xa3, 0xe9, 0xb6, 0x50, 0x59, 0xcd, 0xc7, 0x94, 0xd5, 0x3c, 0x5e,
+	0x79, 0x73, 0x89, 0xca, 0x8b, 0x3e, 0x80, 0x05, 0x66, 0xda, 0x75, 0x1a, 0x81, 0xe3, 0x35, 0xad,
+	0x94, 0xd2, 0x77, 0xb8, 0x80, 0x1e, 0xe1, 0xd9, 0x4e, 0xe8, 0x7f, 0x0f, 0x6e, 0x44, 0x35, 0xf4,
+	0x6d, 0x8d, 0x73, 0xd1, 0xd9, 0xc8, 0x66, 0xaf, 0xae, 0x47, 0xda, 0xd7, 0x89, 0x68, 0xfb, 0xca,
+	0xc0, 0xb6, 0x89, 0x87, 0x5d, 0xe7, 0x13, 0x62, 0x17, 0x61, 0x59, 0xdb, 0x1c, 0x37, 0xfb, 0x04,
+	0xc3, 0x02, 0x5d, 0x15, 0x62, 0x99, 0x3d, 0xdb, 0x30, 0x4e, 0x24, 0x6d, 0x40, 0x02, 0x29, 0x14,
+	0xf4, 0xc4, 0x8c, 0xeb, 0x30, 0x75, 0x80, 0x3b, 0xb8, 0x15, 0xde, 0x74, 0xa3, 0x06, 0xd7, 0x42,
+	0x82, 0xb4, 0xf2, 0x0d, 0xc8, 0xb5, 0x39, 0x45, 0x96, 0xdb, 0x39, 0x55, 0x51, 0xe1, 0x0c, 0x3b,
+	0xa3, 0x5f, 0xfc, 0x7b, 0x69, 0xc4, 0x94, 0xec, 0xc6, 0xb7, 0xe0, 0xfa, 0x9e, 0x73, 0x4a, 0x3c,
+	0xe2, 0xbf, 0x59, 0x1d, 0x69, 0x43, 0xa1, 0x2f, 0xdf, 0x7f, 0x05, 0xbc, 0xe0, 0xcf, 0x97, 0xf0,
+	0x15, 0x20, 0x56, 0xac, 0x82, 0x3a, 0x9e, 0x4d, 0x5e, 0x86, 0x29, 0x24, 0x32, 0x70, 0x92, 0xd3,
+	0x64, 0x06, 0xad, 0x40, 0x5e, 0x5c, 0x5a, 0xab, 0x41, 0xbb, 0x5e, 0xaf, 0x21, 0x15, 0xb4, 0x5d,
+	0x46, 0x32, 0xde, 0x87, 0xe9, 0x8f, 0xb0, 0xe3, 0xd6, 0x79, 0xbb, 0x16, 0x62, 0x5e, 0x81, 0x7c,
+	0x83, 0x7a, 0x7e, 0x02, 0xee, 0x24, 0xa3, 0x85, 0x48, 0xff, 0xa1, 0x01, 0x8a, 0x0a, 0x4a, 0xb0,
+	0x5f, 0x87, 0x5c, 0x87, 0x60, 0x5f, 0x7e, 0xa8, 0xae, 0x3d, 0x58, 0x54, 0x78, 0x8e, 0x89, 0x99,
+	0x9c, 0xc9, 0x94, 0xcc, 0xe8, 0x31, 0xe4, 0x7f, 0x88, 0x1d, 0x97, 0xd8, 0x56, 0xd7, 0x0b, 0x1c,
+	0x57, 0xf6, 0x48, 0x7a, 0x59, 0x8c, 0x62, 0xca, 0xe1, 0x28, 0xa6, 0x7c, 0x18, 0x8e, 0x62, 0x76,
+	0xc6, 0x99, 0xdf, 0x3f, 0xfd, 0xcf, 0x92, 0x66, 0x4e, 0x0a, 0xc9, 0xa7, 0x4c, 0x30, 0x92, 0x73,
+	0xd9, 0x58, 0xce, 0x95, 0x00, 0x02, 0xda, 0x3a, 0xf2, 0x03, 0xea, 0xc9, 0x22, 0x39, 0x6e, 0x46,
+	0x28, 0x77, 0xfe, 0xaa, 0x01, 0xf4, 0x71, 0xa1, 0x79, 0x78, 0xf7, 0xa3, 0xed, 0xda, 0x9e, 0x65,
+	0x56, 0xb7, 0xeb, 0x4f, 0xf6, 0xad, 0xa7, 0xfb, 0xf5, 0x83, 0xea, 0x6e, 0xed, 0x51, 0xad, 0xfa,
+	0xb0, 0x30, 0x82, 0x0c, 0x28, 0x45, 0x37, 0x77, 0x9f, 0xec, 0xd7, 0xab, 0xfb, 0xf5, 0xa7, 0x75,
+	0xeb, 0xe1, 0x93, 0x8f, 0xf7, 0x0f, 0x6b, 0xdf, 0xa9, 0x16, 0x34, 0xb4, 0x0e, 0x2b, 0xe7, 0xf1,
+	0x3c, 0xdd, 0xd9, 0xab, 0x5a, 0xf5, 0xda, 0xe3, 0xfd, 0x42, 0x06, 0x2d, 0xc1, 0x7c, 0x94, 0x6d,
+	0xfb, 0xf0, 0xb0, 0x5a, 0x3f, 0xec, 0xeb, 0xc9, 0x26, 0x6d, 0xf5, 0x18, 0xfa, 0x4a, 0x46, 0x1f,
+	0xfc, 0x0d, 0xc1, 0xd8, 0x77, 0x59, 0x03, 0x89, 0x3e, 0xd7, 0xa0, 0x90, 0x1c, 0x12, 0xa1, 0x3b,
+	0x83, 0x7b, 0x85, 0xe8, 0xfc, 0x4a, 0xbf, 0x3b, 0x14, 0xaf, 0x88, 0xb5, 0x71, 0xfb, 0x27, 0xff,
+	0xfc, 0xdf, 0x67, 0x99, 0x55, 0xb4, 0x52, 0x89, 0x0e, 0xda, 0x4e, 0xef, 0x57, 0xa2, 0xdf, 0x56,
+	0x8b, 0x8d, 0x18, 0xd0, 0x6f, 0x35, 0x98, 0x4e, 0xcd, 0x81, 0x90, 0xca, 0xda, 0x79, 0x33, 0x28,
+	0x7d, 0x6b, 0x38, 0x66, 0x89, 0xed, 0x0e, 0xc7, 0xb6, 0x86, 0x8c, 0x24, 0x36, 0x97, 0x8b, 0x44,
+	0x6b, 0x20, 0xfa, 0xa3, 0x06, 0x33, 0x8a, 0x69, 0x0f, 0xba, 0xa7, 0xb0, 0x78, 0xfe, 0xb4, 0x49,
+	0x2f, 0x0f, 0xcb, 0x2e, 0x21, 0x6e, 0x71, 0x88, 0x1b, 0x68, 0x2d, 0x09, 0x91, 0x48, 0xa1, 0x18,
+	0xc8, 0xdf, 0x6b, 0x30, 0xa3, 0x98, 0xcf, 0x28, 0x41, 0x9e, 0x3f, 0x58, 0xd2, 0xcb, 0xc3, 0xb2,
+	0x4b, 0x90, 0x9b, 0x1c, 0xa4, 0x81, 0x96, 0x53, 0x31, 0x76, 0x5d, 0x2b, 0xf6, 0xc8, 0xf9, 0xb9,
+	0x06, 0x53, 0xb1, 0x31, 0x0b, 0xba, 0xa5, 0xb0, 0xa5, 0x9a, 0xff, 0xe8, 0x9b, 0x17, 0x33, 0x4a,
+	0x38, 0x1b, 0x1c, 0xce, 0x32, 0x2a, 0x25, 0xe1, 0x88, 0x9a, 0x68, 0x35, 0xa4, 0xe9, 0x9f, 0x69,
+	0x90, 0x8f, 0x8e, 0x54, 0xd0, 0x86, 0xc2, 0x84, 0x62, 0xd2, 0xa3, 0xdf, 0xba, 0x90, 0x4f, 0x22,
+	0x59, 0xe7, 0x48, 0x96, 0xd0, 0x62, 0x12, 0x89, 0xa8, 0xc6, 0xb2, 0x31, 0x42, 0xbf, 0xd3, 0x60,
+	0x3a, 0x35, 0xcf, 0x40, 0x17, 0x5c, 0xb3, 0xd8, 0xf0, 0x44, 0xdf, 0x1a, 0x8e, 0x59, 0xe2, 0xba,
+	0xcb, 0x71, 0xad, 0xa3, 0xd5, 0x01, 0x97, 0xd2, 0x3a, 0x3a, 0xe3, 0x1f, 0x7e, 0xf4, 0x27, 0x0d,
+	0x66, 0x14, 0xf3, 0x06, 0x65, 0x52, 0x9d, 0x3f, 0x31, 0xd1, 0xcb, 0xc3, 0xb2, 0x4b, 0x8c, 0xf7,
+	0x38, 0xc6, 0x5b, 0x68, 0x7d, 0x60, 0xe1, 0x38, 0x3a, 0xb3, 0xf8, 0x60, 0x04, 0xfd, 0x54, 0x83,
+	0x7c, 0x2c, 0xe7, 0x37, 0x2e, 0xb0, 0x37, 0x28, 0x98, 0xca, 0x2c, 0x5f, 0xe3, 0x80, 0x4a, 0x68,
+	0x61, 0x10, 0x20, 0xf4, 0x17, 0x0d, 0x6e, 0x28, 0x1f, 0xb0, 0xa8, 0x32, 0xd8, 0x50, 0xea, 0xa1,
+	0xae, 0x7f, 0x6d, 0x78, 0x01, 0x09, 0xb1, 0xcc, 0x21, 0x6e, 0xa2, 0x8d, 0x41, 0x71, 0xf5, 0xfb,
+	0x90, 0x58, 0x51, 0x53, 0xbc, 0x9e, 0x94, 0xa1, 0x3d, 0xff, 0x39, 0xab, 0x97, 0x87, 0x65, 0xbf,
+	0xa8, 0xa8, 0xb5, 0x85, 0x50, 0xbc, 0x66, 0x7c, 0x02, 0x13, 0xfd, 0xc7, 0xd2, 0xaa, 0xea, 0x25,
+	0x90, 0x78, 0x95, 0xe9, 0x6b, 0x83, 0x99, 0x24, 0x0a, 0x83, 0xa3, 0x58, 0x40, 0x7a, 0x12, 0x05,
+	0x7f, 0xb3, 0xf8, 0xdc, 0xdc, 0x1f, 0x34, 0xe5, 0x03, 0x60, 0x6b, 0xb8, 0x76, 0x52, 0xc2, 0xb9,
+	0x37, 0x24, 0xf7, 0x45, 0xde, 0xb1, 0xb9, 0x0c, 0x8f, 0x9f, 0x15, 0x36, 0xaa, 0xa8, 0x05, 0x39,
+	0xd1, 0x64, 0xa2, 0xe5, 0x73, 0xfb, 0xcf, 0x10, 0xc8, 0xca, 0x00, 0x0e, 0x69, 0xbc, 0xc4, 0x8d,
+	0x17, 0xd1, 0xcd, 0x54, 0x68, 0x84, 0x91, 0x2e, 0x8c, 0x87, 0xbd, 0x27, 0x32, 0x94, 0x9f, 0x89,
+	0x58, 0x63, 0xab, 0xaf, 0x0e, 0xe4, 0x91, 0x46, 0x97, 0xb9, 0x51, 0x1d, 0x15, 0x53, 0xdf, 0xe1,
+	0xd0, 0xd4, 0x8f, 0x45, 0xe3, 0x25, 0xfa, 0x48, 0xb4, 0x76, 0x4e, 0xbf, 0x18, 0xeb, 0x4f, 0xf5,
+	0xf5, 0x0b, 0xb8, 0xa4, 0xf1, 0x55, 0x6e, 0x7c, 0x11, 0xcd, 0x27, 0x8d, 0xb3, 0x8e, 0xd1, 0x12,
+	0x13, 0xca, 0x9d, 0xbb, 0x5f, 0xbc, 0x2a, 0x69, 0x5f, 0xbe, 0x2a, 0x69, 0xff, 0x7d, 0x55, 0xd2,
+	0x3e, 0x7d, 0x5d, 0x1a, 0xf9, 0xf2, 0x75, 0x69, 0xe4, 0x5f, 0xaf, 0x4b, 0x23, 0xdf, 0x9b, 0x4e,
+	0xfd, 0x7f, 0x78, 0x94, 0xe3, 0x9d, 0xe8, 0x7b, 0xff, 0x1f, 0x00, 0x3d, 0x19, 0x3b, 0xc1, 0xf3,
+	0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// AttestationsFrom queries halo for approved attestations for the given chain_id
+	// and from the given height (inclusive). The response will contain at most max attestations sequentially
+	// following from_height.
+	AttestationsFrom(ctx context.Context, in *AttestationsFromRequest, opts ...grpc.CallOption) (*AttestationsFromResponse, error)
+	// LatestAttestation queries halo for the latest approved attestations for the given chain_id.
+	LatestAttestation(ctx context.Context, in *LatestAttestationRequest, opts ...grpc.CallOption) (*LatestAttestationResponse, error)
+	// EarliestAttestation queries halo for the earliest approved attestation in consensus chain state for the given chain_id.
+	EarliestAttestation(ctx context.Context, in *EarliestAttestationRequest, opts ...grpc.CallOption) (*EarliestAttestationResponse, error)
+	// ListAllAttestations queries halo for all approved attestations in consensus chain state for the given chain_id.
+	ListAllAttestations(ctx context.Context, in *ListAllAttestationsRequest, opts ...grpc.CallOption) (*ListAllAttestationsResponse, error)
+	// WindowCompare compares the given chain height to the vote window.
+	// It returns whether the request is behind (-1), or in (0), or after (1) the vote window.
+	// The vote window is a configured number of blocks around the latest approved attestation.
+	WindowCompare(ctx context.Context, in *WindowCompareRequest, opts ...grpc.CallOption) (*WindowCompareResponse, error)
+	// OffsetHeight queries halo for a consensus height at which the approved attestation with the
+	// given chain_id and attest_offset is available in consensus chain state.
+	OffsetHeight(ctx context.Context, in *OffsetHeightRequest, opts ...grpc.CallOption) (*OffsetHeightResponse, error)
+	// AttestationByRoot queries halo for the pending or approved attestation with the given attestation root.
+	AttestationByRoot(ctx context.Context, in *AttestationByRootRequest, opts ...grpc.CallOption) (*AttestationByRootResponse, error)
+	// AttestationsByBlock queries halo for all pending or approved attestations of the given source chain block.
+	// Multiple attestations are possible if validators voted for different blocks at the same height.
+	AttestationsByBlock(ctx context.Context, in *AttestationsByBlockRequest, opts ...grpc.CallOption) (*AttestationsByBlockResponse, error)
+	// Attestations queries halo for a paginated list of attestations in consensus chain state,
+	// optionally filtered by chain version, status and created height range.
+	// Results are ordered by created height, or by attest offset or source block height if filtered by chain version.
+	// Queries scanning too many attestations (including offset pagination and count total) are rejected.
+	Attestations(ctx context.Context, in *AttestationsRequest, opts ...grpc.CallOption) (*AttestationsResponse, error)
+	// AttestationSignatures queries halo for the signatures of the attestation with the given attestation root
+	// broken down by validator of the relevant validator set.
+	AttestationSignatures(ctx context.Context, in *AttestationSignaturesRequest, opts ...grpc.CallOption) (*AttestationSignaturesResponse, error)
+	// PendingAttestations queries halo for the pending attestations for the given chain_id
+	// with their current voting power versus the quorum required for approval.
+	PendingAttestations(ctx context.Context, in *PendingAttestationsRequest, opts ...grpc.CallOption) (*PendingAttestationsResponse, error)
+	// VoteStats queries halo for the vote participation stats per chain version of the given validator,
+	// or of all validators if validator_address is empty.
+	VoteStats(ctx context.Context, in *VoteStatsRequest, opts ...grpc.CallOption) (*VoteStatsResponse, error)
+	// DoubleSignEvidence queries halo for double sign evidence of the given validator,
+	// or of all validators if validator_address is empty.
+	DoubleSignEvidence(ctx context.Context, in *DoubleSignEvidenceRequest, opts ...grpc.CallOption) (*DoubleSignEvidenceResponse, error)
+	// Params queries the attest module parameters.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// Liveness queries the attestation liveness of the given validator.
+	Liveness(ctx context.Context, in *LivenessRequest, opts ...grpc.CallOption) (*LivenessResponse, error)
+	// JailStatus queries the reason and duration of the latest jailing of the given validator.
+	JailStatus(ctx context.Context, in *JailStatusRequest, opts ...grpc.CallOption) (*JailStatusResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) AttestationsFrom(ctx context.Context, in *AttestationsFromRequest, opts ...grpc.CallOption) (*AttestationsFromResponse, error) {
+	out := new(AttestationsFromResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/AttestationsFrom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestAttestation(ctx context.Context, in *LatestAttestationRequest, opts ...grpc.CallOption) (*LatestAttestationResponse, error) {
+	out := new(LatestAttestationResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/LatestAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EarliestAttestation(ctx context.Context, in *EarliestAttestationRequest, opts ...grpc.CallOption) (*EarliestAttestationResponse, error) {
+	out := new(EarliestAttestationResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/EarliestAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListAllAttestations(ctx context.Context, in *ListAllAttestationsRequest, opts ...grpc.CallOption) (*ListAllAttestationsResponse, error) {
+	out := new(ListAllAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/ListAllAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WindowCompare(ctx context.Context, in *WindowCompareRequest, opts ...grpc.CallOption) (*WindowCompareResponse, error) {
+	out := new(WindowCompareResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/WindowCompare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OffsetHeight(ctx context.Context, in *OffsetHeightRequest, opts ...grpc.CallOption) (*OffsetHeightResponse, error) {
+	out := new(OffsetHeightResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/OffsetHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationByRoot(ctx context.Context, in *AttestationByRootRequest, opts ...grpc.CallOption) (*AttestationByRootResponse, error) {
+	out := new(AttestationByRootResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/AttestationByRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationsByBlock(ctx context.Context, in *AttestationsByBlockRequest, opts ...grpc.CallOption) (*AttestationsByBlockResponse, error) {
+	out := new(AttestationsByBlockResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/AttestationsByBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Attestations(ctx context.Context, in *AttestationsRequest, opts ...grpc.CallOption) (*AttestationsResponse, error) {
+	out := new(AttestationsResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/Attestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationSignatures(ctx context.Context, in *AttestationSignaturesRequest, opts ...grpc.CallOption) (*AttestationSignaturesResponse, error) {
+	out := new(AttestationSignaturesResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/AttestationSignatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingAttestations(ctx context.Context, in *PendingAttestationsRequest, opts ...grpc.CallOption) (*PendingAttestationsResponse, error) {
+	out := new(PendingAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/PendingAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoteStats(ctx context.Context, in *VoteStatsRequest, opts ...grpc.CallOption) (*VoteStatsResponse, error) {
+	out := new(VoteStatsResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/VoteStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DoubleSignEvidence(ctx context.Context, in *DoubleSignEvidenceRequest, opts ...grpc.CallOption) (*DoubleSignEvidenceResponse, error) {
+	out := new(DoubleSignEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/DoubleSignEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Liveness(ctx context.Context, in *LivenessRequest, opts ...grpc.CallOption) (*LivenessResponse, error) {
+	out := new(LivenessResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/Liveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) JailStatus(ctx context.Context, in *JailStatusRequest, opts ...grpc.CallOption) (*JailStatusResponse, error) {
+	out := new(JailStatusResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/JailStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// AttestationsFrom queries halo for approved attestations for the given chain_id
+	// and from the given height (inclusive). The response will contain at most max attestations sequentially
+	// following from_height.
+	AttestationsFrom(context.Context, *AttestationsFromRequest) (*AttestationsFromResponse, error)
+	// LatestAttestation queries halo for the latest approved attestations for the given chain_id.
+	LatestAttestation(context.Context, *LatestAttestationRequest) (*LatestAttestationResponse, error)
+	// EarliestAttestation queries halo for the earliest approved attestation in consensus chain state for the given chain_id.
+	EarliestAttestation(context.Context, *EarliestAttestationRequest) (*EarliestAttestationResponse, error)
+	// ListAllAttestations queries halo for all approved attestations in consensus chain state for the given chain_id.
+	ListAllAttestations(context.Context, *ListAllAttestationsRequest) (*ListAllAttestationsResponse, error)
+	// WindowCompare compares the given chain height to the vote window.
+	// It returns whether the request is behind (-1), or in (0), or after (1) the vote window.
+	// The vote window is a configured number of blocks around the latest approved attestation.
+	WindowCompare(context.Context, *WindowCompareRequest) (*WindowCompareResponse, error)
+	// OffsetHeight queries halo for a consensus height at which the approved attestation with the
+	// given chain_id and attest_offset is available in consensus chain state.
+	OffsetHeight(context.Context, *OffsetHeightRequest) (*OffsetHeightResponse, error)
+	// AttestationByRoot queries halo for the pending or approved attestation with the given attestation root.
+	AttestationByRoot(context.Context, *AttestationByRootRequest) (*AttestationByRootResponse, error)
+	// AttestationsByBlock queries halo for all pending or approved attestations of the given source chain block.
+	// Multiple attestations are possible if validators voted for different blocks at the same height.
+	AttestationsByBlock(context.Context, *AttestationsByBlockRequest) (*AttestationsByBlockResponse, error)
+	// Attestations queries halo for a paginated list of attestations in consensus chain state,
+	// optionally filtered by chain version, status and created height range.
+	// Results are ordered by created height, or by attest offset or source block height if filtered by chain version.
+	// Queries scanning too many attestations (including offset pagination and count total) are rejected.
+	Attestations(context.Context, *AttestationsRequest) (*AttestationsResponse, error)
+	// AttestationSignatures queries halo for the signatures of the attestation with the given attestation root
+	// broken down by validator of the relevant validator set.
+	AttestationSignatures(context.Context, *AttestationSignaturesRequest) (*AttestationSignaturesResponse, error)
+	// PendingAttestations queries halo for the pending attestations for the given chain_id
+	// with their current voting power versus the quorum required for approval.
+	PendingAttestations(context.Context, *PendingAttestationsRequest) (*PendingAttestationsResponse, error)
+	// VoteStats queries halo for the vote participation stats per chain version of the given validator,
+	// or of all validators if validator_address is empty.
+	VoteStats(context.Context, *VoteStatsRequest) (*VoteStatsResponse, error)
+	// DoubleSignEvidence queries halo for double sign evidence of the given validator,
+	// or of all validators if validator_address is empty.
+	DoubleSignEvidence(context.Context, *DoubleSignEvidenceRequest) (*DoubleSignEvidenceResponse, error)
+	// Params queries the attest module parameters.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// Liveness queries the attestation liveness of the given validator.
+	Liveness(context.Context, *LivenessRequest) (*LivenessResponse, error)
+	// JailStatus queries the reason and duration of the latest jailing of the given validator.
+	JailStatus(context.Context, *JailStatusRequest) (*JailStatusResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) AttestationsFrom(ctx context.Context, req *AttestationsFromRequest) (*AttestationsFromResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationsFrom not implemented")
+}
+func (*UnimplementedQueryServer) LatestAttestation(ctx context.Context, req *LatestAttestationRequest) (*LatestAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestAttestation not implemented")
+}
+func (*UnimplementedQueryServer) EarliestAttestation(ctx context.Context, req *EarliestAttestationRequest) (*EarliestAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EarliestAttestation not implemented")
+}
+func (*UnimplementedQueryServer) ListAllAttestations(ctx context.Context, req *ListAllAttestationsRequest) (*ListAllAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllAttestations not implemented")
+}
+func (*UnimplementedQueryServer) WindowCompare(ctx context.Context, req *WindowCompareRequest) (*WindowCompareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WindowCompare not implemented")
+}
+func (*UnimplementedQueryServer) OffsetHeight(ctx context.Context, req *OffsetHeightRequest) (*OffsetHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffsetHeight not implemented")
+}
+func (*UnimplementedQueryServer) AttestationByRoot(ctx context.Context, req *AttestationByRootRequest) (*AttestationByRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationByRoot not implemented")
+}
+func (*UnimplementedQueryServer) AttestationsByBlock(ctx context.Context, req *AttestationsByBlockRequest) (*AttestationsByBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationsByBlock not implemented")
+}
+func (*UnimplementedQueryServer) Attestations(ctx context.Context, req *AttestationsRequest) (*AttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestations not implemented")
+}
+func (*UnimplementedQueryServer) AttestationSignatures(ctx context.Context, req *AttestationSignaturesRequest) (*AttestationSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationSignatures not implemented")
+}
+func (*UnimplementedQueryServer) PendingAttestations(ctx context.Context, req *PendingAttestationsRequest) (*PendingAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingAttestations not implemented")
+}
+func (*UnimplementedQueryServer) VoteStats(ctx context.Context, req *VoteStatsRequest) (*VoteStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteStats not implemented")
+}
+func (*UnimplementedQueryServer) DoubleSignEvidence(ctx context.Context, req *DoubleSignEvidenceRequest) (*DoubleSignEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoubleSignEvidence not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Liveness(ctx context.Context, req *LivenessRequest) (*LivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Liveness not implemented")
+}
+func (*UnimplementedQueryServer) JailStatus(ctx context.Context, req *JailStatusRequest) (*JailStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JailStatus not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_AttestationsFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationsFromRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationsFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/AttestationsFrom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationsFrom(ctx, req.(*AttestationsFromRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LatestAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/LatestAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestAttestation(ctx, req.(*LatestAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EarliestAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EarliestAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EarliestAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/EarliestAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EarliestAttestation(ctx, req.(*EarliestAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAllAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAllAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/ListAllAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAllAttestations(ctx, req.(*ListAllAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WindowCompare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WindowCompareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WindowCompare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/WindowCompare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WindowCompare(ctx, req.(*WindowCompareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OffsetHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OffsetHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OffsetHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/OffsetHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OffsetHeight(ctx, req.(*OffsetHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationByRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationByRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationByRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/AttestationByRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationByRoot(ctx, req.(*AttestationByRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationsByBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationsByBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationsByBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/AttestationsByBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationsByBlock(ctx, req.(*AttestationsByBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Attestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/Attestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attestations(ctx, req.(*AttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationSignaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/AttestationSignatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationSignatures(ctx, req.(*AttestationSignaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/PendingAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingAttestations(ctx, req.(*PendingAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoteStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/VoteStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteStats(ctx, req.(*VoteStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DoubleSignEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoubleSignEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DoubleSignEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/DoubleSignEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DoubleSignEvidence(ctx, req.(*DoubleSignEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Liveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Liveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/Liveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Liveness(ctx, req.(*LivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_JailStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JailStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).JailStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/JailStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).JailStatus(ctx, req.(*JailStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "halo.attest.types.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AttestationsFrom",
+			Handler:    _Query_AttestationsFrom_Handler,
+		},
+		{
+			MethodName: "LatestAttestation",
+			Handler:    _Query_LatestAttestation_Handler,
+		},
+		{
+			MethodName: "EarliestAttestation",
+			Handler:    _Query_EarliestAttestation_Handler,
+		},
+		{
+			MethodName: "ListAllAttestations",
+			Handler:    _Query_ListAllAttestations_Handler,
+		},
+		{
+			MethodName: "WindowCompare",
+			Handler:    _Query_WindowCompare_Handler,
+		},
+		{
+			MethodName: "OffsetHeight",
+			Handler:    _Query_OffsetHeight_Handler,
+		},
+		{
+			MethodName: "AttestationByRoot",
+			Handler:    _Query_AttestationByRoot_Handler,
+		},
+		{
+			MethodName: "AttestationsByBlock",
+			Handler:    _Query_AttestationsByBlock_Handler,
+		},
+		{
+			MethodName: "Attestations",
+			Handler:    _Query_Attestations_Handler,
+		},
+		{
+			MethodName: "AttestationSignatures",
+			Handler:    _Query_AttestationSignatures_Handler,
+		},
+		{
+			MethodName: "PendingAttestations",
+			Handler:    _Query_PendingAttestations_Handler,
+		},
+		{
+			MethodName: "VoteStats",
+			Handler:    _Query_VoteStats_Handler,
+		},
+		{
+			MethodName: "DoubleSignEvidence",
+			Handler:    _Query_DoubleSignEvidence_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Liveness",
+			Handler:    _Query_Liveness_Handler,
+		},
+		{
+			MethodName: "JailStatus",
+			Handler:    _Query_JailStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "halo/attest/types/query.proto",
+}
+
+func (m *AttestationsFromRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationsFromRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationsFromRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromOffset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConfLevel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConfLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationsFromResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationsFromResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationsFromResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LatestAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LatestAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LatestAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfLevel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConfLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LatestAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LatestAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LatestAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EarliestAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EarliestAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EarliestAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfLevel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConfLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EarliestAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EarliestAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EarliestAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAllAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAllAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAllAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromOffset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromOffset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConfLevel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConfLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAllAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAllAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAllAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WindowCompareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WindowCompareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WindowCompareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AttestOffset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AttestOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConfLevel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConfLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WindowCompareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WindowCompareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WindowCompareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cmp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Cmp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OffsetHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OffsetHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OffsetHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AttestOffset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AttestOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConfLevel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConfLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OffsetHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OffsetHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OffsetHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationByRootRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationByRootRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationByRootRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AttestationRoot) > 0 {
+		i -= len(m.AttestationRoot)
+		copy(dAtA[i:], m.AttestationRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AttestationRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationByRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationByRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationByRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationsByBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationsByBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationsByBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConfLevel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConfLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationsByBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationsByBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationsByBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxCreatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxCreatedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinCreatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinCreatedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConfLevel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConfLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationSignaturesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationSignaturesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationSignaturesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AttestationRoot) > 0 {
+		i -= len(m.AttestationRoot)
+		copy(dAtA[i:], m.AttestationRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AttestationRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationSignaturesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationSignaturesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationSignaturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.SignedPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignedPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorSetId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorSetId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfLevel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConfLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuorumPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QuorumPower))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SignedPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignedPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ValidatorSetId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorSetId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorVoteStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorVoteStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorVoteStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastVoteHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastVoteHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Missed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Missed))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Discarded != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Discarded))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Approved != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Approved))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Included != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Included))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ConfLevel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConfLevel))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoubleSignEvidenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoubleSignEvidenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoubleSignEvidenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoubleSignEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoubleSignEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoubleSignEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Penalized {
+		i--
+		if m.Penalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ConflictingSignature) > 0 {
+		i -= len(m.ConflictingSignature)
+		copy(dAtA[i:], m.ConflictingSignature)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConflictingSignature)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ConflictingAttestationRoot) > 0 {
+		i -= len(m.ConflictingAttestationRoot)
+		copy(dAtA[i:], m.ConflictingAttestationRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConflictingAttestationRoot)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AttestationRoot) > 0 {
+		i -= len(m.AttestationRoot)
+		copy(dAtA[i:], m.AttestationRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AttestationRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AttestOffset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AttestOffset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ConfLevel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConfLevel))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoubleSignEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoubleSignEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoubleSignEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JailStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JailStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JailStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JailStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JailStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JailStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x12
+	if m.Reason != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AttestationsFromRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.ConfLevel != 0 {
+		n += 1 + sovQuery(uint64(m.ConfLevel))
+	}
+	if m.FromOffset != 0 {
+		n += 1 + sovQuery(uint64(m.FromOffset))
+	}
+	return n
+}
+
+func (m *AttestationsFromResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LatestAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.ConfLevel != 0 {
+		n += 1 + sovQuery(uint64(m.ConfLevel))
+	}
+	return n
+}
+
+func (m *LatestAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EarliestAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.ConfLevel != 0 {
+		n += 1 + sovQuery(uint64(m.ConfLevel))
+	}
+	return n
+}
+
+func (m *EarliestAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ListAllAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.ConfLevel != 0 {
+		n += 1 + sovQuery(uint64(m.ConfLevel))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.FromOffset != 0 {
+		n += 1 + sovQuery(uint64(m.FromOffset))
+	}
+	return n
+}
+
+func (m *ListAllAttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WindowCompareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.ConfLevel != 0 {
+		n += 1 + sovQuery(uint64(m.ConfLevel))
+	}
+	if m.AttestOffset != 0 {
+		n += 1 + sovQuery(uint64(m.AttestOffset))
+	}
+	return n
+}
+
+func (m *WindowCompareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cmp != 0 {
+		n += 1 + sovQuery(uint64(m.Cmp))
+	}
+	return n
+}
+
+func (m *OffsetHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.ConfLevel != 0 {
+		n += 1 + sovQuery(uint64(m.ConfLevel))
+	}
+	if m.AttestOffset != 0 {
+		n += 1 + sovQuery(uint64(m.AttestOffset))
+	}
+	return n
+}
+
+func (m *OffsetHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *AttestationInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CreatedHeight))
+	}
+	return n
+}
+
+func (m *AttestationByRootRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AttestationRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AttestationByRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AttestationsByBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.ConfLevel != 0 {
+		n += 1 + sovQuery(uint64(m.ConfLevel))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AttestationsByBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.ConfLevel != 0 {
+		n += 1 + sovQuery(uint64(m.ConfLevel))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.MinCreatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinCreatedHeight))
+	}
+	if m.MaxCreatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxCreatedHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AttestationSignaturesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AttestationRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AttestationSignaturesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorSetId != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorSetId))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	if m.SignedPower != 0 {
+		n += 1 + sovQuery(uint64(m.SignedPower))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.ConfLevel != 0 {
+		n += 1 + sovQuery(uint64(m.ConfLevel))
+	}
+	return n
+}
+
+func (m *PendingAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CreatedHeight))
+	}
+	if m.ValidatorSetId != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorSetId))
+	}
+	if m.SignedPower != 0 {
+		n += 1 + sovQuery(uint64(m.SignedPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	if m.QuorumPower != 0 {
+		n += 1 + sovQuery(uint64(m.QuorumPower))
+	}
+	return n
+}
+
+func (m *PendingAttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VoteStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorVoteStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.ConfLevel != 0 {
+		n += 1 + sovQuery(uint64(m.ConfLevel))
+	}
+	if m.Included != 0 {
+		n += 1 + sovQuery(uint64(m.Included))
+	}
+	if m.Approved != 0 {
+		n += 1 + sovQuery(uint64(m.Approved))
+	}
+	if m.Discarded != 0 {
+		n += 1 + sovQuery(uint64(m.Discarded))
+	}
+	if m.Missed != 0 {
+		n += 1 + sovQuery(uint64(m.Missed))
+	}
+	if m.LastVoteHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastVoteHeight))
+	}
+	return n
+}
+
+func (m *VoteStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DoubleSignEvidenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DoubleSignEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.ConfLevel != 0 {
+		n += 1 + sovQuery(uint64(m.ConfLevel))
+	}
+	if m.AttestOffset != 0 {
+		n += 1 + sovQuery(uint64(m.AttestOffset))
+	}
+	l = len(m.AttestationRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConflictingAttestationRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConflictingSignature)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Penalized {
+		n += 2
+	}
+	return n
+}
+
+func (m *DoubleSignEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovQuery(uint64(m.IndexOffset))
+	}
+	if m.MissedCount != 0 {
+		n += 1 + sovQuery(uint64(m.MissedCount))
+	}
+	return n
+}
+
+func (m *JailStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *JailStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		n += 1 + sovQuery(uint64(m.Reason))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AttestationsFromRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationsFromRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationsFromRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromOffset", wireType)
+			}
+			m.FromOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationsFromResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationsFromResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationsFromResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &Attestation{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EarliestAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EarliestAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EarliestAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EarliestAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EarliestAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EarliestAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &Attestation{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAllAttestationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAllAttestationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAllAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromOffset", wireType)
+			}
+			m.FromOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAllAttestationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAllAttestationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAllAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WindowCompareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindowCompareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindowCompareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestOffset", wireType)
+			}
+			m.AttestOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WindowCompareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindowCompareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindowCompareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmp", wireType)
+			}
+			m.Cmp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cmp |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OffsetHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OffsetHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OffsetHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestOffset", wireType)
+			}
+			m.AttestOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OffsetHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OffsetHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OffsetHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &Attestation{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationByRootRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationByRootRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationByRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationRoot = append(m.AttestationRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttestationRoot == nil {
+				m.AttestationRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationByRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationByRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationByRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &AttestationInfo{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationsByBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationsByBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationsByBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationsByBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationsByBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationsByBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &AttestationInfo{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCreatedHeight", wireType)
+			}
+			m.MinCreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCreatedHeight", wireType)
+			}
+			m.MaxCreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &AttestationInfo{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationSignaturesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationSignaturesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationSignaturesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationRoot = append(m.AttestationRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttestationRoot == nil {
+				m.AttestationRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationSignaturesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationSignaturesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationSignaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetId", wireType)
+			}
+			m.ValidatorSetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPower", wireType)
+			}
+			m.SignedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &ValidatorVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingAttestationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAttestationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &Attestation{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetId", wireType)
+			}
+			m.ValidatorSetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPower", wireType)
+			}
+			m.SignedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumPower", wireType)
+			}
+			m.QuorumPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingAttestationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAttestationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &PendingAttestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorVoteStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorVoteStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorVoteStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Included", wireType)
+			}
+			m.Included = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Included |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			m.Approved = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Approved |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discarded", wireType)
+			}
+			m.Discarded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Discarded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
+			}
+			m.Missed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Missed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastVoteHeight", wireType)
+			}
+			m.LastVoteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastVoteHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, &ValidatorVoteStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *AttestationsFromRequest) Unmarshal(dAtA []byte) error {
+func (m *DoubleSignEvidenceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1387,17 +8162,17 @@ func (m *AttestationsFromRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttestationsFromRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DoubleSignEvidenceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestationsFromRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DoubleSignEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.ChainId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1407,49 +8182,26 @@ func (m *AttestationsFromRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.ConfLevel = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ConfLevel |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromOffset", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.FromOffset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FromOffset |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1471,7 +8223,7 @@ func (m *AttestationsFromRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttestationsFromResponse) Unmarshal(dAtA []byte) error {
+func (m *DoubleSignEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1494,17 +8246,17 @@ func (m *AttestationsFromResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttestationsFromResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DoubleSignEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestationsFromResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DoubleSignEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1514,26 +8266,258 @@ func (m *AttestationsFromResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attestations = append(m.Attestations, &Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestOffset", wireType)
+			}
+			m.AttestOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationRoot = append(m.AttestationRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttestationRoot == nil {
+				m.AttestationRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingAttestationRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictingAttestationRoot = append(m.ConflictingAttestationRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConflictingAttestationRoot == nil {
+				m.ConflictingAttestationRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictingSignature = append(m.ConflictingSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConflictingSignature == nil {
+				m.ConflictingSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Penalized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1555,7 +8539,7 @@ func (m *AttestationsFromResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LatestAttestationRequest) Unmarshal(dAtA []byte) error {
+func (m *DoubleSignEvidenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1578,17 +8562,17 @@ func (m *LatestAttestationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LatestAttestationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DoubleSignEvidenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DoubleSignEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
-			m.ChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1598,30 +8582,26 @@ func (m *LatestAttestationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.ConfLevel = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ConfLevel |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence, &DoubleSignEvidence{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1643,7 +8623,7 @@ func (m *LatestAttestationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LatestAttestationResponse) Unmarshal(dAtA []byte) error {
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1666,48 +8646,12 @@ func (m *LatestAttestationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LatestAttestationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Attestation == nil {
-				m.Attestation = &Attestation{}
-			}
-			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1729,7 +8673,7 @@ func (m *LatestAttestationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EarliestAttestationRequest) Unmarshal(dAtA []byte) error {
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1752,17 +8696,17 @@ func (m *EarliestAttestationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EarliestAttestationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EarliestAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			m.ChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1772,30 +8716,25 @@ func (m *EarliestAttestationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.ConfLevel = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ConfLevel |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1817,7 +8756,7 @@ func (m *EarliestAttestationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EarliestAttestationResponse) Unmarshal(dAtA []byte) error {
+func (m *LivenessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1840,17 +8779,17 @@ func (m *EarliestAttestationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EarliestAttestationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LivenessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EarliestAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1860,26 +8799,24 @@ func (m *EarliestAttestationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attestation == nil {
-				m.Attestation = &Attestation{}
-			}
-			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1903,7 +8840,7 @@ func (m *EarliestAttestationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAllAttestationsRequest) Unmarshal(dAtA []byte) error {
+func (m *LivenessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1926,17 +8863,17 @@ func (m *ListAllAttestationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAllAttestationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LivenessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAllAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			m.ChainId = 0
+			m.Window = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1946,16 +8883,16 @@ func (m *ListAllAttestationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
+				m.Window |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
 			}
-			m.ConfLevel = 0
+			m.IndexOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1965,35 +8902,16 @@ func (m *ListAllAttestationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConfLevel |= uint32(b&0x7F) << shift
+				m.IndexOffset |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCount", wireType)
 			}
-			m.FromOffset = 0
+			m.MissedCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2003,7 +8921,7 @@ func (m *ListAllAttestationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FromOffset |= uint64(b&0x7F) << shift
+				m.MissedCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2029,7 +8947,7 @@ func (m *ListAllAttestationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAllAttestationsResponse) Unmarshal(dAtA []byte) error {
+func (m *JailStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2052,17 +8970,17 @@ func (m *ListAllAttestationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAllAttestationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: JailStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAllAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JailStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2072,24 +8990,24 @@ func (m *ListAllAttestationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attestations = append(m.Attestations, &Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ConsAddress = append(m.ConsAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsAddress == nil {
+				m.ConsAddress = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2113,7 +9031,7 @@ func (m *ListAllAttestationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WindowCompareRequest) Unmarshal(dAtA []byte) error {
+func (m *JailStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2136,17 +9054,17 @@ func (m *WindowCompareRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WindowCompareRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JailStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WindowCompareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JailStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.ChainId = 0
+			m.Reason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2156,16 +9074,16 @@ func (m *WindowCompareRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
+				m.Reason |= JailReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
 			}
-			m.ConfLevel = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2175,16 +9093,30 @@ func (m *WindowCompareRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConfLevel |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.AttestOffset = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2194,66 +9126,16 @@ func (m *WindowCompareRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AttestOffset |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WindowCompareResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WindowCompareResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WindowCompareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cmp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
 			}
-			m.Cmp = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2263,11 +9145,12 @@ func (m *WindowCompareResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Cmp |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Tombstoned = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
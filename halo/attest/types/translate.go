@@ -20,6 +20,13 @@ func (h *WindowCompareRequest) XChainVersion() xchain.ChainVersion {
 	}
 }
 
+func (h *OffsetHeightRequest) XChainVersion() xchain.ChainVersion {
+	return xchain.ChainVersion{
+		ID:        h.ChainId,
+		ConfLevel: xchain.ConfLevel(h.ConfLevel),
+	}
+}
+
 // AttestationsFromProto converts a slice of protobuf Attestations to a slice of xchain.Attestations.
 func AttestationsFromProto(atts []*Attestation) ([]xchain.Attestation, error) {
 	resp := make([]xchain.Attestation, 0, len(atts))
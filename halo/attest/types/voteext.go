@@ -0,0 +1,257 @@
+package types
+
+import (
+	"bytes"
+	"compress/flate"
+	"io"
+	"math"
+	"sort"
+
+	"github.com/omni-network/omni/lib/errors"
+
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// compactPrefix prefixes versioned compact vote extensions.
+// It is followed by the VoteExtensionEncoding byte.
+// Protobuf encoded Votes never start with it since wire type 7 is invalid.
+const compactPrefix byte = 0xFF
+
+// maxDecompressedSize limits the decompressed size of vote extensions, mitigating decompression bombs.
+const maxDecompressedSize = 8 << 20 // 8MB
+
+// MaxVoteExtensionSize limits the encoded size of vote extensions,
+// since the vote extension limit only bounds the number of votes, not their size.
+const MaxVoteExtensionSize = 256 << 10 // 256KB
+
+// EncodeVotes encodes the votes of a single validator as a vote extension using the provided encoding.
+// Empty votes are always encoded as an empty vote extension.
+func EncodeVotes(votes []*Vote, encoding VoteExtensionEncoding) ([]byte, error) {
+	if len(votes) == 0 {
+		return nil, nil
+	}
+
+	switch encoding {
+	case VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_PROTO:
+		bz, err := proto.Marshal(&Votes{Votes: votes})
+		if err != nil {
+			return nil, errors.Wrap(err, "marshal votes")
+		}
+
+		return bz, nil
+	case VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_COMPACT, VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_COMPACT_FLATE:
+	default:
+		return nil, errors.New("unknown vote extension encoding", "encoding", encoding)
+	}
+
+	compact, err := compactVotes(votes)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := proto.Marshal(compact)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal compact votes")
+	}
+
+	if encoding == VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_COMPACT_FLATE {
+		bz, err = compress(bz)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return append([]byte{compactPrefix, byte(encoding)}, bz...), nil
+}
+
+// EncodeVotesLimited encodes the votes like EncodeVotes, dropping trailing votes until the vote extension
+// is at most maxSize bytes. It returns the vote extension and the number of (leading) votes it contains.
+func EncodeVotesLimited(votes []*Vote, encoding VoteExtensionEncoding, maxSize int) ([]byte, int, error) {
+	for n := len(votes); ; n /= 2 {
+		bz, err := EncodeVotes(votes[:n], encoding)
+		if err != nil {
+			return nil, 0, err
+		} else if len(bz) <= maxSize {
+			return bz, n, nil // Always true for zero votes, since they are encoded as an empty vote extension.
+		}
+	}
+}
+
+// DecodeVotes decodes a vote extension in any of the supported encodings.
+func DecodeVotes(bz []byte) (*Votes, error) {
+	if len(bz) == 0 || bz[0] != compactPrefix {
+		resp := new(Votes)
+		if err := proto.Unmarshal(bz, resp); err != nil {
+			return nil, errors.Wrap(err, "unmarshal votes")
+		}
+
+		return resp, nil
+	} else if len(bz) < 2 {
+		return nil, errors.New("missing vote extension encoding")
+	}
+
+	encoding := VoteExtensionEncoding(bz[1])
+	bz = bz[2:]
+
+	switch encoding {
+	case VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_COMPACT:
+	case VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_COMPACT_FLATE:
+		var err error
+		bz, err = decompress(bz)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("unknown vote extension encoding", "encoding", encoding)
+	}
+
+	compact := new(CompactVotes)
+	if err := proto.Unmarshal(bz, compact); err != nil {
+		return nil, errors.Wrap(err, "unmarshal compact votes")
+	}
+
+	return expandVotes(compact)
+}
+
+// compactVotes returns the compact representation of the votes.
+// All votes must be by the same validator for the same consensus chain.
+func compactVotes(votes []*Vote) (*CompactVotes, error) {
+	type chainKey struct {
+		SourceChainID uint64
+		ConfLevel     uint32
+		BlockChainID  uint64
+	}
+
+	resp := new(CompactVotes)
+	var keys []chainKey
+	votesByChain := make(map[chainKey][]*Vote)
+	for i, vote := range votes {
+		if vote.GetAttestHeader() == nil || vote.GetBlockHeader() == nil || vote.GetSignature() == nil {
+			return nil, errors.New("incomplete vote")
+		}
+
+		if i == 0 {
+			resp.ConsensusChainId = vote.AttestHeader.ConsensusChainId
+			resp.ValidatorAddress = vote.Signature.ValidatorAddress
+		} else if vote.AttestHeader.ConsensusChainId != resp.ConsensusChainId {
+			return nil, errors.New("mismatching consensus chain ids")
+		} else if !bytes.Equal(vote.Signature.ValidatorAddress, resp.ValidatorAddress) {
+			return nil, errors.New("mismatching validator addresses")
+		}
+
+		key := chainKey{
+			SourceChainID: vote.AttestHeader.SourceChainId,
+			ConfLevel:     vote.AttestHeader.ConfLevel,
+			BlockChainID:  vote.BlockHeader.ChainId,
+		}
+		if _, ok := votesByChain[key]; !ok {
+			keys = append(keys, key)
+		}
+		votesByChain[key] = append(votesByChain[key], vote)
+	}
+
+	for _, key := range keys {
+		chainVotes := votesByChain[key]
+		sort.SliceStable(chainVotes, func(i, j int) bool {
+			return chainVotes[i].AttestHeader.AttestOffset < chainVotes[j].AttestHeader.AttestOffset
+		})
+
+		chain := &CompactChainVotes{
+			SourceChainId: key.SourceChainID,
+			ConfLevel:     key.ConfLevel,
+			BlockChainId:  key.BlockChainID,
+		}
+
+		var prevOffset, prevHeight uint64
+		for _, vote := range chainVotes {
+			offset, height := vote.AttestHeader.AttestOffset, vote.BlockHeader.BlockHeight
+			if height > math.MaxInt64 {
+				return nil, errors.New("block height overflow")
+			}
+
+			chain.Votes = append(chain.Votes, &CompactVote{
+				OffsetDelta: offset - prevOffset,               // Sorted, so never negative.
+				HeightDelta: int64(height) - int64(prevHeight), //nolint:gosec // Overflow checked above.
+				BlockHash:   vote.BlockHeader.BlockHash,
+				MsgRoot:     vote.MsgRoot,
+				Signature:   vote.Signature.Signature,
+			})
+			prevOffset, prevHeight = offset, height
+		}
+
+		resp.Chains = append(resp.Chains, chain)
+	}
+
+	return resp, nil
+}
+
+// expandVotes returns the votes of the compact representation.
+func expandVotes(compact *CompactVotes) (*Votes, error) {
+	resp := new(Votes)
+	for _, chain := range compact.GetChains() {
+		var offset, height uint64
+		for _, vote := range chain.GetVotes() {
+			if offset+vote.GetOffsetDelta() < offset {
+				return nil, errors.New("attest offset overflow")
+			}
+			offset += vote.GetOffsetDelta()
+
+			next := int64(height) + vote.GetHeightDelta() //nolint:gosec // Heights are checked below.
+			if next < 0 {
+				return nil, errors.New("negative block height")
+			}
+			height = uint64(next)
+
+			resp.Votes = append(resp.Votes, &Vote{
+				AttestHeader: &AttestHeader{
+					ConsensusChainId: compact.GetConsensusChainId(),
+					SourceChainId:    chain.GetSourceChainId(),
+					ConfLevel:        chain.GetConfLevel(),
+					AttestOffset:     offset,
+				},
+				BlockHeader: &BlockHeader{
+					ChainId:     chain.GetBlockChainId(),
+					BlockHeight: height,
+					BlockHash:   vote.GetBlockHash(),
+				},
+				MsgRoot: vote.GetMsgRoot(),
+				Signature: &SigTuple{
+					ValidatorAddress: compact.GetValidatorAddress(),
+					Signature:        vote.GetSignature(),
+				},
+			})
+		}
+	}
+
+	return resp, nil
+}
+
+func compress(bz []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.DefaultCompression)
+	if err != nil {
+		return nil, errors.Wrap(err, "new flate writer")
+	}
+
+	if _, err := w.Write(bz); err != nil {
+		return nil, errors.Wrap(err, "compress")
+	} else if err := w.Close(); err != nil {
+		return nil, errors.Wrap(err, "close flate writer")
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decompress(bz []byte) ([]byte, error) {
+	r := flate.NewReader(bytes.NewReader(bz))
+	defer r.Close()
+
+	resp, err := io.ReadAll(io.LimitReader(r, maxDecompressedSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "decompress")
+	} else if len(resp) > maxDecompressedSize {
+		return nil, errors.New("decompressed vote extension too large")
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,1068 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: halo/attest/types/voteext.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CompactVotes is a compact encoding of Votes by a single validator used in vote extensions.
+// Headers shared by votes are deduplicated and offsets and heights are delta-encoded.
+type CompactVotes struct {
+	ConsensusChainId uint64               `protobuf:"varint,1,opt,name=consensus_chain_id,json=consensusChainId,proto3" json:"consensus_chain_id,omitempty"`
+	ValidatorAddress []byte               `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Chains           []*CompactChainVotes `protobuf:"bytes,3,rep,name=chains,proto3" json:"chains,omitempty"`
+}
+
+func (m *CompactVotes) Reset()         { *m = CompactVotes{} }
+func (m *CompactVotes) String() string { return proto.CompactTextString(m) }
+func (*CompactVotes) ProtoMessage()    {}
+func (*CompactVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee1d8d80da254d61, []int{0}
+}
+func (m *CompactVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactVotes.Merge(m, src)
+}
+func (m *CompactVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactVotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactVotes proto.InternalMessageInfo
+
+func (m *CompactVotes) GetConsensusChainId() uint64 {
+	if m != nil {
+		return m.ConsensusChainId
+	}
+	return 0
+}
+
+func (m *CompactVotes) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *CompactVotes) GetChains() []*CompactChainVotes {
+	if m != nil {
+		return m.Chains
+	}
+	return nil
+}
+
+// CompactChainVotes contains the votes of a single chain version ordered by attest offset.
+type CompactChainVotes struct {
+	SourceChainId uint64         `protobuf:"varint,1,opt,name=source_chain_id,json=sourceChainId,proto3" json:"source_chain_id,omitempty"`
+	ConfLevel     uint32         `protobuf:"varint,2,opt,name=conf_level,json=confLevel,proto3" json:"conf_level,omitempty"`
+	BlockChainId  uint64         `protobuf:"varint,3,opt,name=block_chain_id,json=blockChainId,proto3" json:"block_chain_id,omitempty"`
+	Votes         []*CompactVote `protobuf:"bytes,4,rep,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (m *CompactChainVotes) Reset()         { *m = CompactChainVotes{} }
+func (m *CompactChainVotes) String() string { return proto.CompactTextString(m) }
+func (*CompactChainVotes) ProtoMessage()    {}
+func (*CompactChainVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee1d8d80da254d61, []int{1}
+}
+func (m *CompactChainVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactChainVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactChainVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactChainVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactChainVotes.Merge(m, src)
+}
+func (m *CompactChainVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactChainVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactChainVotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactChainVotes proto.InternalMessageInfo
+
+func (m *CompactChainVotes) GetSourceChainId() uint64 {
+	if m != nil {
+		return m.SourceChainId
+	}
+	return 0
+}
+
+func (m *CompactChainVotes) GetConfLevel() uint32 {
+	if m != nil {
+		return m.ConfLevel
+	}
+	return 0
+}
+
+func (m *CompactChainVotes) GetBlockChainId() uint64 {
+	if m != nil {
+		return m.BlockChainId
+	}
+	return 0
+}
+
+func (m *CompactChainVotes) GetVotes() []*CompactVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+// CompactVote is a single vote of a CompactChainVotes.
+type CompactVote struct {
+	OffsetDelta uint64 `protobuf:"varint,1,opt,name=offset_delta,json=offsetDelta,proto3" json:"offset_delta,omitempty"`
+	HeightDelta int64  `protobuf:"zigzag64,2,opt,name=height_delta,json=heightDelta,proto3" json:"height_delta,omitempty"`
+	BlockHash   []byte `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	MsgRoot     []byte `protobuf:"bytes,4,opt,name=msg_root,json=msgRoot,proto3" json:"msg_root,omitempty"`
+	Signature   []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *CompactVote) Reset()         { *m = CompactVote{} }
+func (m *CompactVote) String() string { return proto.CompactTextString(m) }
+func (*CompactVote) ProtoMessage()    {}
+func (*CompactVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee1d8d80da254d61, []int{2}
+}
+func (m *CompactVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactVote.Merge(m, src)
+}
+func (m *CompactVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactVote proto.InternalMessageInfo
+
+func (m *CompactVote) GetOffsetDelta() uint64 {
+	if m != nil {
+		return m.OffsetDelta
+	}
+	return 0
+}
+
+func (m *CompactVote) GetHeightDelta() int64 {
+	if m != nil {
+		return m.HeightDelta
+	}
+	return 0
+}
+
+func (m *CompactVote) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *CompactVote) GetMsgRoot() []byte {
+	if m != nil {
+		return m.MsgRoot
+	}
+	return nil
+}
+
+func (m *CompactVote) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CompactVotes)(nil), "halo.attest.types.CompactVotes")
+	proto.RegisterType((*CompactChainVotes)(nil), "halo.attest.types.CompactChainVotes")
+	proto.RegisterType((*CompactVote)(nil), "halo.attest.types.CompactVote")
+}
+
+func init() { proto.RegisterFile("halo/attest/types/voteext.proto", fileDescriptor_ee1d8d80da254d61) }
+
+var fileDescriptor_ee1d8d80da254d61 = []byte{
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x31, 0xef, 0xd2, 0x40,
+	0x18, 0xc6, 0x39, 0xe1, 0x8f, 0xf2, 0xb6, 0x28, 0xbd, 0xa9, 0x26, 0x52, 0x91, 0x10, 0x43, 0x82,
+	0x29, 0x89, 0x3a, 0xba, 0x28, 0x0e, 0x9a, 0x38, 0xdd, 0xe0, 0xe0, 0xd2, 0x1c, 0xed, 0xd1, 0x36,
+	0x96, 0xbe, 0xa4, 0xef, 0x41, 0xf4, 0x5b, 0xf8, 0x2d, 0x1c, 0xdc, 0xfd, 0x0c, 0x8e, 0x8c, 0x8e,
+	0x06, 0xbe, 0x88, 0xb9, 0x3b, 0x40, 0x23, 0x71, 0xfd, 0x3d, 0xcf, 0x3d, 0xf9, 0xe5, 0xf2, 0xc2,
+	0xc3, 0x42, 0x56, 0x38, 0x97, 0x5a, 0x2b, 0xd2, 0x73, 0xfd, 0x79, 0xa3, 0x68, 0xbe, 0x43, 0xad,
+	0xd4, 0x27, 0x1d, 0x6f, 0x1a, 0xd4, 0xc8, 0x03, 0x53, 0x88, 0x5d, 0x21, 0xb6, 0x85, 0xf1, 0x57,
+	0x06, 0xfe, 0x02, 0xd7, 0x1b, 0x99, 0xea, 0xf7, 0xa8, 0x15, 0xf1, 0x27, 0xc0, 0x53, 0xac, 0x49,
+	0xd5, 0xb4, 0xa5, 0x24, 0x2d, 0x64, 0x59, 0x27, 0x65, 0x16, 0xb2, 0x11, 0x9b, 0x76, 0xc4, 0xe0,
+	0x92, 0x2c, 0x4c, 0xf0, 0x36, 0xe3, 0x33, 0x08, 0x76, 0xb2, 0x2a, 0x33, 0xa9, 0xb1, 0x49, 0x64,
+	0x96, 0x35, 0x8a, 0x28, 0xbc, 0x35, 0x62, 0x53, 0x5f, 0x0c, 0x2e, 0xc1, 0x4b, 0xc7, 0xf9, 0x0b,
+	0xe8, 0xda, 0x41, 0x0a, 0xdb, 0xa3, 0xf6, 0xd4, 0x7b, 0x3a, 0x89, 0xaf, 0x7c, 0xe2, 0x93, 0x8b,
+	0xdd, 0xb7, 0x42, 0xe2, 0xf4, 0x66, 0xfc, 0x9d, 0x41, 0x70, 0x95, 0xf2, 0xc7, 0x70, 0x8f, 0x70,
+	0xdb, 0xa4, 0xea, 0x5f, 0xd7, 0xbe, 0xc3, 0x67, 0xd1, 0x21, 0x40, 0x8a, 0xf5, 0x2a, 0xa9, 0xd4,
+	0x4e, 0x55, 0xd6, 0xb0, 0x2f, 0x7a, 0x86, 0xbc, 0x33, 0x80, 0x4f, 0xe0, 0xee, 0xb2, 0xc2, 0xf4,
+	0xe3, 0x9f, 0x95, 0xb6, 0x5d, 0xf1, 0x2d, 0x3d, 0x8f, 0x3c, 0x87, 0x1b, 0xf3, 0xa1, 0x14, 0x76,
+	0xac, 0x7f, 0xf4, 0x7f, 0x7f, 0x23, 0x27, 0x5c, 0x79, 0xfc, 0x8d, 0x81, 0xf7, 0x17, 0xe6, 0x8f,
+	0xc0, 0xc7, 0xd5, 0x8a, 0x94, 0x4e, 0x32, 0x55, 0x69, 0x79, 0xf2, 0xf5, 0x1c, 0x7b, 0x6d, 0x90,
+	0xa9, 0x14, 0xaa, 0xcc, 0x8b, 0x73, 0xc5, 0xf8, 0x72, 0xe1, 0x39, 0xe6, 0x2a, 0x43, 0x00, 0x67,
+	0x5c, 0x48, 0x2a, 0xac, 0xad, 0x2f, 0x7a, 0x96, 0xbc, 0x91, 0x54, 0xf0, 0xfb, 0x70, 0x67, 0x4d,
+	0x79, 0xd2, 0x20, 0xea, 0xb0, 0x63, 0xc3, 0xdb, 0x6b, 0xca, 0x05, 0xa2, 0xe6, 0x0f, 0xa0, 0x47,
+	0x65, 0x5e, 0x4b, 0xbd, 0x6d, 0x54, 0x78, 0xe3, 0x1e, 0x5e, 0xc0, 0xab, 0xd9, 0x8f, 0x43, 0xc4,
+	0xf6, 0x87, 0x88, 0xfd, 0x3a, 0x44, 0xec, 0xcb, 0x31, 0x6a, 0xed, 0x8f, 0x51, 0xeb, 0xe7, 0x31,
+	0x6a, 0x7d, 0x08, 0xae, 0xce, 0x6b, 0xd9, 0xb5, 0x77, 0xf5, 0xec, 0xf7, 0x00, 0x07, 0x96, 0x86,
+	0xcf, 0x7a, 0x02, 0x00, 0x00,
+}
+
+func (m *CompactVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteext(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintVoteext(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ConsensusChainId != 0 {
+		i = encodeVarintVoteext(dAtA, i, uint64(m.ConsensusChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompactChainVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactChainVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactChainVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteext(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BlockChainId != 0 {
+		i = encodeVarintVoteext(dAtA, i, uint64(m.BlockChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConfLevel != 0 {
+		i = encodeVarintVoteext(dAtA, i, uint64(m.ConfLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SourceChainId != 0 {
+		i = encodeVarintVoteext(dAtA, i, uint64(m.SourceChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompactVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintVoteext(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MsgRoot) > 0 {
+		i -= len(m.MsgRoot)
+		copy(dAtA[i:], m.MsgRoot)
+		i = encodeVarintVoteext(dAtA, i, uint64(len(m.MsgRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintVoteext(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.HeightDelta != 0 {
+		i = encodeVarintVoteext(dAtA, i, uint64((uint64(m.HeightDelta)<<1)^uint64((m.HeightDelta>>63))))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OffsetDelta != 0 {
+		i = encodeVarintVoteext(dAtA, i, uint64(m.OffsetDelta))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVoteext(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVoteext(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CompactVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsensusChainId != 0 {
+		n += 1 + sovVoteext(uint64(m.ConsensusChainId))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovVoteext(uint64(l))
+	}
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovVoteext(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompactChainVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceChainId != 0 {
+		n += 1 + sovVoteext(uint64(m.SourceChainId))
+	}
+	if m.ConfLevel != 0 {
+		n += 1 + sovVoteext(uint64(m.ConfLevel))
+	}
+	if m.BlockChainId != 0 {
+		n += 1 + sovVoteext(uint64(m.BlockChainId))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovVoteext(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompactVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OffsetDelta != 0 {
+		n += 1 + sovVoteext(uint64(m.OffsetDelta))
+	}
+	if m.HeightDelta != 0 {
+		n += 1 + sozVoteext(uint64(m.HeightDelta))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovVoteext(uint64(l))
+	}
+	l = len(m.MsgRoot)
+	if l > 0 {
+		n += 1 + l + sovVoteext(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovVoteext(uint64(l))
+	}
+	return n
+}
+
+func sovVoteext(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVoteext(x uint64) (n int) {
+	return sovVoteext(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CompactVotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteext
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusChainId", wireType)
+			}
+			m.ConsensusChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsensusChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteext
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteext
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteext
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteext
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, &CompactChainVotes{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteext(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteext
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactChainVotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteext
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactChainVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactChainVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainId", wireType)
+			}
+			m.SourceChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockChainId", wireType)
+			}
+			m.BlockChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteext
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteext
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &CompactVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteext(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteext
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteext
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetDelta", wireType)
+			}
+			m.OffsetDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffsetDelta |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeightDelta", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.HeightDelta = int64(v)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteext
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteext
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteext
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteext
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgRoot = append(m.MsgRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MsgRoot == nil {
+				m.MsgRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteext
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteext
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteext(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteext
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVoteext(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVoteext
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoteext
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoteext
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVoteext
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVoteext
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVoteext
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVoteext        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVoteext          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVoteext = fmt.Errorf("proto: unexpected end of group")
+)
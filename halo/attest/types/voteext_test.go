@@ -0,0 +1,191 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	fuzz "github.com/google/gofuzz"
+	"github.com/stretchr/testify/require"
+)
+
+var encodings = []types.VoteExtensionEncoding{
+	types.VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_PROTO,
+	types.VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_COMPACT,
+	types.VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_COMPACT_FLATE,
+}
+
+func TestEncodeVotes(t *testing.T) {
+	t.Parallel()
+
+	votes := genVotes(t, 4, 8)
+
+	for _, encoding := range encodings {
+		t.Run(encoding.String(), func(t *testing.T) {
+			t.Parallel()
+
+			bz, err := types.EncodeVotes(votes, encoding)
+			require.NoError(t, err)
+
+			decoded, err := types.DecodeVotes(bz)
+			require.NoError(t, err)
+			require.Equal(t, votes, decoded.Votes)
+
+			empty, err := types.EncodeVotes(nil, encoding)
+			require.NoError(t, err)
+			require.Empty(t, empty)
+
+			decoded, err = types.DecodeVotes(empty)
+			require.NoError(t, err)
+			require.Empty(t, decoded.Votes)
+		})
+	}
+
+	// Votes by different validators cannot be compacted.
+	other := genVotes(t, 1, 1)
+	_, err := types.EncodeVotes(append(other, votes...), types.VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_COMPACT)
+	require.ErrorContains(t, err, "mismatching validator addresses")
+
+	// Unknown encodings are rejected.
+	_, err = types.DecodeVotes([]byte{0xFF, 99})
+	require.ErrorContains(t, err, "unknown vote extension encoding")
+}
+
+func TestEncodeVotesLimited(t *testing.T) {
+	t.Parallel()
+
+	votes := genVotes(t, 4, 8)
+	encoding := types.VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_PROTO
+
+	all, err := types.EncodeVotes(votes, encoding)
+	require.NoError(t, err)
+
+	// All votes are encoded if they fit.
+	bz, n, err := types.EncodeVotesLimited(votes, encoding, len(all))
+	require.NoError(t, err)
+	require.Equal(t, all, bz)
+	require.Len(t, votes, n)
+
+	// Trailing votes are dropped until they fit.
+	bz, n, err = types.EncodeVotesLimited(votes, encoding, len(all)-1)
+	require.NoError(t, err)
+	require.Less(t, n, len(votes))
+	require.LessOrEqual(t, len(bz), len(all)-1)
+
+	decoded, err := types.DecodeVotes(bz)
+	require.NoError(t, err)
+	require.Equal(t, votes[:n], decoded.Votes)
+
+	// Nothing fits in zero bytes.
+	bz, n, err = types.EncodeVotesLimited(votes, encoding, 0)
+	require.NoError(t, err)
+	require.Empty(t, bz)
+	require.Zero(t, n)
+}
+
+// BenchmarkEncodeVotes benchmarks vote extension encodings and reports the size per vote extension.
+// Run with: go test ./halo/attest/types -run=NONE -bench=EncodeVotes.
+func BenchmarkEncodeVotes(b *testing.B) {
+	for _, chains := range []int{1, 8, 32} {
+		for _, perChain := range []int{1, 8} {
+			votes := genVotes(b, chains, perChain)
+			for _, encoding := range encodings {
+				name := fmt.Sprintf("chains=%d/votes=%d/%s", chains, len(votes), encoding)
+				b.Run(name, func(b *testing.B) {
+					var size int
+					for i := 0; i < b.N; i++ {
+						bz, err := types.EncodeVotes(votes, encoding)
+						if err != nil {
+							b.Fatal(err)
+						}
+						size = len(bz)
+					}
+					b.ReportMetric(float64(size), "bytes/ext")
+					b.ReportMetric(float64(size)/float64(len(votes)), "bytes/vote")
+				})
+			}
+		}
+	}
+}
+
+// BenchmarkDecodeVotes benchmarks decoding all vote extensions of a block (i.e. from all validators),
+// as done by VerifyVoteExtension and PrepareVotes.
+func BenchmarkDecodeVotes(b *testing.B) {
+	const chains, perChain = 8, 8
+	for _, vals := range []int{10, 100} {
+		for _, encoding := range encodings {
+			var exts [][]byte
+			var total int
+			for v := 0; v < vals; v++ {
+				bz, err := types.EncodeVotes(genVotes(b, chains, perChain), encoding)
+				if err != nil {
+					b.Fatal(err)
+				}
+				exts = append(exts, bz)
+				total += len(bz)
+			}
+
+			b.Run(fmt.Sprintf("vals=%d/%s", vals, encoding), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					for _, ext := range exts {
+						if _, err := types.DecodeVotes(ext); err != nil {
+							b.Fatal(err)
+						}
+					}
+				}
+				b.ReportMetric(float64(total), "bytes/block")
+			})
+		}
+	}
+}
+
+// genVotes returns random votes by a single validator for consecutive offsets of the provided number of chains.
+func genVotes(t testing.TB, chains int, perChain int) []*types.Vote {
+	t.Helper()
+
+	fuzzer := fuzz.New().NilChance(0)
+	var addr common.Address
+	fuzzer.Fuzz(&addr)
+
+	var resp []*types.Vote
+	for c := 0; c < chains; c++ {
+		chainID := uint64(1000 + c)
+		var offset, height uint64
+		fuzzer.Fuzz(&offset)
+		offset %= 1 << 32
+		height = offset + 1_000_000
+
+		for i := 0; i < perChain; i++ {
+			var blockHash, msgRoot common.Hash
+			var sig xchain.Signature65
+			fuzzer.Fuzz(&blockHash)
+			fuzzer.Fuzz(&msgRoot)
+			fuzzer.Fuzz(&sig)
+
+			resp = append(resp, &types.Vote{
+				AttestHeader: &types.AttestHeader{
+					ConsensusChainId: 1_000_001,
+					SourceChainId:    chainID,
+					ConfLevel:        uint32(xchain.ConfFinalized),
+					AttestOffset:     offset + uint64(i),
+				},
+				BlockHeader: &types.BlockHeader{
+					ChainId:     chainID,
+					BlockHeight: height + uint64(i)*3,
+					BlockHash:   blockHash[:],
+				},
+				MsgRoot: msgRoot[:],
+				Signature: &types.SigTuple{
+					ValidatorAddress: addr[:],
+					Signature:        sig[:],
+				},
+			})
+		}
+	}
+
+	return resp
+}
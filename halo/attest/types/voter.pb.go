@@ -0,0 +1,2518 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: halo/attest/types/voter.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type GetAvailableRequest struct {
+}
+
+func (m *GetAvailableRequest) Reset()         { *m = GetAvailableRequest{} }
+func (m *GetAvailableRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAvailableRequest) ProtoMessage()    {}
+func (*GetAvailableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d70a2d439a22585c, []int{0}
+}
+func (m *GetAvailableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAvailableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAvailableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAvailableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAvailableRequest.Merge(m, src)
+}
+func (m *GetAvailableRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAvailableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAvailableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAvailableRequest proto.InternalMessageInfo
+
+type GetAvailableResponse struct {
+	Votes             []*Vote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
+	NeedsValidatorSet bool    `protobuf:"varint,2,opt,name=needs_validator_set,json=needsValidatorSet,proto3" json:"needs_validator_set,omitempty"`
+}
+
+func (m *GetAvailableResponse) Reset()         { *m = GetAvailableResponse{} }
+func (m *GetAvailableResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAvailableResponse) ProtoMessage()    {}
+func (*GetAvailableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d70a2d439a22585c, []int{1}
+}
+func (m *GetAvailableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAvailableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAvailableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAvailableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAvailableResponse.Merge(m, src)
+}
+func (m *GetAvailableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAvailableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAvailableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAvailableResponse proto.InternalMessageInfo
+
+func (m *GetAvailableResponse) GetVotes() []*Vote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *GetAvailableResponse) GetNeedsValidatorSet() bool {
+	if m != nil {
+		return m.NeedsValidatorSet
+	}
+	return false
+}
+
+type SetProposedRequest struct {
+	Headers []*AttestHeader `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+}
+
+func (m *SetProposedRequest) Reset()         { *m = SetProposedRequest{} }
+func (m *SetProposedRequest) String() string { return proto.CompactTextString(m) }
+func (*SetProposedRequest) ProtoMessage()    {}
+func (*SetProposedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d70a2d439a22585c, []int{2}
+}
+func (m *SetProposedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetProposedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetProposedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetProposedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetProposedRequest.Merge(m, src)
+}
+func (m *SetProposedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetProposedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetProposedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetProposedRequest proto.InternalMessageInfo
+
+func (m *SetProposedRequest) GetHeaders() []*AttestHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type SetProposedResponse struct {
+}
+
+func (m *SetProposedResponse) Reset()         { *m = SetProposedResponse{} }
+func (m *SetProposedResponse) String() string { return proto.CompactTextString(m) }
+func (*SetProposedResponse) ProtoMessage()    {}
+func (*SetProposedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d70a2d439a22585c, []int{3}
+}
+func (m *SetProposedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetProposedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetProposedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetProposedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetProposedResponse.Merge(m, src)
+}
+func (m *SetProposedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetProposedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetProposedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetProposedResponse proto.InternalMessageInfo
+
+type SetCommittedRequest struct {
+	Headers []*AttestHeader `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+}
+
+func (m *SetCommittedRequest) Reset()         { *m = SetCommittedRequest{} }
+func (m *SetCommittedRequest) String() string { return proto.CompactTextString(m) }
+func (*SetCommittedRequest) ProtoMessage()    {}
+func (*SetCommittedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d70a2d439a22585c, []int{4}
+}
+func (m *SetCommittedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCommittedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCommittedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetCommittedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCommittedRequest.Merge(m, src)
+}
+func (m *SetCommittedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCommittedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCommittedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCommittedRequest proto.InternalMessageInfo
+
+func (m *SetCommittedRequest) GetHeaders() []*AttestHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type SetCommittedResponse struct {
+}
+
+func (m *SetCommittedResponse) Reset()         { *m = SetCommittedResponse{} }
+func (m *SetCommittedResponse) String() string { return proto.CompactTextString(m) }
+func (*SetCommittedResponse) ProtoMessage()    {}
+func (*SetCommittedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d70a2d439a22585c, []int{5}
+}
+func (m *SetCommittedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCommittedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCommittedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetCommittedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCommittedResponse.Merge(m, src)
+}
+func (m *SetCommittedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCommittedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCommittedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCommittedResponse proto.InternalMessageInfo
+
+type LocalAddressRequest struct {
+}
+
+func (m *LocalAddressRequest) Reset()         { *m = LocalAddressRequest{} }
+func (m *LocalAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*LocalAddressRequest) ProtoMessage()    {}
+func (*LocalAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d70a2d439a22585c, []int{6}
+}
+func (m *LocalAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LocalAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LocalAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LocalAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalAddressRequest.Merge(m, src)
+}
+func (m *LocalAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LocalAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalAddressRequest proto.InternalMessageInfo
+
+type LocalAddressResponse struct {
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *LocalAddressResponse) Reset()         { *m = LocalAddressResponse{} }
+func (m *LocalAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*LocalAddressResponse) ProtoMessage()    {}
+func (*LocalAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d70a2d439a22585c, []int{7}
+}
+func (m *LocalAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LocalAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LocalAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LocalAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalAddressResponse.Merge(m, src)
+}
+func (m *LocalAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LocalAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalAddressResponse proto.InternalMessageInfo
+
+func (m *LocalAddressResponse) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+type TrimBehindRequest struct {
+	Minimums []*ChainMinimum `protobuf:"bytes,1,rep,name=minimums,proto3" json:"minimums,omitempty"`
+}
+
+func (m *TrimBehindRequest) Reset()         { *m = TrimBehindRequest{} }
+func (m *TrimBehindRequest) String() string { return proto.CompactTextString(m) }
+func (*TrimBehindRequest) ProtoMessage()    {}
+func (*TrimBehindRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d70a2d439a22585c, []int{8}
+}
+func (m *TrimBehindRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrimBehindRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrimBehindRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrimBehindRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrimBehindRequest.Merge(m, src)
+}
+func (m *TrimBehindRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrimBehindRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrimBehindRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrimBehindRequest proto.InternalMessageInfo
+
+func (m *TrimBehindRequest) GetMinimums() []*ChainMinimum {
+	if m != nil {
+		return m.Minimums
+	}
+	return nil
+}
+
+type ChainMinimum struct {
+	ChainId      uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ConfLevel    uint32 `protobuf:"varint,2,opt,name=conf_level,json=confLevel,proto3" json:"conf_level,omitempty"`
+	AttestOffset uint64 `protobuf:"varint,3,opt,name=attest_offset,json=attestOffset,proto3" json:"attest_offset,omitempty"`
+}
+
+func (m *ChainMinimum) Reset()         { *m = ChainMinimum{} }
+func (m *ChainMinimum) String() string { return proto.CompactTextString(m) }
+func (*ChainMinimum) ProtoMessage()    {}
+func (*ChainMinimum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d70a2d439a22585c, []int{9}
+}
+func (m *ChainMinimum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainMinimum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainMinimum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainMinimum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainMinimum.Merge(m, src)
+}
+func (m *ChainMinimum) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainMinimum) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainMinimum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainMinimum proto.InternalMessageInfo
+
+func (m *ChainMinimum) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *ChainMinimum) GetConfLevel() uint32 {
+	if m != nil {
+		return m.ConfLevel
+	}
+	return 0
+}
+
+func (m *ChainMinimum) GetAttestOffset() uint64 {
+	if m != nil {
+		return m.AttestOffset
+	}
+	return 0
+}
+
+type TrimBehindResponse struct {
+	Deleted uint64 `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (m *TrimBehindResponse) Reset()         { *m = TrimBehindResponse{} }
+func (m *TrimBehindResponse) String() string { return proto.CompactTextString(m) }
+func (*TrimBehindResponse) ProtoMessage()    {}
+func (*TrimBehindResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d70a2d439a22585c, []int{10}
+}
+func (m *TrimBehindResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrimBehindResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrimBehindResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrimBehindResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrimBehindResponse.Merge(m, src)
+}
+func (m *TrimBehindResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrimBehindResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrimBehindResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrimBehindResponse proto.InternalMessageInfo
+
+func (m *TrimBehindResponse) GetDeleted() uint64 {
+	if m != nil {
+		return m.Deleted
+	}
+	return 0
+}
+
+type UpdateValidatorSetRequest struct {
+	ValidatorSet []byte `protobuf:"bytes,1,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
+}
+
+func (m *UpdateValidatorSetRequest) Reset()         { *m = UpdateValidatorSetRequest{} }
+func (m *UpdateValidatorSetRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateValidatorSetRequest) ProtoMessage()    {}
+func (*UpdateValidatorSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d70a2d439a22585c, []int{11}
+}
+func (m *UpdateValidatorSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateValidatorSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateValidatorSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateValidatorSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateValidatorSetRequest.Merge(m, src)
+}
+func (m *UpdateValidatorSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateValidatorSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateValidatorSetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateValidatorSetRequest proto.InternalMessageInfo
+
+func (m *UpdateValidatorSetRequest) GetValidatorSet() []byte {
+	if m != nil {
+		return m.ValidatorSet
+	}
+	return nil
+}
+
+type UpdateValidatorSetResponse struct {
+}
+
+func (m *UpdateValidatorSetResponse) Reset()         { *m = UpdateValidatorSetResponse{} }
+func (m *UpdateValidatorSetResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateValidatorSetResponse) ProtoMessage()    {}
+func (*UpdateValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d70a2d439a22585c, []int{12}
+}
+func (m *UpdateValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateValidatorSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateValidatorSetResponse.Merge(m, src)
+}
+func (m *UpdateValidatorSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateValidatorSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateValidatorSetResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*GetAvailableRequest)(nil), "halo.attest.types.GetAvailableRequest")
+	proto.RegisterType((*GetAvailableResponse)(nil), "halo.attest.types.GetAvailableResponse")
+	proto.RegisterType((*SetProposedRequest)(nil), "halo.attest.types.SetProposedRequest")
+	proto.RegisterType((*SetProposedResponse)(nil), "halo.attest.types.SetProposedResponse")
+	proto.RegisterType((*SetCommittedRequest)(nil), "halo.attest.types.SetCommittedRequest")
+	proto.RegisterType((*SetCommittedResponse)(nil), "halo.attest.types.SetCommittedResponse")
+	proto.RegisterType((*LocalAddressRequest)(nil), "halo.attest.types.LocalAddressRequest")
+	proto.RegisterType((*LocalAddressResponse)(nil), "halo.attest.types.LocalAddressResponse")
+	proto.RegisterType((*TrimBehindRequest)(nil), "halo.attest.types.TrimBehindRequest")
+	proto.RegisterType((*ChainMinimum)(nil), "halo.attest.types.ChainMinimum")
+	proto.RegisterType((*TrimBehindResponse)(nil), "halo.attest.types.TrimBehindResponse")
+	proto.RegisterType((*UpdateValidatorSetRequest)(nil), "halo.attest.types.UpdateValidatorSetRequest")
+	proto.RegisterType((*UpdateValidatorSetResponse)(nil), "halo.attest.types.UpdateValidatorSetResponse")
+}
+
+func init() { proto.RegisterFile("halo/attest/types/voter.proto", fileDescriptor_d70a2d439a22585c) }
+
+var fileDescriptor_d70a2d439a22585c = []byte{
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x69, 0xa1, 0x61, 0xea, 0x1c, 0xb2, 0x49, 0x21, 0xb5, 0xa8, 0x89, 0x5c, 0xda, 0x44,
+	0x82, 0x3a, 0xa8, 0x9c, 0x10, 0x17, 0xd2, 0x1e, 0x00, 0xa9, 0xa8, 0x91, 0x03, 0x3d, 0x80, 0x44,
+	0xb4, 0x8d, 0x27, 0x8a, 0x25, 0xdb, 0x1b, 0xbc, 0x1b, 0x0b, 0xfe, 0x82, 0xcf, 0xe2, 0xd8, 0x23,
+	0x47, 0x94, 0x9c, 0xf8, 0x0b, 0xe4, 0x75, 0xec, 0x3a, 0xd8, 0xc5, 0x1c, 0x38, 0xce, 0x9b, 0x37,
+	0xf3, 0x76, 0x66, 0xdf, 0x2e, 0xec, 0x4d, 0xa9, 0xcb, 0x7a, 0x54, 0x08, 0xe4, 0xa2, 0x27, 0xbe,
+	0xce, 0x90, 0xf7, 0x42, 0x26, 0x30, 0x30, 0x67, 0x01, 0x13, 0x8c, 0xd4, 0xa3, 0xb4, 0x19, 0xa7,
+	0x4d, 0x99, 0xd6, 0xb4, 0x7c, 0x85, 0xf8, 0x12, 0xd3, 0x8d, 0x1d, 0x68, 0xbc, 0x42, 0xd1, 0x0f,
+	0xa9, 0xe3, 0xd2, 0x4b, 0x17, 0x2d, 0xfc, 0x3c, 0x47, 0x2e, 0x8c, 0x39, 0x34, 0xd7, 0x61, 0x3e,
+	0x63, 0x3e, 0x47, 0x72, 0x04, 0xb7, 0x23, 0x31, 0xde, 0x52, 0xda, 0x1b, 0xdd, 0xed, 0xe3, 0xfb,
+	0x66, 0x4e, 0xcd, 0xbc, 0x60, 0x02, 0xad, 0x98, 0x45, 0x4c, 0x68, 0xf8, 0x88, 0x36, 0x1f, 0x85,
+	0xd4, 0x75, 0x6c, 0x2a, 0x58, 0x30, 0xe2, 0x28, 0x5a, 0xb7, 0xda, 0x4a, 0xb7, 0x6a, 0xd5, 0x65,
+	0xea, 0x22, 0xc9, 0x0c, 0x51, 0x18, 0xe7, 0x40, 0x86, 0x28, 0x06, 0x01, 0x9b, 0x31, 0x8e, 0xf6,
+	0xea, 0x30, 0xe4, 0x39, 0x6c, 0x4d, 0x91, 0xda, 0x18, 0x24, 0xb2, 0x0f, 0x0b, 0x64, 0xfb, 0x32,
+	0x78, 0x2d, 0x79, 0x56, 0xc2, 0x8f, 0xc6, 0x5b, 0x6b, 0x18, 0x8f, 0x61, 0x0c, 0x24, 0x7c, 0xca,
+	0x3c, 0xcf, 0x11, 0xe2, 0xbf, 0x08, 0xdd, 0x83, 0xe6, 0x7a, 0xc7, 0x95, 0xd2, 0x0e, 0x34, 0xce,
+	0xd8, 0x98, 0xba, 0x7d, 0xdb, 0x0e, 0x90, 0xf3, 0x64, 0xbf, 0x4f, 0xa1, 0xb9, 0x0e, 0xaf, 0xf6,
+	0xdb, 0x82, 0x2d, 0x1a, 0x43, 0x2d, 0xa5, 0xad, 0x74, 0x55, 0x2b, 0x09, 0x8d, 0x01, 0xd4, 0xdf,
+	0x05, 0x8e, 0x77, 0x82, 0x53, 0xc7, 0x4f, 0x0f, 0xfc, 0x02, 0xaa, 0x9e, 0xe3, 0x3b, 0xde, 0xdc,
+	0xfb, 0xdb, 0x89, 0x4f, 0xa7, 0xd4, 0xf1, 0xdf, 0xc6, 0x3c, 0x2b, 0x2d, 0x30, 0x3c, 0x50, 0xb3,
+	0x19, 0xb2, 0x0b, 0xd5, 0x71, 0x14, 0x8f, 0x1c, 0x5b, 0x8a, 0x6f, 0x5a, 0x5b, 0x32, 0x7e, 0x63,
+	0x93, 0x3d, 0x80, 0x31, 0xf3, 0x27, 0x23, 0x17, 0x43, 0x74, 0xe5, 0xf5, 0xd5, 0xac, 0xbb, 0x11,
+	0x72, 0x16, 0x01, 0x64, 0x1f, 0x6a, 0xb1, 0xe0, 0x88, 0x4d, 0x26, 0xd1, 0x05, 0x6f, 0xc8, 0x72,
+	0x35, 0x06, 0xcf, 0x25, 0x66, 0x98, 0x40, 0xb2, 0x03, 0x5c, 0x0f, 0x6c, 0xa3, 0x8b, 0x02, 0x53,
+	0xcd, 0x55, 0x68, 0xbc, 0x84, 0xdd, 0xf7, 0x33, 0x9b, 0x0a, 0xcc, 0x3a, 0x24, 0x19, 0x7c, 0x1f,
+	0x6a, 0xeb, 0x96, 0x8a, 0xb7, 0xa5, 0x86, 0x59, 0x37, 0x3d, 0x00, 0xad, 0xa8, 0x43, 0xac, 0x7c,
+	0xfc, 0x6b, 0x13, 0xd4, 0xc8, 0xab, 0xc1, 0x10, 0x83, 0xd0, 0x19, 0x23, 0xa1, 0xa0, 0x66, 0x3d,
+	0x4f, 0x0e, 0x0b, 0x56, 0x59, 0xf0, 0x56, 0xb4, 0x4e, 0x29, 0x6f, 0xe5, 0x85, 0x0a, 0xf9, 0x04,
+	0xdb, 0x19, 0x3b, 0x92, 0x83, 0x82, 0xca, 0xbc, 0xff, 0xb5, 0xc3, 0x32, 0x5a, 0xda, 0x9f, 0x82,
+	0x9a, 0x75, 0x21, 0xb9, 0xa1, 0xf2, 0x4f, 0xe3, 0x6b, 0x9d, 0x52, 0x5e, 0x56, 0x22, 0xeb, 0xdc,
+	0x42, 0x89, 0x02, 0xc7, 0x6b, 0x9d, 0x52, 0x5e, 0x2a, 0xf1, 0x11, 0xe0, 0xda, 0x29, 0xe4, 0x51,
+	0x41, 0x61, 0xee, 0x25, 0x68, 0x07, 0x25, 0xac, 0xb4, 0x39, 0x07, 0x92, 0x37, 0x05, 0x79, 0x52,
+	0x50, 0x7e, 0xa3, 0xfb, 0xb4, 0xa3, 0x7f, 0x64, 0x27, 0xa2, 0x27, 0x8f, 0xbf, 0x2f, 0x74, 0xe5,
+	0x6a, 0xa1, 0x2b, 0x3f, 0x17, 0xba, 0xf2, 0x6d, 0xa9, 0x57, 0xae, 0x96, 0x7a, 0xe5, 0xc7, 0x52,
+	0xaf, 0x7c, 0xa8, 0xe7, 0xfe, 0xe6, 0xcb, 0x3b, 0xf2, 0x67, 0x7e, 0xf6, 0x7b, 0x00, 0x57, 0x2a,
+	0x34, 0xd2, 0xe9, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// VoterServiceClient is the client API for VoterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type VoterServiceClient interface {
+	GetAvailable(ctx context.Context, in *GetAvailableRequest, opts ...grpc.CallOption) (*GetAvailableResponse, error)
+	SetProposed(ctx context.Context, in *SetProposedRequest, opts ...grpc.CallOption) (*SetProposedResponse, error)
+	SetCommitted(ctx context.Context, in *SetCommittedRequest, opts ...grpc.CallOption) (*SetCommittedResponse, error)
+	LocalAddress(ctx context.Context, in *LocalAddressRequest, opts ...grpc.CallOption) (*LocalAddressResponse, error)
+	TrimBehind(ctx context.Context, in *TrimBehindRequest, opts ...grpc.CallOption) (*TrimBehindResponse, error)
+	UpdateValidatorSet(ctx context.Context, in *UpdateValidatorSetRequest, opts ...grpc.CallOption) (*UpdateValidatorSetResponse, error)
+}
+
+type voterServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewVoterServiceClient(cc grpc1.ClientConn) VoterServiceClient {
+	return &voterServiceClient{cc}
+}
+
+func (c *voterServiceClient) GetAvailable(ctx context.Context, in *GetAvailableRequest, opts ...grpc.CallOption) (*GetAvailableResponse, error) {
+	out := new(GetAvailableResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.VoterService/GetAvailable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *voterServiceClient) SetProposed(ctx context.Context, in *SetProposedRequest, opts ...grpc.CallOption) (*SetProposedResponse, error) {
+	out := new(SetProposedResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.VoterService/SetProposed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *voterServiceClient) SetCommitted(ctx context.Context, in *SetCommittedRequest, opts ...grpc.CallOption) (*SetCommittedResponse, error) {
+	out := new(SetCommittedResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.VoterService/SetCommitted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *voterServiceClient) LocalAddress(ctx context.Context, in *LocalAddressRequest, opts ...grpc.CallOption) (*LocalAddressResponse, error) {
+	out := new(LocalAddressResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.VoterService/LocalAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *voterServiceClient) TrimBehind(ctx context.Context, in *TrimBehindRequest, opts ...grpc.CallOption) (*TrimBehindResponse, error) {
+	out := new(TrimBehindResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.VoterService/TrimBehind", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *voterServiceClient) UpdateValidatorSet(ctx context.Context, in *UpdateValidatorSetRequest, opts ...grpc.CallOption) (*UpdateValidatorSetResponse, error) {
+	out := new(UpdateValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.VoterService/UpdateValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VoterServiceServer is the server API for VoterService service.
+type VoterServiceServer interface {
+	GetAvailable(context.Context, *GetAvailableRequest) (*GetAvailableResponse, error)
+	SetProposed(context.Context, *SetProposedRequest) (*SetProposedResponse, error)
+	SetCommitted(context.Context, *SetCommittedRequest) (*SetCommittedResponse, error)
+	LocalAddress(context.Context, *LocalAddressRequest) (*LocalAddressResponse, error)
+	TrimBehind(context.Context, *TrimBehindRequest) (*TrimBehindResponse, error)
+	UpdateValidatorSet(context.Context, *UpdateValidatorSetRequest) (*UpdateValidatorSetResponse, error)
+}
+
+// UnimplementedVoterServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedVoterServiceServer struct {
+}
+
+func (*UnimplementedVoterServiceServer) GetAvailable(ctx context.Context, req *GetAvailableRequest) (*GetAvailableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailable not implemented")
+}
+func (*UnimplementedVoterServiceServer) SetProposed(ctx context.Context, req *SetProposedRequest) (*SetProposedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProposed not implemented")
+}
+func (*UnimplementedVoterServiceServer) SetCommitted(ctx context.Context, req *SetCommittedRequest) (*SetCommittedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommitted not implemented")
+}
+func (*UnimplementedVoterServiceServer) LocalAddress(ctx context.Context, req *LocalAddressRequest) (*LocalAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocalAddress not implemented")
+}
+func (*UnimplementedVoterServiceServer) TrimBehind(ctx context.Context, req *TrimBehindRequest) (*TrimBehindResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrimBehind not implemented")
+}
+func (*UnimplementedVoterServiceServer) UpdateValidatorSet(ctx context.Context, req *UpdateValidatorSetRequest) (*UpdateValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidatorSet not implemented")
+}
+
+func RegisterVoterServiceServer(s grpc1.Server, srv VoterServiceServer) {
+	s.RegisterService(&_VoterService_serviceDesc, srv)
+}
+
+func _VoterService_GetAvailable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VoterServiceServer).GetAvailable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.VoterService/GetAvailable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VoterServiceServer).GetAvailable(ctx, req.(*GetAvailableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VoterService_SetProposed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProposedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VoterServiceServer).SetProposed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.VoterService/SetProposed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VoterServiceServer).SetProposed(ctx, req.(*SetProposedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VoterService_SetCommitted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCommittedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VoterServiceServer).SetCommitted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.VoterService/SetCommitted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VoterServiceServer).SetCommitted(ctx, req.(*SetCommittedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VoterService_LocalAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocalAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VoterServiceServer).LocalAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.VoterService/LocalAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VoterServiceServer).LocalAddress(ctx, req.(*LocalAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VoterService_TrimBehind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrimBehindRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VoterServiceServer).TrimBehind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.VoterService/TrimBehind",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VoterServiceServer).TrimBehind(ctx, req.(*TrimBehindRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VoterService_UpdateValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateValidatorSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VoterServiceServer).UpdateValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.VoterService/UpdateValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VoterServiceServer).UpdateValidatorSet(ctx, req.(*UpdateValidatorSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var VoterService_serviceDesc = _VoterService_serviceDesc
+var _VoterService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "halo.attest.types.VoterService",
+	HandlerType: (*VoterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAvailable",
+			Handler:    _VoterService_GetAvailable_Handler,
+		},
+		{
+			MethodName: "SetProposed",
+			Handler:    _VoterService_SetProposed_Handler,
+		},
+		{
+			MethodName: "SetCommitted",
+			Handler:    _VoterService_SetCommitted_Handler,
+		},
+		{
+			MethodName: "LocalAddress",
+			Handler:    _VoterService_LocalAddress_Handler,
+		},
+		{
+			MethodName: "TrimBehind",
+			Handler:    _VoterService_TrimBehind_Handler,
+		},
+		{
+			MethodName: "UpdateValidatorSet",
+			Handler:    _VoterService_UpdateValidatorSet_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "halo/attest/types/voter.proto",
+}
+
+func (m *GetAvailableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAvailableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAvailableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAvailableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAvailableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAvailableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NeedsValidatorSet {
+		i--
+		if m.NeedsValidatorSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetProposedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetProposedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetProposedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetProposedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetProposedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetProposedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCommittedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCommittedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCommittedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCommittedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCommittedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCommittedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *LocalAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LocalAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LocalAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *LocalAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LocalAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LocalAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVoter(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrimBehindRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrimBehindRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrimBehindRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Minimums) > 0 {
+		for iNdEx := len(m.Minimums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minimums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainMinimum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainMinimum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainMinimum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AttestOffset != 0 {
+		i = encodeVarintVoter(dAtA, i, uint64(m.AttestOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConfLevel != 0 {
+		i = encodeVarintVoter(dAtA, i, uint64(m.ConfLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintVoter(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrimBehindResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrimBehindResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrimBehindResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deleted != 0 {
+		i = encodeVarintVoter(dAtA, i, uint64(m.Deleted))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateValidatorSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorSet) > 0 {
+		i -= len(m.ValidatorSet)
+		copy(dAtA[i:], m.ValidatorSet)
+		i = encodeVarintVoter(dAtA, i, uint64(len(m.ValidatorSet)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVoter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVoter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetAvailableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetAvailableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovVoter(uint64(l))
+		}
+	}
+	if m.NeedsValidatorSet {
+		n += 2
+	}
+	return n
+}
+
+func (m *SetProposedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovVoter(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetProposedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SetCommittedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovVoter(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetCommittedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LocalAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LocalAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVoter(uint64(l))
+	}
+	return n
+}
+
+func (m *TrimBehindRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Minimums) > 0 {
+		for _, e := range m.Minimums {
+			l = e.Size()
+			n += 1 + l + sovVoter(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainMinimum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovVoter(uint64(m.ChainId))
+	}
+	if m.ConfLevel != 0 {
+		n += 1 + sovVoter(uint64(m.ConfLevel))
+	}
+	if m.AttestOffset != 0 {
+		n += 1 + sovVoter(uint64(m.AttestOffset))
+	}
+	return n
+}
+
+func (m *TrimBehindResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deleted != 0 {
+		n += 1 + sovVoter(uint64(m.Deleted))
+	}
+	return n
+}
+
+func (m *UpdateValidatorSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorSet)
+	if l > 0 {
+		n += 1 + l + sovVoter(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovVoter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVoter(x uint64) (n int) {
+	return sovVoter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetAvailableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAvailableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAvailableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAvailableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAvailableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAvailableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NeedsValidatorSet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NeedsValidatorSet = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetProposedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetProposedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetProposedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &AttestHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetProposedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetProposedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetProposedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetCommittedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCommittedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCommittedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &AttestHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetCommittedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCommittedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCommittedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocalAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocalAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocalAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocalAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocalAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocalAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrimBehindRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrimBehindRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrimBehindRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minimums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minimums = append(m.Minimums, &ChainMinimum{})
+			if err := m.Minimums[len(m.Minimums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainMinimum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainMinimum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainMinimum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestOffset", wireType)
+			}
+			m.AttestOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrimBehindResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrimBehindResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrimBehindResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			m.Deleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deleted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateValidatorSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateValidatorSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSet = append(m.ValidatorSet[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorSet == nil {
+				m.ValidatorSet = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVoter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVoter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVoter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVoter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVoter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVoter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVoter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVoter = fmt.Errorf("proto: unexpected end of group")
+)
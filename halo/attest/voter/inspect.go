@@ -0,0 +1,148 @@
+package voter
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/xchain"
+
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// OffsetRange summarises the attest offsets of a set of votes.
+type OffsetRange struct {
+	Count int
+	Min   uint64
+	Max   uint64
+}
+
+// Contains returns true if the offset is within the range.
+func (r OffsetRange) Contains(offset uint64) bool {
+	return r.Count > 0 && r.Min <= offset && offset <= r.Max
+}
+
+func (r OffsetRange) String() string {
+	if r.Count == 0 {
+		return "0"
+	}
+
+	return fmt.Sprintf("%d [%d-%d]", r.Count, r.Min, r.Max)
+}
+
+func (r OffsetRange) add(offset uint64) OffsetRange {
+	if r.Count == 0 || offset < r.Min {
+		r.Min = offset
+	}
+	if r.Count == 0 || offset > r.Max {
+		r.Max = offset
+	}
+	r.Count++
+
+	return r
+}
+
+// ChainState summarises the persisted voter state of a chain version.
+type ChainState struct {
+	ChainVersion xchain.ChainVersion
+	Latest       uint64 // Latest voted attest offset, zero if none.
+	Available    OffsetRange
+	Proposed     OffsetRange
+	Committed    OffsetRange
+}
+
+// InspectState returns the persisted voter state per chain version, ordered by chain version.
+func InspectState(db dbm.DB) ([]ChainState, error) {
+	s, err := newStore(db).Load()
+	if err != nil {
+		return nil, err
+	}
+
+	states := make(map[xchain.ChainVersion]ChainState)
+	update := func(votes []*types.Vote, fn func(*ChainState, uint64)) {
+		for _, vote := range votes {
+			chainVer := vote.AttestHeader.XChainVersion()
+			state := states[chainVer]
+			state.ChainVersion = chainVer
+			fn(&state, vote.AttestHeader.AttestOffset)
+			states[chainVer] = state
+		}
+	}
+
+	update(s.Latest, func(s *ChainState, offset uint64) { s.Latest = offset })
+	update(s.Available, func(s *ChainState, offset uint64) { s.Available = s.Available.add(offset) })
+	update(s.Proposed, func(s *ChainState, offset uint64) { s.Proposed = s.Proposed.add(offset) })
+	update(s.Committed, func(s *ChainState, offset uint64) { s.Committed = s.Committed.add(offset) })
+
+	var resp []ChainState
+	for _, state := range states {
+		resp = append(resp, state)
+	}
+
+	sort.Slice(resp, func(i, j int) bool {
+		if resp[i].ChainVersion.ID != resp[j].ChainVersion.ID {
+			return resp[i].ChainVersion.ID < resp[j].ChainVersion.ID
+		}
+
+		return resp[i].ChainVersion.ConfLevel < resp[j].ChainVersion.ConfLevel
+	})
+
+	return resp, nil
+}
+
+// ResetChain resets the persisted voter state of the chain version so that the voter resumes voting
+// from the provided (on-chain) approved attest offset. It deletes the latest vote and all votes after the approved offset.
+// It returns the number of deleted votes.
+//
+// It returns an error if the latest vote isn't after the approved offset, since the voter
+// already resumes from the approved offset in that case.
+// It also returns an error when resetting a fuzzy chain version without force, since re-voting
+// reorged fuzzy blocks results in conflicting votes, i.e., double signing.
+// Note this must not be called while the voter is running.
+func ResetChain(db dbm.DB, chainVer xchain.ChainVersion, approvedOffset uint64, force bool) (int, error) {
+	if chainVer.ConfLevel.IsFuzzy() && !force {
+		return 0, errors.New("refusing to reset fuzzy chain version without force, risks double signing", "conf_level", chainVer.ConfLevel)
+	}
+
+	store := newStore(db)
+	s, err := store.Load()
+	if err != nil {
+		return 0, err
+	}
+
+	var latest uint64
+	for _, vote := range s.Latest {
+		if vote.AttestHeader.XChainVersion() == chainVer {
+			latest = vote.AttestHeader.AttestOffset
+		}
+	}
+	if latest <= approvedOffset {
+		return 0, errors.New("latest vote not after approved offset, nothing to reset", "latest", latest, "approved", approvedOffset)
+	}
+
+	keep := func(votes []*types.Vote, keepFn func(*types.Vote) bool) []*types.Vote {
+		var resp []*types.Vote
+		for _, vote := range votes {
+			if vote.AttestHeader.XChainVersion() != chainVer || keepFn(vote) {
+				resp = append(resp, vote)
+			}
+		}
+
+		return resp
+	}
+	isApproved := func(vote *types.Vote) bool { return vote.AttestHeader.AttestOffset <= approvedOffset }
+
+	total := len(s.Available) + len(s.Proposed) + len(s.Committed)
+	s.Available = keep(s.Available, isApproved)
+	s.Proposed = keep(s.Proposed, isApproved)
+	s.Committed = keep(s.Committed, isApproved)
+	s.Latest = keep(s.Latest, func(*types.Vote) bool { return false })
+	deleted := total - (len(s.Available) + len(s.Proposed) + len(s.Committed))
+
+	if err := store.Save(s); err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,98 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"os"
+
+	"github.com/omni-network/omni/lib/errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	tokenLen  = 32
+	authKey   = "authorization"
+	tokenType = "Bearer "
+)
+
+// LoadToken returns the hex encoded auth token from the file.
+func LoadToken(file string) ([]byte, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "read voter auth token file")
+	}
+
+	token, err := hex.DecodeString(string(bytes.TrimSpace(bz)))
+	if err != nil {
+		return nil, errors.Wrap(err, "decode voter auth token")
+	} else if len(token) != tokenLen {
+		return nil, errors.New("invalid voter auth token length", "len", len(token))
+	}
+
+	return token, nil
+}
+
+// LoadOrGenerateToken returns the auth token from the file, or generates
+// a new random token and writes it to the file if it doesn't exist.
+func LoadOrGenerateToken(file string) ([]byte, error) {
+	if _, err := os.Stat(file); err == nil {
+		return LoadToken(file)
+	} else if !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "stat voter auth token file")
+	}
+
+	token := make([]byte, tokenLen)
+	if _, err := rand.Read(token); err != nil {
+		return nil, errors.Wrap(err, "generate voter auth token")
+	}
+
+	if err := os.WriteFile(file, []byte(hex.EncodeToString(token)), 0o600); err != nil {
+		return nil, errors.Wrap(err, "write voter auth token file")
+	}
+
+	return token, nil
+}
+
+// authInterceptor returns a gRPC server interceptor that rejects requests without the auth token.
+func authInterceptor(token []byte) grpc.UnaryServerInterceptor {
+	expected := []byte(tokenType + hex.EncodeToString(token))
+
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "missing metadata")
+		}
+
+		values := md.Get(authKey)
+		if len(values) != 1 || subtle.ConstantTimeCompare([]byte(values[0]), expected) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "invalid auth token")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+var _ credentials.PerRPCCredentials = tokenCreds{}
+
+// tokenCreds adds the auth token to each request.
+type tokenCreds struct {
+	token []byte
+}
+
+func (c tokenCreds) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{authKey: tokenType + hex.EncodeToString(c.token)}, nil
+}
+
+// RequireTransportSecurity returns false since plaintext is allowed for local connections,
+// Dial ensures TLS is used for non-local connections.
+func (tokenCreds) RequireTransportSecurity() bool {
+	return false
+}
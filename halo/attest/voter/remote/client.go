@@ -0,0 +1,350 @@
+package remote
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/omni-network/omni/halo/attest/types"
+	vtypes "github.com/omni-network/omni/halo/valsync/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// callTimeout bounds remote calls, since they are made from the consensus path (e.g. ExtendVote).
+	callTimeout = 500 * time.Millisecond
+	// retryPeriod is the period with which failed updates are retried in the background.
+	retryPeriod        = time.Second
+	maxCachedCommitted = 100_000 // Bounds memory if the remote voter is unavailable for a long time.
+)
+
+var _ types.Voter = (*Client)(nil)
+
+// Client implements types.Voter by calling a remote voter via gRPC.
+//
+// Since the remote voter may be unavailable (e.g. restarting), consensus must not fail or stall
+// due to remote errors. Updates are therefore buffered and sent to the remote voter asynchronously,
+// failed updates are retried periodically in the background, and synchronous queries use a short deadline.
+type Client struct {
+	ctx     context.Context //nolint:containedctx // Only used for logging and as parent of request contexts.
+	cancel  context.CancelFunc
+	conn    *grpc.ClientConn
+	cl      types.VoterServiceClient
+	timeout time.Duration
+	flushCh chan struct{} // Triggers an async flush.
+	flushMu chan struct{} // Serializes flushes so updates are sent in order, acquired with a deadline.
+
+	mu         sync.Mutex
+	localAddr  common.Address
+	lastValSet *vtypes.ValidatorSetResponse
+	syncValSet bool // True if lastValSet must be sent to the remote voter.
+	proposed   []*types.AttestHeader
+	committed  []*types.AttestHeader
+}
+
+// Dial returns a new client connected to the remote voter at the target address.
+// The connection is established lazily, so the remote voter doesn't need to be available yet.
+// Buffered updates are flushed in the background until the client is closed.
+// The tlsCreds are required for non-local targets, since the auth token is sent with each request;
+// nil uses plaintext for loopback addresses and unix sockets.
+func Dial(ctx context.Context, target string, token []byte, tlsCreds credentials.TransportCredentials) (*Client, error) {
+	creds, err := transportCreds(target, tlsCreds)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.NewClient(target,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithPerRPCCredentials(tokenCreds{token: token}),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(gogoCodec{})),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "new grpc client", "target", target)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	c := &Client{
+		ctx:     ctx,
+		cancel:  cancel,
+		conn:    conn,
+		cl:      types.NewVoterServiceClient(conn),
+		timeout: callTimeout,
+		flushCh: make(chan struct{}, 1),
+		flushMu: make(chan struct{}, 1),
+	}
+
+	go c.flushForever()
+
+	return c, nil
+}
+
+// Close stops the background flushing and closes the underlying connection.
+func (c *Client) Close() error {
+	c.cancel()
+	return c.conn.Close()
+}
+
+// GetAvailable returns all available votes of the remote voter.
+// Buffered updates are flushed first, so votes that were already proposed or committed are not returned.
+// It returns nil if the remote voter is unavailable or slow, i.e., no votes are included in the vote extension.
+func (c *Client) GetAvailable() []*types.Vote {
+	ctx, cancel := context.WithTimeout(c.ctx, c.timeout)
+	defer cancel()
+
+	if err := c.flush(ctx); err != nil {
+		log.Warn(ctx, "Remote voter flush failed, not voting this block (will retry in background)", err)
+		return nil
+	}
+
+	resp, err := c.cl.GetAvailable(ctx, &types.GetAvailableRequest{})
+	if err != nil {
+		log.Warn(ctx, "Remote voter get available failed, not voting this block", err)
+		return nil
+	}
+
+	if resp.GetNeedsValidatorSet() {
+		c.mu.Lock()
+		if c.lastValSet != nil {
+			// Remote voter restarted, resend the validator set.
+			c.syncValSet = true
+			c.triggerFlush()
+		}
+		c.mu.Unlock()
+	}
+
+	return resp.GetVotes()
+}
+
+func (c *Client) SetProposed(headers []*types.AttestHeader) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Only the latest proposed headers are relevant, since SetProposed resets all other proposed votes.
+	c.proposed = headers
+	c.triggerFlush()
+
+	return nil
+}
+
+func (c *Client) SetCommitted(headers []*types.AttestHeader) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.committed = boundCommitted(append(c.committed, headers...))
+	c.triggerFlush()
+
+	return nil
+}
+
+// LocalAddress returns the remote voter's address. It is cached after the first successful request.
+// It returns the zero address if the remote voter is unavailable, in which case the next call queries it again.
+func (c *Client) LocalAddress() common.Address {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.localAddr != (common.Address{}) {
+		return c.localAddr
+	}
+
+	ctx, cancel := context.WithTimeout(c.ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.cl.LocalAddress(ctx, &types.LocalAddressRequest{})
+	if err != nil {
+		log.Warn(ctx, "Remote voter local address failed", err)
+		return common.Address{}
+	} else if len(resp.GetAddress()) != common.AddressLength {
+		log.Warn(ctx, "Remote voter returned invalid local address", nil, "len", len(resp.GetAddress()))
+		return common.Address{}
+	}
+
+	c.localAddr = common.BytesToAddress(resp.GetAddress())
+
+	return c.localAddr
+}
+
+// TrimBehind trims the remote voter's votes behind the provided minimums.
+// Failures are logged and otherwise ignored, since trimming is repeated every block.
+func (c *Client) TrimBehind(minsByChain map[xchain.ChainVersion]uint64) int {
+	ctx, cancel := context.WithTimeout(c.ctx, c.timeout)
+	defer cancel()
+
+	req := new(types.TrimBehindRequest)
+	for chainVer, offset := range minsByChain {
+		req.Minimums = append(req.Minimums, &types.ChainMinimum{
+			ChainId:      chainVer.ID,
+			ConfLevel:    uint32(chainVer.ConfLevel),
+			AttestOffset: offset,
+		})
+	}
+
+	resp, err := c.cl.TrimBehind(ctx, req)
+	if err != nil {
+		log.Warn(ctx, "Remote voter trim behind failed (will trim next block)", err)
+		return 0
+	}
+
+	return int(resp.GetDeleted())
+}
+
+func (c *Client) UpdateValidatorSet(set *vtypes.ValidatorSetResponse) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lastValSet = set
+	c.syncValSet = true
+	c.triggerFlush()
+
+	return nil
+}
+
+// triggerFlush triggers an async flush of buffered updates, without blocking.
+func (c *Client) triggerFlush() {
+	select {
+	case c.flushCh <- struct{}{}:
+	default: // Flush already triggered.
+	}
+}
+
+// flushForever flushes buffered updates when triggered, and periodically retries failed updates,
+// until the client is closed.
+func (c *Client) flushForever() {
+	ticker := time.NewTicker(retryPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-c.flushCh:
+		case <-ticker.C:
+			if !c.hasPending() {
+				continue
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(c.ctx, c.timeout)
+		if err := c.flush(ctx); err != nil {
+			log.Warn(ctx, "Remote voter update failed (will retry)", err)
+		}
+		cancel()
+	}
+}
+
+// hasPending returns true if there are buffered updates.
+func (c *Client) hasPending() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.syncValSet || len(c.proposed) > 0 || len(c.committed) > 0
+}
+
+// flush sends all buffered updates to the remote voter, in order.
+// Updates that could not be sent are buffered again, to be retried.
+// The lock isn't held while sending, so consensus isn't blocked by a slow remote voter.
+func (c *Client) flush(ctx context.Context) error {
+	select {
+	case c.flushMu <- struct{}{}:
+		defer func() { <-c.flushMu }()
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "wait for concurrent flush")
+	}
+
+	c.mu.Lock()
+	var valset *vtypes.ValidatorSetResponse
+	if c.syncValSet {
+		valset = c.lastValSet
+	}
+	proposed, committed := c.proposed, c.committed
+	c.syncValSet, c.proposed, c.committed = false, nil, nil
+	c.mu.Unlock()
+
+	// requeue buffers the unsent updates again, unless superseded by newer updates.
+	requeue := func(valset *vtypes.ValidatorSetResponse, proposed, committed []*types.AttestHeader) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		if valset != nil && valset == c.lastValSet {
+			c.syncValSet = true
+		}
+		if len(proposed) > 0 && c.proposed == nil {
+			c.proposed = proposed
+		}
+		c.committed = boundCommitted(append(committed, c.committed...))
+	}
+
+	if valset != nil {
+		bz, err := proto.Marshal(valset)
+		if err != nil {
+			requeue(valset, proposed, committed)
+			return errors.Wrap(err, "marshal validator set")
+		}
+
+		_, err = c.cl.UpdateValidatorSet(ctx, &types.UpdateValidatorSetRequest{ValidatorSet: bz})
+		if err != nil {
+			requeue(valset, proposed, committed)
+			return errors.Wrap(err, "remote update validator set")
+		}
+	}
+
+	if len(proposed) > 0 {
+		_, err := c.cl.SetProposed(ctx, &types.SetProposedRequest{Headers: proposed})
+		if err != nil {
+			requeue(nil, proposed, committed)
+			return errors.Wrap(err, "remote set proposed")
+		}
+	}
+
+	if len(committed) > 0 {
+		_, err := c.cl.SetCommitted(ctx, &types.SetCommittedRequest{Headers: committed})
+		if err != nil {
+			requeue(nil, nil, committed)
+			return errors.Wrap(err, "remote set committed")
+		}
+	}
+
+	return nil
+}
+
+// boundCommitted drops the oldest committed headers if there are too many,
+// they will be trimmed by the remote voter anyway.
+func boundCommitted(committed []*types.AttestHeader) []*types.AttestHeader {
+	if len(committed) <= maxCachedCommitted {
+		return committed
+	}
+
+	return committed[len(committed)-maxCachedCommitted:]
+}
+
+// gogoCodec is a gRPC codec that uses gogoproto marshalling.
+type gogoCodec struct{}
+
+func (gogoCodec) Marshal(v any) ([]byte, error) {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil, errors.New("not a proto message")
+	}
+
+	return proto.Marshal(msg)
+}
+
+func (gogoCodec) Unmarshal(data []byte, v any) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return errors.New("not a proto message")
+	}
+
+	return proto.Unmarshal(data, msg)
+}
+
+func (gogoCodec) Name() string {
+	return "proto"
+}
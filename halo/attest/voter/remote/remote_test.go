@@ -0,0 +1,281 @@
+package remote_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/halo/attest/testutil"
+	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/halo/attest/voter/remote"
+	vtypes "github.com/omni-network/omni/halo/valsync/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/tutil"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/credentials"
+)
+
+func TestRemoteVoter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	voter := testutil.NewMockVoter(ctrl)
+
+	token, err := remote.LoadOrGenerateToken(filepath.Join(t.TempDir(), "token"))
+	require.NoError(t, err)
+
+	addr := serve(t, voter, token)
+	cl, err := remote.Dial(ctx, addr, token, nil)
+	require.NoError(t, err)
+	defer cl.Close()
+
+	localAddr := tutil.RandomAddress()
+	voter.EXPECT().LocalAddress().Return(localAddr).Times(1) // Cached after first call.
+	require.Equal(t, localAddr, cl.LocalAddress())
+	require.Equal(t, localAddr, cl.LocalAddress())
+
+	valset := &vtypes.ValidatorSetResponse{Id: 1, Validators: []*vtypes.Validator{{ConsensusPubkey: []byte{1}, Power: 1}}}
+	voter.EXPECT().UpdateValidatorSet(gomock.Any()).DoAndReturn(func(set *vtypes.ValidatorSetResponse) error {
+		require.Equal(t, valset.Id, set.Id)
+		require.Len(t, set.Validators, 1)
+
+		return nil
+	})
+	require.NoError(t, cl.UpdateValidatorSet(valset))
+
+	header := &types.AttestHeader{SourceChainId: 1, ConfLevel: uint32(xchain.ConfFinalized), AttestOffset: 2}
+	voter.EXPECT().SetProposed(gomock.Len(1)).Return(nil)
+	require.NoError(t, cl.SetProposed([]*types.AttestHeader{header}))
+	voter.EXPECT().SetCommitted(gomock.Len(1)).Return(nil)
+	require.NoError(t, cl.SetCommitted([]*types.AttestHeader{header}))
+
+	vote := &types.Vote{AttestHeader: header}
+	voter.EXPECT().GetAvailable().Return([]*types.Vote{vote})
+	available := cl.GetAvailable()
+	require.Len(t, available, 1)
+	require.Equal(t, header.AttestOffset, available[0].GetAttestHeader().GetAttestOffset())
+
+	mins := map[xchain.ChainVersion]uint64{header.XChainVersion(): 3}
+	voter.EXPECT().TrimBehind(mins).Return(1)
+	require.Equal(t, 1, cl.TrimBehind(mins))
+}
+
+func TestRemoteVoterRetry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	voter := testutil.NewMockVoter(ctrl)
+
+	token, err := remote.LoadOrGenerateToken(filepath.Join(t.TempDir(), "token"))
+	require.NoError(t, err)
+
+	addr := serve(t, voter, token)
+	cl, err := remote.Dial(ctx, addr, token, nil)
+	require.NoError(t, err)
+	defer cl.Close()
+
+	// Failed updates are retried in the background, without failing the caller.
+	header := &types.AttestHeader{SourceChainId: 1, ConfLevel: uint32(xchain.ConfFinalized), AttestOffset: 1}
+	retried := make(chan struct{})
+	gomock.InOrder(
+		voter.EXPECT().SetCommitted(gomock.Len(1)).Return(errors.New("unavailable")),
+		voter.EXPECT().SetCommitted(gomock.Len(1)).DoAndReturn(func([]*types.AttestHeader) error {
+			close(retried)
+			return nil
+		}),
+	)
+	require.NoError(t, cl.SetCommitted([]*types.AttestHeader{header}))
+
+	select {
+	case <-retried:
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "failed update not retried")
+	}
+}
+
+func TestRemoteVoterSlow(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	voter := testutil.NewMockVoter(ctrl)
+
+	token, err := remote.LoadOrGenerateToken(filepath.Join(t.TempDir(), "token"))
+	require.NoError(t, err)
+
+	addr := serve(t, voter, token)
+	cl, err := remote.Dial(ctx, addr, token, nil)
+	require.NoError(t, err)
+	defer cl.Close()
+
+	// A slow remote voter doesn't block consensus: queries are bounded by a short deadline.
+	unblock := make(chan struct{})
+	defer close(unblock)
+	voter.EXPECT().GetAvailable().DoAndReturn(func() []*types.Vote {
+		<-unblock
+		return nil
+	}).AnyTimes()
+
+	t0 := time.Now()
+	require.Empty(t, cl.GetAvailable())
+	require.Less(t, time.Since(t0), 2*time.Second)
+
+	// Updates are buffered and return immediately.
+	t0 = time.Now()
+	voter.EXPECT().SetCommitted(gomock.Any()).Return(nil).AnyTimes()
+	require.NoError(t, cl.SetCommitted([]*types.AttestHeader{{AttestOffset: 1}}))
+	require.Less(t, time.Since(t0), 100*time.Millisecond)
+}
+
+func TestRemoteVoterAuth(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	voter := testutil.NewMockVoter(gomock.NewController(t))
+
+	token, err := remote.LoadOrGenerateToken(filepath.Join(dir, "token"))
+	require.NoError(t, err)
+	other, err := remote.LoadOrGenerateToken(filepath.Join(dir, "other"))
+	require.NoError(t, err)
+	require.NotEqual(t, token, other)
+
+	// Existing tokens are loaded.
+	loaded, err := remote.LoadOrGenerateToken(filepath.Join(dir, "token"))
+	require.NoError(t, err)
+	require.Equal(t, token, loaded)
+
+	addr := serve(t, voter, token)
+	cl, err := remote.Dial(ctx, addr, other, nil)
+	require.NoError(t, err)
+	defer cl.Close()
+
+	// Unauthenticated requests are rejected, but never fail consensus.
+	require.Empty(t, cl.GetAvailable())
+	require.NoError(t, cl.SetCommitted([]*types.AttestHeader{{AttestOffset: 1}}))
+	require.Zero(t, cl.LocalAddress())
+}
+
+func TestRemoteVoterTransport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	voter := testutil.NewMockVoter(gomock.NewController(t))
+	localAddr := tutil.RandomAddress()
+	voter.EXPECT().LocalAddress().Return(localAddr).AnyTimes()
+
+	token, err := remote.LoadOrGenerateToken(filepath.Join(dir, "token"))
+	require.NoError(t, err)
+
+	// Plaintext is only allowed for local addresses.
+	require.True(t, remote.IsLocal("127.0.0.1:26660"))
+	require.True(t, remote.IsLocal("localhost:26660"))
+	require.True(t, remote.IsLocal("unix:///tmp/voter.sock"))
+	require.False(t, remote.IsLocal("10.0.0.1:26660"))
+	require.False(t, remote.IsLocal("voter:26660"))
+
+	_, err = remote.Dial(ctx, "10.0.0.1:26660", token, nil)
+	require.ErrorContains(t, err, "tls required")
+
+	// Unix sockets are served in plaintext.
+	socket := "unix://" + filepath.Join(dir, "voter.sock")
+	lis, err := remote.Listen(socket)
+	require.NoError(t, err)
+	serveOn(t, lis, voter, token, nil)
+
+	cl, err := remote.Dial(ctx, socket, token, nil)
+	require.NoError(t, err)
+	defer cl.Close()
+	require.Equal(t, localAddr, cl.LocalAddress())
+
+	// TLS is verified against the CA certificate.
+	certFile, keyFile := genCert(t, dir)
+	serverCreds, err := remote.LoadServerTLS(certFile, keyFile)
+	require.NoError(t, err)
+	clientCreds, err := remote.LoadClientTLS(certFile)
+	require.NoError(t, err)
+
+	lis, err = remote.Listen("127.0.0.1:0")
+	require.NoError(t, err)
+	serveOn(t, lis, voter, token, serverCreds)
+
+	tlsCl, err := remote.Dial(ctx, lis.Addr().String(), token, clientCreds)
+	require.NoError(t, err)
+	defer tlsCl.Close()
+	require.Equal(t, localAddr, tlsCl.LocalAddress())
+
+	// Plaintext clients can't connect to TLS servers.
+	plainCl, err := remote.Dial(ctx, lis.Addr().String(), token, nil)
+	require.NoError(t, err)
+	defer plainCl.Close()
+	require.Zero(t, plainCl.LocalAddress())
+}
+
+func serve(t *testing.T, voter types.Voter, token []byte) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	serveOn(t, lis, voter, token, nil)
+
+	return lis.Addr().String()
+}
+
+func serveOn(t *testing.T, lis net.Listener, voter types.Voter, token []byte, tlsCreds credentials.TransportCredentials) {
+	t.Helper()
+
+	srv := remote.NewServer(voter, token, tlsCreds)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+}
+
+// genCert writes a self-signed 127.0.0.1 certificate and its key to the directory and returns their paths.
+func genCert(t *testing.T, dir string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "voter"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, "voter.crt")
+	keyFile := filepath.Join(dir, "voter.key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	return certFile, keyFile
+}
@@ -0,0 +1,99 @@
+// Package remote provides an authenticated gRPC API that allows the voter to run
+// in a separate (sidecar) process with its own key custody.
+//
+// The sidecar serves a types.Voter via NewServer and halo connects to it
+// via Dial which returns a client implementing types.Voter.
+package remote
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/omni-network/omni/halo/attest/types"
+	vtypes "github.com/omni-network/omni/halo/valsync/types"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// NewServer returns a new gRPC server that serves the provided voter.
+// All requests must be authenticated with the provided token.
+// The server uses TLS if tlsCreds is non-nil, which is required if it isn't bound to a local address.
+func NewServer(voter types.Voter, token []byte, tlsCreds credentials.TransportCredentials) *grpc.Server {
+	opts := []grpc.ServerOption{
+		grpc.ForceServerCodec(gogoCodec{}),
+		grpc.ChainUnaryInterceptor(authInterceptor(token)),
+	}
+	if tlsCreds != nil {
+		opts = append(opts, grpc.Creds(tlsCreds))
+	}
+
+	srv := grpc.NewServer(opts...)
+	types.RegisterVoterServiceServer(srv, &server{voter: voter})
+
+	return srv
+}
+
+var _ types.VoterServiceServer = (*server)(nil)
+
+type server struct {
+	voter     types.Voter
+	hasValSet atomic.Bool
+}
+
+func (s *server) GetAvailable(context.Context, *types.GetAvailableRequest) (*types.GetAvailableResponse, error) {
+	return &types.GetAvailableResponse{
+		Votes:             s.voter.GetAvailable(),
+		NeedsValidatorSet: !s.hasValSet.Load(),
+	}, nil
+}
+
+func (s *server) SetProposed(_ context.Context, req *types.SetProposedRequest) (*types.SetProposedResponse, error) {
+	if err := s.voter.SetProposed(req.GetHeaders()); err != nil {
+		return nil, err
+	}
+
+	return &types.SetProposedResponse{}, nil
+}
+
+func (s *server) SetCommitted(_ context.Context, req *types.SetCommittedRequest) (*types.SetCommittedResponse, error) {
+	if err := s.voter.SetCommitted(req.GetHeaders()); err != nil {
+		return nil, err
+	}
+
+	return &types.SetCommittedResponse{}, nil
+}
+
+func (s *server) LocalAddress(context.Context, *types.LocalAddressRequest) (*types.LocalAddressResponse, error) {
+	return &types.LocalAddressResponse{Address: s.voter.LocalAddress().Bytes()}, nil
+}
+
+func (s *server) TrimBehind(_ context.Context, req *types.TrimBehindRequest) (*types.TrimBehindResponse, error) {
+	minsByChain := make(map[xchain.ChainVersion]uint64, len(req.GetMinimums()))
+	for _, m := range req.GetMinimums() {
+		chainVer := xchain.ChainVersion{ID: m.GetChainId(), ConfLevel: xchain.ConfLevel(m.GetConfLevel())}
+		minsByChain[chainVer] = m.GetAttestOffset()
+	}
+
+	deleted := s.voter.TrimBehind(minsByChain)
+
+	return &types.TrimBehindResponse{Deleted: uint64(deleted)}, nil
+}
+
+func (s *server) UpdateValidatorSet(_ context.Context, req *types.UpdateValidatorSetRequest) (*types.UpdateValidatorSetResponse, error) {
+	set := new(vtypes.ValidatorSetResponse)
+	if err := proto.Unmarshal(req.GetValidatorSet(), set); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator set")
+	}
+
+	if err := s.voter.UpdateValidatorSet(set); err != nil {
+		return nil, err
+	}
+	s.hasValSet.Store(true)
+
+	return &types.UpdateValidatorSetResponse{}, nil
+}
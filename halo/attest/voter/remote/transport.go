@@ -0,0 +1,93 @@
+package remote
+
+import (
+	"net"
+	"strings"
+
+	"github.com/omni-network/omni/lib/errors"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	unixScheme    = "unix://"
+	unixSchemeAlt = "unix:"
+)
+
+// Listen returns a listener on the voter gRPC API address.
+// The address is either a unix socket ("unix:///path/to/voter.sock") or a TCP host:port.
+func Listen(address string) (net.Listener, error) {
+	network, addr := "tcp", address
+	if path, ok := unixPath(address); ok {
+		network, addr = "unix", path
+	}
+
+	lis, err := net.Listen(network, addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "listen", "address", address)
+	}
+
+	return lis, nil
+}
+
+// IsLocal returns true if the address is a unix socket or a loopback TCP address.
+// Only local addresses may be used without TLS, since the auth token is sent with each request.
+func IsLocal(address string) bool {
+	if _, ok := unixPath(address); ok {
+		return true
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	} else if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
+
+// LoadServerTLS returns the voter server's TLS credentials from the PEM encoded certificate and key files.
+func LoadServerTLS(certFile, keyFile string) (credentials.TransportCredentials, error) {
+	creds, err := credentials.NewServerTLSFromFile(certFile, keyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "load voter tls certificate")
+	}
+
+	return creds, nil
+}
+
+// LoadClientTLS returns the client TLS credentials that verify the voter server's certificate
+// against the PEM encoded CA certificate file.
+func LoadClientTLS(caFile string) (credentials.TransportCredentials, error) {
+	creds, err := credentials.NewClientTLSFromFile(caFile, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "load voter tls ca certificate")
+	}
+
+	return creds, nil
+}
+
+// transportCreds returns the transport credentials for the address.
+// It returns an error if TLS isn't configured for non-local addresses, since the auth token would be sent in plaintext.
+func transportCreds(address string, tlsCreds credentials.TransportCredentials) (credentials.TransportCredentials, error) {
+	if tlsCreds != nil {
+		return tlsCreds, nil
+	} else if !IsLocal(address) {
+		return nil, errors.New("tls required for non-local voter address, use a loopback address or unix socket otherwise", "address", address)
+	}
+
+	return insecure.NewCredentials(), nil
+}
+
+// unixPath returns the unix socket path if the address has the unix scheme.
+func unixPath(address string) (string, bool) {
+	if path, ok := strings.CutPrefix(address, unixScheme); ok {
+		return path, true
+	}
+
+	return strings.CutPrefix(address, unixSchemeAlt)
+}
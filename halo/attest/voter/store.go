@@ -0,0 +1,307 @@
+package voter
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+
+	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/xchain"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+const (
+	stateDBName     = "voter_state"
+	stateDBBackend  = dbm.GoLevelDBBackend
+	migratedPostfix = ".migrated"
+)
+
+// Key prefixes of the voter state database.
+// Votes are keyed by prefix|chainID|confLevel|attestOffset, latest votes by prefix|chainID|confLevel.
+const (
+	prefixAvailable byte = iota + 1
+	prefixProposed
+	prefixCommitted
+	prefixLatest
+)
+
+// StateDBExists returns true if the voter state database exists in the directory.
+func StateDBExists(dir string) bool {
+	return dbm.FileExists(filepath.Join(dir, stateDBName+".db"))
+}
+
+// GenEmptyState generates an empty voter state database in the directory.
+// This must be called before OpenState unless a legacy JSON state file exists.
+func GenEmptyState(dir string) error {
+	db, err := dbm.NewDB(stateDBName, stateDBBackend, dir)
+	if err != nil {
+		return errors.Wrap(err, "create voter state db")
+	}
+
+	return db.Close()
+}
+
+// OpenState opens the voter state database in the directory.
+// If the legacy JSON state file exists, it is migrated to the database and renamed with a ".migrated" postfix.
+// It returns an error if neither the database nor the legacy file exists, since missing voter state
+// risks double signing.
+func OpenState(dir string, legacyFile string) (dbm.DB, error) {
+	legacyExists := legacyFile != "" && dbm.FileExists(legacyFile)
+	if !legacyExists && !StateDBExists(dir) {
+		return nil, errors.New("voter state not found", "dir", dir)
+	}
+
+	db, err := dbm.NewDB(stateDBName, stateDBBackend, dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "open voter state db")
+	}
+
+	if legacyExists {
+		if err := migrateLegacy(db, legacyFile, true); err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+	}
+
+	return db, nil
+}
+
+// OpenStateCopy returns an in-memory copy of the voter state in the directory, including the
+// legacy JSON state file if not migrated yet. Changes to the copy are not persisted, which is useful for dry-runs.
+func OpenStateCopy(dir string, legacyFile string) (dbm.DB, error) {
+	legacyExists := legacyFile != "" && dbm.FileExists(legacyFile)
+	if !legacyExists && !StateDBExists(dir) {
+		return nil, errors.New("voter state not found", "dir", dir)
+	}
+
+	resp := dbm.NewMemDB()
+
+	if StateDBExists(dir) {
+		db, err := dbm.NewDB(stateDBName, stateDBBackend, dir)
+		if err != nil {
+			return nil, errors.Wrap(err, "open voter state db")
+		}
+
+		if err := copyDB(db, resp); err != nil {
+			_ = db.Close()
+			return nil, err
+		} else if err := db.Close(); err != nil {
+			return nil, errors.Wrap(err, "close voter state db")
+		}
+	}
+
+	if legacyExists {
+		if err := migrateLegacy(resp, legacyFile, false); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+func copyDB(from dbm.DB, to dbm.DB) error {
+	iter, err := from.Iterator(nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "iterator")
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if err := to.Set(iter.Key(), iter.Value()); err != nil {
+			return errors.Wrap(err, "set")
+		}
+	}
+
+	if err := iter.Error(); err != nil {
+		return errors.Wrap(err, "iterate")
+	}
+
+	return nil
+}
+
+// migrateLegacy migrates the legacy JSON state file to the (empty) database and optionally renames the file.
+// If the database isn't empty, the file was already migrated but not renamed, so it is only renamed.
+func migrateLegacy(db dbm.DB, legacyFile string, rename bool) error {
+	empty, err := isEmpty(db)
+	if err != nil {
+		return err
+	}
+
+	if empty {
+		s, err := loadState(legacyFile)
+		if err != nil {
+			return errors.Wrap(err, "load legacy voter state")
+		}
+
+		if err := newStore(db).Save(s); err != nil {
+			return errors.Wrap(err, "migrate legacy voter state")
+		}
+	}
+
+	if !rename {
+		return nil
+	}
+
+	if err := os.Rename(legacyFile, legacyFile+migratedPostfix); err != nil {
+		return errors.Wrap(err, "rename legacy voter state")
+	}
+
+	return nil
+}
+
+func isEmpty(db dbm.DB) (bool, error) {
+	iter, err := db.Iterator(nil, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "iterator")
+	}
+	defer iter.Close()
+
+	return !iter.Valid(), nil
+}
+
+// store persists the voter state to a database. Only changes since the previous save are written.
+type store struct {
+	db        dbm.DB
+	persisted map[string]*types.Vote // Votes by key as currently persisted.
+}
+
+func newStore(db dbm.DB) *store {
+	return &store{
+		db:        db,
+		persisted: make(map[string]*types.Vote),
+	}
+}
+
+// Load returns the voter state from the database.
+func (s *store) Load() (stateJSON, error) {
+	var resp stateJSON
+	for _, prefix := range []byte{prefixAvailable, prefixProposed, prefixCommitted, prefixLatest} {
+		votes, err := s.loadPrefix(prefix)
+		if err != nil {
+			return stateJSON{}, err
+		}
+
+		switch prefix {
+		case prefixAvailable:
+			resp.Available = votes
+		case prefixProposed:
+			resp.Proposed = votes
+		case prefixCommitted:
+			resp.Committed = votes
+		case prefixLatest:
+			resp.Latest = votes
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *store) loadPrefix(prefix byte) ([]*types.Vote, error) {
+	iter, err := s.db.Iterator([]byte{prefix}, []byte{prefix + 1})
+	if err != nil {
+		return nil, errors.Wrap(err, "iterator")
+	}
+	defer iter.Close()
+
+	var resp []*types.Vote
+	for ; iter.Valid(); iter.Next() {
+		vote := new(types.Vote)
+		if err := proto.Unmarshal(iter.Value(), vote); err != nil {
+			return nil, errors.Wrap(err, "unmarshal vote")
+		} else if err := vote.Verify(); err != nil {
+			return nil, errors.Wrap(err, "verify vote")
+		}
+
+		s.persisted[string(iter.Key())] = vote
+		resp = append(resp, vote)
+	}
+
+	if err := iter.Error(); err != nil {
+		return nil, errors.Wrap(err, "iterate")
+	}
+
+	return resp, nil
+}
+
+// Save persists the state, only writing votes that changed since the previous save.
+// All changes are written atomically.
+func (s *store) Save(state stateJSON) error {
+	next := make(map[string]*types.Vote, len(s.persisted))
+	for _, vote := range state.Available {
+		next[voteKey(prefixAvailable, vote)] = vote
+	}
+	for _, vote := range state.Proposed {
+		next[voteKey(prefixProposed, vote)] = vote
+	}
+	for _, vote := range state.Committed {
+		next[voteKey(prefixCommitted, vote)] = vote
+	}
+	for _, vote := range state.Latest {
+		next[latestKey(vote.AttestHeader.XChainVersion())] = vote
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	var changed bool
+	for key, vote := range next {
+		if s.persisted[key] == vote {
+			continue // Votes are immutable, so unchanged.
+		}
+
+		bz, err := proto.Marshal(vote)
+		if err != nil {
+			return errors.Wrap(err, "marshal vote")
+		}
+
+		if err := batch.Set([]byte(key), bz); err != nil {
+			return errors.Wrap(err, "batch set")
+		}
+		changed = true
+	}
+
+	for key := range s.persisted {
+		if _, ok := next[key]; ok {
+			continue
+		}
+
+		if err := batch.Delete([]byte(key)); err != nil {
+			return errors.Wrap(err, "batch delete")
+		}
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return errors.Wrap(err, "write batch")
+	}
+
+	s.persisted = next
+
+	return nil
+}
+
+func voteKey(prefix byte, vote *types.Vote) string {
+	key := chainVerKey(prefix, vote.AttestHeader.XChainVersion())
+	key = binary.BigEndian.AppendUint64(key, vote.AttestHeader.AttestOffset)
+
+	return string(key)
+}
+
+func latestKey(chainVer xchain.ChainVersion) string {
+	return string(chainVerKey(prefixLatest, chainVer))
+}
+
+func chainVerKey(prefix byte, chainVer xchain.ChainVersion) []byte {
+	key := []byte{prefix}
+	key = binary.BigEndian.AppendUint64(key, chainVer.ID)
+	key = append(key, byte(chainVer.ConfLevel))
+
+	return key
+}
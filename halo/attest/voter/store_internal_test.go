@@ -0,0 +1,274 @@
+package voter
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/xchain"
+
+	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/libs/tempfile"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/gogoproto/proto"
+	fuzz "github.com/google/gofuzz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenState(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	legacyFile := filepath.Join(dir, "voter_state.json")
+
+	_, err := OpenState(dir, legacyFile)
+	require.ErrorContains(t, err, "voter state not found")
+
+	// Write a legacy state file and migrate it.
+	legacy := stateJSON{
+		Available: genVotes(t, 1, 10),
+		Proposed:  genVotes(t, 2, 3),
+		Committed: genVotes(t, 3, 5),
+	}
+	legacy.Latest = []*types.Vote{legacy.Available[9], legacy.Proposed[2], legacy.Committed[4]}
+	saveLegacy(t, legacyFile, legacy)
+
+	db, err := OpenState(dir, legacyFile)
+	require.NoError(t, err)
+	requireStateEqual(t, legacy, db)
+	require.NoFileExists(t, legacyFile)
+	require.FileExists(t, legacyFile+migratedPostfix)
+
+	// Subsequent saves only write changes.
+	store := newStore(db)
+	state, err := store.Load()
+	require.NoError(t, err)
+	state.Committed = append(state.Proposed, state.Committed...) // Proposed chain 2 sorts before committed chain 3.
+	state.Proposed = nil
+	state.Available = state.Available[5:]
+	require.NoError(t, store.Save(state))
+	requireStateEqual(t, state, db)
+	require.NoError(t, db.Close())
+
+	// Reopening doesn't migrate again.
+	db, err = OpenState(dir, legacyFile)
+	require.NoError(t, err)
+	requireStateEqual(t, state, db)
+	require.NoError(t, db.Close())
+
+	// Legacy files are ignored (only renamed) if the database isn't empty.
+	saveLegacy(t, legacyFile, legacy)
+	db, err = OpenState(dir, legacyFile)
+	require.NoError(t, err)
+	requireStateEqual(t, state, db)
+	require.NoFileExists(t, legacyFile)
+	require.NoError(t, db.Close())
+}
+
+func TestGenEmptyState(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.False(t, StateDBExists(dir))
+	require.NoError(t, GenEmptyState(dir))
+	require.True(t, StateDBExists(dir))
+
+	db, err := OpenState(dir, filepath.Join(dir, "voter_state.json"))
+	require.NoError(t, err)
+	requireStateEqual(t, stateJSON{}, db)
+	require.NoError(t, db.Close())
+}
+
+func TestOpenStateCopy(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	legacyFile := filepath.Join(dir, "voter_state.json")
+
+	_, err := OpenStateCopy(dir, legacyFile)
+	require.ErrorContains(t, err, "voter state not found")
+
+	// Legacy state is copied without migrating it.
+	legacy := stateJSON{Available: genVotes(t, 1, 3)}
+	saveLegacy(t, legacyFile, legacy)
+
+	cp, err := OpenStateCopy(dir, legacyFile)
+	require.NoError(t, err)
+	requireStateEqual(t, legacy, cp)
+	require.FileExists(t, legacyFile)
+	require.False(t, StateDBExists(dir))
+
+	// Database state is copied, changes to the copy are not persisted.
+	db, err := OpenState(dir, legacyFile)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	cp, err = OpenStateCopy(dir, legacyFile)
+	require.NoError(t, err)
+	requireStateEqual(t, legacy, cp)
+	require.NoError(t, newStore(cp).Save(stateJSON{}))
+
+	db, err = OpenState(dir, legacyFile)
+	require.NoError(t, err)
+	requireStateEqual(t, legacy, db)
+	require.NoError(t, db.Close())
+}
+
+func TestInspectResetChain(t *testing.T) {
+	t.Parallel()
+
+	votes1 := genVotes(t, 1, 10)
+	votes2 := genVotes(t, 2, 3)
+	chain1 := votes1[0].AttestHeader.XChainVersion()
+	chain2 := votes2[0].AttestHeader.XChainVersion()
+
+	db := dbm.NewMemDB()
+	require.NoError(t, newStore(db).Save(stateJSON{
+		Committed: append(votes1[:4:4], votes2[:1]...),
+		Proposed:  votes1[4:6],
+		Available: append(votes1[6:], votes2[1:]...),
+		Latest:    []*types.Vote{votes1[9], votes2[2]},
+	}))
+
+	states, err := InspectState(db)
+	require.NoError(t, err)
+	require.Equal(t, []ChainState{
+		{
+			ChainVersion: chain1,
+			Latest:       10,
+			Available:    OffsetRange{Count: 4, Min: 7, Max: 10},
+			Proposed:     OffsetRange{Count: 2, Min: 5, Max: 6},
+			Committed:    OffsetRange{Count: 4, Min: 1, Max: 4},
+		},
+		{
+			ChainVersion: chain2,
+			Latest:       3,
+			Available:    OffsetRange{Count: 2, Min: 2, Max: 3},
+			Committed:    OffsetRange{Count: 1, Min: 1, Max: 1},
+		},
+	}, states)
+
+	_, err = ResetChain(db, chain1, 10, false)
+	require.ErrorContains(t, err, "nothing to reset")
+
+	// Fuzzy chain versions are only reset when forced.
+	fuzzy := xchain.NewChainVersion(1, xchain.ConfLatest)
+	_, err = ResetChain(db, fuzzy, 5, false)
+	require.ErrorContains(t, err, "without force")
+	_, err = ResetChain(db, fuzzy, 5, true)
+	require.ErrorContains(t, err, "nothing to reset")
+
+	// Reset chain1 to approved offset 5, deleting offsets 6-10.
+	deleted, err := ResetChain(db, chain1, 5, false)
+	require.NoError(t, err)
+	require.Equal(t, 5, deleted)
+
+	requireStateEqual(t, stateJSON{
+		Committed: append(votes1[:4:4], votes2[:1]...),
+		Proposed:  votes1[4:5],
+		Available: votes2[1:],
+		Latest:    []*types.Vote{votes2[2]},
+	}, db)
+}
+
+// BenchmarkSaveState compares saving a single new vote with the legacy JSON file (full rewrite)
+// versus the database (incremental writes) for different numbers of existing available votes.
+func BenchmarkSaveState(b *testing.B) {
+	for _, size := range []int{100, 1_000, 10_000} {
+		b.Run(fmt.Sprintf("legacy_json_%d", size), func(b *testing.B) {
+			votes := genVotes(b, 1, size+b.N)
+			initial := stateJSON{Available: votes[:size]}
+			file := filepath.Join(b.TempDir(), "voter_state.json")
+			state := initial
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				state.Available = append(state.Available, votes[size+i])
+				saveLegacy(b, file, state)
+			}
+		})
+
+		b.Run(fmt.Sprintf("db_%d", size), func(b *testing.B) {
+			votes := genVotes(b, 1, size+b.N)
+			initial := stateJSON{Available: votes[:size]}
+			db, err := dbm.NewDB(stateDBName, stateDBBackend, b.TempDir())
+			require.NoError(b, err)
+			defer db.Close()
+
+			store := newStore(db)
+			require.NoError(b, store.Save(initial))
+
+			state := initial
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				state.Available = append(state.Available, votes[size+i])
+				require.NoError(b, store.Save(state))
+			}
+		})
+	}
+}
+
+// genVotes returns n sequential votes for the chain.
+func genVotes(t testing.TB, chainID uint64, n int) []*types.Vote {
+	t.Helper()
+
+	fuzzer := fuzz.New().NilChance(0).NumElements(1, 4)
+	privKey := k1.GenPrivKey()
+
+	var resp []*types.Vote
+	for i := 0; i < n; i++ {
+		var block xchain.Block
+		fuzzer.Fuzz(&block)
+		block.ChainID = chainID
+
+		attHeader := xchain.AttestHeader{
+			ConsensusChainID: 1,
+			ChainVersion:     xchain.NewChainVersion(chainID, xchain.ConfFinalized),
+			AttestOffset:     uint64(i + 1),
+		}
+
+		vote, err := CreateVote(privKey, attHeader, block)
+		require.NoError(t, err)
+
+		resp = append(resp, vote)
+	}
+
+	return resp
+}
+
+// saveLegacy saves the state to the legacy JSON state file.
+func saveLegacy(t testing.TB, file string, s stateJSON) {
+	t.Helper()
+
+	bz, err := json.MarshalIndent(s, "", " ")
+	require.NoError(t, err)
+	require.NoError(t, tempfile.WriteFileAtomic(file, bz, 0o600))
+}
+
+func requireStateEqual(t *testing.T, expect stateJSON, db dbm.DB) {
+	t.Helper()
+
+	actual, err := newStore(db).Load()
+	require.NoError(t, err)
+
+	requireVotesEqual := func(expect, actual []*types.Vote) {
+		t.Helper()
+		require.Len(t, actual, len(expect))
+
+		// Keys are ordered by chain and offset, same as the generated votes.
+		for i := range expect {
+			bz1, err := proto.Marshal(expect[i])
+			require.NoError(t, err)
+			bz2, err := proto.Marshal(actual[i])
+			require.NoError(t, err)
+			require.Equal(t, bz1, bz2)
+		}
+	}
+
+	requireVotesEqual(expect.Available, actual.Available)
+	requireVotesEqual(expect.Proposed, actual.Proposed)
+	requireVotesEqual(expect.Committed, actual.Committed)
+	requireVotesEqual(expect.Latest, actual.Latest)
+}
@@ -21,7 +21,8 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
-	"github.com/cometbft/cometbft/libs/tempfile"
+
+	dbm "github.com/cosmos/cosmos-db"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -43,7 +44,7 @@ var _ types.Voter = (*Voter)(nil)
 // Note Start must be called only once on startup.
 // GetAvailable, SetProposed, and SetCommitted are thread safe, but must be called after Start.
 type Voter struct {
-	path        string
+	store       *store
 	cChainID    uint64
 	privKey     crypto.PrivKey
 	network     netconf.Network
@@ -63,23 +64,19 @@ type Voter struct {
 	valSetID    uint64
 }
 
-// GenEmptyStateFile generates an empty attester state file at the given path.
-// This must be called before LoadVoter.
-func GenEmptyStateFile(path string) error {
-	return (&Voter{path: path}).saveUnsafe()
-}
-
-// LoadVoter returns a new attester with state loaded from disk.
-func LoadVoter(privKey crypto.PrivKey, path string, provider xchain.Provider, deps types.VoterDeps,
+// LoadVoter returns a new attester with state loaded from the database.
+// See OpenState for opening the voter state database.
+func LoadVoter(privKey crypto.PrivKey, db dbm.DB, provider xchain.Provider, deps types.VoterDeps,
 	network netconf.Network,
 ) (*Voter, error) {
 	if len(privKey.PubKey().Bytes()) != k1.PubKeySize {
 		return nil, errors.New("invalid private key")
 	}
 
-	s, err := loadState(path)
+	store := newStore(db)
+	s, err := store.Load()
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "load state")
 	}
 
 	addr, err := k1util.PubKeyToAddress(privKey.PubKey())
@@ -91,7 +88,7 @@ func LoadVoter(privKey crypto.PrivKey, path string, provider xchain.Provider, de
 		privKey:  privKey,
 		cChainID: network.ID.Static().OmniConsensusChainIDUint64(),
 		address:  addr,
-		path:     path,
+		store:    store,
 		network:  network,
 		provider: provider,
 		deps:     deps,
@@ -492,7 +489,7 @@ func (v *Voter) latestByChain(chainVer xchain.ChainVersion) (*types.Vote, bool)
 	return vote, ok
 }
 
-// saveUnsafe saves the state to disk. It is unsafe since it assumes the lock is held.
+// saveUnsafe saves the state changes to disk. It is unsafe since it assumes the lock is held.
 func (v *Voter) saveUnsafe() error {
 	sortVotes := func(atts []*types.Vote) {
 		sort.Slice(atts, func(i, j int) bool {
@@ -513,13 +510,8 @@ func (v *Voter) saveUnsafe() error {
 		Committed: v.committed,
 		Latest:    latestToJSON(v.latest),
 	}
-	bz, err := json.MarshalIndent(s, "", " ")
-	if err != nil {
-		return errors.Wrap(err, "marshal state path")
-	}
-
-	if err := tempfile.WriteFileAtomic(v.path, bz, 0o600); err != nil {
-		return errors.Wrap(err, "write state path")
+	if err := v.store.Save(s); err != nil {
+		return errors.Wrap(err, "save state")
 	}
 
 	v.instrumentUnsafe()
@@ -581,6 +573,7 @@ func detectReorg(chainVer xchain.ChainVersion, prevBlock *xchain.Block, block xc
 }
 
 // stateJSON is the JSON representation of the attester state.
+// It is used by the legacy voter state file, which is migrated to the voter state database.
 type stateJSON struct {
 	Available []*types.Vote `json:"available"`
 	Proposed  []*types.Vote `json:"proposed"`
@@ -588,7 +581,7 @@ type stateJSON struct {
 	Latest    []*types.Vote `json:"latest"`
 }
 
-// loadState loads a path state from the given path.
+// loadState loads a legacy voter state from the given path.
 func loadState(path string) (stateJSON, error) {
 	bz, err := os.ReadFile(path)
 	if err != nil {
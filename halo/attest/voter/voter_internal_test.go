@@ -10,16 +10,18 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 
+	dbm "github.com/cosmos/cosmos-db"
+
 	"github.com/stretchr/testify/require"
 )
 
 // LoadVoterForT is a helper function to load a voter for testing.
 // It sets the backoff period to 1ms.
-func LoadVoterForT(t *testing.T, privKey crypto.PrivKey, path string, provider xchain.Provider,
+func LoadVoterForT(t *testing.T, privKey crypto.PrivKey, db dbm.DB, provider xchain.Provider,
 	deps types.VoterDeps, network netconf.Network, backoff func(),
 ) *Voter {
 	t.Helper()
-	v, err := LoadVoter(privKey, path, provider, deps, network)
+	v, err := LoadVoter(privKey, db, provider, deps, network)
 	require.NoError(t, err)
 
 	v.backoffFunc = func(ctx context.Context) func() { return backoff }
@@ -27,6 +29,18 @@ func LoadVoterForT(t *testing.T, privKey crypto.PrivKey, path string, provider x
 	return v
 }
 
+// StateForT is the voter state as persisted in the database for testing purposes only.
+type StateForT = stateJSON
+
+// LoadStateForT returns the voter state persisted in the database for testing purposes only.
+func LoadStateForT(t *testing.T, db dbm.DB) StateForT {
+	t.Helper()
+	s, err := newStore(db).Load()
+	require.NoError(t, err)
+
+	return s
+}
+
 // LatestByChain returns the latest vote by chain for testing purposes only.
 func (v *Voter) LatestByChain(chainVer xchain.ChainVersion) (*types.Vote, bool) {
 	return v.latestByChain(chainVer)
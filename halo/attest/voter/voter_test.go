@@ -2,10 +2,7 @@ package voter_test
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"os"
-	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -19,6 +16,8 @@ import (
 
 	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
 
+	dbm "github.com/cosmos/cosmos-db"
+
 	"github.com/ethereum/go-ethereum/common"
 
 	fuzz "github.com/google/gofuzz"
@@ -30,12 +29,8 @@ func TestRunner(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	path := filepath.Join(t.TempDir(), "state.json")
-	err := voter.GenEmptyStateFile(path)
-	require.NoError(t, err)
-
+	db := dbm.NewMemDB()
 	pk := k1.GenPrivKey()
-	require.NoError(t, err)
 
 	const (
 		chain1     = 1
@@ -50,7 +45,7 @@ func TestRunner(t *testing.T) {
 	prov := make(stubProvider)
 	backoff := new(testBackOff)
 	deps := &mockDeps{}
-	v := voter.LoadVoterForT(t, pk, path, prov, deps, network, backoff.BackOff)
+	v := voter.LoadVoterForT(t, pk, db, prov, deps, network, backoff.BackOff)
 
 	// callback is a helper function that calls the callback and asserts the error.
 	callback := func(t *testing.T, sub sub, height uint64, isVal, ok bool) {
@@ -124,9 +119,7 @@ func TestVoteWindow(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	path := filepath.Join(t.TempDir(), "state.json")
-	err := voter.GenEmptyStateFile(path)
-	require.NoError(t, err)
+	db := dbm.NewMemDB()
 
 	pk := k1.GenPrivKey()
 	const chain1 = 1
@@ -135,8 +128,7 @@ func TestVoteWindow(t *testing.T) {
 	backoff := new(testBackOff)
 	prov := make(stubProvider)
 	deps := &mockDeps{}
-	v := voter.LoadVoterForT(t, pk, path, prov, deps, network, backoff.BackOff)
-	require.NoError(t, err)
+	v := voter.LoadVoterForT(t, pk, db, prov, deps, network, backoff.BackOff)
 	setIsVal(t, v, pk, true)
 
 	v.Start(ctx)
@@ -183,9 +175,7 @@ func TestVoter(t *testing.T) {
 	t.Parallel()
 	fuzzer := fuzz.New().NilChance(0).NumElements(1, 64)
 
-	path := filepath.Join(t.TempDir(), "state.json")
-	err := voter.GenEmptyStateFile(path)
-	require.NoError(t, err)
+	db := dbm.NewMemDB()
 
 	pk := k1.GenPrivKey()
 
@@ -204,8 +194,7 @@ func TestVoter(t *testing.T) {
 
 		p := make(stubProvider)
 		backoff := new(testBackOff)
-		v := voter.LoadVoterForT(t, pk, path, p, stubDeps{}, network, backoff.BackOff)
-		require.NoError(t, err)
+		v := voter.LoadVoterForT(t, pk, db, p, stubDeps{}, network, backoff.BackOff)
 		setIsVal(t, v, pk, true)
 
 		cancel()
@@ -279,16 +268,11 @@ func TestVoter(t *testing.T) {
 	v.Commit(t, 2, 1)
 
 	// All committed
-	bz, err := os.ReadFile(path)
-	require.NoError(t, err)
-	var stateJSON map[string]any
-	require.NoError(t, json.Unmarshal(bz, &stateJSON))
-
-	require.Len(t, stateJSON, 4)
-	require.Empty(t, stateJSON["available"])
-	require.Empty(t, stateJSON["proposed"])
-	require.Len(t, stateJSON["committed"], 2) // One per chain
-	require.Len(t, stateJSON["latest"], 2)    // One per chain
+	state := voter.LoadStateForT(t, db)
+	require.Empty(t, state.Available)
+	require.Empty(t, state.Proposed)
+	require.Len(t, state.Committed, 2) // One per chain
+	require.Len(t, state.Latest, 2)    // One per chain
 
 	v.AddErr(t, 1, 3)
 	v.AddErr(t, 1, 2)
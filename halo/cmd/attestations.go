@@ -0,0 +1,258 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/omni-network/omni/halo/app"
+	"github.com/omni-network/omni/halo/attest/archive"
+	halocfg "github.com/omni-network/omni/halo/config"
+	"github.com/omni-network/omni/lib/cchain"
+	cprovider "github.com/omni-network/omni/lib/cchain/provider"
+	libcmd "github.com/omni-network/omni/lib/cmd"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ExportConfig is the config for the attestations export command.
+type ExportConfig struct {
+	HomeDir    string
+	Output     string
+	ChainID    uint64
+	FromOffset uint64
+}
+
+// ImportConfig is the config for the attestations import command.
+type ImportConfig struct {
+	HomeDir string
+	Input   string
+	Network netconf.ID
+	HaloRPC string
+}
+
+func newAttestationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attestations",
+		Short: "Export or import the node-local archive of approved attestations",
+		Long: "Approved attestations are pruned from state shortly after approval. If --attest-archive is enabled, " +
+			"halo appends them (with signatures) to rolling JSONL files in <home>/data/attestations before pruning. " +
+			"These commands export that archive to backfill indexers and audit history, or import another node's export.",
+	}
+
+	cmd.AddCommand(
+		newAttestationsExportCmd(),
+		newAttestationsImportCmd(),
+	)
+
+	return cmd
+}
+
+func newAttestationsExportCmd() *cobra.Command {
+	cfg := ExportConfig{HomeDir: halocfg.DefaultHomeDir}
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export archived attestations as JSONL",
+		Long:  "Export all unique archived attestations as JSONL records to the output file (or stdout), in the order they were archived.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			out := cmd.OutOrStdout()
+			if cfg.Output != "" {
+				f, err := os.Create(cfg.Output)
+				if err != nil {
+					return errors.Wrap(err, "create output file")
+				}
+				defer f.Close()
+				out = f
+			}
+
+			n, err := exportAttestations(cfg, out)
+			if err != nil {
+				return err
+			}
+
+			if cfg.Output != "" {
+				log.Info(cmd.Context(), "Exported attestations", "count", n, "file", cfg.Output)
+			}
+
+			return nil
+		},
+	}
+
+	bindExportFlags(cmd.Flags(), &cfg)
+
+	return cmd
+}
+
+func newAttestationsImportCmd() *cobra.Command {
+	cfg := ImportConfig{HomeDir: halocfg.DefaultHomeDir, HaloRPC: app.DefaultVoterConfig().HaloRPC}
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import exported attestations into the local archive",
+		Long: "Import JSONL attestations (e.g. exported by another node) into the local archive. " +
+			"All attestation signatures and their quorum are verified against the validator sets queried from halo, " +
+			"and attestations already in the archive are skipped.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if cfg.Input == "" {
+				return errors.New("required flag --input not set")
+			} else if err := cfg.Network.Verify(); err != nil {
+				return errors.Wrap(err, "verify --network")
+			}
+
+			cl, err := rpchttp.New(cfg.HaloRPC, "/websocket")
+			if err != nil {
+				return errors.Wrap(err, "new halo rpc client")
+			}
+			cprov := cprovider.NewABCIProvider(cl, cfg.Network, netconf.ChainVersionNamer(cfg.Network))
+
+			imported, skipped, err := importAttestations(cmd.Context(), cfg, cprov.ValidatorSet)
+			if err != nil {
+				return err
+			}
+
+			log.Info(cmd.Context(), "Imported attestations", "imported", imported, "skipped", skipped)
+
+			return nil
+		},
+	}
+
+	bindImportFlags(cmd.Flags(), &cfg)
+
+	return cmd
+}
+
+// exportAttestations writes all unique archived attestations matching the config to out.
+// It returns the number of exported attestations.
+func exportAttestations(cfg ExportConfig, out io.Writer) (int, error) {
+	dir := halocfg.Config{HomeDir: cfg.HomeDir}.AttestArchiveDir()
+
+	var n int
+	enc := json.NewEncoder(out)
+	err := archive.ReadDir(dir, func(record archive.Record) error {
+		key := record.Key()
+		if cfg.ChainID != 0 && key.ChainVersion.ID != cfg.ChainID {
+			return nil
+		} else if key.AttestOffset < cfg.FromOffset {
+			return nil
+		}
+
+		if err := enc.Encode(record); err != nil {
+			return errors.Wrap(err, "encode record")
+		}
+		n++
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// valSetFunc returns the validators of the validator set with the provided ID, see cchain.Provider.ValidatorSet.
+type valSetFunc func(ctx context.Context, valSetID uint64) ([]cchain.Validator, bool, error)
+
+// importAttestations appends all valid attestations from the input file that are not already archived.
+// Attestations are verified against the validator set that approved them.
+// It returns the number of imported and skipped attestations.
+func importAttestations(ctx context.Context, cfg ImportConfig, valSets valSetFunc) (int, int, error) {
+	dir := halocfg.Config{HomeDir: cfg.HomeDir}.AttestArchiveDir()
+
+	existing := make(map[archive.Key]bool)
+	if _, err := os.Stat(dir); err == nil {
+		err := archive.ReadDir(dir, func(record archive.Record) error {
+			existing[record.Key()] = true
+			return nil
+		})
+		if err != nil {
+			return 0, 0, errors.Wrap(err, "read existing archive")
+		}
+	}
+
+	writer, err := archive.NewWriter(dir, archive.DefaultMaxFileSize)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer writer.Close()
+
+	powersByID := make(map[uint64]map[common.Address]int64) // Cache validator set powers by ID.
+	getPowers := func(valSetID uint64) (map[common.Address]int64, error) {
+		if powers, ok := powersByID[valSetID]; ok {
+			return powers, nil
+		}
+
+		vals, ok, err := valSets(ctx, valSetID)
+		if err != nil {
+			return nil, errors.Wrap(err, "query validator set", "id", valSetID)
+		} else if !ok {
+			return nil, errors.New("validator set not found", "id", valSetID)
+		}
+
+		powers := make(map[common.Address]int64)
+		for _, val := range vals {
+			powers[val.Address] = val.Power
+		}
+		powersByID[valSetID] = powers
+
+		return powers, nil
+	}
+
+	var imported, skipped int
+	err = archive.ReadFile(cfg.Input, func(record archive.Record) error {
+		if existing[record.Key()] {
+			skipped++
+			return nil
+		}
+
+		powers, err := getPowers(record.Attestation.GetValidatorSetId())
+		if err != nil {
+			return err
+		}
+
+		if err := record.Verify(powers); err != nil {
+			return errors.Wrap(err, "verify attestation", "chain", record.Key().ChainVersion.ID, "attest_offset", record.Key().AttestOffset)
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+		existing[record.Key()] = true
+		imported++
+
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return 0, 0, err
+	}
+
+	return imported, skipped, nil
+}
+
+func bindExportFlags(flags *pflag.FlagSet, cfg *ExportConfig) {
+	libcmd.BindHomeFlag(flags, &cfg.HomeDir)
+	flags.StringVar(&cfg.Output, "output", cfg.Output, "Output JSONL file, defaults to stdout")
+	flags.Uint64Var(&cfg.ChainID, "chain-id", cfg.ChainID, "Only export attestations of this source chain ID, 0 exports all chains")
+	flags.Uint64Var(&cfg.FromOffset, "from-offset", cfg.FromOffset, "Only export attestations from this attest offset (inclusive)")
+}
+
+func bindImportFlags(flags *pflag.FlagSet, cfg *ImportConfig) {
+	libcmd.BindHomeFlag(flags, &cfg.HomeDir)
+	netconf.BindFlag(flags, &cfg.Network)
+	flags.StringVar(&cfg.Input, "input", cfg.Input, "Input JSONL file exported by 'halo attestations export'")
+	flags.StringVar(&cfg.HaloRPC, "halo-rpc", cfg.HaloRPC, "The halo CometBFT RPC address used to query validator sets")
+}
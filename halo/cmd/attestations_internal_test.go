@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/omni-network/omni/halo/attest/archive"
+	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/k1util"
+
+	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportAttestations(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	key1, key2 := k1.GenPrivKey(), k1.GenPrivKey()
+	addr1, err := k1util.PubKeyToAddress(key1.PubKey())
+	require.NoError(t, err)
+	addr2, err := k1util.PubKeyToAddress(key2.PubKey())
+	require.NoError(t, err)
+
+	// Validator set 1 only contains val1, validator set 2 contains both (with equal power).
+	valSets := func(_ context.Context, valSetID uint64) ([]cchain.Validator, bool, error) {
+		switch valSetID {
+		case 1:
+			return []cchain.Validator{{Address: addr1, Power: 10}}, true, nil
+		case 2:
+			return []cchain.Validator{{Address: addr1, Power: 10}, {Address: addr2, Power: 10}}, true, nil
+		default:
+			return nil, false, nil
+		}
+	}
+
+	writeInput := func(t *testing.T, atts ...*types.Attestation) string {
+		t.Helper()
+
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		for _, att := range atts {
+			require.NoError(t, enc.Encode(archive.Record{Height: 1, Attestation: att}))
+		}
+
+		file := filepath.Join(t.TempDir(), "input.jsonl")
+		require.NoError(t, os.WriteFile(file, buf.Bytes(), 0o600))
+
+		return file
+	}
+
+	cfg := ImportConfig{HomeDir: t.TempDir()}
+
+	// Attestations signed by a quorum of their validator set are imported, duplicates skipped.
+	cfg.Input = writeInput(t, signedAttestation(t, 1, 1, key1), signedAttestation(t, 2, 2, key1, key2))
+	imported, skipped, err := importAttestations(ctx, cfg, valSets)
+	require.NoError(t, err)
+	require.Equal(t, 2, imported)
+	require.Zero(t, skipped)
+
+	imported, skipped, err = importAttestations(ctx, cfg, valSets)
+	require.NoError(t, err)
+	require.Zero(t, imported)
+	require.Equal(t, 2, skipped)
+
+	// Attestations without quorum of their validator set are rejected.
+	cfg.Input = writeInput(t, signedAttestation(t, 3, 2, key1))
+	_, _, err = importAttestations(ctx, cfg, valSets)
+	require.ErrorContains(t, err, "without quorum")
+
+	// Attestations signed by validators outside their validator set are rejected.
+	cfg.Input = writeInput(t, signedAttestation(t, 3, 1, key2))
+	_, _, err = importAttestations(ctx, cfg, valSets)
+	require.ErrorContains(t, err, "not in validator set")
+
+	// Attestations of unknown validator sets are rejected.
+	cfg.Input = writeInput(t, signedAttestation(t, 3, 3, key1))
+	_, _, err = importAttestations(ctx, cfg, valSets)
+	require.ErrorContains(t, err, "validator set not found")
+}
+
+func signedAttestation(t *testing.T, offset uint64, valSetID uint64, keys ...k1.PrivKey) *types.Attestation {
+	t.Helper()
+
+	const chainID = 100
+	att := &types.Attestation{
+		AttestHeader: &types.AttestHeader{
+			ConsensusChainId: 1,
+			SourceChainId:    chainID,
+			ConfLevel:        1,
+			AttestOffset:     offset,
+		},
+		BlockHeader: &types.BlockHeader{
+			ChainId:     chainID,
+			BlockHeight: offset,
+			BlockHash:   common.HexToHash("0x03").Bytes(),
+		},
+		ValidatorSetId: valSetID,
+		MsgRoot:        common.HexToHash("0x01").Bytes(),
+	}
+
+	root, err := att.AttestationRoot()
+	require.NoError(t, err)
+
+	for _, key := range keys {
+		addr, err := k1util.PubKeyToAddress(key.PubKey())
+		require.NoError(t, err)
+		sig, err := k1util.Sign(key, root)
+		require.NoError(t, err)
+
+		att.Signatures = append(att.Signatures, &types.SigTuple{ValidatorAddress: addr.Bytes(), Signature: sig[:]})
+	}
+
+	return att
+}
@@ -25,8 +25,11 @@ func New() *cobra.Command {
 		newRunCmd("run", app.Run),
 		newInitCmd(),
 		newRollbackCmd(),
+		newExportCmd(),
 		buildinfo.NewVersionCmd(),
 		newConsKeyCmd(),
+		newAttestationsCmd(),
+		newVoterCmd(),
 	)
 }
 
@@ -112,6 +115,52 @@ different block N cannot be re-built/re-proposed since that would result in vali
 	return cmd
 }
 
+func newExportCmd() *cobra.Command {
+	logCfg := log.DefaultConfig()
+	haloCfg := halocfg.DefaultConfig()
+	var exportCfg app.ExportConfig
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the application state as a new genesis file",
+		Long: `
+Export the application state of all modules at the latest committed height as a new genesis file.
+The exported genesis has an initial height of the next height, and contains the execution head
+that the execution chain must resume from. It is used to restart a network from exported state,
+e.g., for a coordinated restart, a hard fork or a testnet fork (see --chain-id).
+The node must be stopped before exporting, and the execution client must be running (to verify consistency).
+Zero height exports are not supported.
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx, err := log.Init(cmd.Context(), logCfg)
+			if err != nil {
+				return err
+			}
+			if err := libcmd.LogFlags(ctx, cmd.Flags()); err != nil {
+				return err
+			}
+
+			cmtCfg, err := parseCometConfig(ctx, haloCfg.HomeDir)
+			if err != nil {
+				return err
+			}
+
+			appCfg := app.Config{
+				Config: haloCfg,
+				Comet:  cmtCfg,
+			}
+
+			return app.Export(ctx, appCfg, exportCfg)
+		},
+	}
+
+	bindRunFlags(cmd, &haloCfg)
+	bindAppExportFlags(cmd.Flags(), &exportCfg)
+	log.BindFlags(cmd.Flags(), &logCfg)
+
+	return cmd
+}
+
 func newConsKeyCmd() *cobra.Command {
 	home := halocfg.DefaultConfig().HomeDir
 
@@ -36,7 +36,7 @@ func TestRunCmd(t *testing.T) { //nolint:paralleltest,tparallel // RunCmd modifi
 		},
 		{
 			Name: "flags",
-			Args: slice("run", "--home=foo", "--engine-jwt-file=bar"),
+			Args: slice("run", "--home=foo", "--engine-jwt-file=bar", "--engine-secondaries=http://a:8551,http://b:8551"),
 		},
 		{
 			Name: "toml files",
@@ -76,6 +76,12 @@ func TestCLIReference(t *testing.T) {
 		{"run"},
 		{"init"},
 		{"rollback"},
+		{"export"},
+		{"attestations"},
+		{"voter serve"},
+		{"voter status"},
+		{"voter compare"},
+		{"voter reset"},
 	}
 
 	for _, test := range tests {
@@ -84,12 +90,7 @@ func TestCLIReference(t *testing.T) {
 
 			var args []string
 			if test.Command != root {
-				if strings.Contains(test.Command, "operator") {
-					subCmd := strings.Split(test.Command, " ")
-					args = append(args, subCmd...)
-				} else {
-					args = append(args, test.Command)
-				}
+				args = append(args, strings.Split(test.Command, " ")...)
 			}
 			args = append(args, "--help")
 
@@ -21,6 +21,7 @@ func bindRunFlags(cmd *cobra.Command, cfg *halocfg.Config) {
 	xchain.BindFlags(flags, &cfg.RPCEndpoints)
 	netconf.BindFlag(flags, &cfg.Network)
 	flags.StringVar(&cfg.EngineEndpoint, "engine-endpoint", cfg.EngineEndpoint, "An EVM execution client Engine API http endpoint")
+	flags.StringSliceVar(&cfg.EngineSecondaries, "engine-secondaries", cfg.EngineSecondaries, "Secondary EVM execution client Engine API http endpoints used for failover")
 	flags.StringVar(&cfg.EngineJWTFile, "engine-jwt-file", cfg.EngineJWTFile, "The path to the Engine API JWT file")
 	flags.Uint64Var(&cfg.SnapshotInterval, "snapshot-interval", cfg.SnapshotInterval, "State sync snapshot interval")
 	flags.Uint64Var(&cfg.SnapshotKeepRecent, "snapshot-keep-recent", cfg.SnapshotKeepRecent, "State sync snapshot to keep")
@@ -29,13 +30,55 @@ func bindRunFlags(cmd *cobra.Command, cfg *halocfg.Config) {
 	flags.StringVar(&cfg.PruningOption, "pruning", cfg.PruningOption, "Pruning strategy (default|nothing|everything)")
 	flags.DurationVar(&cfg.EVMBuildDelay, "evm-build-delay", cfg.EVMBuildDelay, "Minimum delay between triggering and fetching a EVM payload build")
 	flags.BoolVar(&cfg.EVMBuildOptimistic, "evm-build-optimistic", cfg.EVMBuildOptimistic, "Enables optimistic building of EVM payloads on previous block finalize")
+	flags.Uint64Var(&cfg.EVMPragueTime, "evm-prague-time", cfg.EVMPragueTime, "Overrides the network's EVM Prague fork timestamp, must match the execution client's chain config (0 uses the network default)")
+	flags.StringVar(&cfg.GRPCAddress, "grpc-address", cfg.GRPCAddress, "The gRPC query server address to bind to, empty disables the server")
+	flags.StringVar(&cfg.APIAddress, "api-address", cfg.APIAddress, "The REST API server address to bind to, empty disables the server")
+	flags.BoolVar(&cfg.AttestArchive, "attest-archive", cfg.AttestArchive, "Archive approved attestations to local files before pruning them from state")
+	flags.StringVar(&cfg.VoterAddress, "voter-address", cfg.VoterAddress, "The gRPC address of a remote voter sidecar, empty runs the voter inside halo")
+	flags.StringVar(&cfg.VoterAuthFile, "voter-auth-file", cfg.VoterAuthFile, "The path to the remote voter auth token file")
+	flags.StringVar(&cfg.VoterTLSCAFile, "voter-tls-ca-file", cfg.VoterTLSCAFile, "The path to the CA certificate verifying the remote voter's TLS certificate, required for non-local voter addresses")
 	flags.IntSliceVar(&cfg.UnsafeSkipUpgrades, sdkserver.FlagUnsafeSkipUpgrades, cfg.UnsafeSkipUpgrades, "Skip a set of upgrade heights to continue the old binary")
 }
 
+func bindVoterServeFlags(cmd *cobra.Command, cfg *app.VoterConfig) {
+	flags := cmd.Flags()
+
+	libcmd.BindHomeFlag(flags, &cfg.HomeDir)
+	xchain.BindFlags(flags, &cfg.RPCEndpoints)
+	netconf.BindFlag(flags, &cfg.Network)
+	flags.StringVar(&cfg.ListenAddress, "listen-address", cfg.ListenAddress, "The voter gRPC API address to bind to, a TCP host:port or unix:///path/to/voter.sock")
+	flags.StringVar(&cfg.TLSCertFile, "tls-cert-file", cfg.TLSCertFile, "The path to the TLS certificate file, required for non-local listen addresses")
+	flags.StringVar(&cfg.TLSKeyFile, "tls-key-file", cfg.TLSKeyFile, "The path to the TLS private key file, required for non-local listen addresses")
+	flags.StringVar(&cfg.HaloRPC, "halo-rpc", cfg.HaloRPC, "The halo CometBFT RPC address")
+	flags.StringVar(&cfg.OmniEVMRPC, "omni-evm-rpc", cfg.OmniEVMRPC, "The omni execution client RPC address")
+}
+
+func bindVoterStateFlags(flags *pflag.FlagSet, cfg *VoterStateConfig) {
+	libcmd.BindHomeFlag(flags, &cfg.HomeDir)
+	flags.StringVar(&cfg.StateDir, "state-dir", cfg.StateDir, "The voter state directory, defaults to <home>/data (use the voter sidecar home directory for sidecars)")
+}
+
+func bindVoterStateAppFlags(flags *pflag.FlagSet, cfg *VoterStateConfig) {
+	netconf.BindFlag(flags, &cfg.Network)
+	flags.StringVar(&cfg.DBBackend, "app-db-backend", cfg.DBBackend, "The database backend of the halo application state in <home>/data")
+}
+
+func bindVoterResetFlags(flags *pflag.FlagSet, cfg *VoterStateConfig) {
+	flags.BoolVar(&cfg.DryRun, "dry-run", cfg.DryRun, "Operate on an in-memory copy of the voter state, nothing is written to disk")
+	flags.Uint64Var(&cfg.ChainID, "chain-id", cfg.ChainID, "The source chain ID to reset")
+	flags.StringVar(&cfg.ConfLevel, "conf-level", cfg.ConfLevel, "The confirmation level to reset; final, latest")
+	flags.BoolVar(&cfg.Force, "force", cfg.Force, "Allow resetting the latest (fuzzy) confirmation level, which risks double signing")
+}
+
 func bindRollbackFlags(flags *pflag.FlagSet, cfg *app.RollbackConfig) {
 	flags.BoolVar(&cfg.RemoveCometBlock, "hard", cfg.RemoveCometBlock, "Remove last block as well as state")
 }
 
+func bindAppExportFlags(flags *pflag.FlagSet, cfg *app.ExportConfig) {
+	flags.StringVar(&cfg.Output, "output", cfg.Output, "The exported genesis file path")
+	flags.StringVar(&cfg.ChainID, "chain-id", cfg.ChainID, "Optional new consensus chain ID (defaults to the current chain ID)")
+}
+
 func bindInitFlags(flags *pflag.FlagSet, cfg *InitConfig) {
 	libcmd.BindHomeFlag(flags, &cfg.HomeDir)
 	netconf.BindFlag(flags, &cfg.Network)
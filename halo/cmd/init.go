@@ -97,7 +97,7 @@ Ensures all the following files and directories exist:
   ├── data                           # Data directory
   │   ├── snapshots                  # Snapshot directory
   │   ├── priv_validator_state.json  # CometBFT private validator state (slashing protection)
-  │   └── voter_state.json           # Cross chain voter state (slashing protection)
+  │   └── voter_state.db             # Cross chain voter state (slashing protection)
 
 Existing files are not overwritten, unless --clean is specified.
 The home directory should only contain subdirectories, no files, use --force to ignore this check.
@@ -322,13 +322,14 @@ func InitFiles(ctx context.Context, initCfg InitConfig) error {
 	}
 
 	// Vote state
-	voterStateFile := cfg.VoterStateFile()
-	if cmtos.FileExists(voterStateFile) {
-		log.Info(ctx, "Found voter state file", "path", voterStateFile)
-	} else if err := voter.GenEmptyStateFile(voterStateFile); err != nil {
+	if voterStateFile := cfg.VoterStateFile(); cmtos.FileExists(voterStateFile) {
+		log.Info(ctx, "Found legacy voter state file, will be migrated on startup", "path", voterStateFile)
+	} else if voter.StateDBExists(cfg.DataDir()) {
+		log.Info(ctx, "Found voter state database", "dir", cfg.DataDir())
+	} else if err := voter.GenEmptyState(cfg.DataDir()); err != nil {
 		return err
 	} else {
-		log.Info(ctx, "Generated voter state file", "path", voterStateFile)
+		log.Info(ctx, "Generated voter state database", "dir", cfg.DataDir())
 	}
 
 	return nil
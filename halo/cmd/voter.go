@@ -0,0 +1,394 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/omni-network/omni/halo/app"
+	akeeper "github.com/omni-network/omni/halo/attest/keeper"
+	atypes "github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/halo/attest/voter"
+	halocfg "github.com/omni-network/omni/halo/config"
+	libcmd "github.com/omni-network/omni/lib/cmd"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/xchain"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdklog "cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// VoterStateConfig is the config for the voter state inspection and repair commands.
+type VoterStateConfig struct {
+	HomeDir   string
+	StateDir  string // Defaults to <home>/data.
+	DryRun    bool
+	Network   netconf.ID
+	DBBackend string // Backend of the halo application database in <home>/data.
+	ChainID   uint64
+	ConfLevel string
+	Force     bool // Allows resetting fuzzy chain versions.
+}
+
+func defaultVoterStateConfig() VoterStateConfig {
+	return VoterStateConfig{
+		HomeDir:   halocfg.DefaultHomeDir,
+		DBBackend: halocfg.DefaultConfig().BackendType,
+		ConfLevel: xchain.ConfFinalized.String(),
+	}
+}
+
+// stateDir returns the voter state directory.
+func (c VoterStateConfig) stateDir() string {
+	if c.StateDir != "" {
+		return c.StateDir
+	}
+
+	return halocfg.Config{HomeDir: c.HomeDir}.DataDir()
+}
+
+// legacyStateFile returns the path to the legacy voter state file in the state directory.
+func (c VoterStateConfig) legacyStateFile() string {
+	return filepath.Join(c.stateDir(), filepath.Base(halocfg.Config{}.VoterStateFile()))
+}
+
+// chainVersion returns the chain version of the --chain-id and --conf-level flags.
+func (c VoterStateConfig) chainVersion() (xchain.ChainVersion, error) {
+	if c.ChainID == 0 {
+		return xchain.ChainVersion{}, errors.New("required flag --chain-id not set")
+	}
+
+	for _, conf := range []xchain.ConfLevel{xchain.ConfFinalized, xchain.ConfLatest} {
+		if conf.String() == c.ConfLevel {
+			return xchain.NewChainVersion(c.ChainID, conf), nil
+		}
+	}
+
+	return xchain.ChainVersion{}, errors.New("invalid --conf-level", "conf_level", c.ConfLevel)
+}
+
+// chainVerName returns the chain version name, using network metadata if the network is known.
+func (c VoterStateConfig) chainVerName(chainVer xchain.ChainVersion) string {
+	if c.Network != "" && netconf.MetadataByID(c.Network, chainVer.ID).Name != "" {
+		return netconf.ChainVersionNamer(c.Network)(chainVer)
+	}
+
+	return fmt.Sprintf("%d|%s", chainVer.ID, chainVer.ConfLevel.Label())
+}
+
+// openState opens the voter state, or an in-memory copy if --dry-run is set.
+func (c VoterStateConfig) openState() (dbm.DB, error) {
+	if c.DryRun {
+		return voter.OpenStateCopy(c.stateDir(), c.legacyStateFile())
+	}
+
+	db, err := voter.OpenState(c.stateDir(), c.legacyStateFile())
+	if err != nil {
+		return nil, errors.Wrap(err, "open voter state (ensure halo and voter sidecar are stopped)")
+	}
+
+	return db, nil
+}
+
+// approvedFunc returns the latest approved attest offset of the chain version, or zero if none.
+type approvedFunc func(ctx context.Context, chainVer xchain.ChainVersion) (uint64, error)
+
+// openApproved returns an approvedFunc reading the halo application database in <home>/data.
+// The database is read offline, since halo must be stopped anyway to access the voter state.
+// It returns a function to close the database.
+func (c VoterStateConfig) openApproved() (approvedFunc, func() error, error) {
+	dataDir := halocfg.Config{HomeDir: c.HomeDir}.DataDir()
+	if !dbm.FileExists(filepath.Join(dataDir, "application.db")) {
+		return nil, nil, errors.New("halo application database not found", "dir", dataDir)
+	}
+
+	db, err := dbm.NewDB("application", dbm.BackendType(c.DBBackend), dataDir)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "open halo application db (ensure halo is stopped)")
+	}
+
+	approved, err := newApprovedFunc(db)
+	if err != nil {
+		_ = db.Close()
+		return nil, nil, err
+	}
+
+	return approved, db.Close, nil
+}
+
+// newApprovedFunc returns an approvedFunc reading the attest module state of the latest committed
+// version in the halo application database. Nothing is written to the database.
+func newApprovedFunc(db dbm.DB) (approvedFunc, error) {
+	key := storetypes.NewKVStoreKey(atypes.ModuleName)
+	cms := rootmulti.NewStore(db, sdklog.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.SetIAVLDisableFastNode(true) // Avoid fast node upgrades writing to the database.
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	if err := cms.LoadLatestVersion(); err != nil {
+		return nil, errors.Wrap(err, "load halo application state")
+	} else if cms.LastCommitID().Version == 0 {
+		return nil, errors.New("halo application state empty")
+	}
+
+	attStore, err := akeeper.NewORMStore(runtime.NewKVStoreService(key))
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.NewContext(cms.CacheMultiStore(), cmtproto.Header{}, false, sdklog.NewNopLogger())
+
+	return func(ctx context.Context, chainVer xchain.ChainVersion) (uint64, error) {
+		offset, _, err := akeeper.LatestApprovedOffset(sdkCtx.WithContext(ctx), attStore, chainVer)
+		if err != nil {
+			return 0, errors.Wrap(err, "latest approved attestation", "chain_id", chainVer.ID, "conf_level", chainVer.ConfLevel)
+		}
+
+		return offset, nil
+	}, nil
+}
+
+func newVoterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voter",
+		Short: "Run or manage the xchain voter",
+	}
+
+	cmd.AddCommand(
+		newVoterServeCmd(),
+		newVoterStatusCmd(),
+		newVoterCompareCmd(),
+		newVoterResetCmd(),
+	)
+
+	return cmd
+}
+
+func newVoterServeCmd() *cobra.Command {
+	cfg := app.DefaultVoterConfig()
+	logCfg := log.DefaultConfig()
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Runs the xchain voter as a sidecar process",
+		Long: "Runs the xchain voter as a separate process with its own key custody. " +
+			"It signs votes with the CometBFT privval key in <home>/priv_validator_key.json " +
+			"and serves them to halo via an authenticated gRPC API. The shared auth token is " +
+			"generated in <home>/voter_auth_token if it doesn't exist. " +
+			"Configure halo with --voter-address and --voter-auth-file to use it.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx, err := log.Init(cmd.Context(), logCfg)
+			if err != nil {
+				return err
+			}
+			if err := libcmd.LogFlags(ctx, cmd.Flags()); err != nil {
+				return err
+			}
+
+			return app.RunVoter(ctx, cfg)
+		},
+	}
+
+	bindVoterServeFlags(cmd, &cfg)
+	log.BindFlags(cmd.Flags(), &logCfg)
+
+	return cmd
+}
+
+func newVoterStatusCmd() *cobra.Command {
+	cfg := defaultVoterStateConfig()
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the local voter state per chain version",
+		Long: "Show the latest vote, and the count and offset range of available, proposed and committed votes " +
+			"per chain version in the local voter state. Halo (or the voter sidecar) must be stopped.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return voterStatus(cfg, cmd.OutOrStdout())
+		},
+	}
+
+	bindVoterStateFlags(cmd.Flags(), &cfg)
+
+	return cmd
+}
+
+func newVoterCompareCmd() *cobra.Command {
+	cfg := defaultVoterStateConfig()
+
+	cmd := &cobra.Command{
+		Use:   "compare",
+		Short: "Compare the local voter state against the latest approved attestations on-chain",
+		Long: "Compare the latest vote of each chain version in the local voter state against the latest approved " +
+			"attestation in the halo application state in <home>/data. A chain version is stalled if the voter voted ahead of the approved " +
+			"offset but doesn't have the next vote available anymore, see 'halo voter reset'. " +
+			"Halo (and the voter sidecar) must be stopped.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			approved, closeFunc, err := cfg.openApproved()
+			if err != nil {
+				return err
+			}
+			defer closeFunc() //nolint:errcheck // Read-only
+
+			return voterCompare(cmd.Context(), cfg, approved, cmd.OutOrStdout())
+		},
+	}
+
+	bindVoterStateFlags(cmd.Flags(), &cfg)
+	bindVoterStateAppFlags(cmd.Flags(), &cfg)
+
+	return cmd
+}
+
+func newVoterResetCmd() *cobra.Command {
+	cfg := defaultVoterStateConfig()
+
+	cmd := &cobra.Command{
+		Use:   "reset",
+		Short: "Reset the local voter state of a chain version to the latest approved attestation on-chain",
+		Long: "Reset the local voter state of a single chain version so that the voter resumes voting from the latest " +
+			"approved attestation in the halo application state in <home>/data. It deletes the latest vote and all votes after the approved offset. " +
+			"Re-voting finalized blocks results in identical votes, but fuzzy (latest) blocks may have reorged " +
+			"which risks double signing, so resetting the latest confirmation level requires --force. " +
+			"Halo (and the voter sidecar) must be stopped.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			approved, closeFunc, err := cfg.openApproved()
+			if err != nil {
+				return err
+			}
+			defer closeFunc() //nolint:errcheck // Read-only
+
+			return voterReset(cmd.Context(), cfg, approved)
+		},
+	}
+
+	bindVoterStateFlags(cmd.Flags(), &cfg)
+	bindVoterStateAppFlags(cmd.Flags(), &cfg)
+	bindVoterResetFlags(cmd.Flags(), &cfg)
+
+	return cmd
+}
+
+// voterStatus writes the local voter state per chain version to out.
+func voterStatus(cfg VoterStateConfig, out io.Writer) error {
+	states, err := inspectVoterState(cfg)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "CHAIN\tLATEST\tAVAILABLE\tPROPOSED\tCOMMITTED")
+	for _, s := range states {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n",
+			cfg.chainVerName(s.ChainVersion), s.Latest, s.Available, s.Proposed, s.Committed)
+	}
+
+	if err := w.Flush(); err != nil {
+		return errors.Wrap(err, "flush")
+	}
+
+	return nil
+}
+
+// voterCompare writes the local voter state compared to the latest approved attestation per chain version to out.
+func voterCompare(ctx context.Context, cfg VoterStateConfig, approvedOffset approvedFunc, out io.Writer) error {
+	states, err := inspectVoterState(cfg)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "CHAIN\tLATEST\tAPPROVED\tAVAILABLE\tPROPOSED\tSTATUS")
+	for _, s := range states {
+		approved, err := approvedOffset(ctx, s.ChainVersion)
+		if err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\n",
+			cfg.chainVerName(s.ChainVersion), s.Latest, formatOffset(approved), s.Available, s.Proposed, compareStatus(s, approved))
+	}
+
+	if err := w.Flush(); err != nil {
+		return errors.Wrap(err, "flush")
+	}
+
+	return nil
+}
+
+// voterReset resets the local voter state of the configured chain version to the latest approved attestation.
+func voterReset(ctx context.Context, cfg VoterStateConfig, approvedOffset approvedFunc) error {
+	chainVer, err := cfg.chainVersion()
+	if err != nil {
+		return err
+	}
+
+	approved, err := approvedOffset(ctx, chainVer)
+	if err != nil {
+		return err
+	}
+
+	db, err := cfg.openState()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	deleted, err := voter.ResetChain(db, chainVer, approved, cfg.Force)
+	if err != nil {
+		return errors.Wrap(err, "reset voter state", "chain", cfg.chainVerName(chainVer))
+	}
+
+	msg := "Reset voter state"
+	if cfg.DryRun {
+		msg = "Dry-run: would reset voter state"
+	}
+	log.Info(ctx, msg, "chain", cfg.chainVerName(chainVer), "approved_offset", approved, "deleted_votes", deleted)
+
+	return nil
+}
+
+// inspectVoterState returns the local voter state per chain version.
+// It reads an in-memory copy, so the legacy JSON state file isn't migrated, i.e., it is read-only.
+func inspectVoterState(cfg VoterStateConfig) ([]voter.ChainState, error) {
+	db, err := voter.OpenStateCopy(cfg.stateDir(), cfg.legacyStateFile())
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	return voter.InspectState(db)
+}
+
+// compareStatus returns the status of the local voter state compared to the approved offset.
+func compareStatus(s voter.ChainState, approved uint64) string {
+	switch {
+	case s.Latest < approved:
+		return "behind" // Voter skips ahead to the approved offset on startup.
+	case s.Latest == approved:
+		return "ok"
+	case s.Available.Contains(approved+1) || s.Proposed.Contains(approved+1):
+		return "ok"
+	default:
+		return "stalled"
+	}
+}
+
+func formatOffset(offset uint64) string {
+	if offset == 0 {
+		return "-"
+	}
+
+	return strconv.FormatUint(offset, 10)
+}
@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	akeeper "github.com/omni-network/omni/halo/attest/keeper"
+	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/halo/attest/voter"
+	"github.com/omni-network/omni/lib/tutil"
+	"github.com/omni-network/omni/lib/xchain"
+
+	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdklog "cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	fuzz "github.com/google/gofuzz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoterState(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := defaultVoterStateConfig()
+	cfg.StateDir = t.TempDir()
+	cfg.ChainID = 100
+
+	// Votes 1-5 were voted, 1-3 committed, then 4-5 lost (e.g. trimmed); so chain 100 is stalled.
+	// Chain 200 votes 1-2 are available.
+	committed := genVotes(t, 100, 1, 3)
+	lost := genVotes(t, 100, 4, 5)
+	available := genVotes(t, 200, 1, 2)
+	legacy := map[string][]*types.Vote{
+		"available": available,
+		"proposed":  nil,
+		"committed": committed,
+		"latest":    {lost[1], available[1]},
+	}
+	bz, err := json.Marshal(legacy)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(cfg.legacyStateFile(), bz, 0o600))
+
+	approved := stubApproved(map[uint64]uint64{100: 3})
+
+	status := func() string {
+		t.Helper()
+
+		var out bytes.Buffer
+		require.NoError(t, voterStatus(cfg, &out))
+
+		return out.String()
+	}
+
+	compare := func() string {
+		t.Helper()
+
+		var out bytes.Buffer
+		require.NoError(t, voterCompare(ctx, cfg, approved, &out))
+
+		return out.String()
+	}
+
+	const statusBefore = "" +
+		"CHAIN  LATEST  AVAILABLE  PROPOSED  COMMITTED\n" +
+		"100|F  5       0          0         3 [1-3]\n" +
+		"200|F  2       2 [1-2]    0         0\n"
+
+	// Status and compare are read-only, so they don't migrate the legacy file.
+	require.Equal(t, statusBefore, status())
+	require.FileExists(t, cfg.legacyStateFile())
+
+	require.Equal(t, ""+
+		"CHAIN  LATEST  APPROVED  AVAILABLE  PROPOSED  STATUS\n"+
+		"100|F  5       3         0          0         stalled\n"+
+		"200|F  2       -         2 [1-2]    0         ok\n",
+		compare())
+	require.FileExists(t, cfg.legacyStateFile())
+
+	// Dry-run reset doesn't change state, nor migrate the legacy file.
+	cfg.DryRun = true
+	require.NoError(t, voterReset(ctx, cfg, approved))
+	require.Equal(t, statusBefore, status())
+	require.FileExists(t, cfg.legacyStateFile())
+
+	cfg.DryRun = false
+	require.NoError(t, voterReset(ctx, cfg, approved))
+	require.NoFileExists(t, cfg.legacyStateFile())
+	require.Equal(t, ""+
+		"CHAIN  LATEST  APPROVED  AVAILABLE  PROPOSED  STATUS\n"+
+		"100|F  0       3         0          0         behind\n"+
+		"200|F  2       -         2 [1-2]    0         ok\n",
+		compare())
+
+	require.ErrorContains(t, voterReset(ctx, cfg, approved), "nothing to reset")
+
+	// Resetting the latest confirmation level requires force.
+	cfg.ConfLevel = xchain.ConfLatest.String()
+	require.ErrorContains(t, voterReset(ctx, cfg, approved), "without force")
+	cfg.Force = true
+	require.ErrorContains(t, voterReset(ctx, cfg, approved), "nothing to reset")
+}
+
+func TestApprovedFunc(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// Commit an approved and a pending attestation to an application database.
+	db := dbm.NewMemDB()
+	key := storetypes.NewKVStoreKey(types.ModuleName)
+	cms := rootmulti.NewStore(db, sdklog.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	attStore, err := akeeper.NewORMStore(runtime.NewKVStoreService(key))
+	require.NoError(t, err)
+
+	sdkCtx := sdk.NewContext(cms, cmtproto.Header{}, false, sdklog.NewNopLogger())
+	for offset, status := range []akeeper.Status{akeeper.Status_Approved, akeeper.Status_Approved, akeeper.Status_Pending} {
+		err := attStore.AttestationTable().Insert(sdkCtx, &akeeper.Attestation{
+			ChainId:         100,
+			ConfLevel:       uint32(xchain.ConfFinalized),
+			AttestOffset:    uint64(offset + 1),
+			BlockHash:       tutil.RandomHash().Bytes(),
+			MsgRoot:         tutil.RandomHash().Bytes(),
+			AttestationRoot: tutil.RandomHash().Bytes(),
+			Status:          uint32(status),
+			ValidatorSetId:  1,
+		})
+		require.NoError(t, err)
+	}
+	cms.Commit()
+
+	approved, err := newApprovedFunc(db)
+	require.NoError(t, err)
+
+	offset, err := approved(ctx, xchain.NewChainVersion(100, xchain.ConfFinalized))
+	require.NoError(t, err)
+	require.EqualValues(t, 2, offset)
+
+	offset, err = approved(ctx, xchain.NewChainVersion(200, xchain.ConfFinalized))
+	require.NoError(t, err)
+	require.Zero(t, offset)
+
+	// Empty application databases are rejected.
+	_, err = newApprovedFunc(dbm.NewMemDB())
+	require.ErrorContains(t, err, "halo application state empty")
+}
+
+// genVotes returns votes for the chain from and to the attest offsets (inclusive).
+func genVotes(t *testing.T, chainID uint64, from, to uint64) []*types.Vote {
+	t.Helper()
+
+	fuzzer := fuzz.New().NilChance(0).NumElements(1, 4)
+	privKey := k1.GenPrivKey()
+
+	var resp []*types.Vote
+	for offset := from; offset <= to; offset++ {
+		var block xchain.Block
+		fuzzer.Fuzz(&block)
+		block.ChainID = chainID
+
+		vote, err := voter.CreateVote(privKey, xchain.AttestHeader{
+			ConsensusChainID: 1,
+			ChainVersion:     xchain.NewChainVersion(chainID, xchain.ConfFinalized),
+			AttestOffset:     offset,
+		}, block)
+		require.NoError(t, err)
+
+		resp = append(resp, vote)
+	}
+
+	return resp
+}
+
+// stubApproved returns an approvedFunc returning the approved attest offsets by chain ID.
+func stubApproved(approved map[uint64]uint64) approvedFunc {
+	return func(_ context.Context, chainVer xchain.ChainVersion) (uint64, error) {
+		return approved[chainVer.ID], nil
+	}
+}
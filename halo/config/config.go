@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -28,6 +29,7 @@ const (
 	dataDir              = "data"
 	configDir            = "config"
 	snapshotDataDir      = "snapshots"
+	attestArchiveDir     = "attestations"
 	voterStateFile       = "voter_state.json"
 	executionGenesisFile = "execution_genesis.json"
 
@@ -40,6 +42,10 @@ const (
 	defaultDBBackend          = db.GoLevelDBBackend
 	defaultEVMBuildDelay      = time.Millisecond * 600 // 100ms longer than geth's --miner.recommit=500ms.
 	defaultEVMBuildOptimistic = true
+	defaultGRPCAddress        = "" // Disabled by default
+	defaultAPIAddress         = "" // Disabled by default
+	defaultAttestArchive      = false
+	defaultVoterAddress       = "" // In-process voter by default
 )
 
 // DefaultConfig returns the default halo config.
@@ -56,6 +62,13 @@ func DefaultConfig() Config {
 		PruningOption:      defaultPruningOption,
 		EVMBuildDelay:      defaultEVMBuildDelay,
 		EVMBuildOptimistic: defaultEVMBuildOptimistic,
+		EVMPragueTime:      0, // Network default
+		GRPCAddress:        defaultGRPCAddress,
+		APIAddress:         defaultAPIAddress,
+		AttestArchive:      defaultAttestArchive,
+		VoterAddress:       defaultVoterAddress,
+		VoterAuthFile:      "", // No default
+		VoterTLSCAFile:     "", // No default
 		Tracer:             tracer.DefaultConfig(),
 	}
 }
@@ -66,6 +79,7 @@ type Config struct {
 	Network            netconf.ID
 	EngineJWTFile      string
 	EngineEndpoint     string
+	EngineSecondaries  []string // Secondary Engine API endpoints, authenticated with EngineJWTFile.
 	RPCEndpoints       xchain.RPCEndpoints
 	SnapshotInterval   uint64 // See cosmossdk.io/store/snapshots/types/options.go
 	SnapshotKeepRecent uint64 // See cosmossdk.io/store/snapshots/types/options.go
@@ -74,6 +88,13 @@ type Config struct {
 	PruningOption      string // See cosmossdk.io/store/pruning/types/options.go
 	EVMBuildDelay      time.Duration
 	EVMBuildOptimistic bool
+	EVMPragueTime      uint64 // Overrides the network's EVM Prague fork timestamp if non-zero.
+	GRPCAddress        string
+	APIAddress         string
+	AttestArchive      bool
+	VoterAddress       string
+	VoterAuthFile      string
+	VoterTLSCAFile     string // Required if VoterAddress isn't a loopback address or unix socket.
 	Tracer             tracer.Config
 	UnsafeSkipUpgrades []int
 }
@@ -87,10 +108,17 @@ func (c Config) DataDir() string {
 	return filepath.Join(c.HomeDir, dataDir)
 }
 
+// VoterStateFile returns the path to the legacy voter state file.
+// It is migrated to the voter state database in the data directory on startup, see voter.OpenState.
 func (c Config) VoterStateFile() string {
 	return filepath.Join(c.DataDir(), voterStateFile)
 }
 
+// AttestArchiveDir returns the directory of the node-local attestation archive.
+func (c Config) AttestArchiveDir() string {
+	return filepath.Join(c.DataDir(), attestArchiveDir)
+}
+
 func (c Config) AppStateDir() string {
 	return c.DataDir() // Maybe add a subdirectory for app state?
 }
@@ -112,6 +140,8 @@ func (c Config) Verify() error {
 		return errors.New("flag --network is empty")
 	} else if err := c.Network.Verify(); err != nil {
 		return err
+	} else if c.VoterAddress != "" && c.VoterAuthFile == "" {
+		return errors.New("flag --voter-auth-file is empty while --voter-address is set")
 	}
 
 	return nil
@@ -125,7 +155,7 @@ func WriteConfigTOML(cfg Config, logCfg log.Config) error {
 	var buffer bytes.Buffer
 
 	t, err := template.New("").
-		Funcs(template.FuncMap{"FmtIntSlice": fmtSlice[int]}).
+		Funcs(template.FuncMap{"FmtIntSlice": fmtSlice[int], "FmtStringSlice": fmtStringSlice}).
 		Parse(string(tomlTemplate))
 	if err != nil {
 		return errors.Wrap(err, "parse template")
@@ -152,6 +182,16 @@ func WriteConfigTOML(cfg Config, logCfg log.Config) error {
 	return nil
 }
 
+// fmtStringSlice returns the slice formatted as a TOML array of strings.
+func fmtStringSlice(slice []string) string {
+	quoted := make([]string, 0, len(slice))
+	for _, v := range slice {
+		quoted = append(quoted, strconv.Quote(v))
+	}
+
+	return fmtSlice(quoted)
+}
+
 func fmtSlice[T any](slice []T) string {
 	var sb strings.Builder
 	for i, v := range slice {
@@ -0,0 +1,115 @@
+// Package evmattest monitors the AttestParams pre-deploy contract and converts
+// its log events to attest module param updates.
+package evmattest
+
+import (
+	"context"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/halo/app/upgrades"
+	akeeper "github.com/omni-network/omni/halo/attest/keeper"
+	atypes "github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/halo/genutil/evm/predeploys"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/octane/evmengine/eventproc"
+	evmenginetypes "github.com/omni-network/omni/octane/evmengine/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const ModuleName = "evmattest"
+
+var _ evmenginetypes.EvmEventProcessor = EventProcessor{}
+
+// EventProcessor implements the evmenginetypes.EvmEventProcessor interface.
+type EventProcessor struct {
+	eventproc.Processor
+	aKeeper *akeeper.Keeper
+	uKeeper upgrades.Keeper
+}
+
+// New returns a new EventProcessor.
+func New(ethCl ethclient.Client, aKeeper *akeeper.Keeper, uKeeper upgrades.Keeper) (EventProcessor, error) {
+	p := EventProcessor{
+		aKeeper: aKeeper,
+		uKeeper: uKeeper,
+	}
+
+	proc, err := eventproc.New(ethCl, eventproc.Config{
+		Name:    ModuleName,
+		Address: common.HexToAddress(predeploys.AttestParams),
+		ABI:     bindings.AttestParamsMetaData,
+		Handlers: []eventproc.Handler{
+			eventproc.Handle("SetVoteParams", p.deliverSetVoteParams),
+			eventproc.Handle("SetVoteExtensionEncoding", p.deliverSetVoteExtensionEncoding),
+		},
+	})
+	if err != nil {
+		return EventProcessor{}, errors.Wrap(err, "new event processor")
+	}
+	p.Processor = proc
+
+	return p, nil
+}
+
+// deliverSetVoteParams processes a SetVoteParams event.
+// Invalid params are logged and ignored, since they were already accepted by the EVM.
+func (p EventProcessor) deliverSetVoteParams(ctx context.Context, ev *bindings.AttestParamsSetVoteParams) error {
+	if ok, err := p.enabled(ctx, "SetVoteParams"); err != nil || !ok {
+		return err
+	}
+
+	err := p.aKeeper.UpdateVoteParams(ctx, ev.VoteWindow, ev.VoteExtLimit, ev.TrimLag, ev.CTrimLag)
+	if err != nil {
+		log.Warn(ctx, "Ignoring invalid EVM attest vote params", err,
+			"vote_window", ev.VoteWindow,
+			"vote_ext_limit", ev.VoteExtLimit,
+			"trim_lag", ev.TrimLag,
+			"ctrim_lag", ev.CTrimLag,
+		)
+
+		return nil
+	}
+
+	log.Info(ctx, "EVM attest vote params updated",
+		"vote_window", ev.VoteWindow,
+		"vote_ext_limit", ev.VoteExtLimit,
+		"trim_lag", ev.TrimLag,
+		"ctrim_lag", ev.CTrimLag,
+	)
+
+	return nil
+}
+
+// deliverSetVoteExtensionEncoding processes a SetVoteExtensionEncoding event.
+// Unknown encodings are logged and ignored, since they were already accepted by the EVM.
+func (p EventProcessor) deliverSetVoteExtensionEncoding(ctx context.Context, ev *bindings.AttestParamsSetVoteExtensionEncoding) error {
+	if ok, err := p.enabled(ctx, "SetVoteExtensionEncoding"); err != nil || !ok {
+		return err
+	}
+
+	encoding := atypes.VoteExtensionEncoding(ev.Encoding)
+	if err := p.aKeeper.UpdateVoteExtensionEncoding(ctx, encoding); err != nil {
+		log.Warn(ctx, "Ignoring invalid EVM attest vote extension encoding", err, "encoding", ev.Encoding)
+		return nil
+	}
+
+	log.Info(ctx, "EVM attest vote extension encoding updated", "encoding", encoding)
+
+	return nil
+}
+
+// enabled returns true if attest params events are processed, which is only after the V1 network upgrade,
+// since binaries predating it don't process them.
+func (p EventProcessor) enabled(ctx context.Context, event string) (bool, error) {
+	active, err := upgrades.IsActive(ctx, p.uKeeper, upgrades.V1)
+	if err != nil {
+		return false, err
+	} else if !active {
+		log.Info(ctx, "EVM attest params event detected, updates not enabled yet", "event", event)
+	}
+
+	return active, nil
+}
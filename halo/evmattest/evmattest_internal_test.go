@@ -0,0 +1,165 @@
+package evmattest
+
+import (
+	"testing"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/halo/app/upgrades"
+	"github.com/omni-network/omni/halo/attest/keeper"
+	atypes "github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/halo/genutil/evm/predeploys"
+	"github.com/omni-network/omni/octane/evmengine/eventproc"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	setVoteParamsEvent            = eventproc.MustGetEvent(bindings.AttestParamsMetaData, "SetVoteParams")
+	setVoteExtensionEncodingEvent = eventproc.MustGetEvent(bindings.AttestParamsMetaData, "SetVoteExtensionEncoding")
+)
+
+func TestSetVoteParams(t *testing.T) {
+	t.Parallel()
+
+	ctx, proc, aKeeper, ethCl := setupProcessor(t)
+
+	deliver := func(voteWindow, voteExtLimit, trimLag, cTrimLag uint64) error {
+		t.Helper()
+
+		bz, err := setVoteParamsEvent.Inputs.NonIndexed().Pack(voteWindow, voteExtLimit, trimLag, cTrimLag)
+		require.NoError(t, err)
+		ethCl.Logs = []types.Log{{
+			Address: common.HexToAddress(predeploys.AttestParams),
+			Topics:  []common.Hash{setVoteParamsEvent.ID},
+			Data:    bz,
+		}}
+
+		events, err := proc.Prepare(ctx, common.Hash{})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		return proc.Deliver(ctx, common.Hash{}, events[0])
+	}
+
+	params := func() atypes.Params {
+		t.Helper()
+
+		resp, err := aKeeper.Params(ctx, &atypes.ParamsRequest{})
+		require.NoError(t, err)
+
+		return resp.GetParams()
+	}
+
+	// Updates are ignored before the network upgrade.
+	upgradesDone := proc.uKeeper.(eventproc.StubUpgrades)
+	delete(upgradesDone, upgrades.V1)
+	require.NoError(t, deliver(32, 256, 2, 10))
+	require.Equal(t, atypes.DefaultParams().VoteWindow, params().VoteWindow)
+	upgradesDone[upgrades.V1] = 1
+
+	// Valid params are applied.
+	require.NoError(t, deliver(64, 256, 2, 10))
+	require.EqualValues(t, 64, params().VoteWindow)
+	require.EqualValues(t, 256, params().VoteExtensionLimit)
+	require.EqualValues(t, 2, params().TrimLag)
+	require.EqualValues(t, 10, params().ConsensusTrimLag)
+
+	// Invalid params are ignored (without error), leaving the params unchanged.
+	require.NoError(t, deliver(0, 256, 2, 10))
+	require.NoError(t, deliver(64, 256, 10, 2))
+	require.NoError(t, deliver(64, 1<<20, 2, 10))
+	require.EqualValues(t, 64, params().VoteWindow)
+	require.EqualValues(t, 256, params().VoteExtensionLimit)
+	require.EqualValues(t, 10, params().ConsensusTrimLag)
+
+	// Unknown events are rejected.
+	events, err := proc.Prepare(ctx, common.Hash{})
+	require.NoError(t, err)
+	events[0].Topics = [][]byte{common.HexToHash("0x01").Bytes()}
+	require.ErrorContains(t, proc.Deliver(ctx, common.Hash{}, events[0]), "unknown event")
+}
+
+func TestSetVoteExtensionEncoding(t *testing.T) {
+	t.Parallel()
+
+	ctx, proc, aKeeper, ethCl := setupProcessor(t)
+
+	deliver := func(encoding uint8) error {
+		t.Helper()
+
+		bz, err := setVoteExtensionEncodingEvent.Inputs.NonIndexed().Pack(encoding)
+		require.NoError(t, err)
+		ethCl.Logs = []types.Log{{
+			Address: common.HexToAddress(predeploys.AttestParams),
+			Topics:  []common.Hash{setVoteExtensionEncodingEvent.ID},
+			Data:    bz,
+		}}
+
+		events, err := proc.Prepare(ctx, common.Hash{})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		return proc.Deliver(ctx, common.Hash{}, events[0])
+	}
+
+	encoding := func() atypes.VoteExtensionEncoding {
+		t.Helper()
+
+		resp, err := aKeeper.Params(ctx, &atypes.ParamsRequest{})
+		require.NoError(t, err)
+
+		return resp.GetParams().VoteExtensionEncoding
+	}
+
+	// Networks without attest module genesis default to the original encoding.
+	require.Equal(t, atypes.VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_PROTO, encoding())
+
+	// Updates are ignored before the network upgrade.
+	upgradesDone := proc.uKeeper.(eventproc.StubUpgrades)
+	delete(upgradesDone, upgrades.V1)
+	require.NoError(t, deliver(uint8(atypes.VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_COMPACT)))
+	require.Equal(t, atypes.VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_PROTO, encoding())
+	upgradesDone[upgrades.V1] = 1
+
+	// Known encodings are applied.
+	require.NoError(t, deliver(uint8(atypes.VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_COMPACT_FLATE)))
+	require.Equal(t, atypes.VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_COMPACT_FLATE, encoding())
+
+	// Unknown encodings are ignored (without error), leaving the encoding unchanged.
+	require.NoError(t, deliver(99))
+	require.Equal(t, atypes.VoteExtensionEncoding_VOTE_EXTENSION_ENCODING_COMPACT_FLATE, encoding())
+}
+
+func TestFuzz(t *testing.T) {
+	t.Parallel()
+
+	ctx, proc, _, _ := setupProcessor(t)
+	eventproc.Fuzz(t, ctx, proc.Processor, 20)
+}
+
+func setupProcessor(t *testing.T) (sdk.Context, EventProcessor, *keeper.Keeper, *eventproc.StubLogClient) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(atypes.ModuleName)
+	ctx := sdktestutil.DefaultContext(key, storetypes.NewTransientStoreKey("test_key"))
+	codec := moduletestutil.MakeTestEncodingConfig().Codec
+
+	// Only the attest params are used by the event processor, so other dependencies are omitted.
+	aKeeper, err := keeper.New(codec, runtime.NewKVStoreService(key), nil, nil, nil, nil, sdkmath.LegacyZeroDec(), 0)
+	require.NoError(t, err)
+
+	ethCl := new(eventproc.StubLogClient)
+	proc, err := New(ethCl, aKeeper, eventproc.StubUpgrades{upgrades.V1: 1})
+	require.NoError(t, err)
+
+	return ctx, proc, aKeeper, ethCl
+}
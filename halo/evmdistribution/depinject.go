@@ -0,0 +1,43 @@
+package evmdistribution
+
+import (
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	evmenginetypes "github.com/omni-network/omni/octane/evmengine/types"
+
+	"cosmossdk.io/depinject"
+	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
+	bkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	dkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+)
+
+type DIInputs struct {
+	depinject.In
+	EthCl         ethclient.Client
+	DistrKeeper   dkeeper.Keeper
+	BankKeeper    bkeeper.Keeper
+	UpgradeKeeper *upgradekeeper.Keeper
+}
+
+type DIOutputs struct {
+	depinject.Out
+	EventProc         EventProcessor
+	InjectedEventProc evmenginetypes.InjectedEventProc
+}
+
+func DIProvide(input DIInputs) (DIOutputs, error) {
+	proc, err := New(
+		input.EthCl,
+		input.DistrKeeper,
+		input.BankKeeper,
+		input.UpgradeKeeper,
+	)
+	if err != nil {
+		return DIOutputs{}, errors.Wrap(err, "new")
+	}
+
+	return DIOutputs{
+		EventProc:         proc,
+		InjectedEventProc: evmenginetypes.InjectEventProc(proc),
+	}, nil
+}
@@ -0,0 +1,127 @@
+// Package evmdistribution monitors the Distribution pre-deploy contract and converts
+// its log events to cosmosSDK x/distribution logic.
+package evmdistribution
+
+import (
+	"context"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/halo/app/upgrades"
+	"github.com/omni-network/omni/halo/genutil/evm/predeploys"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/octane/evmengine/eventproc"
+	evmenginetypes "github.com/omni-network/omni/octane/evmengine/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	dkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	dtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+const ModuleName = "evmdistribution"
+
+var _ evmenginetypes.EvmEventProcessor = EventProcessor{}
+
+// EventProcessor implements the evmenginetypes.EvmEventProcessor interface.
+type EventProcessor struct {
+	eventproc.Processor
+	dKeeper dkeeper.Keeper
+	bKeeper bkeeper.Keeper
+	uKeeper upgrades.Keeper
+	queue   *withdrawalQueue
+}
+
+// withdrawalQueue wraps the lazily set withdrawal queue, so it is shared by all copies of the EventProcessor.
+type withdrawalQueue struct {
+	evmenginetypes.WithdrawalQueue
+}
+
+// New returns a new EventProcessor.
+func New(ethCl ethclient.Client, dKeeper dkeeper.Keeper, bKeeper bkeeper.Keeper, uKeeper upgrades.Keeper) (EventProcessor, error) {
+	p := EventProcessor{
+		dKeeper: dKeeper,
+		bKeeper: bKeeper,
+		uKeeper: uKeeper,
+		queue:   new(withdrawalQueue),
+	}
+
+	proc, err := eventproc.New(ethCl, eventproc.Config{
+		Name:    ModuleName,
+		Address: common.HexToAddress(predeploys.Distribution),
+		ABI:     bindings.DistributionMetaData,
+		Handlers: []eventproc.Handler{
+			eventproc.Handle("Claim", p.deliverClaim),
+		},
+	})
+	if err != nil {
+		return EventProcessor{}, errors.Wrap(err, "new event processor")
+	}
+	p.Processor = proc
+
+	return p, nil
+}
+
+// SetWithdrawalQueue sets the withdrawal queue used to withdraw claimed rewards to the EVM.
+// It is set after app wiring, since the queue (evmengine keeper) depends on this event processor.
+func (p EventProcessor) SetWithdrawalQueue(queue evmenginetypes.WithdrawalQueue) {
+	p.queue.WithdrawalQueue = queue
+}
+
+// deliverClaim processes a Claim event.
+// - Withdraw the delegator's rewards (and validator commission if self-claimed) to the delegator's account.
+// - Burn the claimed $STAKE and enqueue an EVM withdrawal of the same $OMNI amount.
+//
+// Claims are ignored before the V1 network upgrade, since binaries predating it don't process them.
+// Other $STAKE in the delegator's account (e.g. rewards previously withdrawn automatically by
+// x/distribution on delegation changes) isn't withdrawn.
+func (p EventProcessor) deliverClaim(ctx context.Context, ev *bindings.DistributionClaim) error {
+	if p.queue.WithdrawalQueue == nil {
+		return errors.New("withdrawal queue not set [BUG]")
+	}
+
+	if active, err := upgrades.IsActive(ctx, p.uKeeper, upgrades.V1); err != nil {
+		return err
+	} else if !active {
+		log.Info(ctx, "EVM distribution claim detected, claims not enabled yet",
+			"delegator", ev.Delegator.Hex(),
+			"validator", ev.Validator.Hex())
+
+		return nil
+	}
+
+	delAddr := sdk.AccAddress(ev.Delegator.Bytes())
+	valAddr := sdk.ValAddress(ev.Validator.Bytes())
+
+	rewards, err := p.dKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return errors.Wrap(err, "withdraw delegation rewards")
+	}
+
+	claimed := rewards.AmountOf(sdk.DefaultBondDenom)
+
+	if ev.Delegator == ev.Validator {
+		commission, err := p.dKeeper.WithdrawValidatorCommission(ctx, valAddr)
+		if err != nil && !errors.Is(err, dtypes.ErrNoValidatorCommission) {
+			return errors.Wrap(err, "withdraw validator commission")
+		}
+
+		claimed = claimed.Add(commission.AmountOf(sdk.DefaultBondDenom))
+	}
+
+	amount, err := evmenginetypes.BurnAndWithdraw(ctx, p.queue, p.bKeeper, ModuleName, ev.Delegator, claimed.BigInt())
+	if err != nil {
+		return errors.Wrap(err, "withdraw")
+	}
+
+	log.Info(ctx, "EVM distribution claim detected, withdrawing rewards",
+		"delegator", ev.Delegator.Hex(),
+		"validator", ev.Validator.Hex(),
+		"claimed", claimed,
+		"withdrawn", amount)
+
+	return nil
+}
@@ -0,0 +1,241 @@
+package evmdistribution
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/halo/app/upgrades"
+	"github.com/omni-network/omni/halo/genutil/evm/predeploys"
+	"github.com/omni-network/omni/lib/k1util"
+	"github.com/omni-network/omni/octane/evmengine/eventproc"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	akeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	atypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	btypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	dkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	dtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	skeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+const minter = "minter"
+
+var claimEvent = eventproc.MustGetEvent(bindings.DistributionMetaData, "Claim")
+
+func TestClaim(t *testing.T) {
+	t.Parallel()
+
+	ctx, proc, sKeeper, ethCl := setupProcessor(t)
+	queue := new(stubQueue)
+
+	deliver := func(delegator, validator common.Address) error {
+		t.Helper()
+
+		bz, err := claimEvent.Inputs.NonIndexed().Pack()
+		require.NoError(t, err)
+		ethCl.Logs = []types.Log{{
+			Address: common.HexToAddress(predeploys.Distribution),
+			Topics:  []common.Hash{claimEvent.ID, common.BytesToHash(delegator.Bytes()), common.BytesToHash(validator.Bytes())},
+			Data:    bz,
+		}}
+
+		events, err := proc.Prepare(ctx, common.Hash{})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		return proc.Deliver(ctx, common.Hash{}, events[0])
+	}
+
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	val, err := k1util.PubKeyToAddress(pubkey)
+	require.NoError(t, err)
+	accAddr := sdk.AccAddress(val.Bytes())
+	valAddr := sdk.ValAddress(val.Bytes())
+
+	// The withdrawal queue must be set.
+	require.ErrorContains(t, deliver(val, val), "withdrawal queue not set")
+	proc.SetWithdrawalQueue(queue)
+
+	// Claiming from a non-existent validator fails.
+	require.ErrorContains(t, deliver(val, val), "withdraw delegation rewards")
+
+	// Create a validator with 10% commission.
+	mint(t, ctx, proc.bKeeper, accAddr, ether(100))
+	cosmosPubkey, err := k1util.PubKeyBytesToCosmos(pubkey.Bytes())
+	require.NoError(t, err)
+	msg, err := stypes.NewMsgCreateValidator(
+		valAddr.String(),
+		cosmosPubkey,
+		sdk.NewCoin(sdk.DefaultBondDenom, ether(100)),
+		stypes.Description{Moniker: "val"},
+		stypes.NewCommissionRates(math.LegacyNewDecWithPrec(1, 1), math.LegacyOneDec(), math.LegacyZeroDec()),
+		math.NewInt(1),
+	)
+	require.NoError(t, err)
+	_, err = skeeper.NewMsgServerImpl(sKeeper).CreateValidator(ctx, msg)
+	require.NoError(t, err)
+
+	// Allocate 10 $STAKE rewards to the validator in the next block, 1 of which is commission.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	rewards := sdk.NewDecCoins(sdk.NewDecCoinFromCoin(sdk.NewCoin(sdk.DefaultBondDenom, ether(10))))
+	validator, err := sKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	mint(t, ctx, proc.bKeeper, atypes.NewModuleAddress(dtypes.ModuleName), ether(10))
+	require.NoError(t, proc.dKeeper.AllocateTokensToValidator(ctx, validator, rewards))
+
+	// Claiming rewards from another delegator fails (no delegation).
+	other := common.BytesToAddress(secp256k1.GenPrivKey().PubKey().Address())
+	require.ErrorContains(t, deliver(other, val), "withdraw delegation rewards")
+	require.Empty(t, queue.withdrawals)
+
+	// Claims are ignored before the network upgrade.
+	upgradesDone := proc.uKeeper.(eventproc.StubUpgrades)
+	delete(upgradesDone, upgrades.V1)
+	require.NoError(t, deliver(val, val))
+	require.Empty(t, queue.withdrawals)
+	upgradesDone[upgrades.V1] = 1
+
+	// Claim self-delegation rewards and commission, other $STAKE in the account isn't withdrawn.
+	mint(t, ctx, proc.bKeeper, accAddr, ether(5))
+	require.NoError(t, deliver(val, val))
+	require.Len(t, queue.withdrawals, 1)
+	require.Equal(t, val, queue.withdrawals[0].Address)
+	require.Equal(t, ether(10).BigInt(), queue.withdrawals[0].Amount)
+	require.Equal(t, ether(5), proc.bKeeper.GetBalance(ctx, accAddr, sdk.DefaultBondDenom).Amount)
+
+	// Claiming again withdraws nothing.
+	require.NoError(t, deliver(val, val))
+	require.Len(t, queue.withdrawals, 1)
+}
+
+func mint(t *testing.T, ctx context.Context, bKeeper bkeeper.Keeper, to sdk.AccAddress, amount math.Int) {
+	t.Helper()
+
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount))
+	require.NoError(t, bKeeper.MintCoins(ctx, minter, coins))
+	require.NoError(t, bKeeper.SendCoinsFromModuleToAccount(ctx, minter, to, coins))
+}
+
+func TestFuzz(t *testing.T) {
+	t.Parallel()
+
+	ctx, proc, _, _ := setupProcessor(t)
+	proc.SetWithdrawalQueue(new(stubQueue))
+
+	eventproc.Fuzz(t, ctx, proc.Processor, 20)
+}
+
+func setupProcessor(t *testing.T) (sdk.Context, EventProcessor, *skeeper.Keeper, *eventproc.StubLogClient) {
+	t.Helper()
+
+	keys := storetypes.NewKVStoreKeys(atypes.StoreKey, btypes.StoreKey, stypes.StoreKey, dtypes.StoreKey)
+	ctx := sdktestutil.DefaultContextWithKeys(keys, nil, nil).
+		WithBlockTime(time.Unix(1_700_000_000, 0).UTC()).
+		WithBlockHeight(1)
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(
+		auth.AppModuleBasic{}, bank.AppModuleBasic{}, staking.AppModuleBasic{}, distribution.AppModuleBasic{},
+	)
+	authority := atypes.NewModuleAddress("gov").String()
+	sdkCfg := sdk.GetConfig()
+
+	aKeeper := akeeper.NewAccountKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[atypes.StoreKey]),
+		atypes.ProtoBaseAccount,
+		map[string][]string{
+			ModuleName:               {atypes.Burner},
+			minter:                   {atypes.Minter},
+			dtypes.ModuleName:        nil,
+			stypes.BondedPoolName:    {atypes.Burner, atypes.Staking},
+			stypes.NotBondedPoolName: {atypes.Burner, atypes.Staking},
+			atypes.FeeCollectorName:  nil,
+		},
+		addresscodec.NewBech32Codec(sdkCfg.GetBech32AccountAddrPrefix()),
+		sdkCfg.GetBech32AccountAddrPrefix(),
+		authority,
+	)
+
+	bKeeper := bkeeper.NewBaseKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[btypes.StoreKey]),
+		aKeeper,
+		nil,
+		authority,
+		log.NewNopLogger(),
+	)
+
+	sKeeper := skeeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[stypes.StoreKey]),
+		aKeeper,
+		bKeeper,
+		authority,
+		addresscodec.NewBech32Codec(sdkCfg.GetBech32ValidatorAddrPrefix()),
+		addresscodec.NewBech32Codec(sdkCfg.GetBech32ConsensusAddrPrefix()),
+	)
+	require.NoError(t, sKeeper.SetParams(ctx, stypes.DefaultParams()))
+
+	dKeeper := dkeeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[dtypes.StoreKey]),
+		aKeeper,
+		bKeeper,
+		sKeeper,
+		atypes.FeeCollectorName,
+		authority,
+	)
+	require.NoError(t, dKeeper.Params.Set(ctx, dtypes.DefaultParams()))
+	require.NoError(t, dKeeper.FeePool.Set(ctx, dtypes.InitialFeePool()))
+
+	sKeeper.SetHooks(stypes.NewMultiStakingHooks(dKeeper.Hooks()))
+
+	ethCl := new(eventproc.StubLogClient)
+	proc, err := New(ethCl, dKeeper, bKeeper, eventproc.StubUpgrades{upgrades.V1: 1})
+	require.NoError(t, err)
+
+	return ctx, proc, sKeeper, ethCl
+}
+
+type stubWithdrawal struct {
+	Address common.Address
+	Amount  *big.Int
+}
+
+// stubQueue records inserted withdrawals.
+type stubQueue struct {
+	withdrawals []stubWithdrawal
+}
+
+func (q *stubQueue) InsertWithdrawal(_ context.Context, address common.Address, amountWei *big.Int) error {
+	q.withdrawals = append(q.withdrawals, stubWithdrawal{Address: address, Amount: amountWei})
+	return nil
+}
+
+// ether returns the amount of ether in wei as a math.Int.
+func ether(amount int64) math.Int {
+	return math.NewIntFromBigInt(new(big.Int).Mul(big.NewInt(amount), big.NewInt(params.Ether)))
+}
@@ -6,6 +6,7 @@ import (
 	evmenginetypes "github.com/omni-network/omni/octane/evmengine/types"
 
 	"cosmossdk.io/depinject"
+	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	accountkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -17,6 +18,7 @@ type DIInputs struct {
 	StakingKeeper *stakingkeeper.Keeper
 	BankKeeper    bankkeeper.Keeper
 	AccountKeeper accountkeeper.AccountKeeper
+	UpgradeKeeper *upgradekeeper.Keeper
 }
 
 type DIOutputs struct {
@@ -31,6 +33,7 @@ func DIProvide(input DIInputs) (DIOutputs, error) {
 		input.StakingKeeper,
 		input.BankKeeper,
 		input.AccountKeeper,
+		input.UpgradeKeeper,
 	)
 	if err != nil {
 		return DIOutputs{}, errors.Wrap(err, "new")
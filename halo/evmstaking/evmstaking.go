@@ -7,16 +7,17 @@ import (
 	"math/big"
 
 	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/halo/app/upgrades"
 	"github.com/omni-network/omni/halo/genutil/evm/predeploys"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/ethclient"
 	"github.com/omni-network/omni/lib/k1util"
 	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/octane/evmengine/eventproc"
 	evmenginetypes "github.com/omni-network/omni/octane/evmengine/types"
 
-	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	"github.com/ethereum/go-ethereum/common"
 
 	"cosmossdk.io/math"
@@ -29,22 +30,22 @@ import (
 
 const ModuleName = "evmstaking"
 
-var _ evmenginetypes.EvmEventProcessor = EventProcessor{}
-
 var (
-	stakingABI           = mustGetABI(bindings.StakingMetaData)
-	createValidatorEvent = mustGetEvent(stakingABI, "CreateValidator")
-	delegateEvent        = mustGetEvent(stakingABI, "Delegate")
+	// maxCommissionRate is the maximum commission rate of new validators (100%).
+	maxCommissionRate = math.LegacyOneDec()
+	// maxCommissionChangeRate is the maximum daily commission rate change of new validators (1%).
+	maxCommissionChangeRate = math.LegacyNewDecWithPrec(1, 2)
 )
 
+var _ evmenginetypes.EvmEventProcessor = EventProcessor{}
+
 // EventProcessor implements the evmenginetypes.EvmEventProcessor interface.
 type EventProcessor struct {
-	contract *bindings.Staking
-	ethCl    ethclient.Client
-	address  common.Address
-	sKeeper  *skeeper.Keeper
-	bKeeper  bkeeper.Keeper
-	aKeeper  akeeper.AccountKeeper
+	eventproc.Processor
+	sKeeper *skeeper.Keeper
+	bKeeper bkeeper.Keeper
+	aKeeper akeeper.AccountKeeper
+	uKeeper upgrades.Keeper
 }
 
 // New returns a new EventProcessor.
@@ -53,88 +54,32 @@ func New(
 	sKeeper *skeeper.Keeper,
 	bKeeper bkeeper.Keeper,
 	aKeeper akeeper.AccountKeeper,
+	uKeeper upgrades.Keeper,
 ) (EventProcessor, error) {
-	address := common.HexToAddress(predeploys.Staking)
-	contract, err := bindings.NewStaking(address, ethCl)
-	if err != nil {
-		return EventProcessor{}, errors.Wrap(err, "new staking")
+	p := EventProcessor{
+		sKeeper: sKeeper,
+		bKeeper: bKeeper,
+		aKeeper: aKeeper,
+		uKeeper: uKeeper,
 	}
 
-	return EventProcessor{
-		contract: contract,
-		ethCl:    ethCl,
-		address:  address,
-		sKeeper:  sKeeper,
-		bKeeper:  bKeeper,
-		aKeeper:  aKeeper,
-	}, nil
-}
-
-// Prepare returns all omni stake contract EVM event logs from the provided block hash.
-func (p EventProcessor) Prepare(ctx context.Context, blockHash common.Hash) ([]*evmenginetypes.EVMEvent, error) {
-	logs, err := p.ethCl.FilterLogs(ctx, ethereum.FilterQuery{
-		BlockHash: &blockHash,
-		Addresses: p.Addresses(),
-		Topics:    [][]common.Hash{{createValidatorEvent.ID, delegateEvent.ID}},
+	proc, err := eventproc.New(ethCl, eventproc.Config{
+		Name:    ModuleName,
+		Address: common.HexToAddress(predeploys.Staking),
+		ABI:     bindings.StakingMetaData,
+		Handlers: []eventproc.Handler{
+			eventproc.Handle("CreateValidator", p.deliverCreateValidator),
+			eventproc.Handle("Delegate", p.deliverDelegate),
+			eventproc.Handle("Undelegate", p.deliverUndelegate),
+			eventproc.Handle("EditValidator", p.deliverEditValidator),
+		},
 	})
 	if err != nil {
-		return nil, errors.Wrap(err, "filter logs")
-	}
-
-	resp := make([]*evmenginetypes.EVMEvent, 0, len(logs))
-	for _, l := range logs {
-		topics := make([][]byte, 0, len(l.Topics))
-		for _, t := range l.Topics {
-			topics = append(topics, t.Bytes())
-		}
-		resp = append(resp, &evmenginetypes.EVMEvent{
-			Address: l.Address.Bytes(),
-			Topics:  topics,
-			Data:    l.Data,
-		})
-	}
-
-	return resp, nil
-}
-
-func (EventProcessor) Name() string {
-	return ModuleName
-}
-
-func (p EventProcessor) Addresses() []common.Address {
-	return []common.Address{p.address}
-}
-
-// Deliver processes a omni deposit log event, which must be one of:
-// - CreateValidator
-// - Delegate.
-func (p EventProcessor) Deliver(ctx context.Context, _ common.Hash, elog *evmenginetypes.EVMEvent) error {
-	ethlog := elog.ToEthLog()
-
-	switch ethlog.Topics[0] {
-	case createValidatorEvent.ID:
-		ev, err := p.contract.ParseCreateValidator(ethlog)
-		if err != nil {
-			return errors.Wrap(err, "parse create validator")
-		}
-
-		if err := p.deliverCreateValidator(ctx, ev); err != nil {
-			return errors.Wrap(err, "create validator")
-		}
-	case delegateEvent.ID:
-		ev, err := p.contract.ParseDelegate(ethlog)
-		if err != nil {
-			return errors.Wrap(err, "parse delegate")
-		}
-
-		if err := p.deliverDelegate(ctx, ev); err != nil {
-			return errors.Wrap(err, "delegate")
-		}
-	default:
-		return errors.New("unknown event")
+		return EventProcessor{}, errors.Wrap(err, "new event processor")
 	}
+	p.Processor = proc
 
-	return nil
+	return p, nil
 }
 
 // deliverCreateValidator processes a CreateValidator event, and creates a new validator.
@@ -154,7 +99,12 @@ func (p EventProcessor) deliverCreateValidator(ctx context.Context, ev *bindings
 
 	amountCoin, amountCoins := omniToBondCoin(ev.Deposit)
 
-	if _, err := p.sKeeper.GetValidator(ctx, valAddr); err == nil {
+	if val, err := p.sKeeper.GetValidator(ctx, valAddr); err == nil {
+		if existing, err := val.ConsPubKey(); err == nil && !existing.Equals(pubkey) {
+			// x/staking doesn't support consensus key rotation, so reject it explicitly.
+			return errors.New("validator already exists, consensus pubkey rotation not supported")
+		}
+
 		return errors.New("validator already exists")
 	}
 
@@ -177,7 +127,7 @@ func (p EventProcessor) deliverCreateValidator(ctx context.Context, ev *bindings
 		pubkey,
 		amountCoin,
 		stypes.Description{Moniker: ev.Validator.Hex()},
-		stypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()),
+		stypes.NewCommissionRates(math.LegacyZeroDec(), maxCommissionRate, maxCommissionChangeRate),
 		math.NewInt(1)) // Stub out minimum self delegation for now, just use 1.
 	if err != nil {
 		return errors.Wrap(err, "create validator message")
@@ -236,38 +186,260 @@ func (p EventProcessor) deliverDelegate(ctx context.Context, ev *bindings.Stakin
 	return nil
 }
 
-func (p EventProcessor) createAccIfNone(ctx context.Context, addr sdk.AccAddress) {
-	if !p.aKeeper.HasAccount(ctx, addr) {
-		acc := p.aKeeper.NewAccountWithAddress(ctx, addr)
-		p.aKeeper.SetAccount(ctx, acc)
+// deliverUndelegate processes an Undelegate event, and undelegates from an existing validator.
+// - Start unbonding the amount of $STAKE coins from the delegation.
+// - The unbonded coins are returned to the delegator's account by x/staking when unbonding completes,
+// see HandleCompletedUnbondings.
+//
+// NOTE: if we error, the undelegation is lost (on EVM), but the delegation remains intact.
+func (p EventProcessor) deliverUndelegate(ctx context.Context, ev *bindings.StakingUndelegate) error {
+	if ev.Delegator != ev.Validator {
+		return errors.New("only self undelegation")
+	}
+
+	delAddr := sdk.AccAddress(ev.Delegator.Bytes())
+	valAddr := sdk.ValAddress(ev.Validator.Bytes())
+
+	if _, err := p.sKeeper.GetValidator(ctx, valAddr); err != nil {
+		return errors.New("validator does not exist", "validator", valAddr.String())
+	}
+
+	if _, err := p.sKeeper.GetDelegation(ctx, delAddr, valAddr); err != nil {
+		return errors.New("delegation does not exist", "delegator", delAddr.String(), "validator", valAddr.String())
+	}
+
+	amountCoin, _ := omniToBondCoin(ev.Amount)
+
+	msg := stypes.NewMsgUndelegate(delAddr.String(), valAddr.String(), amountCoin)
+	resp, err := skeeper.NewMsgServerImpl(p.sKeeper).Undelegate(ctx, msg)
+	if err != nil {
+		return errors.Wrap(err, "undelegate")
 	}
+
+	log.Info(ctx, "EVM staking undelegation detected, unbonding",
+		"delegator", ev.Delegator.Hex(),
+		"validator", ev.Validator.Hex(),
+		"amount", ev.Amount.String(),
+		"completion", resp.CompletionTime)
+
+	return nil
 }
 
-// omniToBondCoin converts the $OMNI amount into a $STAKE coin.
-// TODO(corver): At this point, it is 1-to1, but this might change in the future.
-func omniToBondCoin(amount *big.Int) (sdk.Coin, sdk.Coins) {
-	coin := sdk.NewCoin(sdk.DefaultBondDenom, math.NewIntFromBigInt(amount))
-	return coin, sdk.NewCoins(coin)
+// deliverEditValidator processes an EditValidator event, and edits an existing validator.
+// - Empty description fields are left unchanged.
+// - Negative commission rate and minimum self delegation are left unchanged.
+// - x/staking validates the edit; e.g. commission rate max (change) rate and minimum self delegation bounds.
+//
+// Note that the consensus public key cannot be edited, since x/staking doesn't support key rotation.
+// Creating the validator again with another public key is rejected, see deliverCreateValidator.
+func (p EventProcessor) deliverEditValidator(ctx context.Context, ev *bindings.StakingEditValidator) error {
+	valAddr := sdk.ValAddress(ev.Validator.Bytes())
+
+	if _, err := p.sKeeper.GetValidator(ctx, valAddr); err != nil {
+		return errors.New("validator does not exist", "validator", valAddr.String())
+	}
+
+	msg, err := editValidatorMsg(valAddr, ev.Params)
+	if err != nil {
+		return err
+	}
+
+	if _, err := skeeper.NewMsgServerImpl(p.sKeeper).EditValidator(ctx, msg); err != nil {
+		return errors.Wrap(err, "edit validator")
+	}
+
+	log.Info(ctx, "EVM staking edit validator detected, validator edited",
+		"validator", ev.Validator.Hex(),
+		"moniker", msg.Description.Moniker,
+		"commission_rate", msg.CommissionRate,
+		"min_self_delegation", msg.MinSelfDelegation)
+
+	return nil
+}
+
+// editValidatorMsg returns a x/staking MsgEditValidator for the provided EditValidator event params.
+func editValidatorMsg(valAddr sdk.ValAddress, params bindings.StakingEditValidatorParams) (*stypes.MsgEditValidator, error) {
+	orUnchanged := func(s string) string {
+		if s == "" {
+			return stypes.DoNotModifyDesc
+		}
+
+		return s
+	}
+
+	desc := stypes.NewDescription(
+		orUnchanged(params.Moniker),
+		orUnchanged(params.Identity),
+		orUnchanged(params.Website),
+		orUnchanged(params.SecurityContact),
+		orUnchanged(params.Details),
+	)
+
+	var commissionRate *math.LegacyDec
+	if pct := params.CommissionRatePercentage; pct > 100 {
+		return nil, errors.New("invalid commission rate", "percentage", pct)
+	} else if pct >= 0 {
+		rate := math.LegacyNewDecWithPrec(int64(pct), 2)
+		commissionRate = &rate
+	}
+
+	var minSelfDelegation *math.Int
+	if msd := params.MinSelfDelegation; msd == nil {
+		return nil, errors.New("missing min self delegation")
+	} else if msd.Sign() >= 0 {
+		amount := math.NewIntFromBigInt(msd)
+		minSelfDelegation = &amount
+	}
+
+	if desc == (stypes.Description{
+		Moniker:         stypes.DoNotModifyDesc,
+		Identity:        stypes.DoNotModifyDesc,
+		Website:         stypes.DoNotModifyDesc,
+		SecurityContact: stypes.DoNotModifyDesc,
+		Details:         stypes.DoNotModifyDesc,
+	}) && commissionRate == nil && minSelfDelegation == nil {
+		return nil, errors.New("empty edit")
+	}
+
+	return stypes.NewMsgEditValidator(valAddr.String(), desc, commissionRate, minSelfDelegation), nil
 }
 
-// mustGetABI returns the metadata's ABI as an abi.ABI type.
-// It panics on error.
-func mustGetABI(metadata *bind.MetaData) *abi.ABI {
-	abi, err := metadata.GetAbi()
+// MigrateV1 migrates the validators for the V1 network upgrade, see upgrades.V1.
+// Validators created before editing was supported have zero max commission (change) rates,
+// which makes commission changes impossible, so they are set to the rates of new validators.
+// It is idempotent.
+func (p EventProcessor) MigrateV1(ctx context.Context) error {
+	vals, err := p.sKeeper.GetAllValidators(ctx)
 	if err != nil {
-		panic(err)
+		return errors.Wrap(err, "get validators")
 	}
 
-	return abi
+	for _, val := range vals {
+		if !val.Commission.MaxRate.IsZero() {
+			continue
+		}
+
+		val.Commission.MaxRate = maxCommissionRate
+		val.Commission.MaxChangeRate = maxCommissionChangeRate
+		if err := val.Commission.Validate(); err != nil {
+			return errors.Wrap(err, "validate commission", "validator", val.OperatorAddress)
+		}
+
+		if err := p.sKeeper.SetValidator(ctx, val); err != nil {
+			return errors.Wrap(err, "set validator", "validator", val.OperatorAddress)
+		}
+
+		log.Info(ctx, "Migrated validator max commission rates", "validator", val.OperatorAddress)
+	}
+
+	return nil
 }
 
-// mustGetEvent returns the event with the given name from the ABI.
-// It panics if the event is not found.
-func mustGetEvent(abi *abi.ABI, name string) abi.Event {
-	event, ok := abi.Events[name]
-	if !ok {
-		panic("event not found")
+// CompletedUnbonding is a matured x/staking unbonding delegation entry.
+// Its amount has been returned to the delegator's account.
+type CompletedUnbonding struct {
+	Delegator common.Address
+	Validator common.Address
+	Amount    *big.Int
+}
+
+// HandleCompletedUnbondings processes the x/staking "complete_unbonding" events emitted by end blockers.
+// It withdraws the unbonded amount from the delegator's account to the EVM via the withdrawal queue.
+// Withdrawals are only enabled once the V1 network upgrade is active, before that the unbonded
+// amount remains in the delegator's account.
+//
+// Errors are logged and the unbonding skipped (its amount remains in the delegator's account),
+// since failing the end blocker halts the chain. It returns the completed unbondings.
+func (p EventProcessor) HandleCompletedUnbondings(
+	ctx context.Context,
+	events []abci.Event,
+	queue evmenginetypes.WithdrawalQueue,
+) []CompletedUnbonding {
+	var resp []CompletedUnbonding
+	for _, event := range events {
+		if event.Type != stypes.EventTypeCompleteUnbonding {
+			continue
+		}
+
+		completed, err := parseCompletedUnbonding(event)
+		if err != nil {
+			// Don't halt the chain due to unexpected x/staking events, rather skip them.
+			log.Error(ctx, "Skipping invalid complete unbonding event", err)
+			continue
+		}
+
+		resp = append(resp, completed)
+
+		if active, err := upgrades.IsActive(ctx, p.uKeeper, upgrades.V1); err != nil {
+			log.Error(ctx, "Skipping unbonding withdrawal", err, "delegator", completed.Delegator.Hex())
+			continue
+		} else if !active {
+			log.Info(ctx, "EVM staking unbonding completed, withdrawals not enabled yet",
+				"delegator", completed.Delegator.Hex(),
+				"validator", completed.Validator.Hex(),
+				"amount", completed.Amount.String())
+
+			continue
+		}
+
+		withdrawn, err := evmenginetypes.BurnAndWithdraw(ctx, queue, p.bKeeper, ModuleName, completed.Delegator, completed.Amount)
+		if err != nil {
+			log.Error(ctx, "Skipping unbonding withdrawal", err, "delegator", completed.Delegator.Hex())
+			continue
+		}
+
+		log.Info(ctx, "EVM staking unbonding completed, withdrawing",
+			"delegator", completed.Delegator.Hex(),
+			"validator", completed.Validator.Hex(),
+			"amount", completed.Amount.String(),
+			"withdrawn", withdrawn.String())
+	}
+
+	return resp
+}
+
+func parseCompletedUnbonding(event abci.Event) (CompletedUnbonding, error) {
+	var resp CompletedUnbonding
+	for _, attr := range event.Attributes {
+		switch attr.Key {
+		case stypes.AttributeKeyDelegator:
+			addr, err := sdk.AccAddressFromBech32(attr.Value)
+			if err != nil {
+				return CompletedUnbonding{}, errors.Wrap(err, "delegator address")
+			}
+			resp.Delegator = common.BytesToAddress(addr)
+		case stypes.AttributeKeyValidator:
+			addr, err := sdk.ValAddressFromBech32(attr.Value)
+			if err != nil {
+				return CompletedUnbonding{}, errors.Wrap(err, "validator address")
+			}
+			resp.Validator = common.BytesToAddress(addr)
+		case sdk.AttributeKeyAmount:
+			coins, err := sdk.ParseCoinsNormalized(attr.Value)
+			if err != nil {
+				return CompletedUnbonding{}, errors.Wrap(err, "amount")
+			}
+			resp.Amount = coins.AmountOf(sdk.DefaultBondDenom).BigInt()
+		}
 	}
 
-	return event
+	if resp.Delegator == (common.Address{}) || resp.Validator == (common.Address{}) || resp.Amount == nil {
+		return CompletedUnbonding{}, errors.New("missing attributes")
+	}
+
+	return resp, nil
+}
+
+func (p EventProcessor) createAccIfNone(ctx context.Context, addr sdk.AccAddress) {
+	if !p.aKeeper.HasAccount(ctx, addr) {
+		acc := p.aKeeper.NewAccountWithAddress(ctx, addr)
+		p.aKeeper.SetAccount(ctx, acc)
+	}
+}
+
+// omniToBondCoin converts the $OMNI amount into a $STAKE coin.
+// TODO(corver): At this point, it is 1-to1, but this might change in the future.
+func omniToBondCoin(amount *big.Int) (sdk.Coin, sdk.Coins) {
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, math.NewIntFromBigInt(amount))
+	return coin, sdk.NewCoins(coin)
 }
@@ -0,0 +1,502 @@
+package evmstaking
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/halo/app/upgrades"
+	"github.com/omni-network/omni/halo/genutil/evm/predeploys"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/k1util"
+	"github.com/omni-network/omni/octane/evmengine/eventproc"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	akeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	atypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	btypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	skeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUndelegate(t *testing.T) {
+	t.Parallel()
+
+	const unbondingTime = time.Hour
+
+	ctx, proc, ethCl := setupProcessor(t, unbondingTime)
+
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	val, err := k1util.PubKeyToAddress(pubkey)
+	require.NoError(t, err)
+	accAddr := sdk.AccAddress(val.Bytes())
+	valAddr := sdk.ValAddress(val.Bytes())
+
+	deliver := func(logs ...types.Log) error {
+		t.Helper()
+		return deliverLogs(t, ctx, proc, ethCl, logs...)
+	}
+
+	// Undelegating from a non-existent validator fails.
+	require.ErrorContains(t, deliver(undelegateLog(t, val, val, 1)), "validator does not exist")
+
+	// Create a validator with 100 $STAKE and delegate another 10.
+	require.NoError(t, deliver(createValidatorLog(t, pubkey, 100), delegateLog(t, val, val, 10)))
+	_, err = proc.sKeeper.EndBlocker(ctx) // Bond the validator.
+	require.NoError(t, err)
+	requireDelegation(t, ctx, proc.sKeeper, accAddr, valAddr, 110)
+
+	// Only self undelegation is supported.
+	other := common.BytesToAddress(secp256k1.GenPrivKey().PubKey().Address())
+	require.ErrorContains(t, deliver(undelegateLog(t, other, val, 1)), "only self undelegation")
+
+	// Undelegating more than the delegation fails.
+	require.ErrorContains(t, deliver(undelegateLog(t, val, val, 111)), "undelegate")
+	requireDelegation(t, ctx, proc.sKeeper, accAddr, valAddr, 110)
+
+	// Undelegate 10 $STAKE, which starts unbonding.
+	require.NoError(t, deliver(undelegateLog(t, val, val, 10)))
+	requireDelegation(t, ctx, proc.sKeeper, accAddr, valAddr, 100)
+
+	ubd, err := proc.sKeeper.GetUnbondingDelegation(ctx, accAddr, valAddr)
+	require.NoError(t, err)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, ether(10), ubd.Entries[0].Balance)
+	require.Equal(t, ctx.BlockTime().Add(unbondingTime), ubd.Entries[0].CompletionTime)
+	require.True(t, proc.bKeeper.GetBalance(ctx, accAddr, sdk.DefaultBondDenom).IsZero())
+
+	// Unbonding doesn't complete before the unbonding time.
+	queue := new(stubQueue)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(unbondingTime - time.Second)).WithEventManager(sdk.NewEventManager())
+	completed := endBlock(t, ctx, proc, queue)
+	require.Empty(t, completed)
+	require.Empty(t, queue.withdrawals)
+
+	// Simulate 5 $STAKE (and 1 wei) rewards withdrawn to the delegator's account.
+	rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, ether(5).AddRaw(1)))
+	require.NoError(t, proc.bKeeper.MintCoins(ctx, ModuleName, rewards))
+	require.NoError(t, proc.bKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, accAddr, rewards))
+
+	// Unbonding completes after the unbonding time, returning the coins to the delegator
+	// of which only the unbonded amount is withdrawn to the EVM, the rewards remain in the account.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second)).WithEventManager(sdk.NewEventManager())
+	completed = endBlock(t, ctx, proc, queue)
+	require.Equal(t, []CompletedUnbonding{{
+		Delegator: val,
+		Validator: val,
+		Amount:    ether(10).BigInt(),
+	}}, completed)
+	require.Equal(t, []stubWithdrawal{{Address: val, Amount: ether(10).BigInt()}}, queue.withdrawals)
+	require.Equal(t, rewards.AmountOf(sdk.DefaultBondDenom), proc.bKeeper.GetBalance(ctx, accAddr, sdk.DefaultBondDenom).Amount)
+
+	_, err = proc.sKeeper.GetUnbondingDelegation(ctx, accAddr, valAddr)
+	require.ErrorIs(t, err, stypes.ErrNoUnbondingDelegation)
+}
+
+func TestEditValidator(t *testing.T) {
+	t.Parallel()
+
+	ctx, proc, ethCl := setupProcessor(t, time.Hour)
+
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	val, err := k1util.PubKeyToAddress(pubkey)
+	require.NoError(t, err)
+	valAddr := sdk.ValAddress(val.Bytes())
+
+	deliver := func(logs ...types.Log) error {
+		t.Helper()
+		return deliverLogs(t, ctx, proc, ethCl, logs...)
+	}
+
+	// unchanged returns edit params that leave everything unchanged.
+	unchanged := func() bindings.StakingEditValidatorParams {
+		return bindings.StakingEditValidatorParams{
+			CommissionRatePercentage: -1,
+			MinSelfDelegation:        big.NewInt(-1),
+		}
+	}
+
+	requireValidator := func(fn func(stypes.Validator)) {
+		t.Helper()
+		v, err := proc.sKeeper.GetValidator(ctx, valAddr)
+		require.NoError(t, err)
+		fn(v)
+	}
+
+	// Editing a non-existent validator fails.
+	params := unchanged()
+	params.Moniker = "moniker"
+	require.ErrorContains(t, deliver(editValidatorLog(t, val, params)), "validator does not exist")
+
+	require.NoError(t, deliver(createValidatorLog(t, pubkey, 100)))
+
+	// Empty edits are rejected.
+	require.ErrorContains(t, deliver(editValidatorLog(t, val, unchanged())), "empty edit")
+
+	// Edit the description, leaving empty fields unchanged.
+	params = unchanged()
+	params.Moniker = "moniker"
+	params.Website = "https://example.com"
+	require.NoError(t, deliver(editValidatorLog(t, val, params)))
+	requireValidator(func(v stypes.Validator) {
+		require.Equal(t, stypes.Description{Moniker: "moniker", Website: "https://example.com"}, v.Description)
+		require.True(t, v.Commission.Rate.IsZero())
+	})
+
+	params = unchanged()
+	params.Details = "details"
+	require.NoError(t, deliver(editValidatorLog(t, val, params)))
+	requireValidator(func(v stypes.Validator) {
+		require.Equal(t, "moniker", v.Description.Moniker)
+		require.Equal(t, "details", v.Description.Details)
+	})
+
+	// Commission can only be changed once per day.
+	params = unchanged()
+	params.CommissionRatePercentage = 1
+	require.ErrorContains(t, deliver(editValidatorLog(t, val, params)), "commission cannot be changed more than once in 24h")
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(25 * time.Hour))
+	require.NoError(t, deliver(editValidatorLog(t, val, params)))
+	requireValidator(func(v stypes.Validator) {
+		require.Equal(t, math.LegacyNewDecWithPrec(1, 2), v.Commission.Rate)
+	})
+
+	// Commission cannot change by more than the max change rate.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(25 * time.Hour))
+	params.CommissionRatePercentage = 5
+	require.ErrorContains(t, deliver(editValidatorLog(t, val, params)), "commission cannot be changed more than max change rate")
+
+	// Invalid commission rates are rejected.
+	params.CommissionRatePercentage = 101
+	require.ErrorContains(t, deliver(editValidatorLog(t, val, params)), "invalid commission rate")
+
+	// Minimum self delegation must be positive, increasing and at most the validator's tokens.
+	params = unchanged()
+	params.MinSelfDelegation = big.NewInt(0)
+	require.ErrorContains(t, deliver(editValidatorLog(t, val, params)), "minimum self delegation must be a positive integer")
+
+	params.MinSelfDelegation = ether(101).BigInt()
+	require.ErrorContains(t, deliver(editValidatorLog(t, val, params)), "validator's self delegation must be greater than their minimum self delegation")
+
+	params.MinSelfDelegation = ether(50).BigInt()
+	require.NoError(t, deliver(editValidatorLog(t, val, params)))
+	requireValidator(func(v stypes.Validator) {
+		require.Equal(t, ether(50), v.MinSelfDelegation)
+		require.Equal(t, "moniker", v.Description.Moniker)
+	})
+
+	params.MinSelfDelegation = ether(10).BigInt()
+	require.ErrorContains(t, deliver(editValidatorLog(t, val, params)), "minimum self delegation cannot be decrease")
+}
+
+func TestCreateValidatorRotation(t *testing.T) {
+	t.Parallel()
+
+	ctx, proc, ethCl := setupProcessor(t, time.Hour)
+
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	val, err := k1util.PubKeyToAddress(pubkey)
+	require.NoError(t, err)
+
+	deliver := func(logs ...types.Log) error {
+		t.Helper()
+		return deliverLogs(t, ctx, proc, ethCl, logs...)
+	}
+
+	require.NoError(t, deliver(createValidatorLog(t, pubkey, 100)))
+	require.ErrorContains(t, deliver(createValidatorLog(t, pubkey, 100)), "validator already exists")
+
+	// Creating the validator again with another consensus pubkey (i.e. rotation) is explicitly rejected.
+	rotated := stakingLog(t, createValidatorEvent,
+		[]common.Hash{common.BytesToHash(val.Bytes())},
+		secp256k1.GenPrivKey().PubKey().Bytes(), ether(100).BigInt())
+	require.ErrorContains(t, deliver(rotated), "consensus pubkey rotation not supported")
+}
+
+func TestMigrateV1(t *testing.T) {
+	t.Parallel()
+
+	ctx, proc, ethCl := setupProcessor(t, time.Hour)
+
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	val, err := k1util.PubKeyToAddress(pubkey)
+	require.NoError(t, err)
+	valAddr := sdk.ValAddress(val.Bytes())
+
+	require.NoError(t, deliverLogs(t, ctx, proc, ethCl, createValidatorLog(t, pubkey, 100)))
+
+	// Validators created before editing was supported have zero max commission rates.
+	v, err := proc.sKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	v.Commission.MaxRate = math.LegacyZeroDec()
+	v.Commission.MaxChangeRate = math.LegacyZeroDec()
+	require.NoError(t, proc.sKeeper.SetValidator(ctx, v))
+
+	// Migration is idempotent.
+	for range 2 {
+		require.NoError(t, proc.MigrateV1(ctx))
+
+		v, err := proc.sKeeper.GetValidator(ctx, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, maxCommissionRate, v.Commission.MaxRate)
+		require.Equal(t, maxCommissionChangeRate, v.Commission.MaxChangeRate)
+		require.True(t, v.Commission.Rate.IsZero())
+	}
+}
+
+func TestHandleCompletedUnbondings(t *testing.T) {
+	t.Parallel()
+
+	addr := common.BytesToAddress(secp256k1.GenPrivKey().PubKey().Address())
+	event := func(attrs ...sdk.Attribute) sdk.Event {
+		return sdk.NewEvent(stypes.EventTypeCompleteUnbonding, attrs...)
+	}
+	delegator := sdk.NewAttribute(stypes.AttributeKeyDelegator, sdk.AccAddress(addr.Bytes()).String())
+	validator := sdk.NewAttribute(stypes.AttributeKeyValidator, sdk.ValAddress(addr.Bytes()).String())
+	amount := sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, ether(1))).String())
+
+	events := sdk.Events{
+		sdk.NewEvent("other"),
+		event(amount, validator, delegator),
+	}
+
+	// The delegator has no balance, so the withdrawal fails and is skipped.
+	ctx, proc, _ := setupProcessor(t, time.Hour)
+	queue := new(stubQueue)
+
+	completed := proc.HandleCompletedUnbondings(ctx, events.ToABCIEvents(), queue)
+	require.Equal(t, []CompletedUnbonding{{Delegator: addr, Validator: addr, Amount: ether(1).BigInt()}}, completed)
+	require.Empty(t, queue.withdrawals)
+
+	// Failed withdrawals don't change state.
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, ether(1)))
+	require.NoError(t, proc.bKeeper.MintCoins(ctx, ModuleName, coins))
+	require.NoError(t, proc.bKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, sdk.AccAddress(addr.Bytes()), coins))
+	queue.err = errors.New("queue full")
+	proc.HandleCompletedUnbondings(ctx, events.ToABCIEvents(), queue)
+	require.Equal(t, ether(1), proc.bKeeper.GetBalance(ctx, sdk.AccAddress(addr.Bytes()), sdk.DefaultBondDenom).Amount)
+	require.Equal(t, ether(1), proc.bKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount)
+
+	// Withdrawals are disabled before the network upgrade, leaving the coins in the account.
+	queue.err = nil
+	proc.uKeeper = eventproc.StubUpgrades{}
+	proc.HandleCompletedUnbondings(ctx, events.ToABCIEvents(), queue)
+	require.Empty(t, queue.withdrawals)
+	require.Equal(t, ether(1), proc.bKeeper.GetBalance(ctx, sdk.AccAddress(addr.Bytes()), sdk.DefaultBondDenom).Amount)
+
+	// Invalid events are skipped.
+	events = sdk.Events{event(amount, validator)}
+	completed = proc.HandleCompletedUnbondings(ctx, events.ToABCIEvents(), queue)
+	require.Empty(t, completed)
+}
+
+func TestFuzz(t *testing.T) {
+	t.Parallel()
+
+	ctx, proc, _ := setupProcessor(t, time.Hour)
+
+	eventproc.Fuzz(t, ctx, proc.Processor, 20)
+}
+
+// deliverLogs delivers the staking logs of a block.
+func deliverLogs(t *testing.T, ctx sdk.Context, proc EventProcessor, ethCl *eventproc.StubLogClient, logs ...types.Log) error {
+	t.Helper()
+	// Populate log indexes like a real client.
+	ethCl.Logs = nil
+	for i, l := range logs {
+		l.Index = uint(i)
+		ethCl.Logs = append(ethCl.Logs, l)
+	}
+
+	events, err := proc.Prepare(ctx, common.Hash{})
+	require.NoError(t, err)
+	require.Len(t, events, len(logs))
+
+	for _, event := range events {
+		if err := proc.Deliver(ctx, common.Hash{}, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// endBlock runs the x/staking end blocker and returns the completed unbondings.
+func endBlock(t *testing.T, ctx sdk.Context, proc EventProcessor, queue *stubQueue) []CompletedUnbonding {
+	t.Helper()
+
+	_, err := proc.sKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	return proc.HandleCompletedUnbondings(ctx, ctx.EventManager().ABCIEvents(), queue)
+}
+
+func requireDelegation(t *testing.T, ctx context.Context, sKeeper *skeeper.Keeper, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount int64) {
+	t.Helper()
+
+	del, err := sKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.NoError(t, err)
+
+	val, err := sKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+
+	require.Equal(t, ether(amount), val.TokensFromShares(del.Shares).TruncateInt())
+}
+
+func setupProcessor(t *testing.T, unbondingTime time.Duration) (sdk.Context, EventProcessor, *eventproc.StubLogClient) {
+	t.Helper()
+
+	keys := storetypes.NewKVStoreKeys(atypes.StoreKey, btypes.StoreKey, stypes.StoreKey)
+	ctx := sdktestutil.DefaultContextWithKeys(keys, nil, nil).
+		WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}, staking.AppModuleBasic{})
+	authority := atypes.NewModuleAddress("gov").String()
+	sdkCfg := sdk.GetConfig()
+
+	aKeeper := akeeper.NewAccountKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[atypes.StoreKey]),
+		atypes.ProtoBaseAccount,
+		map[string][]string{
+			ModuleName:               {atypes.Minter, atypes.Burner},
+			stypes.BondedPoolName:    {atypes.Burner, atypes.Staking},
+			stypes.NotBondedPoolName: {atypes.Burner, atypes.Staking},
+			atypes.FeeCollectorName:  nil,
+		},
+		addresscodec.NewBech32Codec(sdkCfg.GetBech32AccountAddrPrefix()),
+		sdkCfg.GetBech32AccountAddrPrefix(),
+		authority,
+	)
+
+	bKeeper := bkeeper.NewBaseKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[btypes.StoreKey]),
+		aKeeper,
+		nil,
+		authority,
+		log.NewNopLogger(),
+	)
+
+	sKeeper := skeeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[stypes.StoreKey]),
+		aKeeper,
+		bKeeper,
+		authority,
+		addresscodec.NewBech32Codec(sdkCfg.GetBech32ValidatorAddrPrefix()),
+		addresscodec.NewBech32Codec(sdkCfg.GetBech32ConsensusAddrPrefix()),
+	)
+
+	params := stypes.DefaultParams()
+	params.UnbondingTime = unbondingTime
+	require.NoError(t, sKeeper.SetParams(ctx, params))
+
+	ethCl := new(eventproc.StubLogClient)
+	proc, err := New(ethCl, sKeeper, bKeeper, aKeeper, eventproc.StubUpgrades{upgrades.V1: 1})
+	require.NoError(t, err)
+
+	return ctx, proc, ethCl
+}
+
+type stubWithdrawal struct {
+	Address common.Address
+	Amount  *big.Int
+}
+
+// stubQueue records inserted withdrawals, or returns the configured error.
+type stubQueue struct {
+	withdrawals []stubWithdrawal
+	err         error
+}
+
+func (q *stubQueue) InsertWithdrawal(_ context.Context, address common.Address, amountWei *big.Int) error {
+	if q.err != nil {
+		return q.err
+	}
+	q.withdrawals = append(q.withdrawals, stubWithdrawal{Address: address, Amount: amountWei})
+	return nil
+}
+
+var (
+	createValidatorEvent = eventproc.MustGetEvent(bindings.StakingMetaData, "CreateValidator")
+	delegateEvent        = eventproc.MustGetEvent(bindings.StakingMetaData, "Delegate")
+	undelegateEvent      = eventproc.MustGetEvent(bindings.StakingMetaData, "Undelegate")
+	editValidatorEvent   = eventproc.MustGetEvent(bindings.StakingMetaData, "EditValidator")
+)
+
+func createValidatorLog(t *testing.T, pubkey crypto.PubKey, amount int64) types.Log {
+	t.Helper()
+
+	addr, err := k1util.PubKeyToAddress(pubkey)
+	require.NoError(t, err)
+
+	return stakingLog(t, createValidatorEvent,
+		[]common.Hash{common.BytesToHash(addr.Bytes())},
+		pubkey.Bytes(), ether(amount).BigInt())
+}
+
+func delegateLog(t *testing.T, delegator, validator common.Address, amount int64) types.Log {
+	t.Helper()
+
+	return stakingLog(t, delegateEvent,
+		[]common.Hash{common.BytesToHash(delegator.Bytes()), common.BytesToHash(validator.Bytes())},
+		ether(amount).BigInt())
+}
+
+func undelegateLog(t *testing.T, delegator, validator common.Address, amount int64) types.Log {
+	t.Helper()
+
+	return stakingLog(t, undelegateEvent,
+		[]common.Hash{common.BytesToHash(delegator.Bytes()), common.BytesToHash(validator.Bytes())},
+		ether(amount).BigInt())
+}
+
+func editValidatorLog(t *testing.T, validator common.Address, params bindings.StakingEditValidatorParams) types.Log {
+	t.Helper()
+
+	return stakingLog(t, editValidatorEvent,
+		[]common.Hash{common.BytesToHash(validator.Bytes())},
+		params)
+}
+
+func stakingLog(t *testing.T, event abi.Event, indexed []common.Hash, data ...any) types.Log {
+	t.Helper()
+
+	bz, err := event.Inputs.NonIndexed().Pack(data...)
+	require.NoError(t, err)
+
+	return types.Log{
+		Address: common.HexToAddress(predeploys.Staking),
+		Topics:  append([]common.Hash{event.ID}, indexed...),
+		Data:    bz,
+	}
+}
+
+// ether returns the amount of ether in wei as a math.Int.
+func ether(amount int64) math.Int {
+	return math.NewIntFromBigInt(new(big.Int).Mul(big.NewInt(amount), big.NewInt(params.Ether)))
+}
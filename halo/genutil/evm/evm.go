@@ -65,6 +65,7 @@ func defaultChainConfig(network netconf.ID) *params.ChainConfig {
 		GrayGlacierBlock:              big.NewInt(0),
 		ShanghaiTime:                  newUint64(0),
 		CancunTime:                    newUint64(0),
+		PragueTime:                    network.Static().ExecutionPragueTime,
 		TerminalTotalDifficulty:       big.NewInt(0),
 		TerminalTotalDifficultyPassed: true,
 	}
@@ -17,9 +17,11 @@ const (
 	WOmni            = "0x121E240000000000000000000000000000000003"
 
 	// Octane Predeploys.
-	Staking  = "0xcccccc0000000000000000000000000000000001"
-	Slashing = "0xcccccc0000000000000000000000000000000002"
-	Upgrade  = "0xcccccc0000000000000000000000000000000003"
+	Staking      = "0xcccccc0000000000000000000000000000000001"
+	Slashing     = "0xcccccc0000000000000000000000000000000002"
+	Upgrade      = "0xcccccc0000000000000000000000000000000003"
+	AttestParams = "0xcccccc0000000000000000000000000000000004"
+	Distribution = "0xcccccc0000000000000000000000000000000005"
 )
 
 // Alloc returns the genesis allocs for the predeployed contracts.
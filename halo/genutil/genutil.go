@@ -241,16 +241,17 @@ func defaultAppState(
 	evmengGenesis := evmtypes.NewGenesisState(executionBlockHash)
 
 	return map[string]json.RawMessage{
-		sttypes.ModuleName:  marshal(stakingGenesis),
-		sltypes.ModuleName:  marshal(slashingGenesis),
-		atypes.ModuleName:   marshal(atypes.DefaultGenesisState()),
-		btypes.ModuleName:   marshal(btypes.DefaultGenesisState()),
-		dtypes.ModuleName:   marshal(dtypes.DefaultGenesisState()),
-		etypes.ModuleName:   marshal(etypes.DefaultGenesisState()),
-		vtypes.ModuleName:   marshal(vtypes.DefaultGenesisState()),
-		gtypes.ModuleName:   marshal(gtypes.DefaultGenesisState()),
-		evmtypes.ModuleName: marshal(evmengGenesis),
-		utypes.ModuleName:   []byte("{}"), // See cosmossdk.io/x/upgrade@v0.1.4/module.go#DefaultGenesis
+		sttypes.ModuleName:     marshal(stakingGenesis),
+		sltypes.ModuleName:     marshal(slashingGenesis),
+		atypes.ModuleName:      marshal(atypes.DefaultGenesisState()),
+		btypes.ModuleName:      marshal(btypes.DefaultGenesisState()),
+		dtypes.ModuleName:      marshal(dtypes.DefaultGenesisState()),
+		etypes.ModuleName:      marshal(etypes.DefaultGenesisState()),
+		vtypes.ModuleName:      marshal(vtypes.DefaultGenesisState()),
+		gtypes.ModuleName:      marshal(gtypes.DefaultGenesisState()),
+		evmtypes.ModuleName:    marshal(evmengGenesis),
+		attesttypes.ModuleName: marshal(attesttypes.DefaultGenesisState()),
+		utypes.ModuleName:      []byte("{}"), // See cosmossdk.io/x/upgrade@v0.1.4/module.go#DefaultGenesis
 	}
 }
 
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/omni-network/omni/halo/portal/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ormgenesis"
+)
+
+// InitGenesis imports the exported tables, if any.
+// New networks have empty genesis states.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := ormgenesis.Import(ctx, k.modDB, data.GetTables()); err != nil {
+		return errors.Wrap(err, "import tables")
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the genesis state containing all tables.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	tables, err := ormgenesis.Export(ctx, k.modDB)
+	if err != nil {
+		return nil, errors.Wrap(err, "export tables")
+	}
+
+	return &types.GenesisState{Tables: tables}, nil
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/omni-network/omni/halo/portal/keeper"
+	ptypes "github.com/omni-network/omni/halo/portal/types"
+	"github.com/omni-network/omni/lib/xchain"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesis(t *testing.T) {
+	t.Parallel()
+	exporter, exportCtx := keeper.SetupKeeper(t)
+	importer, importCtx := keeper.SetupKeeper(t)
+
+	emit := func(t *testing.T, k keeper.Keeper, ctx sdk.Context, id uint64) uint64 {
+		t.Helper()
+		blockID, err := k.EmitMsg(ctx, ptypes.MsgTypeValSet, id, xchain.BroadcastChainID, xchain.ShardBroadcast0)
+		require.NoError(t, err)
+
+		return blockID
+	}
+
+	emit(t, exporter, exportCtx, 1)
+	exportCtx = exportCtx.WithBlockHeight(2)
+	emit(t, exporter, exportCtx, 2)
+
+	// New networks have empty genesis states.
+	require.NoError(t, importer.InitGenesis(importCtx, &ptypes.GenesisState{}))
+
+	genesis, err := exporter.ExportGenesis(exportCtx)
+	require.NoError(t, err)
+	require.NotEmpty(t, genesis.GetTables())
+
+	require.NoError(t, importer.InitGenesis(importCtx, genesis))
+
+	reexported, err := importer.ExportGenesis(importCtx)
+	require.NoError(t, err)
+	require.JSONEq(t, string(genesis.GetTables()), string(reexported.GetTables()))
+
+	for _, id := range []uint64{1, 2} {
+		expect, err := exporter.Block(exportCtx, &ptypes.BlockRequest{Id: id})
+		require.NoError(t, err)
+		actual, err := importer.Block(importCtx, &ptypes.BlockRequest{Id: id})
+		require.NoError(t, err)
+		require.Equal(t, expect, actual)
+	}
+
+	// Ensure auto-increment sequences and stream offsets continue after import.
+	exportCtx = exportCtx.WithBlockHeight(3)
+	importCtx = importCtx.WithBlockHeight(3)
+	require.Equal(t, emit(t, exporter, exportCtx, 3), emit(t, importer, importCtx, 3))
+
+	expect, err := exporter.Block(exportCtx, &ptypes.BlockRequest{Id: 3})
+	require.NoError(t, err)
+	actual, err := importer.Block(importCtx, &ptypes.BlockRequest{Id: 3})
+	require.NoError(t, err)
+	require.Equal(t, expect, actual)
+
+	// Unknown tables are rejected.
+	err = importer.InitGenesis(importCtx, &ptypes.GenesisState{Tables: []byte(`{"unknown":[]}`)})
+	require.ErrorContains(t, err, "unknown table")
+}
@@ -15,6 +15,7 @@ import (
 )
 
 type Keeper struct {
+	modDB       ormdb.ModuleDB
 	blockTable  BlockTable
 	msgTable    MsgTable
 	offsetTable OffsetTable
@@ -36,6 +37,7 @@ func NewKeeper(storeService store.KVStoreService) (Keeper, error) {
 	}
 
 	return Keeper{
+		modDB:       modDB,
 		blockTable:  portalStore.BlockTable(),
 		msgTable:    portalStore.MsgTable(),
 		offsetTable: portalStore.OffsetTable(),
@@ -1,8 +1,11 @@
 package module
 
 import (
+	"encoding/json"
+
 	"github.com/omni-network/omni/halo/portal/keeper"
 	"github.com/omni-network/omni/halo/portal/types"
+	"github.com/omni-network/omni/lib/errors"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -10,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 )
@@ -18,6 +22,7 @@ const ConsensusVersion = 1
 
 var (
 	_ module.AppModuleBasic = (*AppModule)(nil)
+	_ module.HasGenesis     = (*AppModule)(nil)
 	_ appmodule.AppModule   = (*AppModule)(nil)
 )
 
@@ -75,6 +80,45 @@ func NewAppModule(
 	}
 }
 
+// InitGenesis imports the exported module state, if any.
+// Networks created before portal module genesis was introduced do not contain it, so it is skipped if empty.
+func (m AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, raw json.RawMessage) {
+	if len(raw) == 0 {
+		return
+	}
+
+	var data types.GenesisState
+	cdc.MustUnmarshalJSON(raw, &data)
+
+	if err := m.keeper.InitGenesis(ctx, &data); err != nil {
+		panic(errors.Wrap(err, "init genesis"))
+	}
+}
+
+func (m AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	data, err := m.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(errors.Wrap(err, "export genesis"))
+	}
+
+	return cdc.MustMarshalJSON(data)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the portal module.
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the portal module.
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var data types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return errors.Wrap(err, "unmarshal genesis state")
+	}
+
+	return nil
+}
+
 // RegisterServices registers a gRPC query service to respond to the module-specific gRPC queries.
 func (m AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), m.keeper)
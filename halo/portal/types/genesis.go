@@ -0,0 +1,5 @@
+package types
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/ethclient"
 	"github.com/omni-network/omni/lib/xchain"
+	"github.com/omni-network/omni/octane/evmengine/eventproc"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -20,12 +21,11 @@ import (
 )
 
 type Keeper struct {
-	emilPortal      ptypes.EmitPortal
-	networkTable    NetworkTable
-	ethCl           ethclient.Client
-	portalRegAdress common.Address
-	portalRegistry  *bindings.PortalRegistryFilterer
-	chainNamer      types.ChainNameFunc
+	modDB        ormdb.ModuleDB
+	emilPortal   ptypes.EmitPortal
+	networkTable NetworkTable
+	eventProc    eventproc.Processor
+	chainNamer   types.ChainNameFunc
 
 	latestCache *cache
 }
@@ -50,21 +50,27 @@ func NewKeeper(
 		return Keeper{}, errors.Wrap(err, "create registry store")
 	}
 
-	address := common.HexToAddress(predeploys.PortalRegistry)
-	protalReg, err := bindings.NewPortalRegistryFilterer(address, ethCl)
+	k := Keeper{
+		modDB:        modDB,
+		emilPortal:   emilPortal,
+		networkTable: registryStore.NetworkTable(),
+		chainNamer:   namer,
+		latestCache:  new(cache),
+	}
+
+	k.eventProc, err = eventproc.New(ethCl, eventproc.Config{
+		Name:    types.ModuleName,
+		Address: common.HexToAddress(predeploys.PortalRegistry),
+		ABI:     bindings.PortalRegistryMetaData,
+		Handlers: []eventproc.Handler{
+			eventproc.Handle("PortalRegistered", k.deliverPortalRegistered),
+		},
+	})
 	if err != nil {
-		return Keeper{}, errors.Wrap(err, "new portal registry")
+		return Keeper{}, errors.Wrap(err, "new event processor")
 	}
 
-	return Keeper{
-		emilPortal:      emilPortal,
-		networkTable:    registryStore.NetworkTable(),
-		ethCl:           ethCl,
-		portalRegAdress: address,
-		portalRegistry:  protalReg,
-		chainNamer:      namer,
-		latestCache:     new(cache),
-	}, nil
+	return k, nil
 }
 
 // getOrCreateEpoch returns a network created in the current height.
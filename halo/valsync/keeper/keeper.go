@@ -12,6 +12,7 @@ import (
 	"github.com/omni-network/omni/lib/ethclient"
 	"github.com/omni-network/omni/lib/log"
 	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/ormgenesis"
 	"github.com/omni-network/omni/lib/xchain"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -33,6 +34,7 @@ import (
 const cometValidatorActiveDelay = 2
 
 type Keeper struct {
+	modDB             ormdb.ModuleDB
 	sKeeper           types.StakingKeeper
 	aKeeper           atypes.AttestKeeper
 	valsetTable       ValidatorSetTable
@@ -75,6 +77,7 @@ func NewKeeper(
 	}
 
 	return &Keeper{
+		modDB:           modDB,
 		valsetTable:     valSyncStore.ValidatorSetTable(),
 		valTable:        valSyncStore.ValidatorTable(),
 		sKeeper:         sKeeper,
@@ -149,6 +152,62 @@ func (k *Keeper) maybeStoreValidatorUpdates(ctx context.Context, updates []abci.
 	return nil
 }
 
+// InitGenesis initializes the module state from the genesis state.
+// New networks insert the genesis validator set, while exported genesis states import all tables.
+func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if len(data.GetTables()) == 0 {
+		return k.InsertGenesisSet(ctx)
+	}
+
+	if err := ormgenesis.Import(ctx, k.modDB, data.GetTables()); err != nil {
+		return errors.Wrap(err, "import tables")
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the genesis state containing all tables.
+func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	tables, err := ormgenesis.Export(ctx, k.modDB)
+	if err != nil {
+		return nil, errors.Wrap(err, "export tables")
+	}
+
+	return &types.GenesisState{Tables: tables}, nil
+}
+
+// VerifyExport returns an error if a validator set update is in progress at the provided export height.
+// Exported genesis validators are the x/staking validators, which only match the
+// active cometBFT validator set once the latest validator set is attested and activated.
+func (k *Keeper) VerifyExport(ctx context.Context, height uint64) error {
+	iter, err := k.valsetTable.List(ctx, ValidatorSetPrimaryKey{}, ormlist.Reverse(), ormlist.DefaultLimit(1))
+	if err != nil {
+		return errors.Wrap(err, "list valsets")
+	}
+	defer iter.Close()
+
+	if !iter.Next() {
+		return errors.New("no validator set found")
+	}
+
+	latest, err := iter.Value()
+	if err != nil {
+		return errors.Wrap(err, "get latest valset")
+	}
+
+	// The exported network restarts at the next height.
+	if !latest.GetAttested() || latest.GetActivatedHeight() > height+1 {
+		return errors.New("validator set update in progress, retry at a later height",
+			"valset_id", latest.GetId(),
+			"attested", latest.GetAttested(),
+			"activated_height", latest.GetActivatedHeight(),
+			"height", height,
+		)
+	}
+
+	return nil
+}
+
 // InsertGenesisSet inserts the current genesis validator set and empty network as the initial epoch.
 // Note: This MUST only be called during InitGenesis AFTER the staking module's InitGenesis.
 func (k *Keeper) InsertGenesisSet(ctx context.Context) error {
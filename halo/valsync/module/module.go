@@ -83,14 +83,22 @@ func (m AppModule) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error)
 	return m.keeper.EndBlock(ctx)
 }
 
-func (m AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, _ json.RawMessage) {
-	if err := m.keeper.InsertGenesisSet(ctx); err != nil {
-		panic(errors.Wrap(err, "insert genesis valset"))
+func (m AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, raw json.RawMessage) {
+	var data types.GenesisState
+	cdc.MustUnmarshalJSON(raw, &data)
+
+	if err := m.keeper.InitGenesis(ctx, &data); err != nil {
+		panic(errors.Wrap(err, "init genesis"))
 	}
 }
 
-func (AppModule) ExportGenesis(sdk.Context, codec.JSONCodec) json.RawMessage {
-	return nil
+func (m AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	data, err := m.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(errors.Wrap(err, "export genesis"))
+	}
+
+	return cdc.MustMarshalJSON(data)
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the bank
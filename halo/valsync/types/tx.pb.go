@@ -22,8 +22,10 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// GenesisState is an empty genesis state required to trigger valsync genesis logic only.
+// GenesisState defines the valsync module's genesis state.
+// It is empty for new networks, which triggers the genesis validator set logic.
 type GenesisState struct {
+	Tables []byte `protobuf:"bytes,1,opt,name=tables,proto3" json:"tables,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -59,6 +61,13 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetTables() []byte {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "halo.valsync.types.GenesisState")
 }
@@ -66,15 +75,16 @@ func init() {
 func init() { proto.RegisterFile("halo/valsync/types/tx.proto", fileDescriptor_74b34e7f9bc4f395) }
 
 var fileDescriptor_74b34e7f9bc4f395 = []byte{
-	// 116 bytes of a gzipped FileDescriptorProto
+	// 131 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0x48, 0xcc, 0xc9,
 	0xd7, 0x2f, 0x4b, 0xcc, 0x29, 0xae, 0xcc, 0x4b, 0xd6, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x2f,
 	0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x02, 0x49, 0xea, 0x41, 0x25, 0xf5, 0xc0,
-	0x92, 0x4a, 0x7c, 0x5c, 0x3c, 0xee, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0xc1, 0x25, 0x89, 0x25,
-	0xa9, 0x4e, 0x3a, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3,
-	0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x25, 0x84, 0x69,
-	0x74, 0x12, 0x1b, 0xd8, 0x60, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x76, 0x4f, 0xad,
-	0x77, 0x00, 0x00, 0x00,
+	0x92, 0x4a, 0x6a, 0x5c, 0x3c, 0xee, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0xc1, 0x25, 0x89, 0x25,
+	0xa9, 0x42, 0x62, 0x5c, 0x6c, 0x25, 0x89, 0x49, 0x39, 0xa9, 0xc5, 0x12, 0x8c, 0x0a, 0x8c, 0x1a,
+	0x3c, 0x41, 0x50, 0x9e, 0x93, 0xce, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78,
+	0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44,
+	0x09, 0x61, 0x5a, 0x99, 0xc4, 0x06, 0xb6, 0xd0, 0x18, 0x30, 0x00, 0xe7, 0x0e, 0xd3, 0x91, 0x8f,
+	0x00, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -97,6 +107,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Tables) > 0 {
+		i -= len(m.Tables)
+		copy(dAtA[i:], m.Tables)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tables)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -117,6 +134,10 @@ func (m *GenesisState) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.Tables)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -155,6 +176,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tables = append(m.Tables[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tables == nil {
+				m.Tables = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
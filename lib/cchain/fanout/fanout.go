@@ -0,0 +1,355 @@
+// Package fanout provides a cchain.Provider that shares attestation streams between subscribers.
+//
+// It runs a single upstream stream per chain version and delivers attestations to many subscribers,
+// each with its own starting offset and pace. Recent attestations are kept in a bounded replay buffer
+// from which new or lagging subscribers are served. Subscribers behind the replay buffer catch up by
+// fetching attestations directly from the wrapped provider.
+package fanout
+
+import (
+	"context"
+	"sync"
+
+	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/expbackoff"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/xchain"
+)
+
+const (
+	defaultReplaySize = 1024
+	upstreamWorker    = "fanout"
+)
+
+var _ cchain.Provider = (*Provider)(nil)
+
+// Option configures the fan-out provider.
+type Option func(*options)
+
+type options struct {
+	ReplaySize  int
+	BackoffFunc func(context.Context) func()
+}
+
+// WithReplaySize returns an option that sets the number of attestations buffered per chain version.
+// It must be positive.
+func WithReplaySize(size int) Option {
+	return func(o *options) {
+		o.ReplaySize = size
+	}
+}
+
+// WithBackoff returns an option that sets the backoff function used when retrying subscriber callbacks and
+// catch-up fetches, or waiting for new attestations while catching up.
+func WithBackoff(backoffFunc func(context.Context) func()) Option {
+	return func(o *options) {
+		o.BackoffFunc = backoffFunc
+	}
+}
+
+// Provider wraps a cchain.Provider sharing a single upstream attestation stream per chain version
+// between all StreamAsync and StreamAttestations subscribers.
+type Provider struct {
+	cchain.Provider
+	opts       options
+	chainNamer func(xchain.ChainVersion) string
+
+	mu   sync.Mutex
+	hubs map[xchain.ChainVersion]*hub
+}
+
+// New returns a new fan-out provider wrapping the provided provider.
+func New(provider cchain.Provider, chainNamer func(xchain.ChainVersion) string, opts ...Option) (*Provider, error) {
+	o := options{
+		ReplaySize: defaultReplaySize,
+		BackoffFunc: func(ctx context.Context) func() {
+			return expbackoff.New(ctx)
+		},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.ReplaySize <= 0 {
+		return nil, errors.New("invalid replay size", "size", o.ReplaySize)
+	}
+
+	return &Provider{
+		Provider:   provider,
+		opts:       o,
+		chainNamer: chainNamer,
+		hubs:       make(map[xchain.ChainVersion]*hub),
+	}, nil
+}
+
+// StreamAsync subscribes to the shared stream of the chain version. It retries callback errors forever.
+func (p *Provider) StreamAsync(
+	ctx context.Context,
+	chainVer xchain.ChainVersion,
+	attestOffset uint64,
+	workerName string,
+	callback cchain.ProviderCallback,
+) {
+	go func() {
+		err := p.subscribe(ctx, chainVer, attestOffset, workerName, callback, true)
+		if err != nil { // retryCallback==true, so this never returns an error.
+			log.Error(ctx, "Unexpected fanout stream error [BUG]", err)
+		}
+	}()
+}
+
+// StreamAttestations subscribes to the shared stream of the chain version. It returns on the first callback error.
+func (p *Provider) StreamAttestations(
+	ctx context.Context,
+	chainVer xchain.ChainVersion,
+	attestOffset uint64,
+	workerName string,
+	callback cchain.ProviderCallback,
+) error {
+	return p.subscribe(ctx, chainVer, attestOffset, workerName, callback, false)
+}
+
+func (p *Provider) subscribe(
+	ctx context.Context,
+	chainVer xchain.ChainVersion,
+	attestOffset uint64,
+	workerName string,
+	callback cchain.ProviderCallback,
+	retryCallback bool,
+) error {
+	if attestOffset == 0 {
+		return errors.New("invalid zero attest offset [BUG]", "worker", workerName)
+	}
+
+	chainName := p.chainNamer(chainVer)
+	ctx = log.WithCtx(ctx, "src_chain", chainName, "worker", workerName)
+
+	h := p.acquire(ctx, chainVer, attestOffset)
+	defer p.release(h)
+
+	subscribers.WithLabelValues(chainName).Inc()
+	defer subscribers.WithLabelValues(chainName).Dec()
+
+	// deliver calls the callback, retrying errors if required. It returns nil if the context is canceled.
+	deliver := func(att xchain.Attestation) error {
+		if !chainVer.ConfLevel.IsFuzzy() && att.ChainVersion.ConfLevel.IsFuzzy() {
+			return errors.New("fuzzy attestation while streaming finalized [BUG]")
+		}
+
+		backoff := p.opts.BackoffFunc(ctx)
+		for {
+			err := callback(ctx, att)
+			if ctx.Err() != nil {
+				return nil //nolint:nilerr // Don't return errors on context cancel.
+			} else if err != nil && !retryCallback {
+				return errors.Wrap(err, "callback", "offset", att.AttestOffset)
+			} else if err != nil {
+				log.Warn(ctx, "Failed processing attestation (will retry)", err, "offset", att.AttestOffset)
+				backoff()
+
+				continue
+			}
+
+			subscriberOffset.WithLabelValues(workerName, chainName).Set(float64(att.AttestOffset))
+
+			return nil
+		}
+	}
+
+	fetchBackoff := p.opts.BackoffFunc(ctx)
+	next := attestOffset
+	for ctx.Err() == nil {
+		att, ok, behind, notify := h.get(next)
+		if ok {
+			if err := deliver(att); err != nil {
+				return err
+			}
+			next++
+
+			continue
+		} else if !behind {
+			// Wait for the upstream stream.
+			select {
+			case <-ctx.Done():
+			case <-notify:
+			}
+
+			continue
+		}
+
+		// Offset is behind the replay buffer, catch up by fetching directly.
+		atts, err := p.Provider.AttestationsFrom(ctx, chainVer, next)
+		if ctx.Err() != nil {
+			return nil
+		} else if err != nil {
+			log.Warn(ctx, "Failed fetching catch-up attestations (will retry)", err, "offset", next)
+			fetchBackoff()
+
+			continue
+		} else if len(atts) == 0 {
+			fetchBackoff()
+			continue
+		}
+
+		catchupTotal.WithLabelValues(chainName).Add(float64(len(atts)))
+
+		for _, att := range atts {
+			if att.AttestOffset != next {
+				log.Warn(ctx, "Unexpected catch-up attestation offset (will retry)", nil, "expect", next, "actual", att.AttestOffset)
+				break
+			}
+
+			if err := deliver(att); err != nil {
+				return err
+			}
+			next++
+		}
+	}
+
+	return nil
+}
+
+// acquire returns the chain version's hub, starting a new upstream stream from the offset if not running.
+func (p *Provider) acquire(ctx context.Context, chainVer xchain.ChainVersion, attestOffset uint64) *hub {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	h, ok := p.hubs[chainVer]
+	if !ok {
+		upstreamCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		h = newHub(chainVer, attestOffset, p.opts.ReplaySize, cancel)
+		p.hubs[chainVer] = h
+
+		chainName := p.chainNamer(chainVer)
+		p.Provider.StreamAsync(upstreamCtx, chainVer, attestOffset, upstreamWorker, func(ctx context.Context, att xchain.Attestation) error {
+			if err := p.addUpstream(ctx, h, chainName, att); err != nil {
+				return err // The upstream stream retries callback errors.
+			}
+			replayOffset.WithLabelValues(chainName).Set(float64(att.AttestOffset))
+
+			return nil
+		})
+	}
+	h.refs++
+
+	return h
+}
+
+// addUpstream adds the upstream attestation to the hub's replay buffer. Duplicates are ignored and
+// gaps are filled by fetching the missing attestations from the wrapped provider, since a stalled
+// hub would stall all its subscribers.
+func (p *Provider) addUpstream(ctx context.Context, h *hub, chainName string, att xchain.Attestation) error {
+	for {
+		next := h.Next()
+		if att.AttestOffset < next {
+			log.Debug(ctx, "Ignoring duplicate fanout attestation", "expect", next, "actual", att.AttestOffset)
+			return nil
+		} else if att.AttestOffset == next {
+			h.add(att)
+			return nil
+		}
+
+		log.Warn(ctx, "Filling fanout attestation gap", nil, "expect", next, "actual", att.AttestOffset)
+
+		atts, err := p.Provider.AttestationsFrom(ctx, h.chainVer, next)
+		if err != nil {
+			return errors.Wrap(err, "fetch gap attestations", "offset", next)
+		}
+
+		var filled int
+		for _, gapAtt := range atts {
+			if gapAtt.AttestOffset != h.Next() || gapAtt.AttestOffset >= att.AttestOffset {
+				break
+			}
+			h.add(gapAtt)
+			filled++
+		}
+
+		if filled == 0 {
+			return errors.New("gap attestations not found", "expect", next, "actual", att.AttestOffset)
+		}
+
+		gapTotal.WithLabelValues(chainName).Add(float64(filled))
+	}
+}
+
+// release stops the hub's upstream stream if it has no more subscribers.
+func (p *Provider) release(h *hub) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	h.refs--
+	if h.refs > 0 {
+		return
+	}
+
+	h.cancel()
+	delete(p.hubs, h.chainVer)
+}
+
+// hub is the shared upstream stream of a chain version with a bounded replay buffer.
+type hub struct {
+	chainVer xchain.ChainVersion
+	cancel   context.CancelFunc // Stops the upstream stream.
+	refs     int                // Number of subscribers, protected by Provider.mu.
+
+	mu     sync.Mutex
+	ring   []xchain.Attestation // Replay buffer indexed by offset modulo size.
+	start  uint64               // Offset the upstream stream started from.
+	next   uint64               // Next offset expected from the upstream stream.
+	notify chan struct{}        // Closed and replaced when an attestation is added.
+}
+
+func newHub(chainVer xchain.ChainVersion, start uint64, size int, cancel context.CancelFunc) *hub {
+	return &hub{
+		chainVer: chainVer,
+		cancel:   cancel,
+		ring:     make([]xchain.Attestation, size),
+		start:    start,
+		next:     start,
+		notify:   make(chan struct{}),
+	}
+}
+
+// Next returns the next offset expected from the upstream stream.
+func (h *hub) Next() uint64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.next
+}
+
+// add adds the next attestation to the replay buffer and wakes up all waiting subscribers.
+// The attestation offset must equal Next, see Provider.addUpstream.
+func (h *hub) add(att xchain.Attestation) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.ring[att.AttestOffset%uint64(len(h.ring))] = att
+	h.next++
+
+	close(h.notify)
+	h.notify = make(chan struct{})
+}
+
+// get returns the attestation at the offset and true if it is in the replay buffer.
+// Otherwise, it returns true if the offset is behind the replay buffer, or
+// a channel that is closed when the next attestation is added.
+func (h *hub) get(offset uint64) (xchain.Attestation, bool, bool, <-chan struct{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	first := h.start
+	if size := uint64(len(h.ring)); h.next > first+size {
+		first = h.next - size
+	}
+
+	if offset < first {
+		return xchain.Attestation{}, false, true, nil
+	} else if offset >= h.next {
+		return xchain.Attestation{}, false, false, h.notify
+	}
+
+	return h.ring[offset%uint64(len(h.ring))], true, false, nil
+}
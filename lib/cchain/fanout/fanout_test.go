@@ -0,0 +1,279 @@
+package fanout_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/cchain/fanout"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/stretchr/testify/require"
+)
+
+var chainVer = xchain.ChainVersion{ID: 100, ConfLevel: xchain.ConfFinalized}
+
+func TestShared(t *testing.T) {
+	t.Parallel()
+
+	const total = 50
+
+	upstream := newTestProvider(total)
+	p := newFanout(t, upstream, fanout.WithReplaySize(total))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subA := newTestSubscriber(total)
+	subB := newTestSubscriber(total)
+	p.StreamAsync(ctx, chainVer, 1, "a", subA.Callback)
+	p.StreamAsync(ctx, chainVer, 1, "b", subB.Callback)
+
+	subA.Wait(t)
+	subB.Wait(t)
+
+	require.EqualValues(t, 1, upstream.streams.Load())
+	require.EqualValues(t, 0, upstream.fetches.Load())
+	requireSequential(t, 1, subA.Offsets())
+	requireSequential(t, 1, subB.Offsets())
+}
+
+func TestLateSubscriber(t *testing.T) {
+	t.Parallel()
+
+	const total = 50
+	const replay = 10
+
+	upstream := newTestProvider(total)
+	p := newFanout(t, upstream, fanout.WithReplaySize(replay))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Note the early subscriber may also fall behind the replay buffer.
+	early := newTestSubscriber(total)
+	p.StreamAsync(ctx, chainVer, 1, "early", early.Callback)
+	early.Wait(t)
+	requireSequential(t, 1, early.Offsets())
+
+	// Served from the replay buffer.
+	fetches := upstream.fetches.Load()
+	recent := newTestSubscriber(replay)
+	p.StreamAsync(ctx, chainVer, total-replay+1, "recent", recent.Callback)
+	recent.Wait(t)
+	require.Equal(t, fetches, upstream.fetches.Load())
+	requireSequential(t, total-replay+1, recent.Offsets())
+
+	// Behind the replay buffer, so catch up by fetching.
+	late := newTestSubscriber(total)
+	p.StreamAsync(ctx, chainVer, 1, "late", late.Callback)
+	late.Wait(t)
+	require.Greater(t, upstream.fetches.Load(), fetches)
+	requireSequential(t, 1, late.Offsets())
+
+	require.EqualValues(t, 1, upstream.streams.Load())
+}
+
+func TestBackpressure(t *testing.T) {
+	t.Parallel()
+
+	const total = 20
+
+	upstream := newTestProvider(total)
+	p := newFanout(t, upstream)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Slow subscriber blocks on the first attestation.
+	unblock := make(chan struct{})
+	p.StreamAsync(ctx, chainVer, 1, "slow", func(ctx context.Context, _ xchain.Attestation) error {
+		select {
+		case <-ctx.Done():
+		case <-unblock:
+		}
+
+		return nil
+	})
+
+	fast := newTestSubscriber(total)
+	p.StreamAsync(ctx, chainVer, 1, "fast", fast.Callback)
+	fast.Wait(t)
+	requireSequential(t, 1, fast.Offsets())
+
+	close(unblock)
+}
+
+func TestStreamAttestationsError(t *testing.T) {
+	t.Parallel()
+
+	upstream := newTestProvider(10)
+	p := newFanout(t, upstream)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := p.StreamAttestations(ctx, chainVer, 1, "test", func(_ context.Context, att xchain.Attestation) error {
+		if att.AttestOffset == 5 {
+			return errors.New("test error")
+		}
+
+		return nil
+	})
+	require.ErrorContains(t, err, "test error")
+
+	// Upstream is stopped when all subscribers are done.
+	require.Eventually(t, func() bool {
+		return upstream.stopped.Load() == 1
+	}, time.Second, time.Millisecond)
+
+	// A new subscriber restarts the upstream.
+	sub := newTestSubscriber(5)
+	p.StreamAsync(ctx, chainVer, 1, "test", sub.Callback)
+	sub.Wait(t)
+	require.EqualValues(t, 2, upstream.streams.Load())
+}
+
+func TestUpstreamGap(t *testing.T) {
+	t.Parallel()
+
+	const total = 20
+
+	upstream := newTestProvider(total)
+	upstream.skip = map[uint64]bool{5: true, 6: true, 7: true, 8: true, 12: true}
+	p := newFanout(t, upstream, fanout.WithReplaySize(total))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sub := newTestSubscriber(total)
+	p.StreamAsync(ctx, chainVer, 1, "sub", sub.Callback)
+	sub.Wait(t)
+
+	// Gaps are filled from the wrapped provider, not by subscribers catching up.
+	requireSequential(t, 1, sub.Offsets())
+	require.EqualValues(t, 3, upstream.fetches.Load())
+}
+
+func newFanout(t *testing.T, upstream cchain.Provider, opts ...fanout.Option) *fanout.Provider {
+	t.Helper()
+
+	opts = append(opts, fanout.WithBackoff(func(context.Context) func() {
+		return func() { time.Sleep(time.Millisecond) }
+	}))
+
+	p, err := fanout.New(upstream, func(xchain.ChainVersion) string { return "test" }, opts...)
+	require.NoError(t, err)
+
+	return p
+}
+
+func requireSequential(t *testing.T, from uint64, offsets []uint64) {
+	t.Helper()
+	for i, offset := range offsets {
+		require.Equal(t, from+uint64(i), offset)
+	}
+}
+
+func newAttestation(offset uint64) xchain.Attestation {
+	return xchain.Attestation{
+		AttestHeader: xchain.AttestHeader{
+			ChainVersion: chainVer,
+			AttestOffset: offset,
+		},
+	}
+}
+
+// testProvider is a stub cchain provider with attestations [1, head].
+type testProvider struct {
+	cchain.Provider
+	head    uint64
+	skip    map[uint64]bool // Offsets skipped by the stream, but returned by AttestationsFrom.
+	streams atomic.Int64
+	stopped atomic.Int64
+	fetches atomic.Int64
+}
+
+func newTestProvider(head uint64) *testProvider {
+	return &testProvider{head: head}
+}
+
+func (p *testProvider) StreamAsync(ctx context.Context, _ xchain.ChainVersion, offset uint64, _ string, callback cchain.ProviderCallback) {
+	p.streams.Add(1)
+	go func() {
+		defer p.stopped.Add(1)
+		for ctx.Err() == nil {
+			if offset > p.head {
+				time.Sleep(time.Millisecond)
+				continue
+			} else if p.skip[offset] {
+				offset++
+				continue
+			}
+
+			if err := callback(ctx, newAttestation(offset)); err != nil {
+				panic(err)
+			}
+			offset++
+		}
+	}()
+}
+
+func (p *testProvider) AttestationsFrom(_ context.Context, _ xchain.ChainVersion, offset uint64) ([]xchain.Attestation, error) {
+	p.fetches.Add(1)
+
+	var resp []xchain.Attestation
+	for ; offset <= p.head && len(resp) < 3; offset++ {
+		resp = append(resp, newAttestation(offset))
+	}
+
+	return resp, nil
+}
+
+// testSubscriber collects offsets until total is reached.
+type testSubscriber struct {
+	mu      sync.Mutex
+	total   int
+	offsets []uint64
+	done    chan struct{}
+}
+
+func newTestSubscriber(total int) *testSubscriber {
+	return &testSubscriber{total: total, done: make(chan struct{})}
+}
+
+func (s *testSubscriber) Callback(_ context.Context, att xchain.Attestation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.offsets) == s.total {
+		return nil // Ignore further attestations.
+	}
+
+	s.offsets = append(s.offsets, att.AttestOffset)
+	if len(s.offsets) == s.total {
+		close(s.done)
+	}
+
+	return nil
+}
+
+func (s *testSubscriber) Wait(t *testing.T) {
+	t.Helper()
+	select {
+	case <-s.done:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timeout waiting for attestations")
+	}
+}
+
+func (s *testSubscriber) Offsets() []uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]uint64(nil), s.offsets...)
+}
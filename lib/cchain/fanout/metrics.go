@@ -0,0 +1,43 @@
+package fanout
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	subscribers = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "lib",
+		Subsystem: "cchain_fanout",
+		Name:      "subscribers",
+		Help:      "Number of active subscribers per source chain version.",
+	}, []string{"chain_version"})
+
+	replayOffset = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "lib",
+		Subsystem: "cchain_fanout",
+		Name:      "replay_offset",
+		Help:      "Latest attestation offset in the replay buffer per source chain version. Alert if not growing.",
+	}, []string{"chain_version"})
+
+	subscriberOffset = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "lib",
+		Subsystem: "cchain_fanout",
+		Name:      "subscriber_offset",
+		Help:      "Latest delivered attestation offset per subscriber per source chain version. Alert if not growing.",
+	}, []string{"worker", "chain_version"})
+
+	catchupTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "lib",
+		Subsystem: "cchain_fanout",
+		Name:      "catchup_total",
+		Help:      "Total number of attestations fetched directly by subscribers behind the replay buffer per source chain version.",
+	}, []string{"chain_version"})
+
+	gapTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "lib",
+		Subsystem: "cchain_fanout",
+		Name:      "gap_total",
+		Help:      "Total number of attestations fetched to fill gaps in the upstream stream per source chain version.",
+	}, []string{"chain_version"})
+)
@@ -3,6 +3,7 @@ package cchain
 import (
 	"context"
 
+	atypes "github.com/omni-network/omni/halo/attest/types"
 	rtypes "github.com/omni-network/omni/halo/registry/types"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/xchain"
@@ -12,6 +13,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	utypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sltypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -63,9 +66,16 @@ type Provider interface {
 	// Validators returns the current staking module validators from latest height.
 	Validators(ctx context.Context) ([]stypes.Validator, error)
 
+	// UnbondingDelegations returns the staking module unbonding delegations of the given delegator address from latest height.
+	UnbondingDelegations(ctx context.Context, delegator common.Address) ([]stypes.UnbondingDelegation, error)
+
 	// Rewards returns the staking module rewards for the given operator address from latest height.
 	Rewards(ctx context.Context, operator common.Address) (float64, bool, error)
 
+	// SlashingStatus returns the slashing module signing info and params, and the jail status
+	// of the given validator consensus address from latest height.
+	SlashingStatus(ctx context.Context, consAddr sdk.ConsAddress) (SlashingStatus, bool, error)
+
 	// XBlock returns the portal module block for the given blockHeight/attestOffset (or latest) or false if none exist or an error.
 	XBlock(ctx context.Context, heightAndOffset uint64, latest bool) (xchain.Block, bool, error)
 
@@ -82,6 +92,13 @@ type Provider interface {
 	CurrentUpgradePlan(ctx context.Context) (utypes.Plan, bool, error)
 }
 
+// SlashingStatus is the slashing and jail status of a validator.
+type SlashingStatus struct {
+	SigningInfo sltypes.ValidatorSigningInfo
+	Params      sltypes.Params
+	Jail        atypes.JailStatusResponse
+}
+
 // Validator is a consensus chain validator in a validator set.
 type Validator struct {
 	Address common.Address
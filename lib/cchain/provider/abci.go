@@ -29,6 +29,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	dtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	sltypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
@@ -63,15 +64,18 @@ func NewABCIProvider(cmtCl rpcclient.Client, network netconf.ID, chainNamer func
 	ucl := utypes.NewQueryClient(rpcAdaptor{abci: cmtCl})
 	scl := stypes.NewQueryClient(rpcAdaptor{abci: cmtCl})
 	dcl := dtypes.NewQueryClient(rpcAdaptor{abci: cmtCl})
+	slcl := sltypes.NewQueryClient(rpcAdaptor{abci: cmtCl})
 
 	return Provider{
-		fetch:       newABCIFetchFunc(acl, cmtCl, chainNamer),
+		fetch:       newABCIFetchFunc(acl, newABCILatestHeightFunc(cmtCl), chainNamer),
 		latest:      newABCILatestFunc(acl),
 		window:      newABCIWindowFunc(acl),
 		valset:      newABCIValsetFunc(vcl),
 		val:         newABCIValFunc(scl),
 		vals:        newABCIValsFunc(scl),
+		unbondings:  newABCIUnbondingsFunc(scl),
 		rewards:     newABCIRewards(dcl),
+		slashing:    newABCISlashingFunc(slcl, acl),
 		portalBlock: newABCIPortalBlockFunc(pcl),
 		networkFunc: newABCINetworkFunc(rcl),
 		genesisFunc: newABCIGenesisFunc(gcl),
@@ -79,6 +83,7 @@ func NewABCIProvider(cmtCl rpcclient.Client, network netconf.ID, chainNamer func
 		chainID:     newChainIDFunc(cmtCl),
 		header:      cmtCl.Header,
 		backoffFunc: backoffFunc,
+		approvals:   newApprovals(cmtCl),
 		chainNamer:  chainNamer,
 		network:     network,
 		cometCl:     cmtCl,
@@ -151,6 +156,42 @@ func newABCIRewards(cl dtypes.QueryClient) rewardsFunc {
 	}
 }
 
+func newABCISlashingFunc(cl sltypes.QueryClient, acl atypes.QueryClient) slashingFunc {
+	return func(ctx context.Context, consAddr sdk.ConsAddress) (cchain.SlashingStatus, bool, error) {
+		const endpoint = "slashing"
+		defer latency(endpoint)()
+
+		ctx, span := tracer.Start(ctx, spanName(endpoint))
+		defer span.End()
+
+		info, err := cl.SigningInfo(ctx, &sltypes.QuerySigningInfoRequest{ConsAddress: consAddr.String()})
+		if errors.Is(err, sdkerrors.ErrKeyNotFound) {
+			return cchain.SlashingStatus{}, false, nil
+		} else if err != nil {
+			incQueryErr(endpoint)
+			return cchain.SlashingStatus{}, false, errors.Wrap(err, "abci query signing info")
+		}
+
+		params, err := cl.Params(ctx, &sltypes.QueryParamsRequest{})
+		if err != nil {
+			incQueryErr(endpoint)
+			return cchain.SlashingStatus{}, false, errors.Wrap(err, "abci query slashing params")
+		}
+
+		jail, err := acl.JailStatus(ctx, &atypes.JailStatusRequest{ConsAddress: consAddr})
+		if err != nil {
+			incQueryErr(endpoint)
+			return cchain.SlashingStatus{}, false, errors.Wrap(err, "abci query jail status")
+		}
+
+		return cchain.SlashingStatus{
+			SigningInfo: info.ValSigningInfo,
+			Params:      params.Params,
+			Jail:        *jail,
+		}, true, nil
+	}
+}
+
 func newABCIValFunc(cl stypes.QueryClient) valFunc {
 	return func(ctx context.Context, operatorAddr common.Address) (stypes.Validator, bool, error) {
 		const endpoint = "validator"
@@ -190,6 +231,25 @@ func newABCIValsFunc(cl stypes.QueryClient) valsFunc {
 	}
 }
 
+func newABCIUnbondingsFunc(cl stypes.QueryClient) unbondingsFunc {
+	return func(ctx context.Context, delegator common.Address) ([]stypes.UnbondingDelegation, error) {
+		const endpoint = "unbondings"
+		defer latency(endpoint)()
+
+		ctx, span := tracer.Start(ctx, spanName(endpoint))
+		defer span.End()
+
+		delAddr := sdk.AccAddress(delegator.Bytes())
+		resp, err := cl.DelegatorUnbondingDelegations(ctx, &stypes.QueryDelegatorUnbondingDelegationsRequest{DelegatorAddr: delAddr.String()})
+		if err != nil {
+			incQueryErr(endpoint)
+			return nil, errors.Wrap(err, "abci query unbonding delegations")
+		}
+
+		return resp.UnbondingResponses, nil
+	}
+}
+
 func newABCIValsetFunc(cl vtypes.QueryClient) valsetFunc {
 	return func(ctx context.Context, valSetID uint64, latest bool) (valSetResponse, bool, error) {
 		const endpoint = "valset"
@@ -227,7 +287,19 @@ func newABCIValsetFunc(cl vtypes.QueryClient) valsetFunc {
 	}
 }
 
-func newABCIFetchFunc(cl atypes.QueryClient, client rpcclient.Client, chainNamer func(xchain.ChainVersion) string) fetchFunc {
+// newABCILatestHeightFunc returns a function that returns the latest consensus block height via ABCIInfo.
+func newABCILatestHeightFunc(cl rpcclient.ABCIClient) latestHeightFunc {
+	return func(ctx context.Context) (uint64, error) {
+		info, err := cl.ABCIInfo(ctx)
+		if err != nil {
+			return 0, errors.Wrap(err, "abci query info")
+		}
+
+		return uint64(info.Response.LastBlockHeight), nil
+	}
+}
+
+func newABCIFetchFunc(cl atypes.QueryClient, latestHeight latestHeightFunc, chainNamer func(xchain.ChainVersion) string) fetchFunc {
 	return func(ctx context.Context, chainVer xchain.ChainVersion, fromOffset uint64) ([]xchain.Attestation, error) {
 		const endpoint = "fetch_attestations"
 		defer latency(endpoint)()
@@ -264,14 +336,25 @@ func newABCIFetchFunc(cl atypes.QueryClient, client rpcclient.Client, chainNamer
 
 		log.Debug(ctx, "Offset not found in latest state", "chain", chainName, "offset", fromOffset, "earliest", earliestAttestationAtLatestHeight.AttestOffset)
 
-		offsetHeight, err := searchOffsetInHistory(ctx, client, cl, chainVer, chainName, fromOffset)
+		// Prefer the offset height index, falling back to searching history if not indexed (or not supported).
+		offsetHeight, ok, err := queryOffsetHeight(ctx, cl, chainVer, fromOffset)
 		if err != nil {
-			incQueryErr(endpoint)
-			return nil, errors.Wrap(err, "searching offset in history")
+			log.Warn(ctx, "Failed querying offset height index (will search history)", err, "chain", chainName, "offset", fromOffset)
+			ok = false
+		}
+
+		if !ok {
+			offsetHeight, err = searchOffsetInHistory(ctx, latestHeight, cl, chainVer, chainName, fromOffset)
+			if err != nil {
+				incQueryErr(endpoint)
+				return nil, errors.Wrap(err, "searching offset in history")
+			}
 		}
 
 		atts, attsFromOk, err := attsFromAtHeight(ctx, cl, chainVer, fromOffset, offsetHeight)
-		if err != nil {
+		if IsErrHistoryPruned(err) {
+			return nil, ErrHistoryPruned
+		} else if err != nil {
 			incQueryErr(endpoint)
 			return nil, errors.Wrap(err, "abci query attestations-from")
 		}
@@ -450,18 +533,18 @@ func spanName(endpoint string) string {
 // searchOffsetInHistory searches the consensus state history and
 // returns a historical consensus block height that contains an approved attestation
 // for the provided chain version and fromOffset.
-func searchOffsetInHistory(ctx context.Context, client rpcclient.Client, cl atypes.QueryClient, chainVer xchain.ChainVersion, chainName string, fromOffset uint64) (uint64, error) {
+func searchOffsetInHistory(ctx context.Context, latestHeight latestHeightFunc, cl atypes.QueryClient, chainVer xchain.ChainVersion, chainName string, fromOffset uint64) (uint64, error) {
 	const endpoint = "search_offset"
 	defer latency(endpoint)
 
 	// Exponentially backoff to find a good start point for binary search, this prefers more recent queries
-	info, err := client.ABCIInfo(ctx)
+	lastBlockHeight, err := latestHeight(ctx)
 	if err != nil {
-		return 0, errors.Wrap(err, "abci query info")
+		return 0, err
 	}
 
 	var startHeightIndex uint64
-	endHeightIndex := uint64(info.Response.LastBlockHeight)
+	endHeightIndex := lastBlockHeight
 	lookback := uint64(1)
 	var lookbackStepsCounter uint64 // For metrics only
 	queryHeight := endHeightIndex
@@ -474,7 +557,7 @@ func searchOffsetInHistory(ctx context.Context, client rpcclient.Client, cl atyp
 			queryHeight -= lookback
 		}
 
-		if queryHeight == 0 || queryHeight >= uint64(info.Response.LastBlockHeight) {
+		if queryHeight == 0 || queryHeight >= lastBlockHeight {
 			return 0, errors.New("unexpected query height [BUG]", "height", queryHeight) // This should never happen
 		}
 		earliestAtt, ok, err := queryEarliestAttestation(ctx, cl, chainVer, queryHeight)
@@ -545,7 +628,7 @@ func searchOffsetInHistory(ctx context.Context, client rpcclient.Client, cl atyp
 		}
 
 		if fromOffset >= earliestAtt.AttestOffset && fromOffset <= latestAtt.AttestOffset {
-			log.Debug(ctx, "Fetching offset from history", "chain", chainName, "from", fromOffset, "latest", info.Response.LastBlockHeight, "found", midHeightIndex, "lookback", lookbackStepsCounter, "search", binarySearchStepsCounter)
+			log.Debug(ctx, "Fetching offset from history", "chain", chainName, "from", fromOffset, "latest", lastBlockHeight, "found", midHeightIndex, "lookback", lookbackStepsCounter, "search", binarySearchStepsCounter)
 			fetchStepsMetrics(chainName, lookbackStepsCounter, binarySearchStepsCounter)
 
 			return midHeightIndex, nil
@@ -603,6 +686,23 @@ func queryEarliestAttestation(ctx context.Context, cl atypes.QueryClient, chainV
 	return att, true, nil
 }
 
+// queryOffsetHeight returns the consensus block height at which the approved attestation
+// for the provided chain version and offset was approved, or false if it isn't indexed.
+func queryOffsetHeight(ctx context.Context, cl atypes.QueryClient, chainVer xchain.ChainVersion, offset uint64) (uint64, bool, error) {
+	resp, err := cl.OffsetHeight(ctx, &atypes.OffsetHeightRequest{
+		ChainId:      chainVer.ID,
+		ConfLevel:    uint32(chainVer.ConfLevel),
+		AttestOffset: offset,
+	})
+	if errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+
+	return resp.GetHeight(), true, nil
+}
+
 // queryLatestAttestation returns the latest approved attestation for the provided chain version
 // at the provided consensus block height, or the latest block height if height is 0.
 func queryLatestAttestation(ctx context.Context, cl atypes.QueryClient, chainVer xchain.ChainVersion, height uint64) (xchain.Attestation, bool, error) {
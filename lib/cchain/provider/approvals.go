@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	atypes "github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/expbackoff"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/xchain"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+const (
+	// approvalsTimeout is the maximum duration to wait for an approval event before polling anyway.
+	// It guards against missed events.
+	approvalsTimeout = 10 * time.Second
+
+	// approvalsCapacity is the subscription buffer capacity (in blocks).
+	approvalsCapacity = 100
+)
+
+// approvals tracks the latest approved attestation offsets per chain version by subscribing to
+// attest module EventAttestationApproved events via cometBFT websocket/eventbus.
+//
+// It allows streams to wake up as soon as an attestation is approved instead of polling.
+// Streams fall back to polling if the subscription is not active.
+type approvals struct {
+	cl rpcclient.EventsClient
+
+	mu         sync.Mutex
+	refs       int                            // Number of active streams.
+	cancel     context.CancelFunc             // Cancels the active subscription, nil if refs==0.
+	gen        int                            // Subscription generation, used for unique subscriber names.
+	subscribed bool                           // True if the subscription is active.
+	latest     map[xchain.ChainVersion]uint64 // Latest approved attestation offset per chain version.
+	notify     chan struct{}                  // Closed and replaced on each event or subscription state change.
+}
+
+func newApprovals(cl rpcclient.EventsClient) *approvals {
+	return &approvals{
+		cl:     cl,
+		latest: make(map[xchain.ChainVersion]uint64),
+		notify: make(chan struct{}),
+	}
+}
+
+// acquire ensures the subscription is active for the lifetime of a stream.
+// The returned release function must be called when the stream stops.
+// The subscription is stopped when all streams have been released.
+func (a *approvals) acquire(ctx context.Context) (release func()) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.refs++
+	if a.refs == 1 {
+		subCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		a.cancel = cancel
+		a.gen++
+		go a.subscribeForever(subCtx, fmt.Sprintf("cprovider-%d", a.gen))
+	}
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			a.mu.Lock()
+			defer a.mu.Unlock()
+
+			a.refs--
+			if a.refs == 0 {
+				a.cancel()
+				a.cancel = nil
+			}
+		})
+	}
+}
+
+// wait blocks until an attestation with the offset (or higher) is approved for the chain version.
+// It returns true if such an attestation was approved.
+// It returns false immediately if not subscribed (so callers fall back to polling), or
+// if the subscription drops, the timeout is reached, or the context is canceled.
+func (a *approvals) wait(ctx context.Context, chainVer xchain.ChainVersion, offset uint64, timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		a.mu.Lock()
+		subscribed := a.subscribed
+		approved := a.latest[chainVer] >= offset
+		notify := a.notify
+		a.mu.Unlock()
+
+		if !subscribed {
+			return false
+		} else if approved {
+			return true
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-timer.C:
+			return false
+		case <-notify:
+		}
+	}
+}
+
+func (a *approvals) subscribeForever(ctx context.Context, subscriber string) {
+	backoff := expbackoff.New(ctx)
+	for ctx.Err() == nil {
+		err := a.subscribeOnce(ctx, subscriber)
+		if ctx.Err() != nil {
+			return
+		}
+
+		approvalsDropTotal.Inc()
+		log.Warn(ctx, "Attestation approvals subscription dropped, polling instead (will retry)", err)
+		backoff()
+	}
+}
+
+func (a *approvals) subscribeOnce(ctx context.Context, subscriber string) error {
+	if err := maybeStart(a.cl); err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf("%s='%s' AND %s.attest_offset EXISTS",
+		cmttypes.EventTypeKey, cmttypes.EventNewBlock, proto.MessageName(&atypes.EventAttestationApproved{}))
+
+	out, err := a.cl.Subscribe(ctx, subscriber, query, approvalsCapacity)
+	if err != nil {
+		return errors.Wrap(err, "subscribe")
+	}
+	defer func() {
+		_ = a.cl.Unsubscribe(context.WithoutCancel(ctx), subscriber, query)
+	}()
+
+	a.setSubscribed(true)
+	defer a.setSubscribed(false)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-out:
+			if !ok {
+				return errors.New("subscription closed")
+			}
+
+			if err := a.process(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// process updates the latest approved offsets from the new block result event.
+func (a *approvals) process(result ctypes.ResultEvent) error {
+	data, ok := result.Data.(cmttypes.EventDataNewBlock)
+	if !ok {
+		return errors.New("unexpected event data type", "type", fmt.Sprintf("%T", result.Data))
+	}
+
+	var approved []*atypes.EventAttestationApproved
+	for _, event := range data.ResultFinalizeBlock.Events {
+		if event.Type != proto.MessageName(&atypes.EventAttestationApproved{}) {
+			continue
+		}
+
+		e, err := parseApproved(event)
+		if err != nil {
+			return err
+		}
+		approved = append(approved, e)
+	}
+
+	if len(approved) == 0 {
+		return nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, e := range approved {
+		chainVer := xchain.ChainVersion{ID: e.GetChainId(), ConfLevel: xchain.ConfLevel(e.GetConfLevel())}
+		if e.GetAttestOffset() > a.latest[chainVer] {
+			a.latest[chainVer] = e.GetAttestOffset()
+		}
+	}
+	a.notifyUnsafe()
+
+	return nil
+}
+
+func (a *approvals) setSubscribed(subscribed bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.subscribed = subscribed
+	a.notifyUnsafe()
+
+	if subscribed {
+		approvalsSubscribed.Set(1)
+	} else {
+		approvalsSubscribed.Set(0)
+	}
+}
+
+// notifyUnsafe wakes up all waiters. It is unsafe since it assumes the lock is held.
+func (a *approvals) notifyUnsafe() {
+	close(a.notify)
+	a.notify = make(chan struct{})
+}
+
+// parseApproved returns the typed approval event from the ABCI event.
+func parseApproved(event abci.Event) (*atypes.EventAttestationApproved, error) {
+	// Drop non-JSON attributes added by baseapp (e.g. mode=EndBlock) before parsing.
+	var attrs []abci.EventAttribute
+	for _, attr := range event.Attributes {
+		if attr.Key == "mode" {
+			continue
+		}
+		attrs = append(attrs, attr)
+	}
+	event.Attributes = attrs
+
+	msg, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse approved event")
+	}
+
+	resp, ok := msg.(*atypes.EventAttestationApproved)
+	if !ok {
+		return nil, errors.New("unexpected approved event type", "type", fmt.Sprintf("%T", msg))
+	}
+
+	return resp, nil
+}
+
+// maybeStart starts the cometBFT client if required, this is required for websocket subscriptions via http clients.
+func maybeStart(cl rpcclient.EventsClient) error {
+	starter, ok := cl.(interface {
+		IsRunning() bool
+		Start() error
+	})
+	if !ok || starter.IsRunning() {
+		return nil
+	}
+
+	if err := starter.Start(); err != nil && !starter.IsRunning() {
+		return errors.Wrap(err, "start comet client")
+	}
+
+	return nil
+}
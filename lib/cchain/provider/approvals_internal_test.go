@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	atypes "github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/xchain"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApprovals(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chainVer := xchain.ChainVersion{ID: testChainID, ConfLevel: xchain.ConfFinalized}
+	cl := &testEventsClient{subs: make(chan chan ctypes.ResultEvent, 1)}
+	a := newApprovals(cl)
+
+	// Not subscribed, so return immediately.
+	require.False(t, a.wait(ctx, chainVer, 1, time.Hour))
+
+	release := a.acquire(ctx)
+	defer release()
+
+	out := <-cl.subs
+	require.Eventually(t, func() bool {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+
+		return a.subscribed
+	}, time.Second, time.Millisecond)
+
+	// Timeout if no approvals.
+	require.False(t, a.wait(ctx, chainVer, 1, time.Millisecond))
+
+	// Wake up on approvals.
+	done := make(chan bool)
+	go func() {
+		done <- a.wait(ctx, chainVer, 2, time.Hour)
+	}()
+	out <- newApprovedEvent(t, chainVer, 1) // Doesn't wake the waiter.
+	out <- newApprovedEvent(t, chainVer, 2)
+	require.True(t, <-done)
+
+	// Other chain versions don't wake up waiters.
+	otherVer := xchain.ChainVersion{ID: testChainID, ConfLevel: xchain.ConfLatest}
+	require.False(t, a.wait(ctx, otherVer, 1, time.Millisecond))
+
+	// Dropped subscriptions wake up waiters (falling back to polling) and are resubscribed.
+	go func() {
+		done <- a.wait(ctx, chainVer, 3, time.Hour)
+	}()
+	close(out)
+	require.False(t, <-done)
+
+	out = <-cl.subs
+	out <- newApprovedEvent(t, chainVer, 3)
+	require.Eventually(t, func() bool {
+		return a.wait(ctx, chainVer, 3, time.Millisecond)
+	}, time.Second, time.Millisecond)
+}
+
+func newApprovedEvent(t *testing.T, chainVer xchain.ChainVersion, offset uint64) ctypes.ResultEvent {
+	t.Helper()
+
+	event, err := sdk.TypedEventToEvent(&atypes.EventAttestationApproved{
+		ChainId:         chainVer.ID,
+		ConfLevel:       uint32(chainVer.ConfLevel),
+		AttestOffset:    offset,
+		AttestationRoot: []byte{1, 2, 3},
+	})
+	require.NoError(t, err)
+
+	// Mimic baseapp EndBlock events.
+	event.Attributes = append(event.Attributes, abci.EventAttribute{Key: "mode", Value: "EndBlock"})
+
+	return ctypes.ResultEvent{
+		Data: cmttypes.EventDataNewBlock{
+			ResultFinalizeBlock: abci.ResponseFinalizeBlock{
+				Events: []abci.Event{{Type: "other"}, abci.Event(event)},
+			},
+		},
+	}
+}
+
+// testEventsClient is a stub cometBFT events client that provides each subscription channel via subs.
+type testEventsClient struct {
+	rpcclient.EventsClient
+	subs chan chan ctypes.ResultEvent
+}
+
+func (c *testEventsClient) Subscribe(context.Context, string, string, ...int) (<-chan ctypes.ResultEvent, error) {
+	out := make(chan ctypes.ResultEvent)
+	c.subs <- out
+
+	return out, nil
+}
+
+func (*testEventsClient) Unsubscribe(context.Context, string, string) error {
+	return nil
+}
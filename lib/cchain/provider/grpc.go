@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	atypes "github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/halo/genutil/genserve"
+	ptypes "github.com/omni-network/omni/halo/portal/types"
+	rtypes "github.com/omni-network/omni/halo/registry/types"
+	vtypes "github.com/omni-network/omni/halo/valsync/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/expbackoff"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/xchain"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+
+	errorsmod "cosmossdk.io/errors"
+	utypes "cosmossdk.io/x/upgrade/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	dtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	sltypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultGRPCPoolSize = 4
+	defaultGRPCTimeout  = 10 * time.Second
+)
+
+// GRPCOption configures the gRPC provider.
+type GRPCOption func(*grpcOptions)
+
+type grpcOptions struct {
+	PoolSize    int
+	Timeout     time.Duration
+	DialOptions []grpc.DialOption
+}
+
+// WithGRPCPoolSize returns an option that sets the number of gRPC connections to halo.
+// Requests are distributed round-robin over the connections.
+func WithGRPCPoolSize(size int) GRPCOption {
+	return func(o *grpcOptions) {
+		o.PoolSize = size
+	}
+}
+
+// WithGRPCTimeout returns an option that sets the default deadline of each gRPC query.
+// It only applies if the provided context doesn't have an earlier deadline.
+func WithGRPCTimeout(timeout time.Duration) GRPCOption {
+	return func(o *grpcOptions) {
+		o.Timeout = timeout
+	}
+}
+
+// WithGRPCDialOptions returns an option that adds custom gRPC dial options, e.g., transport credentials.
+func WithGRPCDialOptions(opts ...grpc.DialOption) GRPCOption {
+	return func(o *grpcOptions) {
+		o.DialOptions = append(o.DialOptions, opts...)
+	}
+}
+
+// NewGRPCProvider returns a new provider that queries halo's gRPC query server at the target address.
+// Compared to the ABCI provider, queries are not serialised via cometBFT RPC.
+//
+// The cometBFT client is still required for CometClient and to fetch the consensus chain ID.
+func NewGRPCProvider(target string, cmtCl rpcclient.Client, network netconf.ID,
+	chainNamer func(xchain.ChainVersion) string, opts ...GRPCOption,
+) (Provider, error) {
+	o := grpcOptions{
+		PoolSize: defaultGRPCPoolSize,
+		Timeout:  defaultGRPCTimeout,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	conn, err := dialGRPCPool(target, o)
+	if err != nil {
+		return Provider{}, err
+	}
+
+	// Stream backoff for 1s, querying new attestations after 1 consensus block
+	backoffFunc := func(ctx context.Context) func() {
+		return expbackoff.New(ctx, expbackoff.WithPeriodicConfig(time.Second))
+	}
+
+	acl := atypes.NewQueryClient(conn)
+	vcl := vtypes.NewQueryClient(conn)
+	pcl := ptypes.NewQueryClient(conn)
+	rcl := rtypes.NewQueryClient(conn)
+	gcl := genserve.NewQueryClient(conn)
+	ucl := utypes.NewQueryClient(conn)
+	scl := stypes.NewQueryClient(conn)
+	dcl := dtypes.NewQueryClient(conn)
+	slcl := sltypes.NewQueryClient(conn)
+
+	// Note the query functions are transport agnostic, they are shared with the ABCI provider.
+	return Provider{
+		fetch:       newABCIFetchFunc(acl, newGRPCLatestHeightFunc(ucl), chainNamer),
+		latest:      newABCILatestFunc(acl),
+		window:      newABCIWindowFunc(acl),
+		valset:      newABCIValsetFunc(vcl),
+		val:         newABCIValFunc(scl),
+		vals:        newABCIValsFunc(scl),
+		unbondings:  newABCIUnbondingsFunc(scl),
+		rewards:     newABCIRewards(dcl),
+		slashing:    newABCISlashingFunc(slcl, acl),
+		portalBlock: newABCIPortalBlockFunc(pcl),
+		networkFunc: newABCINetworkFunc(rcl),
+		genesisFunc: newABCIGenesisFunc(gcl),
+		upgradeFunc: newABCIUpgradeFunc(ucl),
+		chainID:     newChainIDFunc(cmtCl),
+		header:      cmtCl.Header,
+		backoffFunc: backoffFunc,
+		approvals:   newApprovals(cmtCl),
+		chainNamer:  chainNamer,
+		network:     network,
+		cometCl:     cmtCl,
+		closeFunc:   conn.Close,
+	}, nil
+}
+
+// newGRPCLatestHeightFunc returns a function that returns the latest consensus block height.
+// It issues a cheap query and reads the block height header set by the halo gRPC server.
+func newGRPCLatestHeightFunc(ucl utypes.QueryClient) latestHeightFunc {
+	return func(ctx context.Context) (uint64, error) {
+		var header metadata.MD
+		_, err := ucl.CurrentPlan(ctx, &utypes.QueryCurrentPlanRequest{}, grpc.Header(&header))
+		if err != nil {
+			return 0, errors.Wrap(err, "grpc query current plan")
+		}
+
+		vals := header.Get(grpctypes.GRPCBlockHeightHeader)
+		if len(vals) != 1 {
+			return 0, errors.New("missing grpc block height header")
+		}
+
+		height, err := strconv.ParseUint(vals[0], 10, 64)
+		if err != nil {
+			return 0, errors.Wrap(err, "parse grpc block height header")
+		}
+
+		return height, nil
+	}
+}
+
+// grpcPool is a round-robin pool of gRPC client connections.
+// It implements the gogoproto grpc.ClientConn interface.
+type grpcPool struct {
+	conns []*grpc.ClientConn
+	next  *atomic.Uint64
+}
+
+func dialGRPCPool(target string, o grpcOptions) (grpcPool, error) {
+	if o.PoolSize <= 0 {
+		return grpcPool{}, errors.New("invalid grpc pool size", "size", o.PoolSize)
+	}
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(gogoCodec{})),
+		grpc.WithChainUnaryInterceptor(
+			heightInterceptor,
+			timeoutInterceptor(o.Timeout),
+			sdkErrInterceptor,
+		),
+	}
+	dialOpts = append(dialOpts, o.DialOptions...)
+
+	pool := grpcPool{next: new(atomic.Uint64)}
+	for i := 0; i < o.PoolSize; i++ {
+		conn, err := grpc.NewClient(target, dialOpts...)
+		if err != nil {
+			_ = pool.Close()
+			return grpcPool{}, errors.Wrap(err, "new grpc client", "target", target)
+		}
+		pool.conns = append(pool.conns, conn)
+	}
+
+	return pool, nil
+}
+
+func (p grpcPool) conn() *grpc.ClientConn {
+	return p.conns[p.next.Add(1)%uint64(len(p.conns))]
+}
+
+// Close closes all the connections in the pool, returning the first error.
+func (p grpcPool) Close() error {
+	var resp error
+	for _, conn := range p.conns {
+		if err := conn.Close(); err != nil && resp == nil {
+			resp = errors.Wrap(err, "close grpc connection")
+		}
+	}
+
+	return resp
+}
+
+func (p grpcPool) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	return p.conn().Invoke(ctx, method, args, reply, opts...)
+}
+
+func (p grpcPool) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return p.conn().NewStream(ctx, desc, method, opts...)
+}
+
+// heightInterceptor adds the query height from the context (see withCtxHeight) to the outgoing gRPC metadata.
+func heightInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+) error {
+	if height, ok := heightFromCtx(ctx); ok && height > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatUint(height, 10))
+	}
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// timeoutInterceptor returns an interceptor that adds a default deadline to each query.
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+	) error {
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// sdkErrInterceptor converts gRPC status errors to cosmos-sdk errors.
+// It mirrors how the cosmos-sdk converts gRPC errors to ABCI query errors,
+// so errors.Is(err, sdkerrors.ErrKeyNotFound) behaves identically for both transports.
+func sdkErrInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if err == nil {
+		return nil
+	}
+
+	return errors.Wrap(toSDKError(err), "grpc query", "method", method)
+}
+
+// toSDKError converts a gRPC status error to a cosmos-sdk error.
+func toSDKError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	// Registered cosmos errors are formatted as "codespace %s code %d: %s", see errorsmod.Error.GRPCStatus.
+	var (
+		codespace string
+		code      uint32
+	)
+	if n, _ := fmt.Sscanf(st.Message(), "codespace %s code %d", &codespace, &code); n == 2 {
+		return errorsmod.ABCIError(codespace, code, st.Message())
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, st.Message())
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, st.Message())
+	case codes.Unauthenticated:
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, st.Message())
+	default:
+		return err // Retain transport errors, e.g., codes.Unavailable or codes.DeadlineExceeded.
+	}
+}
+
+// gogoCodec is a gRPC codec that uses gogoproto marshalling, identical to the rpcAdaptor.
+type gogoCodec struct{}
+
+func (gogoCodec) Marshal(v any) ([]byte, error) {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil, errors.New("not a proto message")
+	}
+
+	return proto.Marshal(msg)
+}
+
+func (gogoCodec) Unmarshal(data []byte, v any) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return errors.New("not a proto message")
+	}
+
+	return proto.Unmarshal(data, msg)
+}
+
+func (gogoCodec) Name() string {
+	return "proto"
+}
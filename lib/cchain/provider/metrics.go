@@ -52,6 +52,20 @@ var (
 		Help:      "Total number of query errors per endpoint. Alert if growing.",
 	}, []string{"endpoint"})
 
+	approvalsSubscribed = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "lib",
+		Subsystem: "cprovider",
+		Name:      "approvals_subscribed",
+		Help:      "Constant gauge of 1 if subscribed to attestation approval events, 0 if polling.",
+	})
+
+	approvalsDropTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "lib",
+		Subsystem: "cprovider",
+		Name:      "approvals_drop_total",
+		Help:      "Total number of dropped attestation approval event subscriptions. Alert if growing.",
+	})
+
 	fetchLookbackSteps = promauto.NewHistogramVec(prometheus.HistogramOpts{
 		Namespace: "lib",
 		Subsystem: "cprovider",
@@ -24,6 +24,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -38,10 +39,13 @@ type portalBlockFunc func(ctx context.Context, attestOffset uint64, latest bool)
 type networkFunc func(ctx context.Context, networkID uint64, latest bool) (*rtypes.NetworkResponse, bool, error)
 type valFunc func(ctx context.Context, operator common.Address) (stypes.Validator, bool, error)
 type valsFunc func(ctx context.Context) ([]stypes.Validator, error)
+type unbondingsFunc func(ctx context.Context, delegator common.Address) ([]stypes.UnbondingDelegation, error)
 type rewardsFunc func(ctx context.Context, operator common.Address) (float64, bool, error)
+type slashingFunc func(ctx context.Context, consAddr sdk.ConsAddress) (cchain.SlashingStatus, bool, error)
 type valsetFunc func(ctx context.Context, valSetID uint64, latest bool) (valSetResponse, bool, error)
 type headerFunc func(ctx context.Context, height *int64) (*ctypes.ResultHeader, error)
 type chainIDFunc func(ctx context.Context) (uint64, error)
+type latestHeightFunc func(ctx context.Context) (uint64, error)
 type genesisFunc func(ctx context.Context) (execution []byte, consensus []byte, err error)
 type upgradeFunc func(ctx context.Context) (upgradetypes.Plan, bool, error)
 
@@ -61,7 +65,9 @@ type Provider struct {
 	valset      valsetFunc
 	val         valFunc
 	vals        valsFunc
+	unbondings  unbondingsFunc
 	rewards     rewardsFunc
+	slashing    slashingFunc
 	chainID     chainIDFunc
 	header      headerFunc
 	portalBlock portalBlockFunc
@@ -69,8 +75,10 @@ type Provider struct {
 	genesisFunc genesisFunc
 	upgradeFunc upgradeFunc
 	backoffFunc func(context.Context) func()
+	approvals   *approvals // Optional, nil disables waking streams on approval events.
 	chainNamer  func(xchain.ChainVersion) string
 	network     netconf.ID
+	closeFunc   func() error // Optional, releases transport resources.
 }
 
 // NewProviderForT creates a new provider for testing.
@@ -86,6 +94,15 @@ func NewProviderForT(_ *testing.T, fetch fetchFunc, latest latestFunc, window wi
 	}
 }
 
+// Close releases the provider's transport resources, e.g., pooled gRPC connections.
+func (p Provider) Close() error {
+	if p.closeFunc == nil {
+		return nil
+	}
+
+	return p.closeFunc()
+}
+
 func (p Provider) CometClient() rpcclient.Client {
 	return p.cometCl
 }
@@ -121,10 +138,18 @@ func (p Provider) Validators(ctx context.Context) ([]stypes.Validator, error) {
 	return p.vals(ctx)
 }
 
+func (p Provider) UnbondingDelegations(ctx context.Context, delegator common.Address) ([]stypes.UnbondingDelegation, error) {
+	return p.unbondings(ctx, delegator)
+}
+
 func (p Provider) Rewards(ctx context.Context, operator common.Address) (float64, bool, error) {
 	return p.rewards(ctx, operator)
 }
 
+func (p Provider) SlashingStatus(ctx context.Context, consAddr sdk.ConsAddress) (cchain.SlashingStatus, bool, error) {
+	return p.slashing(ctx, consAddr)
+}
+
 func (p Provider) GenesisFiles(ctx context.Context) (execution []byte, consensus []byte, err error) { //nolint:nonamedreturns // Disambiguate identical return types
 	return p.genesisFunc(ctx)
 }
@@ -169,8 +194,24 @@ func (p Provider) stream(
 	srcChain := p.chainNamer(chainVer)
 	ctx := log.WithCtx(in, "src_chain", srcChain, "worker", workerName)
 
+	if p.approvals != nil {
+		release := p.approvals.acquire(ctx)
+		defer release()
+	}
+
 	deps := stream.Deps[xchain.Attestation]{
 		FetchBatch: func(ctx context.Context, _ uint64, offset uint64) ([]xchain.Attestation, error) {
+			atts, err := p.fetch(ctx, chainVer, offset)
+			if err != nil || len(atts) > 0 || p.approvals == nil {
+				return atts, err
+			}
+
+			// Reached the head, wait for the next approval event instead of polling.
+			// This returns false immediately if not subscribed, falling back to polling.
+			if !p.approvals.wait(ctx, chainVer, offset, approvalsTimeout) {
+				return nil, nil
+			}
+
 			return p.fetch(ctx, chainVer, offset)
 		},
 		Backoff:       p.backoffFunc,
@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+
+	atypes "github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/xchain"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testChainID   = 100
+	testLatestOff = 10
+	testStateLen  = 3
+)
+
+// TestTransports ensures the ABCI and gRPC transports behave identically.
+func TestTransports(t *testing.T) {
+	t.Parallel()
+
+	srv := &testAttestServer{}
+	transports := map[string]atypes.QueryClient{
+		"abci": atypes.NewQueryClient(rpcAdaptor{abci: newTestABCI(t, srv)}),
+		"grpc": atypes.NewQueryClient(newTestGRPCPool(t, srv)),
+	}
+
+	for name, cl := range transports {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			chainVer := xchain.ChainVersion{ID: testChainID, ConfLevel: xchain.ConfFinalized}
+
+			// Latest height
+			att, ok, err := queryLatestAttestation(ctx, cl, chainVer, 0)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.EqualValues(t, testLatestOff, att.AttestOffset)
+
+			// Historical height is propagated to the server.
+			att, ok, err = queryLatestAttestation(ctx, cl, chainVer, 5)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.EqualValues(t, 5, att.AttestOffset)
+
+			// Not found is mapped to false.
+			_, ok, err = queryLatestAttestation(ctx, cl, xchain.ChainVersion{ID: 999}, 0)
+			require.NoError(t, err)
+			require.False(t, ok)
+
+			// Attestations from
+			atts, ok, err := attsFromAtHeight(ctx, cl, chainVer, 8, 0)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Len(t, atts, 3)
+			for i, att := range atts {
+				require.EqualValues(t, 8+i, att.AttestOffset)
+			}
+
+			// Other errors are propagated.
+			_, err = cl.WindowCompare(ctx, &atypes.WindowCompareRequest{})
+			require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+			// Offset height index
+			height, ok, err := queryOffsetHeight(ctx, cl, chainVer, 5)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.EqualValues(t, 5, height)
+
+			_, ok, err = queryOffsetHeight(ctx, cl, xchain.ChainVersion{ID: 999}, 5)
+			require.NoError(t, err)
+			require.False(t, ok)
+
+			// Fetching offsets no longer in latest state uses the index, not history search.
+			noSearch := func(context.Context) (uint64, error) {
+				return 0, errors.New("unexpected history search")
+			}
+			fetch := newABCIFetchFunc(cl, noSearch, func(xchain.ChainVersion) string { return "" })
+			atts, err = fetch(ctx, chainVer, 5)
+			require.NoError(t, err)
+			require.Len(t, atts, 1)
+			require.EqualValues(t, 5, atts[0].AttestOffset)
+		})
+	}
+}
+
+// TestGRPCProvider ensures the gRPC provider functions are wired correctly.
+func TestGRPCProvider(t *testing.T) {
+	t.Parallel()
+
+	srv := &testAttestServer{}
+	lis := serveTestGRPC(t, srv)
+
+	p, err := NewGRPCProvider(lis.Addr().String(), newTestABCI(t, srv), netconf.Simnet,
+		func(xchain.ChainVersion) string { return "" }, WithGRPCPoolSize(2))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	chainVer := xchain.ChainVersion{ID: testChainID, ConfLevel: xchain.ConfFinalized}
+
+	att, ok, err := p.LatestAttestation(ctx, chainVer)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.EqualValues(t, testLatestOff, att.AttestOffset)
+
+	atts, err := p.AttestationsFrom(ctx, chainVer, 9)
+	require.NoError(t, err)
+	require.Len(t, atts, 2)
+
+	cmp, err := p.WindowCompare(ctx, chainVer, 1)
+	require.NoError(t, err)
+	require.Equal(t, -1, cmp)
+
+	// Closing releases the pooled connections, so subsequent queries fail.
+	require.NoError(t, p.Close())
+	_, _, err = p.LatestAttestation(ctx, chainVer)
+	require.Error(t, err)
+}
+
+// testAttestServer is a stub attest query server with approved attestations [1, testLatestOff] for testChainID.
+// Only the latest testStateLen attestations are available in state at each height.
+type testAttestServer struct {
+	atypes.UnimplementedQueryServer
+}
+
+// queryHeight returns the query height from the incoming metadata or testLatestOff.
+// For simplicity, the latest offset at each height is equal to the height.
+func (*testAttestServer) queryHeight(ctx context.Context) uint64 {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if vals := md.Get(grpctypes.GRPCBlockHeightHeader); len(vals) == 1 {
+		h, _ := strconv.ParseUint(vals[0], 10, 64)
+		return h
+	}
+
+	return testLatestOff
+}
+
+func (s *testAttestServer) LatestAttestation(ctx context.Context, req *atypes.LatestAttestationRequest,
+) (*atypes.LatestAttestationResponse, error) {
+	if req.ChainId != testChainID {
+		return nil, status.Error(codes.NotFound, "no approved attestations for chain")
+	}
+
+	return &atypes.LatestAttestationResponse{Attestation: newTestAttestation(s.queryHeight(ctx))}, nil
+}
+
+// earliestOffset returns the earliest offset in state at the query height.
+func (s *testAttestServer) earliestOffset(ctx context.Context) uint64 {
+	return max(s.queryHeight(ctx), testStateLen) - testStateLen + 1
+}
+
+func (s *testAttestServer) EarliestAttestation(ctx context.Context, req *atypes.EarliestAttestationRequest,
+) (*atypes.EarliestAttestationResponse, error) {
+	if req.ChainId != testChainID {
+		return nil, status.Error(codes.NotFound, "no approved attestations for chain")
+	}
+
+	return &atypes.EarliestAttestationResponse{Attestation: newTestAttestation(s.earliestOffset(ctx))}, nil
+}
+
+// OffsetHeight returns the offset as height, since the latest offset at each height is equal to the height.
+func (*testAttestServer) OffsetHeight(_ context.Context, req *atypes.OffsetHeightRequest,
+) (*atypes.OffsetHeightResponse, error) {
+	if req.ChainId != testChainID || req.AttestOffset > testLatestOff {
+		return nil, status.Error(codes.NotFound, "attest offset not indexed")
+	}
+
+	return &atypes.OffsetHeightResponse{Height: req.AttestOffset}, nil
+}
+
+func (s *testAttestServer) AttestationsFrom(ctx context.Context, req *atypes.AttestationsFromRequest,
+) (*atypes.AttestationsFromResponse, error) {
+	if req.FromOffset < s.earliestOffset(ctx) {
+		return &atypes.AttestationsFromResponse{}, nil
+	}
+
+	var atts []*atypes.Attestation
+	for offset := req.FromOffset; offset <= s.queryHeight(ctx); offset++ {
+		atts = append(atts, newTestAttestation(offset))
+	}
+
+	return &atypes.AttestationsFromResponse{Attestations: atts}, nil
+}
+
+func (*testAttestServer) WindowCompare(_ context.Context, req *atypes.WindowCompareRequest,
+) (*atypes.WindowCompareResponse, error) {
+	if req.ChainId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "zero chain id")
+	}
+
+	return &atypes.WindowCompareResponse{Cmp: -1}, nil
+}
+
+func newTestAttestation(offset uint64) *atypes.Attestation {
+	return &atypes.Attestation{
+		AttestHeader: &atypes.AttestHeader{
+			ConsensusChainId: 1,
+			SourceChainId:    testChainID,
+			ConfLevel:        uint32(xchain.ConfFinalized),
+			AttestOffset:     offset,
+		},
+		BlockHeader: &atypes.BlockHeader{
+			ChainId:     testChainID,
+			BlockHeight: offset,
+			BlockHash:   common.Hash{1}.Bytes(),
+		},
+		MsgRoot:        common.Hash{2}.Bytes(),
+		ValidatorSetId: 1,
+		Signatures: []*atypes.SigTuple{{
+			ValidatorAddress: common.Address{3}.Bytes(),
+			Signature:        make([]byte, 65),
+		}},
+	}
+}
+
+func serveTestGRPC(t *testing.T, srv atypes.QueryServer) net.Listener {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	gsrv := grpc.NewServer(grpc.ForceServerCodec(gogoCodec{}))
+	atypes.RegisterQueryServer(gsrv, srv)
+
+	go func() { _ = gsrv.Serve(lis) }()
+	t.Cleanup(gsrv.Stop)
+
+	return lis
+}
+
+func newTestGRPCPool(t *testing.T, srv atypes.QueryServer) grpcPool {
+	t.Helper()
+
+	lis := serveTestGRPC(t, srv)
+
+	pool, err := dialGRPCPool(lis.Addr().String(), grpcOptions{PoolSize: 2})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, pool.Close()) })
+
+	return pool
+}
+
+// testABCI is a stub cometBFT client that routes ABCI queries to a gRPC query server.
+// It mimics the cosmos-sdk baseapp gRPC query handling.
+type testABCI struct {
+	rpcclient.Client
+	srv     any
+	methods map[string]grpc.MethodDesc
+}
+
+func newTestABCI(t *testing.T, srv atypes.QueryServer) testABCI {
+	t.Helper()
+
+	resp := testABCI{srv: srv, methods: make(map[string]grpc.MethodDesc)}
+	atypes.RegisterQueryServer(resp, srv)
+
+	return resp
+}
+
+func (c testABCI) RegisterService(sd *grpc.ServiceDesc, _ any) {
+	for _, m := range sd.Methods {
+		c.methods["/"+sd.ServiceName+"/"+m.MethodName] = m
+	}
+}
+
+func (c testABCI) ABCIQueryWithOptions(ctx context.Context, path string, data cmtbytes.HexBytes, opts rpcclient.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+	method, ok := c.methods[path]
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, path)
+	}
+
+	if opts.Height > 0 {
+		md := metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(opts.Height, 10))
+		ctx = metadata.NewIncomingContext(ctx, md)
+	}
+
+	dec := func(req any) error {
+		return proto.Unmarshal(data, req.(proto.Message))
+	}
+
+	resp, err := method.Handler(c.srv, ctx, dec, nil)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			err = errorsmod.Wrap(sdkerrors.ErrKeyNotFound, err.Error())
+		} else {
+			err = errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		codespace, code, log := errorsmod.ABCIInfo(err, false)
+
+		return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Codespace: codespace, Code: code, Log: log}}, nil
+	}
+
+	bz, err := proto.Marshal(resp.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz}}, nil
+}
@@ -0,0 +1,603 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/omni-network/omni/halo/attest/keeper"
+	atypes "github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/xchain"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/light"
+	lprovider "github.com/cometbft/cometbft/light/provider"
+	lhttp "github.com/cometbft/cometbft/light/provider/http"
+	lrpc "github.com/cometbft/cometbft/light/rpc"
+	ldb "github.com/cometbft/cometbft/light/store/db"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/orm/types/ormerrors"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+)
+
+const (
+	defaultTrustPeriod = 7 * 24 * time.Hour
+	// maxTrustPeriod must be less than the consensus chain unbonding period (21 days),
+	// after which validators of trusted headers can no longer be slashed.
+	maxTrustPeriod = 14 * 24 * time.Hour
+)
+
+// VerifyConfig configures light client verification of provider queries.
+//
+// Only attestation queries are verified: the contents of returned attestations (approval, validator set ID
+// and signatures) and the completeness of AttestationsFrom responses. LatestAttestation and EarliestAttestation
+// responses (including not found errors) aren't proven to be the latest or earliest, and other queries
+// (e.g. validator sets and portal registry) are not verified at all.
+type VerifyConfig struct {
+	// TrustHeight and TrustHash seed the light client with a trusted consensus block.
+	// They must be obtained out-of-band from a trusted source.
+	TrustHeight int64
+	TrustHash   []byte
+	// TrustPeriod is the light client trusting period, it defaults to one week.
+	TrustPeriod time.Duration
+	// Witnesses are cometBFT clients used to cross-check headers from the primary.
+	// At least one witness independent of the primary is required.
+	Witnesses []rpcclient.Client
+}
+
+// Verify returns an error if the config is invalid.
+func (c VerifyConfig) Verify() error {
+	if c.TrustHeight <= 0 {
+		return errors.New("trusted height required")
+	} else if len(c.TrustHash) != tmhash.Size {
+		return errors.New("invalid trusted hash length", "length", len(c.TrustHash))
+	} else if c.TrustPeriod < 0 || c.TrustPeriod > maxTrustPeriod {
+		return errors.New("invalid trust period", "period", c.TrustPeriod, "max", maxTrustPeriod)
+	} else if len(c.Witnesses) == 0 {
+		return errors.New("witnesses required")
+	}
+
+	return nil
+}
+
+// NewVerifiedABCIProvider returns a new ABCI provider that verifies attestation query responses
+// against the attest module state proven by cometBFT light client headers.
+// This allows using untrusted (third-party) halo RPC endpoints.
+//
+// Note that only attestation queries are verified, see VerifyConfig. An untrusted endpoint can still
+// return stale latest attestations, which only affects liveness, not safety.
+func NewVerifiedABCIProvider(ctx context.Context, cmtCl rpcclient.Client, network netconf.ID,
+	chainNamer func(xchain.ChainVersion) string, cfg VerifyConfig,
+) (Provider, error) {
+	lc, err := newLightClient(ctx, cmtCl, network, cfg)
+	if err != nil {
+		return Provider{}, err
+	}
+
+	v, err := newVerifier(cmtCl, lc)
+	if err != nil {
+		return Provider{}, err
+	}
+
+	acl := atypes.NewQueryClient(verifiedConn{ClientConn: rpcAdaptor{abci: cmtCl}, v: v})
+
+	p := NewABCIProvider(cmtCl, network, chainNamer)
+	p.fetch = newABCIFetchFunc(acl, newABCILatestHeightFunc(cmtCl), chainNamer)
+	p.latest = newABCILatestFunc(acl)
+
+	return p, nil
+}
+
+// newLightClient returns a cometBFT light client seeded from the trusted height in the config.
+func newLightClient(ctx context.Context, cmtCl rpcclient.Client, network netconf.ID, cfg VerifyConfig) (*light.Client, error) {
+	if err := cfg.Verify(); err != nil {
+		return nil, errors.Wrap(err, "verify config")
+	}
+
+	if cfg.TrustPeriod == 0 {
+		cfg.TrustPeriod = defaultTrustPeriod
+	}
+
+	chainID := network.Static().OmniConsensusChainIDStr()
+	primary := newLightProvider(chainID, cmtCl)
+
+	var witnesses []lprovider.Provider
+	for _, w := range cfg.Witnesses {
+		if w == cmtCl {
+			return nil, errors.New("primary can't be its own witness")
+		}
+		witnesses = append(witnesses, newLightProvider(chainID, w))
+	}
+
+	opts := light.TrustOptions{
+		Period: cfg.TrustPeriod,
+		Height: cfg.TrustHeight,
+		Hash:   cfg.TrustHash,
+	}
+
+	lc, err := light.NewClient(ctx, chainID, opts, primary, witnesses, ldb.New(dbm.NewMemDB(), chainID))
+	if err != nil {
+		return nil, errors.Wrap(err, "new light client")
+	}
+
+	return lc, nil
+}
+
+// newLightProvider returns a light client provider using the cometBFT client.
+func newLightProvider(chainID string, cl rpcclient.Client) lprovider.Provider {
+	return lhttp.NewWithClient(chainID, remoteClient{Client: cl})
+}
+
+// remoteClient adapts a cometBFT client to the rpcclient.RemoteClient interface required by light providers.
+type remoteClient struct {
+	rpcclient.Client
+}
+
+func (remoteClient) Remote() string {
+	return "cprovider"
+}
+
+// verifier verifies attest module state against light client headers.
+type verifier struct {
+	abci     rpcclient.ABCIClient
+	lc       lrpc.LightClient
+	prt      *merkle.ProofRuntime
+	attStore keeper.AttestationStore
+}
+
+func newVerifier(abci rpcclient.ABCIClient, lc lrpc.LightClient) (*verifier, error) {
+	v := &verifier{
+		abci: abci,
+		lc:   lc,
+		prt:  rootmulti.DefaultProofRuntime(),
+	}
+
+	attStore, err := keeper.NewORMStore(proofStoreService{v: v})
+	if err != nil {
+		return nil, err
+	}
+	v.attStore = attStore
+
+	return v, nil
+}
+
+// trustedHeight returns the latest consensus height with state verifiable by the light client.
+// Note that the AppHash of height H is in header H+1.
+func (v *verifier) trustedHeight(ctx context.Context) (uint64, error) {
+	lb, err := v.lc.Update(ctx, time.Now())
+	if err != nil {
+		return 0, errors.Wrap(err, "update light client")
+	} else if lb == nil { // Already up to date
+		lb, err = v.lc.TrustedLightBlock(0)
+		if err != nil {
+			return 0, errors.Wrap(err, "latest trusted light block")
+		}
+	}
+
+	if lb.Height <= 1 {
+		return 0, errors.New("no verifiable state yet")
+	}
+
+	return uint64(lb.Height - 1), nil
+}
+
+// get returns the value of the attest module store key at the provided height.
+// It returns nil if the key doesn't exist. Both existence and absence are verified.
+func (v *verifier) get(ctx context.Context, height uint64, key []byte) ([]byte, error) {
+	value, _, err := v.query(ctx, height, key)
+	return value, err
+}
+
+// query returns the value of the attest module store key at the provided height.
+// If the key doesn't exist, it returns a nil value and the next key in the store (nil if none).
+// Both existence and absence (including the next key) are verified.
+// Note that keys with empty values (e.g. non-unique ORM index entries) can't be proven.
+func (v *verifier) query(ctx context.Context, height uint64, key []byte) ([]byte, []byte, error) {
+	res, err := v.abci.ABCIQueryWithOptions(ctx, "/store/"+atypes.ModuleName+"/key", key, rpcclient.ABCIQueryOptions{
+		Height: int64(height),
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "abci store query")
+	}
+
+	resp := res.Response
+	if !resp.IsOK() {
+		return nil, nil, errors.New("abci store query failed", "code", resp.Code, "log", resp.Log)
+	} else if resp.Height != int64(height) {
+		return nil, nil, errors.New("unexpected store query height", "actual", resp.Height, "expected", height)
+	} else if !bytes.Equal(resp.Key, key) {
+		return nil, nil, errors.New("unexpected store query key")
+	} else if resp.ProofOps == nil || len(resp.ProofOps.Ops) == 0 {
+		return nil, nil, errors.New("no store query proof")
+	}
+
+	// The first proof op proves the key in the attest module store.
+	op, err := storetypes.CommitmentOpDecoder(resp.ProofOps.Ops[0])
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "decode store query proof")
+	}
+	commitOp, ok := op.(storetypes.CommitmentOp)
+	if !ok {
+		return nil, nil, errors.New("unexpected store query proof type")
+	}
+	proof := commitOp.Proof
+
+	lb, err := v.lc.VerifyLightBlockAtHeight(ctx, int64(height)+1, time.Now())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "verify light block", "height", height+1)
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(atypes.ModuleName), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingURL).
+		String()
+
+	if resp.Value == nil {
+		if err := v.prt.VerifyAbsence(resp.ProofOps, lb.AppHash, keyPath); err != nil {
+			return nil, nil, errors.Wrap(err, "verify absence proof")
+		} else if proof.GetNonexist() == nil {
+			return nil, nil, errors.New("unexpected absence proof type")
+		}
+
+		// The verified absence proof contains the neighboring keys.
+		return nil, proof.GetNonexist().GetRight().GetKey(), nil
+	}
+
+	if err := v.prt.VerifyValue(resp.ProofOps, lb.AppHash, keyPath, resp.Value); err != nil {
+		return nil, nil, errors.Wrap(err, "verify value proof")
+	}
+
+	return resp.Value, nil, nil
+}
+
+// first returns the first key (and its value) in the attest module store that is equal to or greater than the start key
+// at the provided height, or false if none.
+func (v *verifier) first(ctx context.Context, height uint64, start []byte) ([]byte, []byte, bool, error) {
+	value, next, err := v.query(ctx, height, start)
+	if err != nil {
+		return nil, nil, false, err
+	} else if value != nil {
+		return start, value, true, nil
+	} else if next == nil {
+		return nil, nil, false, nil
+	}
+
+	value, err = v.get(ctx, height, next)
+	if err != nil {
+		return nil, nil, false, err
+	} else if value == nil {
+		return nil, nil, false, errors.New("next key not found")
+	}
+
+	return next, value, true, nil
+}
+
+// verifyAttestation verifies that the attestation is approved in the attest module state at the provided height.
+// It returns the verified stored attestation.
+func (v *verifier) verifyAttestation(ctx context.Context, height uint64, att *atypes.Attestation) (*keeper.Attestation, error) {
+	ctx = withCtxHeight(ctx, height)
+
+	root, err := att.AttestationRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "attestation root")
+	}
+
+	stored, err := v.attStore.AttestationTable().GetByAttestationRoot(ctx, root[:])
+	if ormerrors.IsNotFound(err) {
+		return nil, errors.New("attestation not found in verified state", "height", height)
+	} else if err != nil {
+		return nil, errors.Wrap(err, "get verified attestation")
+	}
+
+	if stored.GetStatus() != uint32(keeper.Status_Approved) {
+		return nil, errors.New("verified attestation not approved")
+	} else if stored.GetValidatorSetId() != att.GetValidatorSetId() {
+		return nil, errors.New("verified attestation validator set mismatch",
+			"actual", att.GetValidatorSetId(), "verified", stored.GetValidatorSetId())
+	}
+
+	for _, sig := range att.GetSignatures() {
+		storedSig, err := v.attStore.SignatureTable().GetByAttIdValidatorAddress(ctx, stored.GetId(), sig.GetValidatorAddress())
+		if ormerrors.IsNotFound(err) {
+			return nil, errors.New("signature not found in verified state",
+				"validator", common.BytesToAddress(sig.GetValidatorAddress()))
+		} else if err != nil {
+			return nil, errors.Wrap(err, "get verified signature")
+		} else if !bytes.Equal(storedSig.GetSignature(), sig.GetSignature()) {
+			return nil, errors.New("verified signature mismatch",
+				"validator", common.BytesToAddress(sig.GetValidatorAddress()))
+		}
+	}
+
+	return stored, nil
+}
+
+// approvedAt returns the verified approved attestation at the offset (possibly overridden by a finalized attestation)
+// or false if none exists at the provided height.
+//
+// Absence is proven via the signature chain/offset index, since all attestations at an offset have signatures.
+// Note the attestation chain/offset index can't be used, since its entries have empty values which can't be proven.
+func (v *verifier) approvedAt(ctx context.Context, height uint64, chainID uint64, confLevel uint32, offset uint64) (*keeper.Attestation, bool, error) {
+	ctx, iterErr := withIterErr(withCtxHeight(ctx, height))
+
+	idx := keeper.SignatureChainIdConfLevelAttestOffsetValidatorAddressIndexKey{}.
+		WithChainIdConfLevelAttestOffset(chainID, confLevel, offset)
+	iter, err := v.attStore.SignatureTable().List(ctx, idx)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "list verified signatures")
+	}
+	defer iter.Close()
+
+	checked := make(map[uint64]bool)
+	for iter.Next() {
+		sig, err := iter.Value()
+		if err != nil {
+			return nil, false, errors.Wrap(err, "verified signature value")
+		} else if checked[sig.GetAttId()] {
+			continue
+		}
+		checked[sig.GetAttId()] = true
+
+		att, err := v.attStore.AttestationTable().Get(ctx, sig.GetAttId())
+		if err != nil {
+			return nil, false, errors.Wrap(err, "get verified attestation")
+		} else if att.GetStatus() == uint32(keeper.Status_Approved) {
+			return att, true, nil
+		}
+	}
+
+	if *iterErr != nil {
+		return nil, false, errors.Wrap(*iterErr, "iterate verified signatures")
+	}
+
+	return nil, false, nil
+}
+
+// verifyAttestationsFrom verifies that the response contains the contiguous approved attestations from the requested
+// offset. Unless the response is full, it also verifies that no approved attestation exists at the next offset,
+// so attestations can't be withheld.
+func (v *verifier) verifyAttestationsFrom(ctx context.Context, height uint64, req *atypes.AttestationsFromRequest,
+	resp *atypes.AttestationsFromResponse,
+) error {
+	atts := resp.GetAttestations()
+	for i, att := range atts {
+		offset := req.GetFromOffset() + uint64(i)
+
+		stored, err := v.verifyAttestation(ctx, height, att)
+		if err != nil {
+			return errors.Wrap(err, "verify attestation", "offset", offset)
+		}
+
+		if stored.GetChainId() == req.GetChainId() && stored.GetConfLevel() == req.GetConfLevel() &&
+			stored.GetAttestOffset() == offset {
+			continue // Approved attestation at the expected offset.
+		}
+
+		// Fuzzy attestations overridden by finalized attestations are returned as the finalized attestation.
+		approved, ok, err := v.approvedAt(ctx, height, req.GetChainId(), req.GetConfLevel(), offset)
+		if err != nil {
+			return errors.Wrap(err, "verify approved attestation", "offset", offset)
+		} else if !ok {
+			return errors.New("no approved attestation at offset in verified state", "offset", offset)
+		} else if approved.GetFinalizedAttId() != stored.GetId() {
+			return errors.New("attestation not contiguous in verified state", "offset", offset)
+		}
+	}
+
+	if len(atts) >= keeper.ApprovedFromLimit {
+		return nil // Full response, more attestations may exist.
+	}
+
+	next := req.GetFromOffset() + uint64(len(atts))
+	if _, ok, err := v.approvedAt(ctx, height, req.GetChainId(), req.GetConfLevel(), next); err != nil {
+		return errors.Wrap(err, "verify next attestation absence", "offset", next)
+	} else if ok {
+		return errors.New("approved attestation withheld", "offset", next)
+	}
+
+	return nil
+}
+
+// verifyResponse verifies the attestations in the attest query response.
+// AttestationsFrom responses are also verified to be complete, see verifyAttestationsFrom.
+func (v *verifier) verifyResponse(ctx context.Context, height uint64, req, resp any) error {
+	var atts []*atypes.Attestation
+	switch r := resp.(type) {
+	case *atypes.AttestationsFromResponse:
+		fromReq, ok := req.(*atypes.AttestationsFromRequest)
+		if !ok {
+			return errors.New("unexpected attestations from request [BUG]")
+		}
+
+		return v.verifyAttestationsFrom(ctx, height, fromReq, r)
+	case *atypes.LatestAttestationResponse:
+		atts = append(atts, r.GetAttestation())
+	case *atypes.EarliestAttestationResponse:
+		atts = append(atts, r.GetAttestation())
+	default:
+		return nil // Other responses are not verified.
+	}
+
+	for _, att := range atts {
+		if _, err := v.verifyAttestation(ctx, height, att); err != nil {
+			return errors.Wrap(err, "verify attestation", "offset", att.GetAttestHeader().GetAttestOffset())
+		}
+	}
+
+	return nil
+}
+
+// verifiedConn wraps an attest query client connection verifying all returned attestations.
+// Queries are pinned to a height verifiable by the light client if not explicitly provided.
+type verifiedConn struct {
+	gogogrpc.ClientConn
+	v *verifier
+}
+
+func (c verifiedConn) Invoke(ctx context.Context, method string, req, resp any, opts ...grpc.CallOption) error {
+	height, ok := heightFromCtx(ctx)
+	if !ok || height == 0 {
+		var err error
+		height, err = c.v.trustedHeight(ctx)
+		if err != nil {
+			return err
+		}
+		ctx = withCtxHeight(ctx, height)
+	}
+
+	if err := c.ClientConn.Invoke(ctx, method, req, resp, opts...); err != nil {
+		return err
+	}
+
+	return c.v.verifyResponse(ctx, height, req, resp)
+}
+
+// proofStoreService is a read-only KV store service of the attest module state verified by the light client.
+// The store height is obtained from the context, see withCtxHeight.
+type proofStoreService struct {
+	v *verifier
+}
+
+func (s proofStoreService) OpenKVStore(ctx context.Context) store.KVStore {
+	height, _ := heightFromCtx(ctx)
+	return proofKVStore{ctx: ctx, v: s.v, height: height}
+}
+
+// proofKVStore is a read-only KV store that verifies each read. It only supports forward iteration.
+type proofKVStore struct {
+	ctx    context.Context //nolint:containedctx // KVStore interface doesn't support contexts.
+	v      *verifier
+	height uint64
+}
+
+func (s proofKVStore) Get(key []byte) ([]byte, error) {
+	if s.height == 0 {
+		return nil, errors.New("zero verified store height [BUG]")
+	}
+
+	return s.v.get(s.ctx, s.height, key)
+}
+
+func (s proofKVStore) Has(key []byte) (bool, error) {
+	val, err := s.Get(key)
+	return val != nil, err
+}
+
+func (proofKVStore) Set([]byte, []byte) error {
+	return errors.New("verified store is read-only")
+}
+
+func (proofKVStore) Delete([]byte) error {
+	return errors.New("verified store is read-only")
+}
+
+// Iterator returns a verified forward iterator over the domain, see proofIterator.
+func (s proofKVStore) Iterator(start, end []byte) (store.Iterator, error) {
+	if s.height == 0 {
+		return nil, errors.New("zero verified store height [BUG]")
+	} else if start == nil {
+		return nil, errors.New("verified store iteration requires a start key")
+	}
+
+	it := &proofIterator{
+		ctx:    s.ctx,
+		v:      s.v,
+		height: s.height,
+		start:  start,
+		end:    end,
+	}
+	if err := it.seek(start); err != nil {
+		return nil, err
+	}
+
+	return it, nil
+}
+
+func (proofKVStore) ReverseIterator([]byte, []byte) (store.Iterator, error) {
+	return nil, errors.New("verified store doesn't support reverse iteration")
+}
+
+// proofIterator is a verified forward store iterator. Each element is found via the verified absence proof
+// of the key following the previous element, which contains the next key in the store.
+// The ORM ignores iterator errors, so errors are also reported to the context's iteration error, see withIterErr.
+type proofIterator struct {
+	ctx        context.Context //nolint:containedctx // Iterator interface doesn't support contexts.
+	v          *verifier
+	height     uint64
+	start, end []byte
+	key, value []byte
+	valid      bool
+	err        error
+}
+
+// seek moves the iterator to the first key equal to or greater than the provided key.
+func (it *proofIterator) seek(key []byte) error {
+	next, value, ok, err := it.v.first(it.ctx, it.height, key)
+	if err != nil {
+		it.valid, it.err = false, err
+		if iterErr, ok := it.ctx.Value(iterErrKey{}).(*error); ok {
+			*iterErr = err
+		}
+
+		return err
+	}
+
+	it.key, it.value = next, value
+	it.valid = ok && (it.end == nil || bytes.Compare(next, it.end) < 0)
+
+	return nil
+}
+
+func (it *proofIterator) Domain() ([]byte, []byte) {
+	return it.start, it.end
+}
+
+func (it *proofIterator) Valid() bool {
+	return it.valid
+}
+
+func (it *proofIterator) Next() {
+	if !it.valid {
+		return
+	}
+
+	// The smallest key greater than the current key.
+	_ = it.seek(append(bytes.Clone(it.key), 0))
+}
+
+func (it *proofIterator) Key() []byte {
+	return it.key
+}
+
+func (it *proofIterator) Value() []byte {
+	return it.value
+}
+
+func (it *proofIterator) Error() error {
+	return it.err
+}
+
+func (*proofIterator) Close() error {
+	return nil
+}
+
+type iterErrKey struct{}
+
+// withIterErr returns a copy of the context that records verified store iteration errors
+// and a pointer to the recorded error, which must be checked after iterating.
+func withIterErr(ctx context.Context) (context.Context, *error) {
+	iterErr := new(error)
+	return context.WithValue(ctx, iterErrKey{}, iterErr), iterErr
+}
@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/halo/attest/keeper"
+	atypes "github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/xchain"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestVerifier(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	att := newTestAttestation(testLatestOff)
+	cms, height := newTestAttestStore(t, att)
+
+	lc := &testLightClient{appHashes: map[int64][]byte{int64(height) + 1: cms.LastCommitID().Hash}}
+	v, err := newVerifier(testStoreABCI{cms: cms}, lc)
+	require.NoError(t, err)
+
+	trusted, err := v.trustedHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, height, trusted)
+
+	// Valid attestation
+	req := &atypes.LatestAttestationRequest{}
+	resp := &atypes.LatestAttestationResponse{Attestation: att}
+	require.NoError(t, v.verifyResponse(ctx, height, req, resp))
+
+	// Unverified responses are ignored.
+	require.NoError(t, v.verifyResponse(ctx, height, &atypes.WindowCompareRequest{}, &atypes.WindowCompareResponse{}))
+
+	// Unknown attestation (absence proof verified)
+	unknown := newTestAttestation(testLatestOff + 1)
+	fromReq := &atypes.AttestationsFromRequest{ChainId: testChainID, ConfLevel: uint32(xchain.ConfFinalized), FromOffset: testLatestOff}
+	err = v.verifyResponse(ctx, height, fromReq, &atypes.AttestationsFromResponse{Attestations: []*atypes.Attestation{att, unknown}})
+	require.ErrorContains(t, err, "attestation not found in verified state")
+
+	// Tampered validator set
+	tampered := newTestAttestation(testLatestOff)
+	tampered.ValidatorSetId++
+	err = v.verifyResponse(ctx, height, req, &atypes.LatestAttestationResponse{Attestation: tampered})
+	require.ErrorContains(t, err, "validator set mismatch")
+
+	// Tampered signature
+	tampered = newTestAttestation(testLatestOff)
+	tampered.Signatures[0].Signature[0] = 1
+	err = v.verifyResponse(ctx, height, req, &atypes.LatestAttestationResponse{Attestation: tampered})
+	require.ErrorContains(t, err, "verified signature mismatch")
+
+	// Invalid app hash
+	lc.appHashes[int64(height)+1] = make([]byte, 32)
+	err = v.verifyResponse(ctx, height, req, resp)
+	require.ErrorContains(t, err, "verify value proof")
+}
+
+func TestVerifyAttestationsFrom(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	first, second := newTestAttestation(testLatestOff), newTestAttestation(testLatestOff+1)
+	pending := newTestAttestation(testLatestOff + 2)
+	cms, height := newTestAttestStoreWithPending(t, []*atypes.Attestation{pending}, first, second)
+
+	lc := &testLightClient{appHashes: map[int64][]byte{int64(height) + 1: cms.LastCommitID().Hash}}
+	v, err := newVerifier(testStoreABCI{cms: cms}, lc)
+	require.NoError(t, err)
+
+	verify := func(from uint64, atts ...*atypes.Attestation) error {
+		req := &atypes.AttestationsFromRequest{ChainId: testChainID, ConfLevel: uint32(xchain.ConfFinalized), FromOffset: from}
+		return v.verifyResponse(ctx, height, req, &atypes.AttestationsFromResponse{Attestations: atts})
+	}
+
+	// Complete responses
+	require.NoError(t, verify(testLatestOff, first, second))
+	require.NoError(t, verify(testLatestOff+1, second))
+	require.NoError(t, verify(testLatestOff+2)) // Pending attestation at next offset.
+	require.NoError(t, verify(testLatestOff+3)) // Absence of next offset proven.
+	require.NoError(t, verify(0))               // Before the earliest attestation.
+
+	// Withheld attestations
+	require.ErrorContains(t, verify(testLatestOff, first), "approved attestation withheld")
+	require.ErrorContains(t, verify(testLatestOff), "approved attestation withheld")
+
+	// Non-contiguous attestations
+	require.ErrorContains(t, verify(testLatestOff, second), "attestation not contiguous")
+	require.ErrorContains(t, verify(testLatestOff, second, first), "attestation not contiguous")
+	require.ErrorContains(t, verify(testLatestOff+1, second, first), "no approved attestation at offset")
+	require.ErrorContains(t, verify(testLatestOff+2, pending), "verified attestation not approved")
+
+	// Invalid app hash
+	lc.appHashes[int64(height)+1] = make([]byte, 32)
+	require.ErrorContains(t, verify(testLatestOff+3), "verify absence proof")
+}
+
+func TestVerifyConfig(t *testing.T) {
+	t.Parallel()
+
+	witness := new(stubCometClient)
+	valid := VerifyConfig{
+		TrustHeight: 10,
+		TrustHash:   make([]byte, 32),
+		Witnesses:   []rpcclient.Client{witness},
+	}
+	require.NoError(t, valid.Verify())
+
+	tests := []struct {
+		name   string
+		mutate func(*VerifyConfig)
+		err    string
+	}{
+		{"no trusted height", func(c *VerifyConfig) { c.TrustHeight = 0 }, "trusted height required"},
+		{"invalid trusted hash", func(c *VerifyConfig) { c.TrustHash = []byte{1} }, "invalid trusted hash length"},
+		{"unbounded trust period", func(c *VerifyConfig) { c.TrustPeriod = maxTrustPeriod + time.Hour }, "invalid trust period"},
+		{"no witnesses", func(c *VerifyConfig) { c.Witnesses = nil }, "witnesses required"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := valid
+			test.mutate(&cfg)
+			require.ErrorContains(t, cfg.Verify(), test.err)
+		})
+	}
+
+	// The primary can't be its own witness.
+	primary := new(stubCometClient)
+	cfg := valid
+	cfg.Witnesses = []rpcclient.Client{primary}
+	_, err := newLightClient(context.Background(), primary, netconf.Simnet, cfg)
+	require.ErrorContains(t, err, "primary can't be its own witness")
+}
+
+func TestVerifiedConn(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	att := newTestAttestation(testLatestOff)
+	cms, height := newTestAttestStore(t, att)
+
+	lc := &testLightClient{appHashes: map[int64][]byte{int64(height) + 1: cms.LastCommitID().Hash}}
+	v, err := newVerifier(testStoreABCI{cms: cms}, lc)
+	require.NoError(t, err)
+
+	next := &testConn{att: att}
+	cl := atypes.NewQueryClient(verifiedConn{ClientConn: next, v: v})
+
+	// Queries are pinned to the trusted height.
+	_, err = cl.LatestAttestation(ctx, &atypes.LatestAttestationRequest{})
+	require.NoError(t, err)
+	require.Equal(t, height, next.height)
+
+	// Unverifiable attestations are rejected.
+	next.att = newTestAttestation(testLatestOff + 1)
+	_, err = cl.LatestAttestation(ctx, &atypes.LatestAttestationRequest{})
+	require.ErrorContains(t, err, "attestation not found in verified state")
+}
+
+// newTestAttestStore returns a committed multistore containing the approved attestations and the committed height.
+func newTestAttestStore(t *testing.T, atts ...*atypes.Attestation) (*rootmulti.Store, uint64) {
+	t.Helper()
+	return newTestAttestStoreWithPending(t, nil, atts...)
+}
+
+// newTestAttestStoreWithPending returns a committed multistore containing the pending and approved attestations
+// and the committed height.
+func newTestAttestStoreWithPending(t *testing.T, pending []*atypes.Attestation, approved ...*atypes.Attestation) (*rootmulti.Store, uint64) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(atypes.ModuleName)
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	cms.Commit() // Height 1 is empty
+
+	attStore, err := keeper.NewORMStore(runtime.NewKVStoreService(key))
+	require.NoError(t, err)
+
+	ctx := sdk.NewContext(cms, cmtproto.Header{}, false, log.NewNopLogger())
+
+	insert := func(att *atypes.Attestation, status keeper.Status) {
+		root, err := att.AttestationRoot()
+		require.NoError(t, err)
+
+		attID, err := attStore.AttestationTable().InsertReturningId(ctx, &keeper.Attestation{
+			ChainId:         att.GetAttestHeader().GetSourceChainId(),
+			ConfLevel:       att.GetAttestHeader().GetConfLevel(),
+			AttestOffset:    att.GetAttestHeader().GetAttestOffset(),
+			BlockHeight:     att.GetBlockHeader().GetBlockHeight(),
+			BlockHash:       att.GetBlockHeader().GetBlockHash(),
+			MsgRoot:         att.GetMsgRoot(),
+			AttestationRoot: root[:],
+			Status:          uint32(status),
+			ValidatorSetId:  att.GetValidatorSetId(),
+		})
+		require.NoError(t, err)
+
+		for _, sig := range att.GetSignatures() {
+			err := attStore.SignatureTable().Insert(ctx, &keeper.Signature{
+				Signature:        sig.GetSignature(),
+				ValidatorAddress: sig.GetValidatorAddress(),
+				AttId:            attID,
+				ChainId:          att.GetAttestHeader().GetSourceChainId(),
+				ConfLevel:        att.GetAttestHeader().GetConfLevel(),
+				AttestOffset:     att.GetAttestHeader().GetAttestOffset(),
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	for _, att := range pending {
+		insert(att, keeper.Status_Pending)
+	}
+	for _, att := range approved {
+		insert(att, keeper.Status_Approved)
+	}
+
+	commitID := cms.Commit()
+
+	return cms, uint64(commitID.Version)
+}
+
+// testStoreABCI is a stub cometBFT client that routes ABCI store queries to a multistore.
+// stubCometClient is a cometBFT client stub, compared by pointer.
+type stubCometClient struct {
+	rpcclient.Client
+}
+
+type testStoreABCI struct {
+	rpcclient.ABCIClient
+	cms *rootmulti.Store
+}
+
+func (c testStoreABCI) ABCIQueryWithOptions(_ context.Context, path string, data cmtbytes.HexBytes, opts rpcclient.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+	resp, err := c.cms.Query(&storetypes.RequestQuery{
+		Path:   strings.TrimPrefix(path, "/store"),
+		Data:   data,
+		Height: opts.Height,
+		Prove:  opts.Prove,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{
+		Key:      resp.Key,
+		Value:    resp.Value,
+		ProofOps: resp.ProofOps,
+		Height:   resp.Height,
+	}}, nil
+}
+
+// testLightClient is a stub light client returning light blocks with the provided app hashes.
+// The latest light block is the highest height in appHashes.
+type testLightClient struct {
+	appHashes map[int64][]byte
+}
+
+func (*testLightClient) ChainID() string {
+	return ""
+}
+
+func (c *testLightClient) Update(context.Context, time.Time) (*cmttypes.LightBlock, error) {
+	var latest int64
+	for h := range c.appHashes {
+		latest = max(latest, h)
+	}
+
+	return c.TrustedLightBlock(latest)
+}
+
+func (c *testLightClient) VerifyLightBlockAtHeight(_ context.Context, height int64, _ time.Time) (*cmttypes.LightBlock, error) {
+	return c.TrustedLightBlock(height)
+}
+
+func (c *testLightClient) TrustedLightBlock(height int64) (*cmttypes.LightBlock, error) {
+	return &cmttypes.LightBlock{SignedHeader: &cmttypes.SignedHeader{Header: &cmttypes.Header{
+		Height:  height,
+		AppHash: c.appHashes[height],
+	}}}, nil
+}
+
+// testConn is a stub attest query connection returning the attestation and recording the query height.
+type testConn struct {
+	gogogrpc.ClientConn
+	att    *atypes.Attestation
+	height uint64
+}
+
+func (c *testConn) Invoke(ctx context.Context, _ string, _, resp any, _ ...grpc.CallOption) error {
+	c.height, _ = heightFromCtx(ctx)
+	resp.(*atypes.LatestAttestationResponse).Attestation = c.att
+
+	return nil
+}
@@ -2,6 +2,7 @@ package ethclient
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
@@ -17,12 +19,14 @@ const (
 
 	newPayloadV2 = "engine_newPayloadV2"
 	newPayloadV3 = "engine_newPayloadV3"
+	newPayloadV4 = "engine_newPayloadV4"
 
 	forkchoiceUpdatedV2 = "engine_forkchoiceUpdatedV2"
 	forkchoiceUpdatedV3 = "engine_forkchoiceUpdatedV3"
 
 	getPayloadV2 = "engine_getPayloadV2"
 	getPayloadV3 = "engine_getPayloadV3"
+	getPayloadV4 = "engine_getPayloadV4"
 )
 
 // EngineClient defines the Engine API authenticated JSON-RPC endpoints.
@@ -35,6 +39,10 @@ type EngineClient interface {
 	// NewPayloadV3 creates an Eth1 block, inserts it in the chain, and returns the status of the chain.
 	NewPayloadV3(ctx context.Context, params engine.ExecutableData, versionedHashes []common.Hash,
 		beaconRoot *common.Hash) (engine.PayloadStatusV1, error)
+	// NewPayloadV4 is equivalent to V3 with the addition of EIP-7685 execution requests.
+	// Note that Prague doesn't introduce ForkchoiceUpdatedV4, ForkchoiceUpdatedV3 is used instead.
+	NewPayloadV4(ctx context.Context, params engine.ExecutableData, versionedHashes []common.Hash,
+		beaconRoot *common.Hash, executionRequests [][]byte) (engine.PayloadStatusV1, error)
 
 	// ForkchoiceUpdatedV2 has several responsibilities:
 	//  - It sets the chain the head.
@@ -51,6 +59,66 @@ type EngineClient interface {
 	GetPayloadV2(ctx context.Context, payloadID engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error)
 	// GetPayloadV3 returns a cached payload by id.
 	GetPayloadV3(ctx context.Context, payloadID engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error)
+	// GetPayloadV4 returns a cached payload by id, including its EIP-7685 execution requests.
+	GetPayloadV4(ctx context.Context, payloadID engine.PayloadID) (*ExecutionPayloadEnvelopeV4, error)
+}
+
+// ExecutionPayloadEnvelopeV4 is the engine_getPayloadV4 response.
+// It extends the V3 envelope with EIP-7685 execution requests.
+type ExecutionPayloadEnvelopeV4 struct {
+	engine.ExecutionPayloadEnvelope
+	Requests [][]byte
+}
+
+// executionRequestsJSON is the JSON encoding of execution requests in the engine_getPayloadV4 response.
+type executionRequestsJSON struct {
+	Requests []hexutil.Bytes `json:"executionRequests"`
+}
+
+// MarshalJSON implements json.Marshaler.
+// It is required since the embedded envelope's MarshalJSON would otherwise omit the execution requests.
+func (e ExecutionPayloadEnvelopeV4) MarshalJSON() ([]byte, error) {
+	bz, err := json.Marshal(e.ExecutionPayloadEnvelope)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal envelope")
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, errors.Wrap(err, "unmarshal envelope fields")
+	}
+
+	requests := make([]hexutil.Bytes, 0, len(e.Requests)) // Cannot be null.
+	for _, r := range e.Requests {
+		requests = append(requests, r)
+	}
+
+	if fields["executionRequests"], err = json.Marshal(requests); err != nil {
+		return nil, errors.Wrap(err, "marshal requests")
+	}
+
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *ExecutionPayloadEnvelopeV4) UnmarshalJSON(input []byte) error {
+	if err := json.Unmarshal(input, &e.ExecutionPayloadEnvelope); err != nil {
+		return errors.Wrap(err, "unmarshal envelope")
+	}
+
+	var dec executionRequestsJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return errors.Wrap(err, "unmarshal requests")
+	} else if dec.Requests == nil {
+		return errors.New("missing required field 'executionRequests' for ExecutionPayloadEnvelopeV4")
+	}
+
+	e.Requests = make([][]byte, 0, len(dec.Requests))
+	for _, r := range dec.Requests {
+		e.Requests = append(e.Requests, r)
+	}
+
+	return nil
 }
 
 // engineClient implements EngineClient using JSON-RPC.
@@ -107,6 +175,27 @@ func (c engineClient) NewPayloadV3(ctx context.Context, params engine.Executable
 	return resp, nil
 }
 
+func (c engineClient) NewPayloadV4(ctx context.Context, params engine.ExecutableData, versionedHashes []common.Hash,
+	beaconRoot *common.Hash, executionRequests [][]byte,
+) (engine.PayloadStatusV1, error) {
+	const endpoint = "new_payload_v4"
+	defer latency(c.chain, endpoint)()
+
+	requests := make([]hexutil.Bytes, 0, len(executionRequests)) // Cannot be null.
+	for _, r := range executionRequests {
+		requests = append(requests, r)
+	}
+
+	var resp engine.PayloadStatusV1
+	err := c.cl.Client().CallContext(ctx, &resp, newPayloadV4, params, versionedHashes, beaconRoot, requests)
+	if err != nil {
+		incError(c.chain, endpoint)
+		return engine.PayloadStatusV1{}, errors.Wrap(err, "rpc new payload v4")
+	}
+
+	return resp, nil
+}
+
 func (c engineClient) ForkchoiceUpdatedV2(ctx context.Context, update engine.ForkchoiceStateV1,
 	payloadAttributes *engine.PayloadAttributes,
 ) (engine.ForkChoiceResponse, error) {
@@ -170,3 +259,19 @@ func (c engineClient) GetPayloadV3(ctx context.Context, payloadID engine.Payload
 
 	return &resp, nil
 }
+
+func (c engineClient) GetPayloadV4(ctx context.Context, payloadID engine.PayloadID) (
+	*ExecutionPayloadEnvelopeV4, error,
+) {
+	const endpoint = "get_payload_v4"
+	defer latency(c.chain, endpoint)()
+
+	var resp ExecutionPayloadEnvelopeV4
+	err := c.cl.Client().CallContext(ctx, &resp, getPayloadV4, payloadID)
+	if err != nil {
+		incError(c.chain, endpoint)
+		return nil, errors.Wrap(err, "rpc get payload v4")
+	}
+
+	return &resp, nil
+}
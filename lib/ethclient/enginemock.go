@@ -34,6 +34,7 @@ import (
 type payloadArgs struct {
 	params     engine.ExecutableData
 	beaconRoot *common.Hash
+	requests   [][]byte // EIP-7685 execution requests, only populated by NewPayloadV4.
 }
 
 //nolint:gochecknoglobals // This is a static mapping.
@@ -56,6 +57,7 @@ type engineMock struct {
 	pendingLogs map[common.Address][]types.Log
 	logs        map[common.Hash][]types.Log
 	payloads    map[engine.PayloadID]payloadArgs
+	balances    map[common.Address]*big.Int // Balances credited by withdrawals.
 }
 
 // WithMockSelfDelegation returns an option to add a self-delegation Delegate event to the mock.
@@ -180,7 +182,7 @@ func MockGenesisBlock() (*types.Block, error) {
 		fuzzer           = NewFuzzer(timestamp)
 	)
 
-	genesisPayload, err := makePayload(fuzzer, height, uint64(timestamp), parentHash, common.Address{}, parentHash, &parentBeaconRoot)
+	genesisPayload, err := makePayload(fuzzer, height, uint64(timestamp), parentHash, common.Address{}, parentHash, &parentBeaconRoot, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "make next payload")
 	}
@@ -208,6 +210,7 @@ func NewEngineMock(opts ...func(mock *engineMock)) (EngineClient, error) {
 		pendingLogs: make(map[common.Address][]types.Log),
 		payloads:    make(map[engine.PayloadID]payloadArgs),
 		logs:        make(map[common.Hash][]types.Log),
+		balances:    make(map[common.Address]*big.Int),
 	}
 
 	for _, opt := range opts {
@@ -264,6 +267,12 @@ func (m *engineMock) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]ty
 		return nil, nil
 	}
 
+	// Populate block hash and log indexes like a real client.
+	for i := range eventLogs {
+		eventLogs[i].BlockHash = *q.BlockHash
+		eventLogs[i].Index = uint(i)
+	}
+
 	m.logs[*q.BlockHash] = eventLogs
 	delete(m.pendingLogs, addr)
 
@@ -338,6 +347,20 @@ func (m *engineMock) BlockByNumber(ctx context.Context, number *big.Int) (*types
 }
 
 func (m *engineMock) NewPayloadV3(ctx context.Context, params engine.ExecutableData, _ []common.Hash, beaconRoot *common.Hash) (engine.PayloadStatusV1, error) {
+	return m.newPayload(ctx, payloadArgs{params: params, beaconRoot: beaconRoot})
+}
+
+func (m *engineMock) NewPayloadV4(ctx context.Context, params engine.ExecutableData, _ []common.Hash, beaconRoot *common.Hash,
+	executionRequests [][]byte,
+) (engine.PayloadStatusV1, error) {
+	if executionRequests == nil {
+		return engine.PayloadStatusV1{}, errors.New("nil execution requests")
+	}
+
+	return m.newPayload(ctx, payloadArgs{params: params, beaconRoot: beaconRoot, requests: executionRequests})
+}
+
+func (m *engineMock) newPayload(ctx context.Context, args payloadArgs) (engine.PayloadStatusV1, error) {
 	if err := m.maybeErr(ctx); err != nil {
 		return engine.PayloadStatusV1{}, err
 	}
@@ -345,11 +368,6 @@ func (m *engineMock) NewPayloadV3(ctx context.Context, params engine.ExecutableD
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	args := payloadArgs{
-		params:     params,
-		beaconRoot: beaconRoot,
-	}
-
 	id, err := MockPayloadID(args.params, args.beaconRoot)
 	if err != nil {
 		return engine.PayloadStatusV1{}, err
@@ -358,8 +376,8 @@ func (m *engineMock) NewPayloadV3(ctx context.Context, params engine.ExecutableD
 	m.payloads[id] = args
 
 	log.Debug(ctx, "Engine mock received new payload from proposer",
-		"height", params.Number,
-		log.Hex7("hash", params.BlockHash.Bytes()),
+		"height", args.params.Number,
+		log.Hex7("hash", args.params.BlockHash.Bytes()),
 	)
 
 	return engine.PayloadStatusV1{
@@ -404,6 +422,10 @@ func (m *engineMock) ForkchoiceUpdatedV3(ctx context.Context, update engine.Fork
 			m.head = block
 			found = true
 
+			for _, w := range block.Withdrawals() {
+				m.creditUnsafe(w.Address, w.Amount)
+			}
+
 			id, err := MockPayloadID(args.params, args.beaconRoot)
 			if err != nil {
 				return engine.ForkChoiceResponse{}, err
@@ -421,7 +443,7 @@ func (m *engineMock) ForkchoiceUpdatedV3(ctx context.Context, update engine.Fork
 	// If we have payload attributes, make a new payload
 	if attrs != nil {
 		payload, err := makePayload(m.fuzzer, m.head.NumberU64()+1,
-			attrs.Timestamp, update.HeadBlockHash, attrs.SuggestedFeeRecipient, attrs.Random, attrs.BeaconRoot)
+			attrs.Timestamp, update.HeadBlockHash, attrs.SuggestedFeeRecipient, attrs.Random, attrs.BeaconRoot, attrs.Withdrawals)
 		if err != nil {
 			return engine.ForkChoiceResponse{}, err
 		}
@@ -446,6 +468,39 @@ func (m *engineMock) ForkchoiceUpdatedV3(ctx context.Context, update engine.Fork
 	return resp, nil
 }
 
+// BalanceAt returns the balance of the account credited by withdrawals. Only the latest block is supported.
+func (m *engineMock) BalanceAt(ctx context.Context, account common.Address, number *big.Int) (*big.Int, error) {
+	if err := m.maybeErr(ctx); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if number != nil && number.Cmp(m.head.Number()) != 0 {
+		return nil, errors.New("block not found") // Only support latest block
+	}
+
+	balance, ok := m.balances[account]
+	if !ok {
+		return big.NewInt(0), nil
+	}
+
+	return new(big.Int).Set(balance), nil
+}
+
+// creditUnsafe credits the account with the withdrawal amount (in gwei).
+// It assumes the lock is held.
+func (m *engineMock) creditUnsafe(account common.Address, amountGwei uint64) {
+	balance, ok := m.balances[account]
+	if !ok {
+		balance = big.NewInt(0)
+	}
+
+	amount := new(big.Int).Mul(new(big.Int).SetUint64(amountGwei), big.NewInt(params.GWei))
+	m.balances[account] = balance.Add(balance, amount)
+}
+
 func (m *engineMock) GetPayloadV3(ctx context.Context, payloadID engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
 	if err := m.maybeErr(ctx); err != nil {
 		return nil, err
@@ -464,6 +519,30 @@ func (m *engineMock) GetPayloadV3(ctx context.Context, payloadID engine.PayloadI
 	}, nil
 }
 
+func (m *engineMock) GetPayloadV4(ctx context.Context, payloadID engine.PayloadID) (*ExecutionPayloadEnvelopeV4, error) {
+	if err := m.maybeErr(ctx); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	args, ok := m.payloads[payloadID]
+	if !ok {
+		return nil, errors.New("payload not found")
+	}
+
+	requests := args.requests
+	if requests == nil {
+		requests = [][]byte{} // Built payloads don't contain execution requests.
+	}
+
+	return &ExecutionPayloadEnvelopeV4{
+		ExecutionPayloadEnvelope: engine.ExecutionPayloadEnvelope{ExecutionPayload: &args.params},
+		Requests:                 requests,
+	}, nil
+}
+
 func (*engineMock) NewPayloadV2(context.Context, engine.ExecutableData) (engine.PayloadStatusV1, error) {
 	panic("implement me")
 }
@@ -479,7 +558,8 @@ func (*engineMock) GetPayloadV2(context.Context, engine.PayloadID) (*engine.Exec
 
 // makePayload returns a new fuzzed payload using head as parent if provided.
 func makePayload(fuzzer *fuzz.Fuzzer, height uint64, timestamp uint64, parentHash common.Hash,
-	feeRecipient common.Address, randao common.Hash, beaconRoot *common.Hash) (engine.ExecutableData, error) {
+	feeRecipient common.Address, randao common.Hash, beaconRoot *common.Hash, withdrawals []*types.Withdrawal,
+) (engine.ExecutableData, error) {
 	// Build a new header
 	var header types.Header
 	fuzzer.Fuzz(&header)
@@ -491,7 +571,7 @@ func makePayload(fuzzer *fuzz.Fuzzer, height uint64, timestamp uint64, parentHas
 	header.ParentBeaconRoot = beaconRoot
 
 	// Convert header to block
-	block := types.NewBlock(&header, nil, nil, trie.NewStackTrie(nil))
+	block := types.NewBlock(&header, &types.Body{Withdrawals: withdrawals}, nil, trie.NewStackTrie(nil))
 
 	// Convert block to payload
 	env := engine.BlockToExecutableData(block, big.NewInt(0), nil)
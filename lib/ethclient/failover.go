@@ -0,0 +1,800 @@
+package ethclient
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// failoverQueueSize is the maximum number of pending Engine API calls per execution client.
+	// Calls to a client with a full queue (e.g. a hanging client) are skipped.
+	failoverQueueSize = 64
+	// failoverTimeout is the maximum duration to wait for a client's result before skipping it,
+	// so a stalled client doesn't block failover to lower priority clients.
+	failoverTimeout = 2 * time.Second
+	// failoverBackoff is the initial duration a client isn't awaited after a timeout.
+	// It doubles with each consecutive timeout up to failoverMaxBackoff.
+	failoverBackoff    = 5 * time.Second
+	failoverMaxBackoff = 2 * time.Minute
+	// failoverPayloadIDs is the number of recent payload IDs mapped to each client's payload ID.
+	failoverPayloadIDs = 16
+)
+
+var _ EngineClient = failoverEngine{}
+
+// failoverEngine implements EngineClient by driving multiple execution clients.
+//
+// State changing Engine API calls (newPayload and forkchoiceUpdated) are fanned out to all clients.
+// The response of the first healthy client (in priority order) is returned, and that client
+// becomes the active client used to build payloads and to serve all other queries.
+// So it automatically fails over to a secondary if the primary fails, and back once the primary recovers.
+//
+// Responses of lower priority clients are compared asynchronously to the returned response
+// to detect execution layer consensus bugs.
+type failoverEngine struct {
+	clients    []EngineClient    // Ordered by priority, primary first.
+	queues     []chan func()     // Per client FIFO call queues, ensures ordered Engine API calls.
+	active     *atomic.Int64     // Index of the active client.
+	done       chan struct{}     // Closed on Close.
+	closed     *sync.Once        // Ensures Close is idempotent.
+	names      []string          // Client names for logging and metrics.
+	workers    *sync.WaitGroup   // Queue worker goroutines.
+	payloadIDs *payloadIDs       // Maps returned payload IDs to each client's payload ID.
+	health     []*clientHealth   // Per client timeout backoff.
+	timeout    time.Duration     // Maximum duration to wait for a client's result.
+	backoff    time.Duration     // Initial duration a client isn't awaited after a timeout.
+	hooks      failoverTestHooks // Test hooks.
+}
+
+// failoverTestHooks are hooks used in tests.
+type failoverTestHooks struct {
+	OnCompared        func(mismatches int) // Called after lower priority responses were compared.
+	OnPayloadCompared func(mismatches int) // Called after payloads built by other clients were compared.
+	Timeout           time.Duration        // Overrides failoverTimeout if non-zero.
+	Backoff           time.Duration        // Overrides failoverBackoff if non-zero.
+}
+
+// NewFailoverEngineClient returns an EngineClient that drives the primary and secondary execution clients,
+// failing over to the secondaries (in order) when the primary is unhealthy.
+// A client is healthy if it doesn't return an error and isn't syncing.
+func NewFailoverEngineClient(primary EngineClient, secondaries ...EngineClient) EngineClient {
+	return newFailoverEngine(failoverTestHooks{}, append([]EngineClient{primary}, secondaries...)...)
+}
+
+func newFailoverEngine(hooks failoverTestHooks, clients ...EngineClient) failoverEngine {
+	f := failoverEngine{
+		clients:    clients,
+		active:     new(atomic.Int64),
+		done:       make(chan struct{}),
+		closed:     new(sync.Once),
+		workers:    new(sync.WaitGroup),
+		payloadIDs: new(payloadIDs),
+		timeout:    failoverTimeout,
+		backoff:    failoverBackoff,
+		hooks:      hooks,
+	}
+	if hooks.Timeout != 0 {
+		f.timeout = hooks.Timeout
+	}
+	if hooks.Backoff != 0 {
+		f.backoff = hooks.Backoff
+	}
+
+	for i, cl := range clients {
+		name := "secondary_" + cl.Address()
+		if i == 0 {
+			name = "primary_" + cl.Address()
+		}
+		f.names = append(f.names, name)
+		f.health = append(f.health, new(clientHealth))
+
+		queue := make(chan func(), failoverQueueSize)
+		f.queues = append(f.queues, queue)
+
+		f.workers.Add(1)
+		go func() {
+			defer f.workers.Done()
+			for {
+				select {
+				case <-f.done:
+					return
+				case call := <-queue:
+					call()
+				}
+			}
+		}()
+	}
+
+	return f
+}
+
+// activeClient returns the active client.
+func (f failoverEngine) activeClient() EngineClient {
+	return f.clients[f.active.Load()]
+}
+
+// setActive sets the active client index, logging failovers.
+func (f failoverEngine) setActive(ctx context.Context, idx int) {
+	prev := f.active.Swap(int64(idx))
+	if prev == int64(idx) {
+		return
+	}
+
+	engineActive.Set(float64(idx))
+	if idx > int(prev) {
+		log.Warn(ctx, "Execution client failover", nil, "from", f.names[int(prev)], "to", f.names[idx])
+	} else {
+		log.Info(ctx, "Execution client restored", "from", f.names[int(prev)], "to", f.names[idx])
+	}
+}
+
+// failoverResult is the result of an Engine API call to a single execution client.
+type failoverResult[R any] struct {
+	Resp R
+	Err  error
+}
+
+// fanOut enqueues the call to all clients and returns the result of the first healthy client in priority order,
+// which also becomes the active client. If no client is healthy, the first non-error result or the primary error is returned.
+//
+// Clients that don't respond within the failover timeout are skipped, so a stalled primary doesn't block failover.
+// It doesn't wait for lower priority clients, their results are compared to the returned result asynchronously.
+// Note that calls are not canceled if the provided context is canceled, since all clients must receive all calls.
+func fanOut[R any](
+	ctx context.Context,
+	f failoverEngine,
+	endpoint string,
+	call func(ctx context.Context, idx int, cl EngineClient) (R, error),
+	healthy func(R) bool,
+	equal func(R, R) bool,
+) (R, error) {
+	callCtx := context.WithoutCancel(ctx)
+
+	var results []chan failoverResult[R]
+	for i, cl := range f.clients {
+		result := make(chan failoverResult[R], 1)
+		results = append(results, result)
+
+		select {
+		case f.queues[i] <- func() {
+			resp, err := call(callCtx, i, cl)
+			result <- failoverResult[R]{Resp: resp, Err: err}
+		}:
+		default:
+			result <- failoverResult[R]{Err: errors.New("execution client queue full")}
+		}
+	}
+
+	// All clients share the same deadline, since all calls were enqueued at the same time.
+	timeoutCtx, cancel := context.WithTimeout(callCtx, f.timeout)
+	defer cancel()
+
+	var (
+		fallback    failoverResult[R]
+		hasFallback bool
+		primaryErr  error
+	)
+	for i, result := range results {
+		res, err := awaitResult(ctx, f, i, result, timeoutCtx.Done())
+		if err != nil {
+			var zero R
+			return zero, err
+		}
+
+		if errors.Is(res.Err, errBackedOff) {
+			// Stalled client, not awaited until its backoff expires.
+			if i == 0 {
+				primaryErr = res.Err
+			}
+
+			continue
+		} else if res.Err != nil {
+			engineErrors.WithLabelValues(f.names[i], endpoint).Inc()
+			log.Warn(ctx, "Execution client call failed", res.Err, "client", f.names[i], "endpoint", endpoint)
+			if i == 0 {
+				primaryErr = res.Err
+			}
+
+			continue
+		} else if !healthy(res.Resp) {
+			if !hasFallback {
+				fallback, hasFallback = res, true
+			}
+
+			continue
+		}
+
+		f.setActive(ctx, i)
+		go func() {
+			mismatches := compareResults(callCtx, f, endpoint, res.Resp, results[i+1:], i+1, equal)
+			if f.hooks.OnCompared != nil {
+				f.hooks.OnCompared(mismatches)
+			}
+		}()
+
+		return res.Resp, nil
+	}
+
+	if hasFallback {
+		return fallback.Resp, nil
+	}
+
+	return fallback.Resp, errors.Wrap(primaryErr, "all execution clients failed", "endpoint", endpoint)
+}
+
+// errBackedOff is returned for results of clients that are not awaited after timing out.
+var errBackedOff = errors.New("execution client backed off")
+
+// awaitResult returns the client's result, or a timeout error result if it isn't available before the timeout is closed.
+// Clients that timed out are backed off: their results are not awaited until the backoff expires, but
+// are still used if immediately available, which also ends the backoff.
+// It returns an error if the context is done or the clients are closed.
+func awaitResult[R any](
+	ctx context.Context,
+	f failoverEngine,
+	idx int,
+	result <-chan failoverResult[R],
+	timeout <-chan struct{},
+) (failoverResult[R], error) {
+	health := f.health[idx]
+
+	// Prefer available results, even if the timeout fired.
+	select {
+	case res := <-result:
+		health.Responded()
+		return res, nil
+	default:
+	}
+
+	if health.BackedOff(time.Now()) {
+		return failoverResult[R]{Err: errBackedOff}, nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return failoverResult[R]{}, errors.Wrap(ctx.Err(), "context done")
+	case <-f.done:
+		return failoverResult[R]{}, errors.New("execution clients closed")
+	case res := <-result:
+		health.Responded()
+		return res, nil
+	case <-timeout:
+		backoff := health.TimedOut(time.Now(), f.backoff)
+		log.Warn(ctx, "Execution client call timeout, backing off", nil, "client", f.names[idx], "backoff", backoff)
+
+		return failoverResult[R]{Err: errors.New("execution client call timeout")}, nil
+	}
+}
+
+// clientHealth tracks consecutive timeouts of an execution client, so stalled clients are not awaited
+// on the consensus critical path until their backoff expires.
+type clientHealth struct {
+	mu       sync.Mutex
+	timeouts int       // Consecutive timeouts.
+	until    time.Time // Not awaited before this time.
+}
+
+// BackedOff returns true if the client shouldn't be awaited at the provided time.
+func (h *clientHealth) BackedOff(now time.Time) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return now.Before(h.until)
+}
+
+// TimedOut records a timeout and returns the backoff, doubling the initial backoff for each consecutive timeout.
+func (h *clientHealth) TimedOut(now time.Time, initial time.Duration) time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	backoff := initial
+	for i := 0; i < h.timeouts && backoff < failoverMaxBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, failoverMaxBackoff)
+
+	h.timeouts++
+	h.until = now.Add(backoff)
+
+	return backoff
+}
+
+// Responded resets the backoff, since the client responded.
+func (h *clientHealth) Responded() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.timeouts = 0
+	h.until = time.Time{}
+}
+
+// compareResults waits for the results and logs and counts any mismatches with the expected response.
+// Clients that don't respond within the failover timeout are skipped. It returns the number of mismatches.
+func compareResults[R any](
+	ctx context.Context,
+	f failoverEngine,
+	endpoint string,
+	expected R,
+	results []chan failoverResult[R],
+	offset int,
+	equal func(R, R) bool,
+) int {
+	timeoutCtx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	var mismatches int
+	for i, result := range results {
+		name := f.names[offset+i]
+
+		res, err := awaitResult(ctx, f, offset+i, result, timeoutCtx.Done())
+		if err != nil {
+			return mismatches
+		}
+
+		if errors.Is(res.Err, errBackedOff) {
+			continue
+		} else if res.Err != nil {
+			engineErrors.WithLabelValues(name, endpoint).Inc()
+			log.Warn(ctx, "Execution client call failed", res.Err, "client", name, "endpoint", endpoint)
+		} else if !equal(expected, res.Resp) {
+			mismatches++
+			engineMismatches.WithLabelValues(name, endpoint).Inc()
+			log.Error(ctx, "Execution client response mismatch", nil,
+				"client", name,
+				"endpoint", endpoint,
+				"expected", expected,
+				"actual", res.Resp,
+			)
+		}
+	}
+
+	return mismatches
+}
+
+// statusHealthy returns true if the payload status is VALID or INVALID, i.e., the client isn't syncing.
+func statusHealthy(status engine.PayloadStatusV1) bool {
+	return status.Status == engine.VALID || status.Status == engine.INVALID
+}
+
+// statusEqual returns true if the payload statuses are equal, ignoring validation errors.
+// Syncing statuses are considered equal since they don't indicate consensus bugs.
+func statusEqual(a, b engine.PayloadStatusV1) bool {
+	if !statusHealthy(a) || !statusHealthy(b) {
+		return true
+	}
+
+	if a.Status != b.Status {
+		return false
+	}
+
+	if a.LatestValidHash == nil || b.LatestValidHash == nil {
+		return a.LatestValidHash == b.LatestValidHash
+	}
+
+	return *a.LatestValidHash == *b.LatestValidHash
+}
+
+func (f failoverEngine) NewPayloadV2(ctx context.Context, params engine.ExecutableData) (engine.PayloadStatusV1, error) {
+	return fanOut(ctx, f, newPayloadV2, func(ctx context.Context, _ int, cl EngineClient) (engine.PayloadStatusV1, error) {
+		return cl.NewPayloadV2(ctx, params)
+	}, statusHealthy, statusEqual)
+}
+
+func (f failoverEngine) NewPayloadV3(ctx context.Context, params engine.ExecutableData, versionedHashes []common.Hash,
+	beaconRoot *common.Hash,
+) (engine.PayloadStatusV1, error) {
+	return fanOut(ctx, f, newPayloadV3, func(ctx context.Context, _ int, cl EngineClient) (engine.PayloadStatusV1, error) {
+		return cl.NewPayloadV3(ctx, params, versionedHashes, beaconRoot)
+	}, statusHealthy, statusEqual)
+}
+
+func (f failoverEngine) NewPayloadV4(ctx context.Context, params engine.ExecutableData, versionedHashes []common.Hash,
+	beaconRoot *common.Hash, executionRequests [][]byte,
+) (engine.PayloadStatusV1, error) {
+	return fanOut(ctx, f, newPayloadV4, func(ctx context.Context, _ int, cl EngineClient) (engine.PayloadStatusV1, error) {
+		return cl.NewPayloadV4(ctx, params, versionedHashes, beaconRoot, executionRequests)
+	}, statusHealthy, statusEqual)
+}
+
+// fcrHealthy returns true if the forkchoice response payload status is healthy.
+func fcrHealthy(resp engine.ForkChoiceResponse) bool {
+	return statusHealthy(resp.PayloadStatus)
+}
+
+// fcrEqual returns true if the forkchoice response payload statuses are equal.
+// Payload IDs are not compared since they are client specific.
+func fcrEqual(a, b engine.ForkChoiceResponse) bool {
+	return statusEqual(a.PayloadStatus, b.PayloadStatus)
+}
+
+// forkchoiceUpdated fans out the forkchoice update and maps the returned payload ID to the payload ID
+// returned by each client, since payload IDs are client specific.
+func forkchoiceUpdated(
+	ctx context.Context,
+	f failoverEngine,
+	endpoint string,
+	update func(context.Context, EngineClient) (engine.ForkChoiceResponse, error),
+) (engine.ForkChoiceResponse, error) {
+	clientIDs := make([]*engine.PayloadID, len(f.clients))
+	resp, err := fanOut(ctx, f, endpoint, func(ctx context.Context, idx int, cl EngineClient) (engine.ForkChoiceResponse, error) {
+		resp, err := update(ctx, cl)
+		if err == nil {
+			f.payloadIDs.set(clientIDs, idx, resp.PayloadID)
+		}
+
+		return resp, err
+	}, fcrHealthy, fcrEqual)
+	if err == nil && resp.PayloadID != nil {
+		f.payloadIDs.add(*resp.PayloadID, clientIDs)
+	}
+
+	return resp, err
+}
+
+func (f failoverEngine) ForkchoiceUpdatedV2(ctx context.Context, update engine.ForkchoiceStateV1,
+	payloadAttributes *engine.PayloadAttributes,
+) (engine.ForkChoiceResponse, error) {
+	return forkchoiceUpdated(ctx, f, forkchoiceUpdatedV2, func(ctx context.Context, cl EngineClient) (engine.ForkChoiceResponse, error) {
+		return cl.ForkchoiceUpdatedV2(ctx, update, payloadAttributes)
+	})
+}
+
+func (f failoverEngine) ForkchoiceUpdatedV3(ctx context.Context, update engine.ForkchoiceStateV1,
+	payloadAttributes *engine.PayloadAttributes,
+) (engine.ForkChoiceResponse, error) {
+	return forkchoiceUpdated(ctx, f, forkchoiceUpdatedV3, func(ctx context.Context, cl EngineClient) (engine.ForkChoiceResponse, error) {
+		return cl.ForkchoiceUpdatedV3(ctx, update, payloadAttributes)
+	})
+}
+
+// payloadIDs maps recently returned payload IDs to the payload ID returned by each client.
+type payloadIDs struct {
+	mu      sync.Mutex
+	entries []payloadIDEntry // Ordered by insertion, oldest first.
+}
+
+type payloadIDEntry struct {
+	returned engine.PayloadID
+	clients  []*engine.PayloadID // Indexed by client, nil if unknown.
+}
+
+// set sets the client's payload ID of a forkchoice update.
+func (p *payloadIDs) set(clientIDs []*engine.PayloadID, idx int, id *engine.PayloadID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	clientIDs[idx] = id
+}
+
+// add maps the returned payload ID to the client payload IDs, evicting the oldest entry if full.
+// Note the client payload IDs of lower priority clients may still be set after this.
+func (p *payloadIDs) add(returned engine.PayloadID, clientIDs []*engine.PayloadID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.entries = append(p.entries, payloadIDEntry{returned: returned, clients: clientIDs})
+	if len(p.entries) > failoverPayloadIDs {
+		p.entries = p.entries[1:]
+	}
+}
+
+// get returns the client's payload ID mapped to the returned payload ID, or false if unknown.
+func (p *payloadIDs) get(returned engine.PayloadID, idx int) (engine.PayloadID, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i := len(p.entries) - 1; i >= 0; i-- {
+		if p.entries[i].returned != returned {
+			continue
+		} else if id := p.entries[i].clients[idx]; id != nil {
+			return *id, true
+		}
+
+		return engine.PayloadID{}, false
+	}
+
+	return engine.PayloadID{}, false
+}
+
+// getPayload returns the payload from the active client, or from the other clients (in priority order) if it fails.
+// Note all clients start building payloads since forkchoiceUpdated (with payload attributes) is fanned out,
+// each client is queried with the payload ID it returned. The payloads built by the other clients
+// are compared to the returned payload asynchronously.
+func getPayload[R any](
+	ctx context.Context,
+	f failoverEngine,
+	endpoint string,
+	payloadID engine.PayloadID,
+	get func(context.Context, EngineClient, engine.PayloadID) (R, error),
+	blockHash func(R) common.Hash,
+) (R, error) {
+	compare := func(idx int, resp R) {
+		go func() {
+			mismatches := comparePayloads(context.WithoutCancel(ctx), f, endpoint, payloadID, idx, blockHash(resp), get, blockHash)
+			if f.hooks.OnPayloadCompared != nil {
+				f.hooks.OnPayloadCompared(mismatches)
+			}
+		}()
+	}
+
+	active := int(f.active.Load())
+	activeID, ok := f.payloadIDs.get(payloadID, active)
+	if !ok {
+		activeID = payloadID // The returned payload ID is the active client's.
+	}
+
+	resp, err := get(ctx, f.clients[active], activeID)
+	if err == nil {
+		compare(active, resp)
+		return resp, nil
+	}
+
+	engineErrors.WithLabelValues(f.names[active], endpoint).Inc()
+	log.Warn(ctx, "Execution client call failed", err, "client", f.names[active], "endpoint", endpoint)
+
+	for i, cl := range f.clients {
+		if i == active {
+			continue
+		}
+
+		id, ok := f.payloadIDs.get(payloadID, i)
+		if !ok {
+			log.Warn(ctx, "Execution client payload ID unknown", nil, "client", f.names[i], "endpoint", endpoint)
+			continue
+		}
+
+		resp, err := get(ctx, cl, id)
+		if err != nil {
+			engineErrors.WithLabelValues(f.names[i], endpoint).Inc()
+			log.Warn(ctx, "Execution client call failed", err, "client", f.names[i], "endpoint", endpoint)
+
+			continue
+		}
+
+		f.setActive(ctx, i)
+		compare(i, resp)
+
+		return resp, nil
+	}
+
+	return resp, errors.Wrap(err, "all execution clients failed", "endpoint", endpoint)
+}
+
+// comparePayloads gets the payloads built by all other (not backed off) clients with their own payload IDs
+// and logs and counts those with a different block hash than the expected (returned) payload.
+// Note that payloads may differ if the clients' mempools differ, so only sustained mismatches indicate bugs.
+// It returns the number of mismatches.
+func comparePayloads[R any](
+	ctx context.Context,
+	f failoverEngine,
+	endpoint string,
+	payloadID engine.PayloadID,
+	returned int,
+	expected common.Hash,
+	get func(context.Context, EngineClient, engine.PayloadID) (R, error),
+	blockHash func(R) common.Hash,
+) int {
+	var mismatches int
+	for i, cl := range f.clients {
+		if i == returned || f.health[i].BackedOff(time.Now()) {
+			continue
+		}
+
+		id, ok := f.payloadIDs.get(payloadID, i)
+		if !ok {
+			continue // Payload not built by this client.
+		}
+
+		callCtx, cancel := context.WithTimeout(ctx, f.timeout)
+		resp, err := get(callCtx, cl, id)
+		cancel()
+		if err != nil {
+			engineErrors.WithLabelValues(f.names[i], endpoint).Inc()
+			log.Warn(ctx, "Execution client call failed", err, "client", f.names[i], "endpoint", endpoint)
+
+			continue
+		}
+
+		if actual := blockHash(resp); actual != expected {
+			mismatches++
+			enginePayloadMismatches.WithLabelValues(f.names[i], endpoint).Inc()
+			log.Debug(ctx, "Execution client built payload mismatch",
+				"client", f.names[i],
+				"endpoint", endpoint,
+				"expected", expected,
+				"actual", actual,
+			)
+		}
+	}
+
+	return mismatches
+}
+
+func (f failoverEngine) GetPayloadV2(ctx context.Context, payloadID engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
+	return getPayload(ctx, f, getPayloadV2, payloadID,
+		func(ctx context.Context, cl EngineClient, id engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
+			return cl.GetPayloadV2(ctx, id)
+		}, envelopeHash)
+}
+
+func (f failoverEngine) GetPayloadV3(ctx context.Context, payloadID engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
+	return getPayload(ctx, f, getPayloadV3, payloadID,
+		func(ctx context.Context, cl EngineClient, id engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
+			return cl.GetPayloadV3(ctx, id)
+		}, envelopeHash)
+}
+
+func (f failoverEngine) GetPayloadV4(ctx context.Context, payloadID engine.PayloadID) (*ExecutionPayloadEnvelopeV4, error) {
+	return getPayload(ctx, f, getPayloadV4, payloadID,
+		func(ctx context.Context, cl EngineClient, id engine.PayloadID) (*ExecutionPayloadEnvelopeV4, error) {
+			return cl.GetPayloadV4(ctx, id)
+		}, func(env *ExecutionPayloadEnvelopeV4) common.Hash {
+			return envelopeHash(&env.ExecutionPayloadEnvelope)
+		})
+}
+
+// envelopeHash returns the block hash of the envelope's execution payload, or zero if nil.
+func envelopeHash(env *engine.ExecutionPayloadEnvelope) common.Hash {
+	if env == nil || env.ExecutionPayload == nil {
+		return common.Hash{}
+	}
+
+	return env.ExecutionPayload.BlockHash
+}
+
+// Close stops the call queues and closes all clients.
+func (f failoverEngine) Close() {
+	f.closed.Do(func() {
+		close(f.done)
+		f.workers.Wait()
+		for _, cl := range f.clients {
+			cl.Close()
+		}
+	})
+}
+
+// Address returns the active client's address.
+func (f failoverEngine) Address() string {
+	return f.activeClient().Address()
+}
+
+// The remaining (read-only) methods are served by the active client.
+
+func (f failoverEngine) HeaderByType(ctx context.Context, typ HeadType) (*types.Header, error) {
+	return f.activeClient().HeaderByType(ctx, typ)
+}
+
+func (f failoverEngine) SetHead(ctx context.Context, height uint64) error {
+	return f.activeClient().SetHead(ctx, height)
+}
+
+func (f failoverEngine) PeerCount(ctx context.Context) (uint64, error) {
+	return f.activeClient().PeerCount(ctx)
+}
+
+func (f failoverEngine) EtherBalanceAt(ctx context.Context, addr common.Address) (float64, error) {
+	return f.activeClient().EtherBalanceAt(ctx, addr)
+}
+
+func (f failoverEngine) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	return f.activeClient().BlockByHash(ctx, hash)
+}
+
+func (f failoverEngine) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return f.activeClient().BlockByNumber(ctx, number)
+}
+
+func (f failoverEngine) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	return f.activeClient().HeaderByHash(ctx, hash)
+}
+
+func (f failoverEngine) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return f.activeClient().HeaderByNumber(ctx, number)
+}
+
+func (f failoverEngine) TransactionCount(ctx context.Context, blockHash common.Hash) (uint, error) {
+	return f.activeClient().TransactionCount(ctx, blockHash)
+}
+
+func (f failoverEngine) TransactionInBlock(ctx context.Context, blockHash common.Hash, index uint) (*types.Transaction, error) {
+	return f.activeClient().TransactionInBlock(ctx, blockHash, index)
+}
+
+func (f failoverEngine) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return f.activeClient().SubscribeNewHead(ctx, ch)
+}
+
+func (f failoverEngine) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+	return f.activeClient().TransactionByHash(ctx, txHash)
+}
+
+func (f failoverEngine) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return f.activeClient().TransactionReceipt(ctx, txHash)
+}
+
+func (f failoverEngine) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return f.activeClient().BalanceAt(ctx, account, blockNumber)
+}
+
+func (f failoverEngine) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	return f.activeClient().StorageAt(ctx, account, key, blockNumber)
+}
+
+func (f failoverEngine) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return f.activeClient().CodeAt(ctx, account, blockNumber)
+}
+
+func (f failoverEngine) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return f.activeClient().NonceAt(ctx, account, blockNumber)
+}
+
+func (f failoverEngine) SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error) {
+	return f.activeClient().SyncProgress(ctx)
+}
+
+func (f failoverEngine) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return f.activeClient().CallContract(ctx, call, blockNumber)
+}
+
+func (f failoverEngine) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return f.activeClient().FilterLogs(ctx, q)
+}
+
+func (f failoverEngine) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return f.activeClient().SubscribeFilterLogs(ctx, q, ch)
+}
+
+func (f failoverEngine) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return f.activeClient().SendTransaction(ctx, tx)
+}
+
+func (f failoverEngine) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return f.activeClient().SuggestGasPrice(ctx)
+}
+
+func (f failoverEngine) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return f.activeClient().SuggestGasTipCap(ctx)
+}
+
+func (f failoverEngine) PendingBalanceAt(ctx context.Context, account common.Address) (*big.Int, error) {
+	return f.activeClient().PendingBalanceAt(ctx, account)
+}
+
+func (f failoverEngine) PendingStorageAt(ctx context.Context, account common.Address, key common.Hash) ([]byte, error) {
+	return f.activeClient().PendingStorageAt(ctx, account, key)
+}
+
+func (f failoverEngine) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return f.activeClient().PendingCodeAt(ctx, account)
+}
+
+func (f failoverEngine) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return f.activeClient().PendingNonceAt(ctx, account)
+}
+
+func (f failoverEngine) PendingTransactionCount(ctx context.Context) (uint, error) {
+	return f.activeClient().PendingTransactionCount(ctx)
+}
+
+func (f failoverEngine) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return f.activeClient().EstimateGas(ctx, call)
+}
+
+func (f failoverEngine) BlockNumber(ctx context.Context) (uint64, error) {
+	return f.activeClient().BlockNumber(ctx)
+}
+
+func (f failoverEngine) ChainID(ctx context.Context) (*big.Int, error) {
+	return f.activeClient().ChainID(ctx)
+}
@@ -0,0 +1,287 @@
+package ethclient
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/lib/errors"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailoverEngine(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	primary := &stubEngine{address: "primary"}
+	secondary := &stubEngine{address: "secondary"}
+
+	mismatches := make(chan int, 1)
+	f := newFailoverEngine(failoverTestHooks{
+		OnCompared: func(n int) { mismatches <- n },
+	}, primary, secondary)
+	defer f.Close()
+
+	hash := common.HexToHash("0x01")
+	valid := engine.PayloadStatusV1{Status: engine.VALID, LatestValidHash: &hash}
+	syncing := engine.PayloadStatusV1{Status: engine.SYNCING}
+
+	// newPayload asserts that the expected client status is returned, that both clients received the call,
+	// and that the active client and number of mismatches are as expected.
+	newPayload := func(t *testing.T, expected engine.PayloadStatusV1, active string, mismatch int) {
+		t.Helper()
+
+		status, err := f.NewPayloadV3(ctx, engine.ExecutableData{}, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, expected, status)
+		require.Equal(t, active, f.Address())
+		if mismatch >= 0 {
+			require.Equal(t, mismatch, <-mismatches)
+		}
+		require.Eventually(t, func() bool {
+			return primary.Calls() == secondary.Calls()
+		}, time.Second, time.Millisecond)
+	}
+
+	// Both healthy, primary active
+	primary.Set(valid, nil)
+	secondary.Set(valid, nil)
+	newPayload(t, valid, "primary", 0)
+
+	// Primary error, failover to secondary
+	primary.Set(engine.PayloadStatusV1{}, errors.New("primary down"))
+	newPayload(t, valid, "secondary", 0)
+
+	// Primary restored
+	primary.Set(valid, nil)
+	newPayload(t, valid, "primary", 0)
+
+	// Primary syncing, failover to secondary
+	primary.Set(syncing, nil)
+	newPayload(t, valid, "secondary", 0)
+
+	// Both syncing, syncing primary response returned
+	secondary.Set(syncing, nil)
+	newPayload(t, syncing, "secondary", -1) // Nothing compared
+
+	// Secondary mismatch detected
+	other := common.HexToHash("0x02")
+	primary.Set(valid, nil)
+	secondary.Set(engine.PayloadStatusV1{Status: engine.VALID, LatestValidHash: &other}, nil)
+	newPayload(t, valid, "primary", 1)
+
+	// Secondary syncing isn't a mismatch
+	secondary.Set(syncing, nil)
+	newPayload(t, valid, "primary", 0)
+
+	// All clients failing
+	primary.Set(engine.PayloadStatusV1{}, errors.New("primary down"))
+	secondary.Set(engine.PayloadStatusV1{}, errors.New("secondary down"))
+	_, err := f.NewPayloadV3(ctx, engine.ExecutableData{}, nil, nil)
+	require.ErrorContains(t, err, "all execution clients failed")
+	require.ErrorContains(t, err, "primary down")
+}
+
+func TestFailoverEngineStalled(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	primary := &stubEngine{address: "primary", block: make(chan struct{})}
+	secondary := &stubEngine{address: "secondary"}
+
+	const timeout = 50 * time.Millisecond
+	const backoff = 200 * time.Millisecond
+	f := newFailoverEngine(failoverTestHooks{Timeout: timeout, Backoff: backoff}, primary, secondary)
+	defer f.Close()
+
+	hash := common.HexToHash("0x01")
+	valid := engine.PayloadStatusV1{Status: engine.VALID, LatestValidHash: &hash}
+	primary.Set(valid, nil)
+	secondary.Set(valid, nil)
+
+	// Stalled primary is skipped after the timeout, failover to secondary.
+	t0 := time.Now()
+	status, err := f.NewPayloadV3(ctx, engine.ExecutableData{}, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, valid, status)
+	require.Equal(t, "secondary", f.Address())
+	require.GreaterOrEqual(t, time.Since(t0), timeout)
+
+	// Backed off primary isn't awaited.
+	t0 = time.Now()
+	status, err = f.NewPayloadV3(ctx, engine.ExecutableData{}, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, valid, status)
+	require.Equal(t, "secondary", f.Address())
+	require.Less(t, time.Since(t0), timeout)
+
+	// Primary retried and restored after the backoff once it responds again.
+	close(primary.block)
+	time.Sleep(backoff)
+	require.Eventually(t, func() bool {
+		_, err := f.NewPayloadV3(ctx, engine.ExecutableData{}, nil, nil)
+		return err == nil && f.Address() == "primary"
+	}, time.Second, time.Millisecond)
+}
+
+func TestClientHealth(t *testing.T) {
+	t.Parallel()
+
+	const initial = time.Second
+	now := time.Now()
+	var h clientHealth
+	require.False(t, h.BackedOff(now))
+
+	require.Equal(t, initial, h.TimedOut(now, initial))
+	require.True(t, h.BackedOff(now))
+	require.False(t, h.BackedOff(now.Add(initial)))
+
+	require.Equal(t, 2*initial, h.TimedOut(now, initial))
+	require.Equal(t, 4*initial, h.TimedOut(now, initial))
+
+	for range 10 {
+		h.TimedOut(now, initial)
+	}
+	require.Equal(t, failoverMaxBackoff, h.TimedOut(now, initial))
+
+	h.Responded()
+	require.False(t, h.BackedOff(now))
+	require.Equal(t, initial, h.TimedOut(now, initial))
+}
+
+func TestFailoverEngineGetPayload(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	hash := common.HexToHash("0x01")
+	valid := engine.PayloadStatusV1{Status: engine.VALID, LatestValidHash: &hash}
+
+	primary := &stubEngine{address: "primary", payloadID: engine.PayloadID{1}}
+	secondary := &stubEngine{address: "secondary", payloadID: engine.PayloadID{2}}
+	primary.Set(valid, nil)
+	secondary.Set(valid, nil)
+
+	primary.SetBlockHash(hash)
+	secondary.SetBlockHash(hash)
+
+	compared := make(chan int, 1)
+	payloadsCompared := make(chan int, 1)
+	f := newFailoverEngine(failoverTestHooks{
+		OnCompared:        func(n int) { compared <- n },
+		OnPayloadCompared: func(n int) { payloadsCompared <- n },
+	}, primary, secondary)
+	defer f.Close()
+
+	// Unknown payload IDs are only sent to the active client.
+	_, err := f.GetPayloadV3(ctx, engine.PayloadID{9})
+	require.ErrorContains(t, err, "unknown payload id")
+	require.Empty(t, payloadsCompared)
+
+	resp, err := f.ForkchoiceUpdatedV3(ctx, engine.ForkchoiceStateV1{}, &engine.PayloadAttributes{})
+	require.NoError(t, err)
+	require.Equal(t, primary.payloadID, *resp.PayloadID)
+	require.Zero(t, <-compared) // Secondary payload ID was recorded.
+
+	env, err := f.GetPayloadV3(ctx, *resp.PayloadID)
+	require.NoError(t, err)
+	require.Equal(t, hash, env.ExecutionPayload.BlockHash)
+	require.Equal(t, "primary", f.Address())
+	require.Zero(t, <-payloadsCompared) // Secondary built the same payload.
+
+	// Secondary built payload mismatch detected.
+	secondary.SetBlockHash(common.HexToHash("0x02"))
+	_, err = f.GetPayloadV3(ctx, *resp.PayloadID)
+	require.NoError(t, err)
+	require.Equal(t, 1, <-payloadsCompared)
+
+	// Secondary is queried with its own payload ID.
+	primary.Set(engine.PayloadStatusV1{}, errors.New("primary down"))
+	env, err = f.GetPayloadV3(ctx, *resp.PayloadID)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToHash("0x02"), env.ExecutionPayload.BlockHash)
+	require.Equal(t, "secondary", f.Address())
+	require.Zero(t, <-payloadsCompared) // Failing primary isn't a mismatch.
+
+	secondary.Set(engine.PayloadStatusV1{}, errors.New("secondary down"))
+	_, err = f.GetPayloadV3(ctx, *resp.PayloadID)
+	require.ErrorContains(t, err, "all execution clients failed")
+}
+
+// stubEngine is a stub EngineClient returning configurable payload statuses and errors.
+type stubEngine struct {
+	EngineClient
+
+	address   string
+	payloadID engine.PayloadID // Returned by forkchoiceUpdated and expected by getPayload.
+	block     chan struct{}    // If non-nil, newPayload blocks until closed.
+
+	mu        sync.Mutex
+	status    engine.PayloadStatusV1
+	err       error
+	calls     int
+	blockHash common.Hash // Block hash of the built payload.
+}
+
+func (s *stubEngine) SetBlockHash(hash common.Hash) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blockHash = hash
+}
+
+func (s *stubEngine) Set(status engine.PayloadStatusV1, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = status
+	s.err = err
+}
+
+func (s *stubEngine) Calls() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.calls
+}
+
+func (s *stubEngine) NewPayloadV3(context.Context, engine.ExecutableData, []common.Hash, *common.Hash) (engine.PayloadStatusV1, error) {
+	if s.block != nil {
+		<-s.block
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+
+	return s.status, s.err
+}
+
+func (s *stubEngine) ForkchoiceUpdatedV3(context.Context, engine.ForkchoiceStateV1, *engine.PayloadAttributes) (engine.ForkChoiceResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := s.payloadID
+
+	return engine.ForkChoiceResponse{PayloadStatus: s.status, PayloadID: &id}, s.err
+}
+
+func (s *stubEngine) GetPayloadV3(_ context.Context, id engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if id != s.payloadID {
+		return nil, errors.New("unknown payload id")
+	}
+
+	return &engine.ExecutionPayloadEnvelope{
+		ExecutionPayload: &engine.ExecutableData{BlockHash: s.blockHash},
+	}, s.err
+}
+
+func (s *stubEngine) Address() string {
+	return s.address
+}
+
+func (*stubEngine) Close() {}
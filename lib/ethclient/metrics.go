@@ -21,6 +21,34 @@ var (
 		Name:      "errors_total",
 		Help:      "Total number of errors returned by a Ethereum JSON-RPC by chain and endpoint",
 	}, []string{"chain", "endpoint"})
+
+	engineActive = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "lib",
+		Subsystem: "ethclient",
+		Name:      "engine_active_client",
+		Help:      "Index of the active execution client used for building payloads (0 is the primary)",
+	})
+
+	engineErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "lib",
+		Subsystem: "ethclient",
+		Name:      "engine_errors_total",
+		Help:      "Total number of Engine API errors by execution client and endpoint",
+	}, []string{"client", "endpoint"})
+
+	engineMismatches = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "lib",
+		Subsystem: "ethclient",
+		Name:      "engine_mismatches_total",
+		Help:      "Total number of Engine API responses of a secondary execution client that differ from the active client by client and endpoint. Alert if non-zero",
+	}, []string{"client", "endpoint"})
+
+	enginePayloadMismatches = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "lib",
+		Subsystem: "ethclient",
+		Name:      "engine_payload_mismatches_total",
+		Help:      "Total number of payloads built by a secondary execution client with a different block hash than the returned payload by client and endpoint. Alert if sustained",
+	}, []string{"client", "endpoint"})
 )
 
 // latency returns a function that records the latency of an RPC call.
@@ -35,6 +35,7 @@ type Static struct {
 	ConsensusSeedTXT     []byte
 	ExecutionGenesisJSON []byte
 	ExecutionSeedTXT     []byte
+	ExecutionPragueTime  *uint64 // EVM Prague fork timestamp, nil if not scheduled.
 }
 
 type Deployment struct {
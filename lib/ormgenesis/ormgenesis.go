@@ -0,0 +1,126 @@
+// Package ormgenesis provides genesis export and import of cosmos ORM module databases.
+// It allows modules to include their complete ORM state (all tables, including auto-increment sequences)
+// in their genesis state, which is required to restart a network from exported state.
+package ormgenesis
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/omni-network/omni/lib/errors"
+
+	"cosmossdk.io/orm/model/ormdb"
+)
+
+// Export returns the JSON state of all the module database tables as a JSON object keyed by table name.
+func Export(ctx context.Context, db ormdb.ModuleDB) ([]byte, error) {
+	tables := make(map[string]json.RawMessage)
+	err := db.GenesisHandler().ExportGenesis(ctx, func(name string) (io.WriteCloser, error) {
+		return &tableWriter{close: func(bz []byte) {
+			tables[name] = bz
+		}}, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "export tables")
+	}
+
+	bz, err := json.Marshal(tables)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal tables")
+	}
+
+	return bz, nil
+}
+
+// Import imports the JSON state of the module database tables as returned by Export.
+// Empty state is ignored. Tables are imported into an empty database, since existing rows result in errors.
+func Import(ctx context.Context, db ormdb.ModuleDB, bz []byte) error {
+	if len(bz) == 0 {
+		return nil
+	}
+
+	tables, err := unmarshal(db, bz)
+	if err != nil {
+		return err
+	}
+
+	err = db.GenesisHandler().InitGenesis(ctx, func(name string) (io.ReadCloser, error) {
+		table, ok := tables[name]
+		if !ok {
+			return nil, nil // Missing tables are skipped.
+		}
+
+		return io.NopCloser(bytes.NewReader(table)), nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "import tables")
+	}
+
+	return nil
+}
+
+// Validate returns an error if the JSON state of the module database tables is invalid.
+// Empty state is valid.
+func Validate(db ormdb.ModuleDB, bz []byte) error {
+	if len(bz) == 0 {
+		return nil
+	}
+
+	tables, err := unmarshal(db, bz)
+	if err != nil {
+		return err
+	}
+
+	err = db.GenesisHandler().ValidateGenesis(func(name string) (io.ReadCloser, error) {
+		table, ok := tables[name]
+		if !ok {
+			return nil, nil // Missing tables are skipped.
+		}
+
+		return io.NopCloser(bytes.NewReader(table)), nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "validate tables")
+	}
+
+	return nil
+}
+
+// unmarshal returns the JSON tables state by table name.
+// It returns an error if the state contains tables not defined in the module database.
+func unmarshal(db ormdb.ModuleDB, bz []byte) (map[string]json.RawMessage, error) {
+	var tables map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &tables); err != nil {
+		return nil, errors.Wrap(err, "unmarshal tables")
+	}
+
+	known := make(map[string]bool)
+	err := db.GenesisHandler().DefaultGenesis(func(name string) (io.WriteCloser, error) {
+		known[name] = true
+		return &tableWriter{close: func([]byte) {}}, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "default tables")
+	}
+
+	for name := range tables {
+		if !known[name] {
+			return nil, errors.New("unknown table", "table", name)
+		}
+	}
+
+	return tables, nil
+}
+
+// tableWriter buffers a table's JSON state, calling close with the result.
+type tableWriter struct {
+	bytes.Buffer
+	close func([]byte)
+}
+
+func (w *tableWriter) Close() error {
+	w.close(w.Bytes())
+	return nil
+}
@@ -0,0 +1,276 @@
+// Package eventproc provides a declarative evmengine EvmEventProcessor.
+// It filters the EVM log events of a single contract, decodes them using the contract ABI,
+// and delivers them to typed per-event handlers.
+//
+// A new contract's events are processed by declaring its address, ABI and handlers:
+//
+//	proc, err := eventproc.New(ethCl, eventproc.Config{
+//		Name:    ModuleName,
+//		Address: common.HexToAddress(predeploys.Slashing),
+//		ABI:     bindings.SlashingMetaData,
+//		Handlers: []eventproc.Handler{
+//			eventproc.Handle("Unjail", p.deliverUnjail), // func(context.Context, *bindings.SlashingUnjail) error
+//		},
+//	})
+package eventproc
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	evmenginetypes "github.com/omni-network/omni/octane/evmengine/types"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var _ evmenginetypes.EvmEventProcessor = Processor{}
+
+// Config declares the contract events processed by a Processor.
+type Config struct {
+	// Name of the processor, usually the module name.
+	Name string
+	// Address of the contract emitting the events.
+	Address common.Address
+	// ABI of the contract, usually bindings.<Contract>MetaData.
+	ABI *bind.MetaData
+	// Handlers of the contract events, see Handle.
+	Handlers []Handler
+}
+
+// Handler delivers a single contract event, see Handle.
+type Handler struct {
+	event   string
+	decode  func(event abi.Event, elog types.Log) (any, error)
+	deliver func(ctx context.Context, ev any) error
+	random  func(f fuzzer) any
+}
+
+// Handle returns a handler of the named contract event.
+// The event is decoded into E, usually the abigen binding event type, e.g. bindings.SlashingUnjail.
+// Fields are matched to event arguments by their camel-cased names, see abi.ToCamelCase.
+// A `Raw types.Log` field, if present, is populated with the original log.
+func Handle[E any](event string, deliver func(ctx context.Context, ev *E) error) Handler {
+	return Handler{
+		event: event,
+		decode: func(event abi.Event, elog types.Log) (any, error) {
+			return decode[E](event, elog)
+		},
+		deliver: func(ctx context.Context, ev any) error {
+			e, ok := ev.(*E)
+			if !ok {
+				return errors.New("unexpected event type [BUG]")
+			}
+
+			return deliver(ctx, e)
+		},
+		random: func(f fuzzer) any {
+			e := new(E)
+			f.Fuzz(e)
+
+			return e
+		},
+	}
+}
+
+// handler is a handler with its resolved ABI event.
+type handler struct {
+	Handler
+	Event abi.Event
+}
+
+// Processor implements the evmenginetypes.EvmEventProcessor interface for a single contract.
+type Processor struct {
+	name     string
+	address  common.Address
+	ethCl    ethclient.Client
+	handlers []handler                // Ordered as declared.
+	byTopic  map[common.Hash]*handler // Handlers by event ID.
+}
+
+// New returns a new Processor of the declared contract events.
+func New(ethCl ethclient.Client, cfg Config) (Processor, error) {
+	if cfg.Name == "" {
+		return Processor{}, errors.New("empty name")
+	} else if cfg.ABI == nil {
+		return Processor{}, errors.New("nil abi")
+	} else if len(cfg.Handlers) == 0 {
+		return Processor{}, errors.New("no handlers")
+	}
+
+	contractABI, err := cfg.ABI.GetAbi()
+	if err != nil {
+		return Processor{}, errors.Wrap(err, "get abi")
+	}
+
+	p := Processor{
+		name:    cfg.Name,
+		address: cfg.Address,
+		ethCl:   ethCl,
+		byTopic: make(map[common.Hash]*handler),
+	}
+
+	for _, h := range cfg.Handlers {
+		event, ok := contractABI.Events[h.event]
+		if !ok {
+			return Processor{}, errors.New("event not found", "event", h.event)
+		} else if event.Anonymous {
+			return Processor{}, errors.New("anonymous events not supported", "event", h.event)
+		} else if _, ok := p.byTopic[event.ID]; ok {
+			return Processor{}, errors.New("duplicate event handler", "event", h.event)
+		}
+
+		p.handlers = append(p.handlers, handler{Handler: h, Event: event})
+		p.byTopic[event.ID] = nil // Populated below, once the handlers slice is final.
+	}
+
+	for i := range p.handlers {
+		p.byTopic[p.handlers[i].Event.ID] = &p.handlers[i]
+	}
+
+	return p, nil
+}
+
+func (p Processor) Name() string {
+	return p.name
+}
+
+func (p Processor) Addresses() []common.Address {
+	return []common.Address{p.address}
+}
+
+// Prepare returns all the contract's handled EVM event logs from the provided block hash.
+// It verifies that the logs are from the block, not removed, and ordered by log index.
+func (p Processor) Prepare(ctx context.Context, blockHash common.Hash) ([]*evmenginetypes.EVMEvent, error) {
+	topics := make([]common.Hash, 0, len(p.handlers))
+	for _, h := range p.handlers {
+		topics = append(topics, h.Event.ID)
+	}
+
+	logs, err := p.ethCl.FilterLogs(ctx, ethereum.FilterQuery{
+		BlockHash: &blockHash,
+		Addresses: p.Addresses(),
+		Topics:    [][]common.Hash{topics},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "filter logs")
+	}
+
+	resp := make([]*evmenginetypes.EVMEvent, 0, len(logs))
+	for i, l := range logs {
+		if l.BlockHash != blockHash {
+			return nil, errors.New("log block hash mismatch", "expected", blockHash, "actual", l.BlockHash)
+		} else if l.Removed {
+			return nil, errors.New("removed log", "index", l.Index)
+		} else if l.Address != p.address {
+			return nil, errors.New("log address mismatch", "expected", p.address, "actual", l.Address)
+		} else if i > 0 && l.Index <= logs[i-1].Index {
+			return nil, errors.New("logs not ordered", "index", l.Index, "prev", logs[i-1].Index)
+		}
+
+		resp = append(resp, toEVMEvent(l))
+	}
+
+	return resp, nil
+}
+
+// Deliver decodes the contract log event and delivers it to its handler.
+func (p Processor) Deliver(ctx context.Context, _ common.Hash, elog *evmenginetypes.EVMEvent) error {
+	ethlog := elog.ToEthLog()
+
+	if ethlog.Address != p.address {
+		return errors.New("unknown address", "address", ethlog.Address)
+	} else if len(ethlog.Topics) == 0 {
+		return errors.New("empty topics")
+	}
+
+	h, ok := p.byTopic[ethlog.Topics[0]]
+	if !ok {
+		return errors.New("unknown event")
+	}
+
+	ev, err := h.decode(h.Event, ethlog)
+	if err != nil {
+		failedEvents.WithLabelValues(p.name, h.event).Inc()
+		return errors.Wrap(err, "decode event", "event", h.event)
+	}
+
+	if err := h.deliver(ctx, ev); err != nil {
+		failedEvents.WithLabelValues(p.name, h.event).Inc()
+		return errors.Wrap(err, "deliver event", "event", h.event)
+	}
+
+	deliveredEvents.WithLabelValues(p.name, h.event).Inc()
+
+	return nil
+}
+
+// decode decodes the log into a new E, similar to abigen's Parse<Event> functions.
+func decode[E any](event abi.Event, elog types.Log) (*E, error) {
+	if len(elog.Topics) == 0 || elog.Topics[0] != event.ID {
+		return nil, errors.New("event signature mismatch")
+	}
+
+	ev := new(E)
+	if len(event.Inputs.NonIndexed()) > 0 {
+		// Note that copying must use all inputs (not only non-indexed) to copy fields by name, see abi.UnpackIntoInterface.
+		values, err := event.Inputs.Unpack(elog.Data)
+		if err != nil {
+			return nil, errors.Wrap(err, "unpack data")
+		} else if err := event.Inputs.Copy(ev, values); err != nil {
+			return nil, errors.Wrap(err, "copy data")
+		}
+	}
+
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+
+	if err := abi.ParseTopics(ev, indexed, elog.Topics[1:]); err != nil {
+		return nil, errors.Wrap(err, "parse topics")
+	}
+
+	if raw := reflect.ValueOf(ev).Elem().FieldByName("Raw"); raw.IsValid() && raw.Type() == reflect.TypeOf(elog) {
+		raw.Set(reflect.ValueOf(elog))
+	}
+
+	return ev, nil
+}
+
+// toEVMEvent converts the eth log to an EVMEvent.
+func toEVMEvent(l types.Log) *evmenginetypes.EVMEvent {
+	topics := make([][]byte, 0, len(l.Topics))
+	for _, t := range l.Topics {
+		topics = append(topics, t.Bytes())
+	}
+
+	return &evmenginetypes.EVMEvent{
+		Address: l.Address.Bytes(),
+		Topics:  topics,
+		Data:    l.Data,
+	}
+}
+
+// MustGetEvent returns the named event of the metadata's ABI.
+// It panics if the ABI is invalid or if the event is not found.
+func MustGetEvent(metadata *bind.MetaData, name string) abi.Event {
+	contractABI, err := metadata.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+
+	event, ok := contractABI.Events[name]
+	if !ok {
+		panic("event not found: " + name)
+	}
+
+	return event
+}
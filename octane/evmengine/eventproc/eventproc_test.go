@@ -0,0 +1,146 @@
+package eventproc_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/omni-network/omni/lib/tutil"
+	"github.com/omni-network/omni/octane/evmengine/eventproc"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+var testMetaData = &bind.MetaData{
+	ABI: `[
+		{"type":"event","name":"Deposit","anonymous":false,"inputs":[
+			{"name":"account","type":"address","indexed":true},
+			{"name":"amount","type":"uint256","indexed":false},
+			{"name":"memo","type":"string","indexed":false}
+		]},
+		{"type":"event","name":"Withdraw","anonymous":false,"inputs":[
+			{"name":"account","type":"address","indexed":true},
+			{"name":"id","type":"uint64","indexed":true},
+			{"name":"data","type":"bytes","indexed":false}
+		]},
+		{"type":"event","name":"Ignored","anonymous":false,"inputs":[]}
+	]`,
+}
+
+// testDeposit is the abigen binding of the Deposit event.
+type testDeposit struct {
+	Account common.Address
+	Amount  *big.Int
+	Memo    string
+	Raw     types.Log
+}
+
+// testWithdraw is the abigen binding of the Withdraw event.
+type testWithdraw struct {
+	Account common.Address
+	Id      uint64 //nolint:revive,stylecheck // Abigen naming.
+	Data    []byte
+	Raw     types.Log
+}
+
+func TestProcessor(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	address := tutil.RandomAddress()
+	blockHash := tutil.RandomHash()
+	ethCl := new(eventproc.StubLogClient)
+
+	var deposits []*testDeposit
+	var withdrawals []*testWithdraw
+	proc, err := eventproc.New(ethCl, eventproc.Config{
+		Name:    "test",
+		Address: address,
+		ABI:     testMetaData,
+		Handlers: []eventproc.Handler{
+			eventproc.Handle("Deposit", func(_ context.Context, ev *testDeposit) error {
+				deposits = append(deposits, ev)
+				return nil
+			}),
+			eventproc.Handle("Withdraw", func(_ context.Context, ev *testWithdraw) error {
+				withdrawals = append(withdrawals, ev)
+				return nil
+			}),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "test", proc.Name())
+	require.Equal(t, []common.Address{address}, proc.Addresses())
+
+	depositEvent := eventproc.MustGetEvent(testMetaData, "Deposit")
+	data, err := depositEvent.Inputs.NonIndexed().Pack(big.NewInt(100), "hello")
+	require.NoError(t, err)
+	account := tutil.RandomAddress()
+
+	deposit := types.Log{
+		Address:   address,
+		Topics:    []common.Hash{depositEvent.ID, common.BytesToHash(account.Bytes())},
+		Data:      data,
+		BlockHash: blockHash,
+		Index:     1,
+	}
+	ethCl.Logs = []types.Log{deposit}
+
+	events, err := proc.Prepare(ctx, blockHash)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	withdrawEvent := eventproc.MustGetEvent(testMetaData, "Withdraw")
+	require.Equal(t, [][]common.Hash{{depositEvent.ID, withdrawEvent.ID}}, ethCl.Query.Topics)
+	require.Equal(t, &blockHash, ethCl.Query.BlockHash)
+
+	require.NoError(t, proc.Deliver(ctx, blockHash, events[0]))
+	require.Len(t, deposits, 1)
+	require.Equal(t, account, deposits[0].Account)
+	require.Equal(t, big.NewInt(100), deposits[0].Amount)
+	require.Equal(t, "hello", deposits[0].Memo)
+	require.Equal(t, events[0].ToEthLog(), deposits[0].Raw)
+
+	// Log verification
+	second := deposit
+	second.Index = 0
+	ethCl.Logs = []types.Log{deposit, second}
+	_, err = proc.Prepare(ctx, blockHash)
+	require.ErrorContains(t, err, "logs not ordered")
+
+	ethCl.Logs = []types.Log{deposit}
+	_, err = proc.Prepare(ctx, tutil.RandomHash())
+	require.ErrorContains(t, err, "log block hash mismatch")
+
+	removed := deposit
+	removed.Removed = true
+	ethCl.Logs = []types.Log{removed}
+	_, err = proc.Prepare(ctx, blockHash)
+	require.ErrorContains(t, err, "removed log")
+
+	// Fuzz all handlers
+	eventproc.Fuzz(t, ctx, proc, 100)
+	require.NotEmpty(t, withdrawals)
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	noop := func(context.Context, *testDeposit) error { return nil }
+
+	_, err := eventproc.New(nil, eventproc.Config{Name: "test", ABI: testMetaData})
+	require.ErrorContains(t, err, "no handlers")
+
+	_, err = eventproc.New(nil, eventproc.Config{Name: "test", ABI: testMetaData, Handlers: []eventproc.Handler{
+		eventproc.Handle("Unknown", noop),
+	}})
+	require.ErrorContains(t, err, "event not found")
+
+	_, err = eventproc.New(nil, eventproc.Config{Name: "test", ABI: testMetaData, Handlers: []eventproc.Handler{
+		eventproc.Handle("Deposit", noop),
+		eventproc.Handle("Deposit", noop),
+	}})
+	require.ErrorContains(t, err, "duplicate event handler")
+}
@@ -0,0 +1,134 @@
+package eventproc
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/omni-network/omni/lib/errors"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	fuzz "github.com/google/gofuzz"
+	"github.com/stretchr/testify/require"
+)
+
+// fuzzer abstracts gofuzz.Fuzzer.
+type fuzzer interface {
+	Fuzz(obj any)
+}
+
+// Fuzz is a test harness that fuzzes all the processor's handlers.
+// For each handler and iteration it verifies that:
+//   - random events are encoded and decoded without loss,
+//   - malformed events (truncated data or missing topics) are rejected,
+//   - delivering random events doesn't panic (errors are expected, e.g. invalid amounts or addresses).
+//
+// Random events are delivered using the provided context, which is typically a cosmos SDK context
+// with the processor's dependencies (keepers) initialized.
+func Fuzz(t *testing.T, ctx context.Context, proc Processor, iterations int) {
+	t.Helper()
+
+	f := fuzz.New().NilChance(0).NumElements(0, 8).Funcs(
+		func(b *big.Int, c fuzz.Continue) {
+			b.SetUint64(uint64(c.Uint32())) // Fits all ABI integer types.
+		},
+		func(l *types.Log, _ fuzz.Continue) {
+			*l = types.Log{} // Raw logs are populated by decoding.
+		},
+	)
+
+	for _, h := range proc.handlers {
+		t.Run(h.event, func(t *testing.T) {
+			t.Helper()
+
+			for i := 0; i < iterations; i++ {
+				ev := h.random(f)
+				elog, err := encode(h.Event, proc.address, ev)
+				require.NoError(t, err)
+
+				// Round trip
+				decoded, err := h.decode(h.Event, elog)
+				require.NoError(t, err)
+				reencoded, err := encode(h.Event, proc.address, decoded)
+				require.NoError(t, err)
+				require.Equal(t, elog, reencoded)
+
+				// Malformed
+				if len(elog.Data) > 0 {
+					truncated := elog
+					truncated.Data = elog.Data[:len(elog.Data)-32] // Remove last word, since padding isn't verified.
+					require.Error(t, proc.Deliver(ctx, common.Hash{}, toEVMEvent(truncated)))
+				}
+				if len(elog.Topics) > 1 {
+					missing := elog
+					missing.Topics = elog.Topics[:len(elog.Topics)-1]
+					require.Error(t, proc.Deliver(ctx, common.Hash{}, toEVMEvent(missing)))
+				}
+
+				// Deliver
+				require.NotPanics(t, func() {
+					_ = proc.Deliver(ctx, common.Hash{}, toEVMEvent(elog))
+				})
+			}
+		})
+	}
+
+	unknown := toEVMEvent(types.Log{Address: proc.address, Topics: []common.Hash{{}}})
+	require.ErrorContains(t, proc.Deliver(ctx, common.Hash{}, unknown), "unknown event")
+}
+
+// encode returns the event (as decoded by Handle) encoded as a contract log.
+func encode(event abi.Event, address common.Address, ev any) (types.Log, error) {
+	v := reflect.ValueOf(ev)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return types.Log{}, errors.New("event not a struct pointer")
+	}
+	v = v.Elem()
+
+	var (
+		values  []any
+		indexed [][]any
+	)
+	for _, arg := range event.Inputs {
+		field := v.FieldByName(abi.ToCamelCase(arg.Name))
+		if !field.IsValid() {
+			return types.Log{}, errors.New("missing event field", "arg", arg.Name)
+		}
+
+		if arg.Indexed {
+			indexed = append(indexed, []any{field.Interface()})
+		} else {
+			values = append(values, field.Interface())
+		}
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(values...)
+	if err != nil {
+		return types.Log{}, errors.Wrap(err, "pack data")
+	}
+
+	topics, err := abi.MakeTopics(indexed...)
+	if err != nil {
+		return types.Log{}, errors.Wrap(err, "make topics")
+	}
+
+	elog := types.Log{
+		Address: address,
+		Topics:  []common.Hash{event.ID},
+		Data:    data,
+	}
+	for _, topic := range topics {
+		elog.Topics = append(elog.Topics, topic[0])
+	}
+
+	if bytes.Equal(elog.Data, []byte{}) {
+		elog.Data = nil // Normalise empty data.
+	}
+
+	return elog, nil
+}
@@ -0,0 +1,22 @@
+package eventproc
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	deliveredEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "octane",
+		Subsystem: "evmengine",
+		Name:      "delivered_events_total",
+		Help:      "Total number of successfully delivered EVM log events by processor and event",
+	}, []string{"processor", "event"})
+
+	failedEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "octane",
+		Subsystem: "evmengine",
+		Name:      "failed_events_total",
+		Help:      "Total number of EVM log events that failed delivery by processor and event",
+	}, []string{"processor", "event"})
+)
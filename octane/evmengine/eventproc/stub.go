@@ -0,0 +1,31 @@
+package eventproc
+
+import (
+	"context"
+
+	"github.com/omni-network/omni/lib/ethclient"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// StubLogClient is an ethclient.Client for tests that returns the configured logs for all FilterLogs queries.
+type StubLogClient struct {
+	ethclient.Client
+
+	Logs  []types.Log          // Returned by FilterLogs.
+	Query ethereum.FilterQuery // Last FilterLogs query.
+}
+
+func (c *StubLogClient) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	c.Query = q
+	return c.Logs, nil
+}
+
+// StubUpgrades returns the done heights of network upgrades for tests.
+// It is a map, so done heights can be changed after the event handlers were bound.
+type StubUpgrades map[string]int64
+
+func (u StubUpgrades) GetDoneHeight(_ context.Context, name string) (int64, error) {
+	return u[name], nil
+}
@@ -18,7 +18,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
-	etypes "github.com/ethereum/go-ethereum/core/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -56,11 +55,11 @@ func (k *Keeper) PrepareProposal(ctx sdk.Context, req *abci.RequestPreparePropos
 	appHash := common.BytesToHash(ctx.BlockHeader().AppHash)
 
 	// Either use the optimistic payload or create a new one.
-	payloadID, height, triggeredAt := k.getOptimisticPayload()
+	payloadID, height, timestamp, triggeredAt := k.getOptimisticPayload()
 	if uint64(req.Height) != height {
 		// Create a new payload (retrying on network errors).
 		err := retryForever(ctx, func(ctx context.Context) (bool, error) {
-			response, err := k.startBuild(ctx, appHash, req.Time)
+			response, ts, err := k.startBuild(ctx, appHash, req.Time)
 			if err != nil {
 				log.Warn(ctx, "Preparing proposal failed: build new evm payload (will retry)", err)
 				return false, nil
@@ -69,6 +68,7 @@ func (k *Keeper) PrepareProposal(ctx sdk.Context, req *abci.RequestPreparePropos
 			}
 
 			payloadID = response.PayloadID
+			timestamp = ts
 
 			return true, nil
 		})
@@ -89,10 +89,13 @@ func (k *Keeper) PrepareProposal(ctx sdk.Context, req *abci.RequestPreparePropos
 	}
 
 	// Fetch the payload (retrying on network errors).
-	var payloadResp *engine.ExecutionPayloadEnvelope
+	var (
+		payload  *engine.ExecutableData
+		requests [][]byte
+	)
 	err := retryForever(ctx, func(ctx context.Context) (bool, error) {
 		var err error
-		payloadResp, err = k.engineCl.GetPayloadV3(ctx, *payloadID)
+		payload, requests, err = k.getPayload(ctx, *payloadID, timestamp)
 		if isUnknownPayload(err) {
 			return false, err
 		} else if err != nil {
@@ -107,7 +110,7 @@ func (k *Keeper) PrepareProposal(ctx sdk.Context, req *abci.RequestPreparePropos
 	}
 
 	// Create execution payload message
-	payloadData, err := json.Marshal(payloadResp.ExecutionPayload)
+	payloadData, err := json.Marshal(payload)
 	if err != nil {
 		return nil, errors.Wrap(err, "encode")
 	}
@@ -119,7 +122,7 @@ func (k *Keeper) PrepareProposal(ctx sdk.Context, req *abci.RequestPreparePropos
 	}
 
 	// Next, collect all prev payload evm event logs.
-	evmEvents, err := k.evmEvents(ctx, payloadResp.ExecutionPayload.ParentHash)
+	evmEvents, err := k.evmEvents(ctx, payload.ParentHash)
 	if err != nil {
 		return nil, errors.Wrap(err, "prepare evm event logs")
 	}
@@ -129,6 +132,7 @@ func (k *Keeper) PrepareProposal(ctx sdk.Context, req *abci.RequestPreparePropos
 		Authority:         authtypes.NewModuleAddress(types.ModuleName).String(),
 		ExecutionPayload:  payloadData,
 		PrevPayloadEvents: evmEvents,
+		ExecutionRequests: requests,
 	}
 
 	// Combine all the votes messages and the payload message into a single transaction.
@@ -145,7 +149,7 @@ func (k *Keeper) PrepareProposal(ctx sdk.Context, req *abci.RequestPreparePropos
 
 	log.Info(ctx, "Proposing new block",
 		"height", req.Height,
-		log.Hex7("execution_block_hash", payloadResp.ExecutionPayload.BlockHash[:]),
+		log.Hex7("execution_block_hash", payload.BlockHash[:]),
 		"vote_msgs", len(voteMsgs),
 		"evm_events", len(evmEvents),
 	)
@@ -182,7 +186,7 @@ func (k *Keeper) PostFinalize(ctx sdk.Context) error {
 	logAttr := slog.Int64("next_height", nextHeight)
 	log.Debug(ctx, "Starting optimistic EVM payload build", logAttr)
 
-	fcr, err := k.startBuild(ctx, appHash, timestamp)
+	fcr, ts, err := k.startBuild(ctx, appHash, timestamp)
 	if err != nil || isUnknown(fcr.PayloadStatus) {
 		log.Warn(ctx, "Starting optimistic build failed", err, logAttr)
 		return nil
@@ -194,17 +198,19 @@ func (k *Keeper) PostFinalize(ctx sdk.Context) error {
 		return nil
 	}
 
-	k.setOptimisticPayload(fcr.PayloadID, uint64(nextHeight))
+	k.setOptimisticPayload(fcr.PayloadID, uint64(nextHeight), ts)
 
 	return nil
 }
 
 // startBuild triggers the building of a new execution payload on top of the current execution head.
-// It returns the EngineAPI response which contains a status and payload ID.
-func (k *Keeper) startBuild(ctx context.Context, appHash common.Hash, timestamp time.Time) (engine.ForkChoiceResponse, error) {
+// It returns the EngineAPI response which contains a status and payload ID, and the payload timestamp.
+//
+// Note that Prague doesn't introduce ForkchoiceUpdatedV4, so ForkchoiceUpdatedV3 is used for all payloads.
+func (k *Keeper) startBuild(ctx context.Context, appHash common.Hash, timestamp time.Time) (engine.ForkChoiceResponse, uint64, error) {
 	head, err := k.getExecutionHead(ctx)
 	if err != nil {
-		return engine.ForkChoiceResponse{}, errors.Wrap(err, "latest execution block")
+		return engine.ForkChoiceResponse{}, 0, errors.Wrap(err, "latest execution block")
 	}
 
 	// Use provided time as timestamp for the next block.
@@ -215,6 +221,11 @@ func (k *Keeper) startBuild(ctx context.Context, appHash common.Hash, timestamp
 		ts = head.GetBlockTime() + 1 // Subsequent blocks must have a higher timestamp.
 	}
 
+	withdrawals, err := k.nextWithdrawals(ctx)
+	if err != nil {
+		return engine.ForkChoiceResponse{}, 0, err
+	}
+
 	// CometBFT has instant finality, so head/safe/finalized is latest height.
 	fcs := engine.ForkchoiceStateV1{
 		HeadBlockHash:      head.Hash(),
@@ -226,16 +237,36 @@ func (k *Keeper) startBuild(ctx context.Context, appHash common.Hash, timestamp
 		Timestamp:             ts,
 		Random:                head.Hash(), // We use head block hash as randao.
 		SuggestedFeeRecipient: k.feeRecProvider.LocalFeeRecipient(),
-		Withdrawals:           []*etypes.Withdrawal{}, // Withdrawals not supported yet.
+		Withdrawals:           withdrawals,
 		BeaconRoot:            &appHash,
 	}
 
 	resp, err := k.engineCl.ForkchoiceUpdatedV3(ctx, fcs, attrs)
 	if err != nil {
-		return engine.ForkChoiceResponse{}, errors.Wrap(err, "forkchoice update")
+		return engine.ForkChoiceResponse{}, 0, errors.Wrap(err, "forkchoice update")
+	}
+
+	return resp, ts, nil
+}
+
+// getPayload returns the built execution payload with the provided timestamp and its EIP-7685 execution requests.
+// It uses Engine API V4 from the Prague fork, otherwise V3 in which case the execution requests are nil.
+func (k *Keeper) getPayload(ctx context.Context, payloadID engine.PayloadID, timestamp uint64) (*engine.ExecutableData, [][]byte, error) {
+	if k.isPrague(timestamp) {
+		resp, err := k.engineCl.GetPayloadV4(ctx, payloadID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return resp.ExecutionPayload, resp.Requests, nil
+	}
+
+	resp, err := k.engineCl.GetPayloadV3(ctx, payloadID)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return resp, nil
+	return resp.ExecutionPayload, nil, nil
 }
 
 // isUnknownPayload returns true if the error is due to an unknown payload.
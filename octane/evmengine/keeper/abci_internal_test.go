@@ -302,8 +302,9 @@ func TestOptimistic(t *testing.T) {
 	err = keeper.PostFinalize(ctx)
 	require.NoError(t, err)
 
-	payloadID, h, ts := keeper.getOptimisticPayload()
+	payloadID, h, payloadTS, ts := keeper.getOptimisticPayload()
 	require.EqualValues(t, height+1, h)
+	require.EqualValues(t, timestamp.Unix(), payloadTS)
 	require.NotEmpty(t, ts)
 
 	b, err := mockEngine.HeaderByType(ctx, ethclient.HeadLatest)
@@ -535,6 +536,14 @@ func (m *mockEngineAPI) GetPayloadV3(ctx context.Context, payloadID eengine.Payl
 	return m.mock.GetPayloadV3(ctx, payloadID)
 }
 
+func (m *mockEngineAPI) NewPayloadV4(ctx context.Context, params eengine.ExecutableData, versionedHashes []common.Hash, beaconRoot *common.Hash, executionRequests [][]byte) (eengine.PayloadStatusV1, error) {
+	return m.mock.NewPayloadV4(ctx, params, versionedHashes, beaconRoot, executionRequests)
+}
+
+func (m *mockEngineAPI) GetPayloadV4(ctx context.Context, payloadID eengine.PayloadID) (*ethclient.ExecutionPayloadEnvelopeV4, error) {
+	return m.mock.GetPayloadV4(ctx, payloadID)
+}
+
 // pushPayload - invokes the ForkchoiceUpdatedV2 method on the mock engine and returns the payload ID.
 func (m *mockEngineAPI) pushPayload(t *testing.T, ctx context.Context, feeRecipient common.Address, blockHash common.Hash, ts time.Time, appHash common.Hash) *eengine.PayloadID {
 	t.Helper()
@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 
 	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ormgenesis"
+	"github.com/omni-network/omni/octane/evmengine/types"
 
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
@@ -43,6 +46,47 @@ func (k *Keeper) InsertGenesisHead(ctx context.Context, executionBlockHash []byt
 	return nil
 }
 
+// InitGenesis initializes the module state from the genesis state.
+// New networks only define the execution genesis block hash, while exported genesis states also include all tables.
+func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if len(data.GetTables()) == 0 {
+		return k.InsertGenesisHead(ctx, data.GetExecutionBlockHash())
+	}
+
+	if err := ormgenesis.Import(ctx, k.modDB, data.GetTables()); err != nil {
+		return errors.Wrap(err, "import tables")
+	}
+
+	head, err := k.getExecutionHead(ctx)
+	if err != nil {
+		return err
+	} else if !bytes.Equal(head.GetBlockHash(), data.GetExecutionBlockHash()) {
+		return errors.New("genesis execution block hash mismatch",
+			"head", head.Hash(), "genesis", common.BytesToHash(data.GetExecutionBlockHash()))
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the genesis state containing all tables.
+// The execution block hash is the current execution head, since that is what the exported state builds on top of.
+func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	head, err := k.getExecutionHead(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := ormgenesis.Export(ctx, k.modDB)
+	if err != nil {
+		return nil, errors.Wrap(err, "export tables")
+	}
+
+	return &types.GenesisState{
+		ExecutionBlockHash: head.GetBlockHash(),
+		Tables:             tables,
+	}, nil
+}
+
 // getExecutionHead returns the current execution head.
 func (k *Keeper) getExecutionHead(ctx context.Context) (*ExecutionHead, error) {
 	head, err := k.headTable.Get(ctx, executionHeadID)
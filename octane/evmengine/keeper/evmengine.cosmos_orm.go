@@ -133,20 +133,298 @@ func NewExecutionHeadTable(db ormtable.Schema) (ExecutionHeadTable, error) {
 	return executionHeadTable{table.(ormtable.AutoIncrementTable)}, nil
 }
 
+type WithdrawalTable interface {
+	Insert(ctx context.Context, withdrawal *Withdrawal) error
+	InsertReturningId(ctx context.Context, withdrawal *Withdrawal) (uint64, error)
+	LastInsertedSequence(ctx context.Context) (uint64, error)
+	Update(ctx context.Context, withdrawal *Withdrawal) error
+	Save(ctx context.Context, withdrawal *Withdrawal) error
+	Delete(ctx context.Context, withdrawal *Withdrawal) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Withdrawal, error)
+	List(ctx context.Context, prefixKey WithdrawalIndexKey, opts ...ormlist.Option) (WithdrawalIterator, error)
+	ListRange(ctx context.Context, from, to WithdrawalIndexKey, opts ...ormlist.Option) (WithdrawalIterator, error)
+	DeleteBy(ctx context.Context, prefixKey WithdrawalIndexKey) error
+	DeleteRange(ctx context.Context, from, to WithdrawalIndexKey) error
+
+	doNotImplement()
+}
+
+type WithdrawalIterator struct {
+	ormtable.Iterator
+}
+
+func (i WithdrawalIterator) Value() (*Withdrawal, error) {
+	var withdrawal Withdrawal
+	err := i.UnmarshalMessage(&withdrawal)
+	return &withdrawal, err
+}
+
+type WithdrawalIndexKey interface {
+	id() uint32
+	values() []interface{}
+	withdrawalIndexKey()
+}
+
+// primary key starting index..
+type WithdrawalPrimaryKey = WithdrawalIdIndexKey
+
+type WithdrawalIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x WithdrawalIdIndexKey) id() uint32            { return 0 }
+func (x WithdrawalIdIndexKey) values() []interface{} { return x.vs }
+func (x WithdrawalIdIndexKey) withdrawalIndexKey()   {}
+
+func (this WithdrawalIdIndexKey) WithId(id uint64) WithdrawalIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type withdrawalTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this withdrawalTable) Insert(ctx context.Context, withdrawal *Withdrawal) error {
+	return this.table.Insert(ctx, withdrawal)
+}
+
+func (this withdrawalTable) Update(ctx context.Context, withdrawal *Withdrawal) error {
+	return this.table.Update(ctx, withdrawal)
+}
+
+func (this withdrawalTable) Save(ctx context.Context, withdrawal *Withdrawal) error {
+	return this.table.Save(ctx, withdrawal)
+}
+
+func (this withdrawalTable) Delete(ctx context.Context, withdrawal *Withdrawal) error {
+	return this.table.Delete(ctx, withdrawal)
+}
+
+func (this withdrawalTable) InsertReturningId(ctx context.Context, withdrawal *Withdrawal) (uint64, error) {
+	return this.table.InsertReturningPKey(ctx, withdrawal)
+}
+
+func (this withdrawalTable) LastInsertedSequence(ctx context.Context) (uint64, error) {
+	return this.table.LastInsertedSequence(ctx)
+}
+
+func (this withdrawalTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this withdrawalTable) Get(ctx context.Context, id uint64) (*Withdrawal, error) {
+	var withdrawal Withdrawal
+	found, err := this.table.PrimaryKey().Get(ctx, &withdrawal, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &withdrawal, nil
+}
+
+func (this withdrawalTable) List(ctx context.Context, prefixKey WithdrawalIndexKey, opts ...ormlist.Option) (WithdrawalIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return WithdrawalIterator{it}, err
+}
+
+func (this withdrawalTable) ListRange(ctx context.Context, from, to WithdrawalIndexKey, opts ...ormlist.Option) (WithdrawalIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return WithdrawalIterator{it}, err
+}
+
+func (this withdrawalTable) DeleteBy(ctx context.Context, prefixKey WithdrawalIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this withdrawalTable) DeleteRange(ctx context.Context, from, to WithdrawalIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this withdrawalTable) doNotImplement() {}
+
+var _ WithdrawalTable = withdrawalTable{}
+
+func NewWithdrawalTable(db ormtable.Schema) (WithdrawalTable, error) {
+	table := db.GetTable(&Withdrawal{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Withdrawal{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return withdrawalTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type StakingEventTable interface {
+	Insert(ctx context.Context, stakingEvent *StakingEvent) error
+	InsertReturningId(ctx context.Context, stakingEvent *StakingEvent) (uint64, error)
+	LastInsertedSequence(ctx context.Context) (uint64, error)
+	Update(ctx context.Context, stakingEvent *StakingEvent) error
+	Save(ctx context.Context, stakingEvent *StakingEvent) error
+	Delete(ctx context.Context, stakingEvent *StakingEvent) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*StakingEvent, error)
+	List(ctx context.Context, prefixKey StakingEventIndexKey, opts ...ormlist.Option) (StakingEventIterator, error)
+	ListRange(ctx context.Context, from, to StakingEventIndexKey, opts ...ormlist.Option) (StakingEventIterator, error)
+	DeleteBy(ctx context.Context, prefixKey StakingEventIndexKey) error
+	DeleteRange(ctx context.Context, from, to StakingEventIndexKey) error
+
+	doNotImplement()
+}
+
+type StakingEventIterator struct {
+	ormtable.Iterator
+}
+
+func (i StakingEventIterator) Value() (*StakingEvent, error) {
+	var stakingEvent StakingEvent
+	err := i.UnmarshalMessage(&stakingEvent)
+	return &stakingEvent, err
+}
+
+type StakingEventIndexKey interface {
+	id() uint32
+	values() []interface{}
+	stakingEventIndexKey()
+}
+
+// primary key starting index..
+type StakingEventPrimaryKey = StakingEventIdIndexKey
+
+type StakingEventIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x StakingEventIdIndexKey) id() uint32            { return 0 }
+func (x StakingEventIdIndexKey) values() []interface{} { return x.vs }
+func (x StakingEventIdIndexKey) stakingEventIndexKey() {}
+
+func (this StakingEventIdIndexKey) WithId(id uint64) StakingEventIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type StakingEventValidatorIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x StakingEventValidatorIdIndexKey) id() uint32            { return 2 }
+func (x StakingEventValidatorIdIndexKey) values() []interface{} { return x.vs }
+func (x StakingEventValidatorIdIndexKey) stakingEventIndexKey() {}
+
+func (this StakingEventValidatorIdIndexKey) WithValidator(validator []byte) StakingEventValidatorIdIndexKey {
+	this.vs = []interface{}{validator}
+	return this
+}
+
+func (this StakingEventValidatorIdIndexKey) WithValidatorId(validator []byte, id uint64) StakingEventValidatorIdIndexKey {
+	this.vs = []interface{}{validator, id}
+	return this
+}
+
+type stakingEventTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this stakingEventTable) Insert(ctx context.Context, stakingEvent *StakingEvent) error {
+	return this.table.Insert(ctx, stakingEvent)
+}
+
+func (this stakingEventTable) Update(ctx context.Context, stakingEvent *StakingEvent) error {
+	return this.table.Update(ctx, stakingEvent)
+}
+
+func (this stakingEventTable) Save(ctx context.Context, stakingEvent *StakingEvent) error {
+	return this.table.Save(ctx, stakingEvent)
+}
+
+func (this stakingEventTable) Delete(ctx context.Context, stakingEvent *StakingEvent) error {
+	return this.table.Delete(ctx, stakingEvent)
+}
+
+func (this stakingEventTable) InsertReturningId(ctx context.Context, stakingEvent *StakingEvent) (uint64, error) {
+	return this.table.InsertReturningPKey(ctx, stakingEvent)
+}
+
+func (this stakingEventTable) LastInsertedSequence(ctx context.Context) (uint64, error) {
+	return this.table.LastInsertedSequence(ctx)
+}
+
+func (this stakingEventTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this stakingEventTable) Get(ctx context.Context, id uint64) (*StakingEvent, error) {
+	var stakingEvent StakingEvent
+	found, err := this.table.PrimaryKey().Get(ctx, &stakingEvent, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &stakingEvent, nil
+}
+
+func (this stakingEventTable) List(ctx context.Context, prefixKey StakingEventIndexKey, opts ...ormlist.Option) (StakingEventIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return StakingEventIterator{it}, err
+}
+
+func (this stakingEventTable) ListRange(ctx context.Context, from, to StakingEventIndexKey, opts ...ormlist.Option) (StakingEventIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return StakingEventIterator{it}, err
+}
+
+func (this stakingEventTable) DeleteBy(ctx context.Context, prefixKey StakingEventIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this stakingEventTable) DeleteRange(ctx context.Context, from, to StakingEventIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this stakingEventTable) doNotImplement() {}
+
+var _ StakingEventTable = stakingEventTable{}
+
+func NewStakingEventTable(db ormtable.Schema) (StakingEventTable, error) {
+	table := db.GetTable(&StakingEvent{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&StakingEvent{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return stakingEventTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
 type EvmengineStore interface {
 	ExecutionHeadTable() ExecutionHeadTable
+	WithdrawalTable() WithdrawalTable
+	StakingEventTable() StakingEventTable
 
 	doNotImplement()
 }
 
 type evmengineStore struct {
 	executionHead ExecutionHeadTable
+	withdrawal    WithdrawalTable
+	stakingEvent  StakingEventTable
 }
 
 func (x evmengineStore) ExecutionHeadTable() ExecutionHeadTable {
 	return x.executionHead
 }
 
+func (x evmengineStore) WithdrawalTable() WithdrawalTable {
+	return x.withdrawal
+}
+
+func (x evmengineStore) StakingEventTable() StakingEventTable {
+	return x.stakingEvent
+}
+
 func (evmengineStore) doNotImplement() {}
 
 var _ EvmengineStore = evmengineStore{}
@@ -157,7 +435,19 @@ func NewEvmengineStore(db ormtable.Schema) (EvmengineStore, error) {
 		return nil, err
 	}
 
+	withdrawalTable, err := NewWithdrawalTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	stakingEventTable, err := NewStakingEventTable(db)
+	if err != nil {
+		return nil, err
+	}
+
 	return evmengineStore{
 		executionHeadTable,
+		withdrawalTable,
+		stakingEventTable,
 	}, nil
 }
@@ -102,6 +102,176 @@ func (x *ExecutionHead) GetBlockTime() uint64 {
 	return 0
 }
 
+// Withdrawal defines a pending EVM withdrawal in the withdrawal queue.
+// Withdrawals are included in execution payloads in ID order.
+type Withdrawal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                            // Auto-incremented ID, also the EVM withdrawal index.
+	Address       []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`                                   // EVM address to credit (20 bytes).
+	AmountGwei    uint64 `protobuf:"varint,3,opt,name=amount_gwei,json=amountGwei,proto3" json:"amount_gwei,omitempty"`          // Amount to credit in gwei.
+	CreatedHeight uint64 `protobuf:"varint,4,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"` // Consensus chain height this withdrawal was created in.
+}
+
+func (x *Withdrawal) Reset() {
+	*x = Withdrawal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octane_evmengine_keeper_evmengine_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Withdrawal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Withdrawal) ProtoMessage() {}
+
+func (x *Withdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_octane_evmengine_keeper_evmengine_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Withdrawal.ProtoReflect.Descriptor instead.
+func (*Withdrawal) Descriptor() ([]byte, []int) {
+	return file_octane_evmengine_keeper_evmengine_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Withdrawal) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Withdrawal) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Withdrawal) GetAmountGwei() uint64 {
+	if x != nil {
+		return x.AmountGwei
+	}
+	return 0
+}
+
+func (x *Withdrawal) GetCreatedHeight() uint64 {
+	if x != nil {
+		return x.CreatedHeight
+	}
+	return 0
+}
+
+// StakingEvent defines a x/staking lifecycle event recorded by the staking hooks.
+// It provides an on-chain audit trail of validator and delegation changes.
+type StakingEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                            // Auto-incremented ID.
+	CreatedHeight uint64 `protobuf:"varint,2,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"` // Consensus chain height this event was created in.
+	Type          int32  `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`                                        // Staking event type, see types.StakingEventType.
+	Validator     []byte `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`                               // Validator operator address (20 bytes), empty for unbonding initiated events.
+	Delegator     []byte `protobuf:"bytes,5,opt,name=delegator,proto3" json:"delegator,omitempty"`                               // Delegator address (20 bytes), only for delegation events.
+	SlashFraction string `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3" json:"slash_fraction,omitempty"`  // Slash fraction, only for slashed events.
+	UnbondingId   uint64 `protobuf:"varint,7,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`       // Unbonding ID, only for unbonding initiated events.
+}
+
+func (x *StakingEvent) Reset() {
+	*x = StakingEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octane_evmengine_keeper_evmengine_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingEvent) ProtoMessage() {}
+
+func (x *StakingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_octane_evmengine_keeper_evmengine_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingEvent.ProtoReflect.Descriptor instead.
+func (*StakingEvent) Descriptor() ([]byte, []int) {
+	return file_octane_evmengine_keeper_evmengine_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StakingEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StakingEvent) GetCreatedHeight() uint64 {
+	if x != nil {
+		return x.CreatedHeight
+	}
+	return 0
+}
+
+func (x *StakingEvent) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *StakingEvent) GetValidator() []byte {
+	if x != nil {
+		return x.Validator
+	}
+	return nil
+}
+
+func (x *StakingEvent) GetDelegator() []byte {
+	if x != nil {
+		return x.Delegator
+	}
+	return nil
+}
+
+func (x *StakingEvent) GetSlashFraction() string {
+	if x != nil {
+		return x.SlashFraction
+	}
+	return ""
+}
+
+func (x *StakingEvent) GetUnbondingId() uint64 {
+	if x != nil {
+		return x.UnbondingId
+	}
+	return 0
+}
+
 var File_octane_evmengine_keeper_evmengine_proto protoreflect.FileDescriptor
 
 var file_octane_evmengine_keeper_evmengine_proto_rawDesc = []byte{
@@ -122,22 +292,47 @@ var file_octane_evmengine_keeper_evmengine_proto_rawDesc = []byte{
 	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
 	0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
 	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x3a, 0x10, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x0a, 0x0a, 0x06, 0x0a,
-	0x02, 0x69, 0x64, 0x10, 0x01, 0x18, 0x01, 0x42, 0xe1, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e,
-	0x6f, 0x63, 0x74, 0x61, 0x6e, 0x65, 0x2e, 0x65, 0x76, 0x6d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x2e, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x42, 0x0e, 0x45, 0x76, 0x6d, 0x65, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2f, 0x6f, 0x63, 0x74, 0x61, 0x6e, 0x65, 0x2f, 0x65,
-	0x76, 0x6d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0xa2,
-	0x02, 0x03, 0x4f, 0x45, 0x4b, 0xaa, 0x02, 0x17, 0x4f, 0x63, 0x74, 0x61, 0x6e, 0x65, 0x2e, 0x45,
-	0x76, 0x6d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0xca,
-	0x02, 0x17, 0x4f, 0x63, 0x74, 0x61, 0x6e, 0x65, 0x5c, 0x45, 0x76, 0x6d, 0x65, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x5c, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0xe2, 0x02, 0x23, 0x4f, 0x63, 0x74, 0x61,
-	0x6e, 0x65, 0x5c, 0x45, 0x76, 0x6d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5c, 0x4b, 0x65, 0x65,
-	0x70, 0x65, 0x72, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x19, 0x4f, 0x63, 0x74, 0x61, 0x6e, 0x65, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x65, 0x6e, 0x67,
-	0x69, 0x6e, 0x65, 0x3a, 0x3a, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x02, 0x69, 0x64, 0x10, 0x01, 0x18, 0x01, 0x22, 0x90, 0x01, 0x0a, 0x0a, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x67, 0x77, 0x65, 0x69, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x77, 0x65,
+	0x69, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x10, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x0a,
+	0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x18, 0x02, 0x22, 0x83, 0x02, 0x0a, 0x0c, 0x53,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x62,
+	0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x3a, 0x22, 0xf2, 0x9e,
+	0xd3, 0x8e, 0x03, 0x1c, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2c, 0x69, 0x64, 0x10, 0x02, 0x18, 0x03,
+	0x42, 0xe1, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x63, 0x74, 0x61, 0x6e, 0x65, 0x2e,
+	0x65, 0x76, 0x6d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72,
+	0x42, 0x0e, 0x45, 0x76, 0x6d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f,
+	0x6d, 0x6e, 0x69, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x6d, 0x6e, 0x69,
+	0x2f, 0x6f, 0x63, 0x74, 0x61, 0x6e, 0x65, 0x2f, 0x65, 0x76, 0x6d, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2f, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x4f, 0x45, 0x4b, 0xaa, 0x02,
+	0x17, 0x4f, 0x63, 0x74, 0x61, 0x6e, 0x65, 0x2e, 0x45, 0x76, 0x6d, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2e, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0xca, 0x02, 0x17, 0x4f, 0x63, 0x74, 0x61, 0x6e,
+	0x65, 0x5c, 0x45, 0x76, 0x6d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5c, 0x4b, 0x65, 0x65, 0x70,
+	0x65, 0x72, 0xe2, 0x02, 0x23, 0x4f, 0x63, 0x74, 0x61, 0x6e, 0x65, 0x5c, 0x45, 0x76, 0x6d, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5c, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x4f, 0x63, 0x74, 0x61, 0x6e,
+	0x65, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x3a, 0x3a, 0x4b, 0x65,
+	0x65, 0x70, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -152,9 +347,11 @@ func file_octane_evmengine_keeper_evmengine_proto_rawDescGZIP() []byte {
 	return file_octane_evmengine_keeper_evmengine_proto_rawDescData
 }
 
-var file_octane_evmengine_keeper_evmengine_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_octane_evmengine_keeper_evmengine_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_octane_evmengine_keeper_evmengine_proto_goTypes = []any{
 	(*ExecutionHead)(nil), // 0: octane.evmengine.keeper.ExecutionHead
+	(*Withdrawal)(nil),    // 1: octane.evmengine.keeper.Withdrawal
+	(*StakingEvent)(nil),  // 2: octane.evmengine.keeper.StakingEvent
 }
 var file_octane_evmengine_keeper_evmengine_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -182,6 +379,30 @@ func file_octane_evmengine_keeper_evmengine_proto_init() {
 				return nil
 			}
 		}
+		file_octane_evmengine_keeper_evmengine_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Withdrawal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octane_evmengine_keeper_evmengine_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*StakingEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -189,7 +410,7 @@ func file_octane_evmengine_keeper_evmengine_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_octane_evmengine_keeper_evmengine_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -3,72 +3,156 @@ package keeper
 import (
 	"context"
 
+	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/log"
+	etypes "github.com/omni-network/omni/octane/evmengine/types"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
+// maxStakingEvents is the maximum number of staking events retained in the staking history.
+// Older events are pruned as new events are recorded.
+const maxStakingEvents = 100_000
+
 var _ types.StakingHooks = Hooks{}
 
-// Hooks implements the staking hooks. It just logs at this point.
-type Hooks struct{}
+// Hooks implements the staking hooks.
+// It records staking lifecycle events in the evmengine staking history.
+type Hooks struct {
+	k *Keeper
+}
+
+// Hooks returns the keeper's staking hooks.
+func (k *Keeper) Hooks() Hooks {
+	return Hooks{k: k}
+}
 
-// AfterValidatorBonded updates the signing info start height or create a new signing info.
-func (Hooks) AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+// AfterValidatorBonded records a validator bonded event.
+func (h Hooks) AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	log.Debug(ctx, "📚 Validator bonded", "cons_addr", consAddr, "val_addr", valAddr)
-	return nil
+	return h.k.recordStakingEvent(ctx, &StakingEvent{
+		Type:      int32(etypes.StakingEventType_STAKING_EVENT_TYPE_VALIDATOR_BONDED),
+		Validator: valAddr,
+	})
 }
 
-// AfterValidatorRemoved deletes the address-pubkey relation when a validator is removed,.
-func (Hooks) AfterValidatorRemoved(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+// AfterValidatorRemoved records a validator removed event.
+func (h Hooks) AfterValidatorRemoved(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	log.Debug(ctx, "📚 Validator removed", "cons_addr", consAddr, "val_addr", valAddr)
-	return nil
+	return h.k.recordStakingEvent(ctx, &StakingEvent{
+		Type:      int32(etypes.StakingEventType_STAKING_EVENT_TYPE_VALIDATOR_REMOVED),
+		Validator: valAddr,
+	})
 }
 
-// AfterValidatorCreated adds the address-pubkey relation when a validator is created.
-func (Hooks) AfterValidatorCreated(ctx context.Context, valAddr sdk.ValAddress) error {
+// AfterValidatorCreated records a validator created event.
+func (h Hooks) AfterValidatorCreated(ctx context.Context, valAddr sdk.ValAddress) error {
 	log.Debug(ctx, "📚 Validator created", "val_addr", valAddr)
-	return nil
+	return h.k.recordStakingEvent(ctx, &StakingEvent{
+		Type:      int32(etypes.StakingEventType_STAKING_EVENT_TYPE_VALIDATOR_CREATED),
+		Validator: valAddr,
+	})
 }
 
-func (Hooks) AfterValidatorBeginUnbonding(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+// AfterValidatorBeginUnbonding records a validator begin unbonding event.
+func (h Hooks) AfterValidatorBeginUnbonding(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	log.Debug(ctx, "📚 Validator begin unbonding", "cons_addr", consAddr, "val_addr", valAddr)
-	return nil
+	return h.k.recordStakingEvent(ctx, &StakingEvent{
+		Type:      int32(etypes.StakingEventType_STAKING_EVENT_TYPE_VALIDATOR_BEGIN_UNBONDING),
+		Validator: valAddr,
+	})
 }
 
-func (Hooks) BeforeValidatorModified(ctx context.Context, valAddr sdk.ValAddress) error {
+// BeforeValidatorModified records a validator modified event.
+func (h Hooks) BeforeValidatorModified(ctx context.Context, valAddr sdk.ValAddress) error {
 	log.Debug(ctx, "📚 Validator modified", "val_addr", valAddr)
-	return nil
+	return h.k.recordStakingEvent(ctx, &StakingEvent{
+		Type:      int32(etypes.StakingEventType_STAKING_EVENT_TYPE_VALIDATOR_MODIFIED),
+		Validator: valAddr,
+	})
 }
 
-func (Hooks) BeforeDelegationCreated(ctx context.Context, accAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+// BeforeDelegationCreated records a delegation created event.
+func (h Hooks) BeforeDelegationCreated(ctx context.Context, accAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
 	log.Debug(ctx, "📚 Delegation created", "acc_addr", accAddr, "val_addr", valAddr)
-	return nil
+	return h.k.recordStakingEvent(ctx, &StakingEvent{
+		Type:      int32(etypes.StakingEventType_STAKING_EVENT_TYPE_DELEGATION_CREATED),
+		Validator: valAddr,
+		Delegator: accAddr,
+	})
 }
 
+// BeforeDelegationSharesModified only logs, since AfterDelegationModified records the resulting modification.
 func (Hooks) BeforeDelegationSharesModified(ctx context.Context, accAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
 	log.Debug(ctx, "📚 Delegation shares modified", "acc_addr", accAddr, "val_addr", valAddr)
 	return nil
 }
 
-func (Hooks) BeforeDelegationRemoved(ctx context.Context, accAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+// BeforeDelegationRemoved records a delegation removed event.
+func (h Hooks) BeforeDelegationRemoved(ctx context.Context, accAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
 	log.Debug(ctx, "📚 Delegation removed", "acc_addr", accAddr, "val_addr", valAddr)
-	return nil
+	return h.k.recordStakingEvent(ctx, &StakingEvent{
+		Type:      int32(etypes.StakingEventType_STAKING_EVENT_TYPE_DELEGATION_REMOVED),
+		Validator: valAddr,
+		Delegator: accAddr,
+	})
 }
 
-func (Hooks) AfterDelegationModified(ctx context.Context, accAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+// AfterDelegationModified records a delegation modified event.
+func (h Hooks) AfterDelegationModified(ctx context.Context, accAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
 	log.Debug(ctx, "📚 Delegation modified", "acc_addr", accAddr, "val_addr", valAddr)
-	return nil
+	return h.k.recordStakingEvent(ctx, &StakingEvent{
+		Type:      int32(etypes.StakingEventType_STAKING_EVENT_TYPE_DELEGATION_MODIFIED),
+		Validator: valAddr,
+		Delegator: accAddr,
+	})
 }
 
-func (Hooks) BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, amount sdkmath.LegacyDec) error {
-	log.Debug(ctx, "📚 Validator slashed", "val_addr", valAddr, "amount", amount)
-	return nil
+// BeforeValidatorSlashed records a validator slashed event.
+func (h Hooks) BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, fraction sdkmath.LegacyDec) error {
+	log.Debug(ctx, "📚 Validator slashed", "val_addr", valAddr, "fraction", fraction)
+	return h.k.recordStakingEvent(ctx, &StakingEvent{
+		Type:          int32(etypes.StakingEventType_STAKING_EVENT_TYPE_VALIDATOR_SLASHED),
+		Validator:     valAddr,
+		SlashFraction: fraction.String(),
+	})
 }
 
-func (Hooks) AfterUnbondingInitiated(ctx context.Context, id uint64) error {
+// AfterUnbondingInitiated records an unbonding initiated event.
+func (h Hooks) AfterUnbondingInitiated(ctx context.Context, id uint64) error {
 	log.Debug(ctx, "📚 Unbonding initiated", "id", id)
+	return h.k.recordStakingEvent(ctx, &StakingEvent{
+		Type:        int32(etypes.StakingEventType_STAKING_EVENT_TYPE_UNBONDING_INITIATED),
+		UnbondingId: id,
+	})
+}
+
+// recordStakingEvent inserts the staking event into the staking history,
+// pruning the oldest event if the history exceeds maxStakingEvents.
+// It also emits the event as a typed SDK event for downstream consumers.
+func (k *Keeper) recordStakingEvent(ctx context.Context, event *StakingEvent) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	event.CreatedHeight = uint64(sdkCtx.BlockHeight())
+
+	id, err := k.stakingEventTable.InsertReturningId(ctx, event)
+	if err != nil {
+		return errors.Wrap(err, "insert staking event")
+	}
+	event.Id = id
+
+	if id > maxStakingEvents {
+		err := k.stakingEventTable.Delete(ctx, &StakingEvent{Id: id - maxStakingEvents})
+		if err != nil && !errors.Is(err, ormerrors.NotFound) {
+			return errors.Wrap(err, "prune staking event")
+		}
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(stakingEventToProto(event)); err != nil {
+		return errors.Wrap(err, "emit staking event")
+	}
+
 	return nil
 }
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/tutil"
+	"github.com/omni-network/omni/octane/evmengine/types"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStakingHooks(t *testing.T) {
+	t.Parallel()
+
+	cdc := getCodec(t)
+	txConfig := authtx.NewTxConfig(cdc, nil)
+
+	engineCl, err := ethclient.NewEngineMock()
+	require.NoError(t, err)
+
+	ctx, storeService := setupCtxStore(t, &cmtproto.Header{Height: 7})
+	keeper, err := NewKeeper(cdc, storeService, engineCl, txConfig, nil, newRandomFeeRecipientProvider())
+	require.NoError(t, err)
+
+	hooks := keeper.Hooks()
+	val1 := sdk.ValAddress(tutil.RandomAddress().Bytes())
+	val2 := sdk.ValAddress(tutil.RandomAddress().Bytes())
+	del := sdk.AccAddress(tutil.RandomAddress().Bytes())
+	cons := sdk.ConsAddress(tutil.RandomAddress().Bytes())
+
+	require.NoError(t, hooks.AfterValidatorCreated(ctx, val1))
+	require.NoError(t, hooks.AfterValidatorBonded(ctx, cons, val1))
+	require.NoError(t, hooks.AfterValidatorCreated(ctx, val2))
+	require.NoError(t, hooks.BeforeDelegationCreated(ctx, del, val1))
+	require.NoError(t, hooks.BeforeDelegationSharesModified(ctx, del, val1)) // Not recorded
+	require.NoError(t, hooks.AfterDelegationModified(ctx, del, val1))
+	require.NoError(t, hooks.BeforeValidatorSlashed(ctx, val1, sdkmath.LegacyNewDecWithPrec(5, 2)))
+	require.NoError(t, hooks.AfterUnbondingInitiated(ctx, 3))
+	require.NoError(t, hooks.AfterValidatorBeginUnbonding(ctx, cons, val1))
+	require.NoError(t, hooks.BeforeValidatorModified(ctx, val1))
+	require.NoError(t, hooks.BeforeDelegationRemoved(ctx, del, val1))
+	require.NoError(t, hooks.AfterValidatorRemoved(ctx, cons, val1))
+
+	// Staking events are also emitted as typed SDK events.
+	require.Len(t, sdk.UnwrapSDKContext(ctx).EventManager().Events(), 11)
+
+	// All events in ID order.
+	resp, err := keeper.StakingHistory(ctx, &types.StakingHistoryRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Events, 11)
+	for i, event := range resp.Events {
+		require.Equal(t, uint64(i+1), event.Id)
+		require.Equal(t, uint64(7), event.CreatedHeight)
+	}
+
+	require.Equal(t, &types.StakingEvent{
+		Id:            6,
+		CreatedHeight: 7,
+		Type:          types.StakingEventType_STAKING_EVENT_TYPE_VALIDATOR_SLASHED,
+		Validator:     val1,
+		SlashFraction: "0.050000000000000000",
+	}, resp.Events[5])
+	require.Equal(t, &types.StakingEvent{
+		Id:            5,
+		CreatedHeight: 7,
+		Type:          types.StakingEventType_STAKING_EVENT_TYPE_DELEGATION_MODIFIED,
+		Validator:     val1,
+		Delegator:     del,
+	}, resp.Events[4])
+	require.Equal(t, uint64(3), resp.Events[6].UnbondingId)
+
+	// Filter by validator.
+	resp, err = keeper.StakingHistory(ctx, &types.StakingHistoryRequest{Validator: val2})
+	require.NoError(t, err)
+	require.Len(t, resp.Events, 1)
+	require.Equal(t, types.StakingEventType_STAKING_EVENT_TYPE_VALIDATOR_CREATED, resp.Events[0].Type)
+
+	// Paginate validator events.
+	resp, err = keeper.StakingHistory(ctx, &types.StakingHistoryRequest{Validator: val1, FromId: 5, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Events, 2)
+	require.Equal(t, uint64(5), resp.Events[0].Id)
+	require.Equal(t, uint64(6), resp.Events[1].Id)
+
+	resp, err = keeper.StakingHistory(ctx, &types.StakingHistoryRequest{FromId: 10})
+	require.NoError(t, err)
+	require.Len(t, resp.Events, 2)
+	require.Equal(t, types.StakingEventType_STAKING_EVENT_TYPE_VALIDATOR_REMOVED, resp.Events[1].Type)
+
+	// Invalid requests.
+	_, err = keeper.StakingHistory(ctx, &types.StakingHistoryRequest{Validator: []byte{1}})
+	require.ErrorContains(t, err, "invalid validator address")
+	_, err = keeper.StakingHistory(ctx, &types.StakingHistoryRequest{Limit: maxStakingHistoryLimit + 1})
+	require.ErrorContains(t, err, "limit exceeds maximum")
+}
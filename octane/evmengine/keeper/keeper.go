@@ -24,18 +24,22 @@ import (
 )
 
 type Keeper struct {
-	cdc             codec.BinaryCodec
-	storeService    store.KVStoreService
-	headTable       ExecutionHeadTable
-	engineCl        ethclient.EngineClient
-	txConfig        client.TxConfig
-	voteProvider    types.VoteExtensionProvider
-	eventProcs      []types.EvmEventProcessor
-	cmtAPI          comet.API
-	addrProvider    types.AddressProvider
-	feeRecProvider  types.FeeRecipientProvider
-	buildDelay      time.Duration
-	buildOptimistic bool
+	cdc               codec.BinaryCodec
+	storeService      store.KVStoreService
+	modDB             ormdb.ModuleDB
+	headTable         ExecutionHeadTable
+	withdrawalTable   WithdrawalTable
+	stakingEventTable StakingEventTable
+	engineCl          ethclient.EngineClient
+	txConfig          client.TxConfig
+	voteProvider      types.VoteExtensionProvider
+	eventProcs        []types.EvmEventProcessor
+	cmtAPI            comet.API
+	addrProvider      types.AddressProvider
+	feeRecProvider    types.FeeRecipientProvider
+	buildDelay        time.Duration
+	buildOptimistic   bool
+	pragueTime        *uint64 // EVM Prague fork timestamp, nil if not scheduled.
 
 	// mutablePayload contains the previous optimistically triggered payload.
 	// It is optimistic because the validator set can change,
@@ -44,6 +48,7 @@ type Keeper struct {
 		sync.Mutex
 		ID        *engine.PayloadID
 		Height    uint64
+		Timestamp uint64 // Execution block timestamp of the payload.
 		UpdatedAt time.Time
 	}
 }
@@ -72,14 +77,17 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		cdc:            cdc,
-		storeService:   storeService,
-		headTable:      dbStore.ExecutionHeadTable(),
-		engineCl:       engineCl,
-		txConfig:       txConfig,
-		addrProvider:   addrProvider,
-		feeRecProvider: feeRecProvider,
-		eventProcs:     eventProcs,
+		cdc:               cdc,
+		storeService:      storeService,
+		modDB:             modDB,
+		headTable:         dbStore.ExecutionHeadTable(),
+		withdrawalTable:   dbStore.WithdrawalTable(),
+		stakingEventTable: dbStore.StakingEventTable(),
+		engineCl:          engineCl,
+		txConfig:          txConfig,
+		addrProvider:      addrProvider,
+		feeRecProvider:    feeRecProvider,
+		eventProcs:        eventProcs,
 	}, nil
 }
 
@@ -102,6 +110,18 @@ func (k *Keeper) SetBuildOptimistic(b bool) {
 	k.buildOptimistic = b
 }
 
+// SetPragueTime sets the EVM Prague fork timestamp, which must match the execution chain config.
+// It is sourced from the static network config (or an explicit override), not from a local genesis file.
+// Engine API V4 is used for payloads from this timestamp; nil disables it.
+func (k *Keeper) SetPragueTime(t *uint64) {
+	k.pragueTime = t
+}
+
+// isPrague returns true if the EVM Prague fork is active at the provided execution block timestamp.
+func (k *Keeper) isPrague(timestamp uint64) bool {
+	return k.pragueTime != nil && timestamp >= *k.pragueTime
+}
+
 // RegisterProposalService registers the proposal service on the provided router.
 // This implements abci.ProcessProposal verification of new proposals.
 func (k *Keeper) RegisterProposalService(server grpc1.Server) {
@@ -117,9 +137,9 @@ func (k *Keeper) parseAndVerifyProposedPayload(ctx context.Context, msg *types.M
 		return engine.ExecutableData{}, errors.Wrap(err, "unmarshal payload")
 	}
 
-	// Ensure no withdrawals are included in the payload.
-	if len(payload.Withdrawals) > 0 {
-		return engine.ExecutableData{}, errors.New("withdrawals not allowed in payload")
+	// Ensure the withdrawals match the next withdrawals in the queue.
+	if err := k.verifyWithdrawals(ctx, payload.Withdrawals); err != nil {
+		return engine.ExecutableData{}, errors.Wrap(err, "verify proposed withdrawals")
 	}
 
 	// Ensure fee recipient using provider
@@ -160,6 +180,25 @@ func (k *Keeper) parseAndVerifyProposedPayload(ctx context.Context, msg *types.M
 	return payload, nil
 }
 
+// executionRequests returns the proposed EIP-7685 execution requests of the payload.
+// It returns nil before the Prague fork (execution requests are not supported)
+// and a non-nil slice after it (execution requests are required), see pushPayload.
+func (k *Keeper) executionRequests(payload engine.ExecutableData, msg *types.MsgExecutionPayload) ([][]byte, error) {
+	if !k.isPrague(payload.Timestamp) {
+		if len(msg.ExecutionRequests) > 0 {
+			return nil, errors.New("execution requests before prague")
+		}
+
+		return nil, nil //nolint:nilnil // Nil requests indicate pre-prague payloads.
+	}
+
+	if msg.ExecutionRequests == nil {
+		return [][]byte{}, nil // Empty requests are decoded as nil.
+	}
+
+	return msg.ExecutionRequests, nil
+}
+
 // isNextProposer returns true if the local node is the proposer
 // for the next block. It also returns the next block height.
 //
@@ -194,18 +233,19 @@ func (k *Keeper) isNextProposer(ctx context.Context, currentProposer []byte, cur
 	return isNextProposer, nil
 }
 
-func (k *Keeper) setOptimisticPayload(id *engine.PayloadID, height uint64) {
+func (k *Keeper) setOptimisticPayload(id *engine.PayloadID, height uint64, timestamp uint64) {
 	k.mutablePayload.Lock()
 	defer k.mutablePayload.Unlock()
 
 	k.mutablePayload.ID = id
 	k.mutablePayload.Height = height
+	k.mutablePayload.Timestamp = timestamp
 	k.mutablePayload.UpdatedAt = time.Now()
 }
 
-func (k *Keeper) getOptimisticPayload() (*engine.PayloadID, uint64, time.Time) {
+func (k *Keeper) getOptimisticPayload() (*engine.PayloadID, uint64, uint64, time.Time) {
 	k.mutablePayload.Lock()
 	defer k.mutablePayload.Unlock()
 
-	return k.mutablePayload.ID, k.mutablePayload.Height, k.mutablePayload.UpdatedAt
+	return k.mutablePayload.ID, k.mutablePayload.Height, k.mutablePayload.Timestamp, k.mutablePayload.UpdatedAt
 }
@@ -33,8 +33,13 @@ func (s msgServer) ExecutionPayload(ctx context.Context, msg *types.MsgExecution
 		return nil, err
 	}
 
+	requests, err := s.executionRequests(payload, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	err = retryForever(ctx, func(ctx context.Context) (bool, error) {
-		status, err := pushPayload(ctx, s.engineCl, payload)
+		status, err := pushPayload(ctx, s.engineCl, payload, requests)
 		if err != nil || isUnknown(status) {
 			// We need to retry forever on networking errors, but can't easily identify them, so retry all errors.
 			log.Warn(ctx, "Processing finalized payload failed: push new payload to evm (will retry)", err,
@@ -99,6 +104,10 @@ func (s msgServer) ExecutionPayload(ctx context.Context, msg *types.MsgExecution
 		return nil, errors.Wrap(err, "deliver event logs")
 	}
 
+	if err := s.dequeueWithdrawals(ctx, payload.Withdrawals); err != nil {
+		return nil, errors.Wrap(err, "dequeue withdrawals")
+	}
+
 	if err := s.updateExecutionHead(ctx, payload); err != nil {
 		return nil, errors.Wrap(err, "update execution head")
 	}
@@ -152,8 +161,10 @@ func (s msgServer) deliverEvents(ctx context.Context, height uint64, blockHash c
 }
 
 // pushPayload pushes the provided execution data as a possible new head to the execution client.
+// It uses Engine API V4 if execution requests are non-nil (i.e., from the Prague fork), otherwise V3.
 // It returns the engine payload status or an error.
-func pushPayload(ctx context.Context, engineCl ethclient.EngineClient, payload engine.ExecutableData) (engine.PayloadStatusV1, error) {
+func pushPayload(ctx context.Context, engineCl ethclient.EngineClient, payload engine.ExecutableData, requests [][]byte,
+) (engine.PayloadStatusV1, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	appHash := common.BytesToHash(sdkCtx.BlockHeader().AppHash)
 	if appHash == (common.Hash{}) {
@@ -163,7 +174,13 @@ func pushPayload(ctx context.Context, engineCl ethclient.EngineClient, payload e
 	emptyVersionHashes := make([]common.Hash, 0) // Cannot use nil.
 
 	// Push it back to the execution client (mark it as possible new head).
-	status, err := engineCl.NewPayloadV3(ctx, payload, emptyVersionHashes, &appHash)
+	var status engine.PayloadStatusV1
+	var err error
+	if requests != nil {
+		status, err = engineCl.NewPayloadV4(ctx, payload, emptyVersionHashes, &appHash, requests)
+	} else {
+		status, err = engineCl.NewPayloadV3(ctx, payload, emptyVersionHashes, &appHash)
+	}
 	if err != nil {
 		return engine.PayloadStatusV1{}, errors.Wrap(err, "new payload")
 	}
@@ -242,7 +242,7 @@ func Test_pushPayload(t *testing.T) {
 				tt.args.transformPayload(&payload)
 			}
 
-			status, err := pushPayload(ctx, &mockEngine, payload)
+			status, err := pushPayload(ctx, &mockEngine, payload, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("pushPayload() error = %v, wantErr %v", err, tt.wantErr)
 				return
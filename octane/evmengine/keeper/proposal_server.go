@@ -23,9 +23,14 @@ func (s proposalServer) ExecutionPayload(ctx context.Context, msg *types.MsgExec
 		return nil, err
 	}
 
+	requests, err := s.executionRequests(payload, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Push the payload to the EVM.
 	err = retryForever(ctx, func(ctx context.Context) (bool, error) {
-		status, err := pushPayload(ctx, s.engineCl, payload)
+		status, err := pushPayload(ctx, s.engineCl, payload, requests)
 		if err != nil || isUnknown(status) {
 			// We need to retry forever on networking errors, but can't easily identify them, so retry all errors.
 			log.Warn(ctx, "Verifying proposal failed: push new payload to evm (will retry)", err,
@@ -71,24 +71,39 @@ func Test_proposalServer_ExecutionPayload(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	assertExecutionPayload := func(ctx context.Context) {
-		resp, err := propSrv.ExecutionPayload(ctx, &types.MsgExecutionPayload{
-			Authority:        authtypes.NewModuleAddress(types.ModuleName).String(),
-			ExecutionPayload: payloadData,
+	sendExecutionPayload := func(ctx context.Context, requests [][]byte) error {
+		_, err := propSrv.ExecutionPayload(ctx, &types.MsgExecutionPayload{
+			Authority:         authtypes.NewModuleAddress(types.ModuleName).String(),
+			ExecutionPayload:  payloadData,
+			ExecutionRequests: requests,
 		})
-		require.NoError(t, err)
-		require.NotNil(t, resp)
 
-		gotPayload, err := mockEngine.GetPayloadV3(ctx, payloadID)
+		return err
+	}
+
+	assertExecutionPayload := func(ctx context.Context, requests [][]byte) {
+		require.NoError(t, sendExecutionPayload(ctx, requests))
+
+		gotPayload, err := mockEngine.GetPayloadV4(ctx, payloadID)
 		require.NoError(t, err)
 		require.Equal(t, latestHeight+1, gotPayload.ExecutionPayload.Number)
 		require.Equal(t, block.Hash(), gotPayload.ExecutionPayload.BlockHash)
 		require.Equal(t, frp.LocalFeeRecipient(), gotPayload.ExecutionPayload.FeeRecipient)
 		require.Empty(t, gotPayload.ExecutionPayload.Withdrawals)
+		require.Equal(t, requests, gotPayload.Requests)
 	}
 
 	newPayload(sdkCtx)
-	assertExecutionPayload(sdkCtx)
+	assertExecutionPayload(sdkCtx, [][]byte{})
+
+	// Execution requests are not allowed before prague
+	requests := [][]byte{{0x00, 0x01}}
+	require.ErrorContains(t, sendExecutionPayload(sdkCtx, requests), "execution requests before prague")
+
+	// Execution requests are pushed via NewPayloadV4 after prague
+	var pragueTime uint64
+	keeper.SetPragueTime(&pragueTime)
+	assertExecutionPayload(sdkCtx, requests)
 }
 
 func fastBackoffForT() {
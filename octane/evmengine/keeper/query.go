@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+	"math"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/octane/evmengine/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/orm/model/ormlist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultStakingHistoryLimit = 100
+	maxStakingHistoryLimit     = 1000
+)
+
+var _ types.QueryServer = (*Keeper)(nil)
+
+// StakingHistory returns the recorded staking events in ascending ID order.
+func (k *Keeper) StakingHistory(ctx context.Context, req *types.StakingHistoryRequest) (*types.StakingHistoryResponse, error) {
+	if len(req.Validator) != 0 && len(req.Validator) != common.AddressLength {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator address length")
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultStakingHistoryLimit
+	} else if limit > maxStakingHistoryLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit exceeds maximum %d", maxStakingHistoryLimit)
+	}
+
+	events, err := k.stakingEvents(ctx, req.Validator, req.FromId, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := make([]*types.StakingEvent, 0, len(events))
+	for _, event := range events {
+		resp = append(resp, stakingEventToProto(event))
+	}
+
+	return &types.StakingHistoryResponse{Events: resp}, nil
+}
+
+// stakingEvents returns up to limit staking events with IDs greater than or equal to fromID,
+// optionally filtered by validator.
+func (k *Keeper) stakingEvents(ctx context.Context, validator []byte, fromID uint64, limit uint64) ([]*StakingEvent, error) {
+	var (
+		iter StakingEventIterator
+		err  error
+	)
+	if len(validator) == 0 {
+		iter, err = k.stakingEventTable.ListRange(ctx,
+			StakingEventIdIndexKey{}.WithId(fromID),
+			StakingEventIdIndexKey{}.WithId(math.MaxUint64),
+			ormlist.DefaultLimit(limit),
+		)
+	} else {
+		iter, err = k.stakingEventTable.ListRange(ctx,
+			StakingEventValidatorIdIndexKey{}.WithValidatorId(validator, fromID),
+			StakingEventValidatorIdIndexKey{}.WithValidatorId(validator, math.MaxUint64),
+			ormlist.DefaultLimit(limit),
+		)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "list staking events")
+	}
+	defer iter.Close()
+
+	var resp []*StakingEvent
+	for iter.Next() {
+		event, err := iter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "staking event value")
+		}
+
+		resp = append(resp, event)
+	}
+
+	return resp, nil
+}
+
+func stakingEventToProto(event *StakingEvent) *types.StakingEvent {
+	return &types.StakingEvent{
+		Id:            event.GetId(),
+		CreatedHeight: event.GetCreatedHeight(),
+		Type:          types.StakingEventType(event.GetType()),
+		Validator:     event.GetValidator(),
+		Delegator:     event.GetDelegator(),
+		SlashFraction: event.GetSlashFraction(),
+		UnbondingId:   event.GetUnbondingId(),
+	}
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/octane/evmengine/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	"cosmossdk.io/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxWithdrawalsPerPayload is the maximum number of withdrawals included in a single execution payload.
+// It matches Ethereum's MAX_WITHDRAWALS_PER_PAYLOAD.
+const maxWithdrawalsPerPayload = 16
+
+var _ types.WithdrawalQueue = (*Keeper)(nil)
+
+// InsertWithdrawal enqueues an EVM withdrawal of the amount (in wei) to the address.
+// Withdrawals are included in subsequent execution payloads in insertion order.
+// The amount must be a non-zero multiple of gwei, since EVM withdrawals are denominated in gwei.
+func (k *Keeper) InsertWithdrawal(ctx context.Context, address common.Address, amountWei *big.Int) error {
+	gwei, rem := new(big.Int).QuoRem(amountWei, big.NewInt(params.GWei), new(big.Int))
+	if gwei.Sign() <= 0 {
+		return errors.New("invalid withdrawal amount", "amount", amountWei)
+	} else if rem.Sign() != 0 {
+		return errors.New("withdrawal amount not a multiple of gwei", "amount", amountWei)
+	} else if !gwei.IsUint64() {
+		return errors.New("withdrawal amount too large", "amount", amountWei)
+	}
+
+	err := k.withdrawalTable.Insert(ctx, &Withdrawal{
+		Address:       address.Bytes(),
+		AmountGwei:    gwei.Uint64(),
+		CreatedHeight: uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()),
+	})
+	if err != nil {
+		return errors.Wrap(err, "insert withdrawal")
+	}
+
+	return nil
+}
+
+// nextWithdrawals returns the next withdrawals in the queue to include in an execution payload.
+// It returns an empty (non-nil) slice if the queue is empty, as required by the engine API.
+func (k *Keeper) nextWithdrawals(ctx context.Context) ([]*etypes.Withdrawal, error) {
+	iter, err := k.withdrawalTable.List(ctx, WithdrawalIdIndexKey{}, ormlist.DefaultLimit(maxWithdrawalsPerPayload))
+	if err != nil {
+		return nil, errors.Wrap(err, "list withdrawals")
+	}
+	defer iter.Close()
+
+	resp := make([]*etypes.Withdrawal, 0)
+	for iter.Next() {
+		w, err := iter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "withdrawal value")
+		}
+
+		resp = append(resp, &etypes.Withdrawal{
+			Index:   w.GetId(),
+			Address: common.BytesToAddress(w.GetAddress()),
+			Amount:  w.GetAmountGwei(),
+		})
+	}
+
+	return resp, nil
+}
+
+// verifyWithdrawals returns an error if the proposed withdrawals are not equal to the next withdrawals in the queue.
+func (k *Keeper) verifyWithdrawals(ctx context.Context, proposed []*etypes.Withdrawal) error {
+	expected, err := k.nextWithdrawals(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(proposed) != len(expected) {
+		return errors.New("withdrawal count mismatch", "proposed", len(proposed), "expected", len(expected))
+	}
+
+	for i := range expected {
+		if proposed[i] == nil || *proposed[i] != *expected[i] {
+			return errors.New("withdrawal mismatch", "index", expected[i].Index)
+		}
+	}
+
+	return nil
+}
+
+// dequeueWithdrawals deletes the (verified) withdrawals included in a finalized execution payload from the queue.
+func (k *Keeper) dequeueWithdrawals(ctx context.Context, withdrawals []*etypes.Withdrawal) error {
+	for _, w := range withdrawals {
+		if err := k.withdrawalTable.Delete(ctx, &Withdrawal{Id: w.Index}); err != nil {
+			return errors.Wrap(err, "delete withdrawal")
+		}
+	}
+
+	return nil
+}
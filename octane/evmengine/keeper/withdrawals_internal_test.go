@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/tutil"
+	"github.com/omni-network/omni/octane/evmengine/types"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithdrawals(t *testing.T) {
+	t.Parallel()
+	fastBackoffForT()
+
+	cdc := getCodec(t)
+	txConfig := authtx.NewTxConfig(cdc, nil)
+
+	engineCl, err := ethclient.NewEngineMock()
+	require.NoError(t, err)
+
+	ctx, storeService := setupCtxStore(t, &cmtproto.Header{
+		Height:  1,
+		AppHash: tutil.RandomHash().Bytes(),
+		Time:    time.Now(),
+	})
+	ctx = ctx.WithExecMode(sdk.ExecModeFinalize)
+
+	frp := newRandomFeeRecipientProvider()
+	keeper, err := NewKeeper(cdc, storeService, engineCl, txConfig, nil, frp)
+	require.NoError(t, err)
+	populateGenesisHead(ctx, t, keeper)
+
+	propSrv := NewProposalServer(keeper)
+	msgSrv := NewMsgServerImpl(keeper)
+
+	gwei := func(n int64) *big.Int { return big.NewInt(n * params.GWei) }
+
+	// Invalid withdrawal amounts.
+	addr := tutil.RandomAddress()
+	require.ErrorContains(t, keeper.InsertWithdrawal(ctx, addr, big.NewInt(0)), "invalid withdrawal amount")
+	require.ErrorContains(t, keeper.InsertWithdrawal(ctx, addr, big.NewInt(1)), "invalid withdrawal amount")
+	require.ErrorContains(t, keeper.InsertWithdrawal(ctx, addr, new(big.Int).Add(gwei(1), big.NewInt(1))), "not a multiple of gwei")
+
+	// Enqueue more withdrawals than fit in a single payload.
+	const total = maxWithdrawalsPerPayload + 4
+	addrs := []common.Address{tutil.RandomAddress(), tutil.RandomAddress()}
+	for i := 0; i < total; i++ {
+		require.NoError(t, keeper.InsertWithdrawal(ctx, addrs[i%2], gwei(int64(i+1))))
+	}
+
+	// buildPayload builds the next payload and returns the execution payload message.
+	buildPayload := func() (*types.MsgExecutionPayload, []*etypes.Withdrawal) {
+		t.Helper()
+
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+		appHash := common.BytesToHash(ctx.BlockHeader().AppHash)
+
+		resp, _, err := keeper.startBuild(ctx, appHash, ctx.BlockTime())
+		require.NoError(t, err)
+
+		envelope, err := engineCl.GetPayloadV3(ctx, *resp.PayloadID)
+		require.NoError(t, err)
+
+		bz, err := json.Marshal(envelope.ExecutionPayload)
+		require.NoError(t, err)
+
+		return &types.MsgExecutionPayload{
+			Authority:        authtypes.NewModuleAddress(types.ModuleName).String(),
+			ExecutionPayload: bz,
+		}, envelope.ExecutionPayload.Withdrawals
+	}
+
+	// First payload includes the maximum number of withdrawals in insertion order.
+	msg, withdrawals := buildPayload()
+	require.Len(t, withdrawals, maxWithdrawalsPerPayload)
+	for i, w := range withdrawals {
+		require.Equal(t, uint64(i+1), w.Index)
+		require.Equal(t, addrs[i%2], w.Address)
+		require.Equal(t, uint64(i+1), w.Amount)
+	}
+
+	// Proposals with different withdrawals are rejected.
+	for _, mutate := range []func([]*etypes.Withdrawal) []*etypes.Withdrawal{
+		func(ws []*etypes.Withdrawal) []*etypes.Withdrawal { return ws[1:] },
+		func([]*etypes.Withdrawal) []*etypes.Withdrawal { return nil },
+		func(ws []*etypes.Withdrawal) []*etypes.Withdrawal {
+			cloned := *ws[0]
+			cloned.Amount++
+
+			return append([]*etypes.Withdrawal{&cloned}, ws[1:]...)
+		},
+	} {
+		var payload engine.ExecutableData
+		require.NoError(t, json.Unmarshal(msg.ExecutionPayload, &payload))
+		payload.Withdrawals = mutate(payload.Withdrawals)
+		bz, err := json.Marshal(payload)
+		require.NoError(t, err)
+
+		_, err = propSrv.ExecutionPayload(ctx, &types.MsgExecutionPayload{
+			Authority:        msg.Authority,
+			ExecutionPayload: bz,
+		})
+		require.ErrorContains(t, err, "verify proposed withdrawals")
+	}
+
+	// Valid proposal is accepted and finalized, dequeuing the withdrawals.
+	_, err = propSrv.ExecutionPayload(ctx, msg)
+	require.NoError(t, err)
+	_, err = msgSrv.ExecutionPayload(ctx, msg)
+	require.NoError(t, err)
+
+	// Second payload includes the remaining withdrawals.
+	msg, withdrawals = buildPayload()
+	require.Len(t, withdrawals, total-maxWithdrawalsPerPayload)
+	require.Equal(t, uint64(maxWithdrawalsPerPayload+1), withdrawals[0].Index)
+	_, err = propSrv.ExecutionPayload(ctx, msg)
+	require.NoError(t, err)
+	_, err = msgSrv.ExecutionPayload(ctx, msg)
+	require.NoError(t, err)
+
+	// Queue is empty now.
+	msg, withdrawals = buildPayload()
+	require.NotNil(t, withdrawals)
+	require.Empty(t, withdrawals)
+	_, err = msgSrv.ExecutionPayload(ctx, msg)
+	require.NoError(t, err)
+
+	// All withdrawals were credited on the EVM.
+	for i, addr := range addrs {
+		expect := big.NewInt(0)
+		for j := i; j < total; j += 2 {
+			expect.Add(expect, gwei(int64(j+1)))
+		}
+
+		balance, err := engineCl.BalanceAt(ctx, addr, nil)
+		require.NoError(t, err)
+		require.Equal(t, expect, balance)
+	}
+}
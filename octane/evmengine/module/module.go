@@ -90,13 +90,18 @@ func (m AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, raw json.Ra
 	var data types.GenesisState
 	cdc.MustUnmarshalJSON(raw, &data)
 
-	if err := m.keeper.InsertGenesisHead(ctx, data.ExecutionBlockHash); err != nil {
-		panic(errors.Wrap(err, "insert genesis head"))
+	if err := m.keeper.InitGenesis(ctx, &data); err != nil {
+		panic(errors.Wrap(err, "init genesis"))
 	}
 }
 
-func (AppModule) ExportGenesis(sdk.Context, codec.JSONCodec) json.RawMessage {
-	return nil
+func (m AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	data, err := m.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(errors.Wrap(err, "export genesis"))
+	}
+
+	return cdc.MustMarshalJSON(data)
 }
 
 func (AppModuleBasic) DefaultGenesis(codec.JSONCodec) json.RawMessage {
@@ -118,6 +123,7 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingCo
 // RegisterServices registers a gRPC query service to respond to the module-specific gRPC queries.
 func (m AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServiceServer(cfg.MsgServer(), keeper.NewMsgServerImpl(m.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), m.keeper)
 }
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
@@ -189,6 +195,6 @@ func ProvideModule(in ModuleInputs) (ModuleOutputs, error) {
 	return ModuleOutputs{
 		EngEVMKeeper: k,
 		Module:       m,
-		Hooks:        staking.StakingHooksWrapper{StakingHooks: keeper.Hooks{}},
+		Hooks:        staking.StakingHooksWrapper{StakingHooks: k.Hooks()},
 	}, nil
 }
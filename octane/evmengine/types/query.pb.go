@@ -0,0 +1,1152 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: octane/evmengine/types/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StakingEventType defines the type of a x/staking lifecycle event.
+type StakingEventType int32
+
+const (
+	StakingEventType_STAKING_EVENT_TYPE_UNKNOWN                   StakingEventType = 0
+	StakingEventType_STAKING_EVENT_TYPE_VALIDATOR_CREATED         StakingEventType = 1
+	StakingEventType_STAKING_EVENT_TYPE_VALIDATOR_MODIFIED        StakingEventType = 2
+	StakingEventType_STAKING_EVENT_TYPE_VALIDATOR_BONDED          StakingEventType = 3
+	StakingEventType_STAKING_EVENT_TYPE_VALIDATOR_BEGIN_UNBONDING StakingEventType = 4
+	StakingEventType_STAKING_EVENT_TYPE_VALIDATOR_REMOVED         StakingEventType = 5
+	StakingEventType_STAKING_EVENT_TYPE_VALIDATOR_SLASHED         StakingEventType = 6
+	StakingEventType_STAKING_EVENT_TYPE_DELEGATION_CREATED        StakingEventType = 7
+	StakingEventType_STAKING_EVENT_TYPE_DELEGATION_MODIFIED       StakingEventType = 8
+	StakingEventType_STAKING_EVENT_TYPE_DELEGATION_REMOVED        StakingEventType = 9
+	StakingEventType_STAKING_EVENT_TYPE_UNBONDING_INITIATED       StakingEventType = 10
+)
+
+var StakingEventType_name = map[int32]string{
+	0:  "STAKING_EVENT_TYPE_UNKNOWN",
+	1:  "STAKING_EVENT_TYPE_VALIDATOR_CREATED",
+	2:  "STAKING_EVENT_TYPE_VALIDATOR_MODIFIED",
+	3:  "STAKING_EVENT_TYPE_VALIDATOR_BONDED",
+	4:  "STAKING_EVENT_TYPE_VALIDATOR_BEGIN_UNBONDING",
+	5:  "STAKING_EVENT_TYPE_VALIDATOR_REMOVED",
+	6:  "STAKING_EVENT_TYPE_VALIDATOR_SLASHED",
+	7:  "STAKING_EVENT_TYPE_DELEGATION_CREATED",
+	8:  "STAKING_EVENT_TYPE_DELEGATION_MODIFIED",
+	9:  "STAKING_EVENT_TYPE_DELEGATION_REMOVED",
+	10: "STAKING_EVENT_TYPE_UNBONDING_INITIATED",
+}
+
+var StakingEventType_value = map[string]int32{
+	"STAKING_EVENT_TYPE_UNKNOWN":                   0,
+	"STAKING_EVENT_TYPE_VALIDATOR_CREATED":         1,
+	"STAKING_EVENT_TYPE_VALIDATOR_MODIFIED":        2,
+	"STAKING_EVENT_TYPE_VALIDATOR_BONDED":          3,
+	"STAKING_EVENT_TYPE_VALIDATOR_BEGIN_UNBONDING": 4,
+	"STAKING_EVENT_TYPE_VALIDATOR_REMOVED":         5,
+	"STAKING_EVENT_TYPE_VALIDATOR_SLASHED":         6,
+	"STAKING_EVENT_TYPE_DELEGATION_CREATED":        7,
+	"STAKING_EVENT_TYPE_DELEGATION_MODIFIED":       8,
+	"STAKING_EVENT_TYPE_DELEGATION_REMOVED":        9,
+	"STAKING_EVENT_TYPE_UNBONDING_INITIATED":       10,
+}
+
+func (x StakingEventType) String() string {
+	return proto.EnumName(StakingEventType_name, int32(x))
+}
+
+func (StakingEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6e58696b8f23d7ac, []int{0}
+}
+
+type StakingHistoryRequest struct {
+	Validator []byte `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	FromId    uint64 `protobuf:"varint,2,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	Limit     uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *StakingHistoryRequest) Reset()         { *m = StakingHistoryRequest{} }
+func (m *StakingHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*StakingHistoryRequest) ProtoMessage()    {}
+func (*StakingHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e58696b8f23d7ac, []int{0}
+}
+func (m *StakingHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakingHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingHistoryRequest.Merge(m, src)
+}
+func (m *StakingHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingHistoryRequest proto.InternalMessageInfo
+
+func (m *StakingHistoryRequest) GetValidator() []byte {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *StakingHistoryRequest) GetFromId() uint64 {
+	if m != nil {
+		return m.FromId
+	}
+	return 0
+}
+
+func (m *StakingHistoryRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type StakingHistoryResponse struct {
+	Events []*StakingEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (m *StakingHistoryResponse) Reset()         { *m = StakingHistoryResponse{} }
+func (m *StakingHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*StakingHistoryResponse) ProtoMessage()    {}
+func (*StakingHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e58696b8f23d7ac, []int{1}
+}
+func (m *StakingHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakingHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingHistoryResponse.Merge(m, src)
+}
+func (m *StakingHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingHistoryResponse proto.InternalMessageInfo
+
+func (m *StakingHistoryResponse) GetEvents() []*StakingEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type StakingEvent struct {
+	Id            uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedHeight uint64           `protobuf:"varint,2,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	Type          StakingEventType `protobuf:"varint,3,opt,name=type,proto3,enum=octane.evmengine.types.StakingEventType" json:"type,omitempty"`
+	Validator     []byte           `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+	Delegator     []byte           `protobuf:"bytes,5,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	SlashFraction string           `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3" json:"slash_fraction,omitempty"`
+	UnbondingId   uint64           `protobuf:"varint,7,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
+}
+
+func (m *StakingEvent) Reset()         { *m = StakingEvent{} }
+func (m *StakingEvent) String() string { return proto.CompactTextString(m) }
+func (*StakingEvent) ProtoMessage()    {}
+func (*StakingEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e58696b8f23d7ac, []int{2}
+}
+func (m *StakingEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakingEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingEvent.Merge(m, src)
+}
+func (m *StakingEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingEvent proto.InternalMessageInfo
+
+func (m *StakingEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *StakingEvent) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *StakingEvent) GetType() StakingEventType {
+	if m != nil {
+		return m.Type
+	}
+	return StakingEventType_STAKING_EVENT_TYPE_UNKNOWN
+}
+
+func (m *StakingEvent) GetValidator() []byte {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *StakingEvent) GetDelegator() []byte {
+	if m != nil {
+		return m.Delegator
+	}
+	return nil
+}
+
+func (m *StakingEvent) GetSlashFraction() string {
+	if m != nil {
+		return m.SlashFraction
+	}
+	return ""
+}
+
+func (m *StakingEvent) GetUnbondingId() uint64 {
+	if m != nil {
+		return m.UnbondingId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("octane.evmengine.types.StakingEventType", StakingEventType_name, StakingEventType_value)
+	proto.RegisterType((*StakingHistoryRequest)(nil), "octane.evmengine.types.StakingHistoryRequest")
+	proto.RegisterType((*StakingHistoryResponse)(nil), "octane.evmengine.types.StakingHistoryResponse")
+	proto.RegisterType((*StakingEvent)(nil), "octane.evmengine.types.StakingEvent")
+}
+
+func init() {
+	proto.RegisterFile("octane/evmengine/types/query.proto", fileDescriptor_6e58696b8f23d7ac)
+}
+
+var fileDescriptor_6e58696b8f23d7ac = []byte{
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x5f, 0x6f, 0xd2, 0x50,
+	0x18, 0xc6, 0x29, 0xff, 0x26, 0xef, 0x36, 0xd2, 0x9c, 0xe8, 0x24, 0xcb, 0xd2, 0x20, 0x6e, 0x5a,
+	0x17, 0xed, 0x96, 0x79, 0xbb, 0x9b, 0xce, 0x9e, 0xb1, 0x93, 0xb1, 0x56, 0x4b, 0x87, 0xd1, 0x9b,
+	0xa6, 0xa3, 0x67, 0x70, 0x22, 0xb4, 0xd0, 0x1e, 0x48, 0xf0, 0x1b, 0x78, 0xe7, 0xc7, 0xf2, 0x72,
+	0x97, 0x5e, 0x1a, 0xf0, 0x83, 0x98, 0x16, 0xac, 0x42, 0x18, 0xec, 0xb2, 0xcf, 0xfb, 0xbc, 0xe7,
+	0xfc, 0xde, 0x27, 0x7d, 0x0f, 0x54, 0xfc, 0x26, 0x77, 0x3c, 0x7a, 0x44, 0x87, 0x5d, 0xea, 0xb5,
+	0x98, 0x47, 0x8f, 0xf8, 0xa8, 0x47, 0xc3, 0xa3, 0xfe, 0x80, 0x06, 0x23, 0xa5, 0x17, 0xf8, 0xdc,
+	0x47, 0x3b, 0x53, 0x8f, 0x92, 0x78, 0x94, 0xd8, 0x53, 0x71, 0xe1, 0x49, 0x9d, 0x3b, 0x5f, 0x98,
+	0xd7, 0xba, 0x60, 0x21, 0xf7, 0x83, 0x91, 0x49, 0xfb, 0x03, 0x1a, 0x72, 0xb4, 0x07, 0x85, 0xa1,
+	0xd3, 0x61, 0xae, 0xc3, 0xfd, 0xa0, 0x24, 0x94, 0x05, 0x79, 0xcb, 0xfc, 0x27, 0xa0, 0xa7, 0xb0,
+	0x71, 0x1b, 0xf8, 0x5d, 0x9b, 0xb9, 0xa5, 0x74, 0x59, 0x90, 0xb3, 0x66, 0x3e, 0xfa, 0x24, 0x2e,
+	0x7a, 0x0c, 0xb9, 0x0e, 0xeb, 0x32, 0x5e, 0xca, 0xc4, 0xf2, 0xf4, 0xa3, 0xd2, 0x80, 0x9d, 0xc5,
+	0x5b, 0xc2, 0x9e, 0xef, 0x85, 0x14, 0x9d, 0x42, 0x9e, 0x0e, 0xa9, 0xc7, 0xc3, 0x92, 0x50, 0xce,
+	0xc8, 0x9b, 0x27, 0xfb, 0xca, 0x72, 0x50, 0x65, 0xd6, 0x8f, 0x23, 0xb3, 0x39, 0xeb, 0xa9, 0x7c,
+	0x4b, 0xc3, 0xd6, 0xff, 0x05, 0x54, 0x84, 0x34, 0x73, 0x63, 0xdc, 0xac, 0x99, 0x66, 0x2e, 0x3a,
+	0x80, 0x62, 0x33, 0xa0, 0x0e, 0xa7, 0xae, 0xdd, 0xa6, 0xac, 0xd5, 0xe6, 0x33, 0xdc, 0xed, 0x99,
+	0x7a, 0x11, 0x8b, 0xe8, 0x14, 0xb2, 0xd1, 0x2d, 0x31, 0x74, 0xf1, 0x44, 0x7e, 0x08, 0x83, 0x35,
+	0xea, 0x51, 0x33, 0xee, 0x9a, 0x8f, 0x2a, 0xbb, 0x18, 0xd5, 0x1e, 0x14, 0x5c, 0xda, 0xa1, 0xad,
+	0xb8, 0x9a, 0x9b, 0x56, 0x13, 0x21, 0x02, 0x0c, 0x3b, 0x4e, 0xd8, 0xb6, 0x6f, 0x03, 0xa7, 0xc9,
+	0x99, 0xef, 0x95, 0xf2, 0x65, 0x41, 0x2e, 0x98, 0xdb, 0xb1, 0x7a, 0x3e, 0x13, 0xd1, 0x33, 0xd8,
+	0x1a, 0x78, 0x37, 0xbe, 0xe7, 0x32, 0xaf, 0x15, 0x85, 0xbe, 0x11, 0x4f, 0xb1, 0x99, 0x68, 0xc4,
+	0x3d, 0xfc, 0x9d, 0x01, 0x71, 0x11, 0x10, 0x49, 0xb0, 0x5b, 0xb7, 0xd4, 0x4b, 0xa2, 0x57, 0x6d,
+	0xdc, 0xc0, 0xba, 0x65, 0x5b, 0x9f, 0xde, 0x63, 0xfb, 0x5a, 0xbf, 0xd4, 0x8d, 0x8f, 0xba, 0x98,
+	0x42, 0x32, 0xec, 0x2f, 0xa9, 0x37, 0xd4, 0x1a, 0xd1, 0x54, 0xcb, 0x30, 0xed, 0x77, 0x26, 0x56,
+	0x2d, 0xac, 0x89, 0x02, 0x7a, 0x05, 0x07, 0x2b, 0x9d, 0x57, 0x86, 0x46, 0xce, 0x09, 0xd6, 0xc4,
+	0x34, 0x7a, 0x09, 0xcf, 0x57, 0x5a, 0xcf, 0x0c, 0x5d, 0xc3, 0x9a, 0x98, 0x41, 0xc7, 0xf0, 0x7a,
+	0xb5, 0x11, 0x57, 0x89, 0x6e, 0x5f, 0xeb, 0x51, 0x03, 0xd1, 0xab, 0x62, 0x76, 0x2d, 0xaf, 0x89,
+	0xaf, 0x8c, 0x06, 0xd6, 0xc4, 0xdc, 0x5a, 0x67, 0xbd, 0xa6, 0xd6, 0x2f, 0xb0, 0x26, 0xe6, 0xef,
+	0x99, 0x4c, 0xc3, 0x35, 0x5c, 0x55, 0x2d, 0x62, 0xe8, 0x49, 0x08, 0x1b, 0xe8, 0x10, 0x5e, 0xac,
+	0xb6, 0x26, 0x29, 0x3c, 0x5a, 0x7f, 0xec, 0x5f, 0xd6, 0xc2, 0x3d, 0xc7, 0x26, 0x73, 0xdb, 0x44,
+	0x27, 0x16, 0x89, 0x11, 0xe0, 0xe4, 0x2b, 0xe4, 0x3e, 0x44, 0x7b, 0x8d, 0xfa, 0x50, 0x9c, 0xdf,
+	0x29, 0xf4, 0x66, 0xcd, 0x7f, 0x3b, 0xbf, 0xe1, 0xbb, 0xca, 0x43, 0xed, 0xd3, 0x55, 0xad, 0xa4,
+	0xce, 0x8e, 0x7f, 0x8c, 0x25, 0xe1, 0x6e, 0x2c, 0x09, 0xbf, 0xc6, 0x92, 0xf0, 0x7d, 0x22, 0xa5,
+	0xee, 0x26, 0x52, 0xea, 0xe7, 0x44, 0x4a, 0x7d, 0xde, 0x59, 0xfe, 0x04, 0xdd, 0xe4, 0xe3, 0xd7,
+	0xe7, 0xed, 0x9f, 0x01, 0x00, 0xcd, 0x75, 0xfe, 0x22, 0xa3, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// StakingHistory returns the recorded x/staking lifecycle events in ascending ID order.
+	StakingHistory(ctx context.Context, in *StakingHistoryRequest, opts ...grpc.CallOption) (*StakingHistoryResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) StakingHistory(ctx context.Context, in *StakingHistoryRequest, opts ...grpc.CallOption) (*StakingHistoryResponse, error) {
+	out := new(StakingHistoryResponse)
+	err := c.cc.Invoke(ctx, "/octane.evmengine.types.Query/StakingHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// StakingHistory returns the recorded x/staking lifecycle events in ascending ID order.
+	StakingHistory(context.Context, *StakingHistoryRequest) (*StakingHistoryResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) StakingHistory(ctx context.Context, req *StakingHistoryRequest) (*StakingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingHistory not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_StakingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StakingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/octane.evmengine.types.Query/StakingHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakingHistory(ctx, req.(*StakingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "octane.evmengine.types.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StakingHistory",
+			Handler:    _Query_StakingHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "octane/evmengine/types/query.proto",
+}
+
+func (m *StakingHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakingHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakingEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SlashFraction) > 0 {
+		i -= len(m.SlashFraction)
+		copy(dAtA[i:], m.SlashFraction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashFraction)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StakingHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromId != 0 {
+		n += 1 + sovQuery(uint64(m.FromId))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *StakingHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StakingEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CreatedHeight))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SlashFraction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingId != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingId))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StakingHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromId", wireType)
+			}
+			m.FromId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &StakingEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= StakingEventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = append(m.Delegator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegator == nil {
+				m.Delegator = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -28,9 +28,10 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// GenesisState is an empty genesis state required to trigger valsync genesis logic only.
+// GenesisState defines the evmengine module's genesis state.
 type GenesisState struct {
 	ExecutionBlockHash []byte `protobuf:"bytes,1,opt,name=execution_block_hash,json=executionBlockHash,proto3" json:"execution_block_hash,omitempty"`
+	Tables             []byte `protobuf:"bytes,2,opt,name=tables,proto3" json:"tables,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -73,12 +74,20 @@ func (m *GenesisState) GetExecutionBlockHash() []byte {
 	return nil
 }
 
+func (m *GenesisState) GetTables() []byte {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
 // MsgExecutionPayload defines the  next EVM execution payload and the
 // logs from previous execution payload.
 type MsgExecutionPayload struct {
 	Authority         string      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	ExecutionPayload  []byte      `protobuf:"bytes,2,opt,name=execution_payload,json=executionPayload,proto3" json:"execution_payload,omitempty"`
 	PrevPayloadEvents []*EVMEvent `protobuf:"bytes,3,rep,name=prev_payload_events,json=prevPayloadEvents,proto3" json:"prev_payload_events,omitempty"`
+	ExecutionRequests [][]byte    `protobuf:"bytes,4,rep,name=execution_requests,json=executionRequests,proto3" json:"execution_requests,omitempty"`
 }
 
 func (m *MsgExecutionPayload) Reset()         { *m = MsgExecutionPayload{} }
@@ -135,6 +144,13 @@ func (m *MsgExecutionPayload) GetPrevPayloadEvents() []*EVMEvent {
 	return nil
 }
 
+func (m *MsgExecutionPayload) GetExecutionRequests() [][]byte {
+	if m != nil {
+		return m.ExecutionRequests
+	}
+	return nil
+}
+
 type ExecutionPayloadResponse struct {
 }
 
@@ -243,32 +259,34 @@ func init() {
 func init() { proto.RegisterFile("octane/evmengine/types/tx.proto", fileDescriptor_288b272163299061) }
 
 var fileDescriptor_288b272163299061 = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x31, 0xcb, 0xda, 0x50,
-	0x14, 0xf5, 0x99, 0xd6, 0xd6, 0x57, 0x29, 0xfa, 0x2c, 0x36, 0x84, 0x92, 0x86, 0x4c, 0xa2, 0x90,
-	0x58, 0xbb, 0x75, 0x2a, 0x82, 0xb4, 0x8b, 0x20, 0x11, 0x3a, 0x74, 0x91, 0x67, 0x72, 0x49, 0x42,
-	0x35, 0x2f, 0xcd, 0x7d, 0x06, 0xdd, 0x4a, 0x87, 0xce, 0xfd, 0x29, 0xfe, 0x86, 0x4e, 0x1d, 0x1d,
-	0x3b, 0x16, 0x1d, 0xfc, 0x1b, 0x1f, 0x89, 0x46, 0xc1, 0x4f, 0xa7, 0xe4, 0xde, 0x7b, 0xce, 0xb9,
-	0xef, 0x1c, 0x2e, 0x7d, 0x2b, 0x5c, 0xc9, 0x23, 0xb0, 0x21, 0x5d, 0x40, 0xe4, 0x87, 0x11, 0xd8,
-	0x72, 0x1d, 0x03, 0xda, 0x72, 0x65, 0xc5, 0x89, 0x90, 0x82, 0xb5, 0x8e, 0x00, 0xeb, 0x0c, 0xb0,
-	0x72, 0x80, 0xf6, 0xda, 0x15, 0xb8, 0x10, 0x68, 0x2f, 0xd0, 0xb7, 0xd3, 0x77, 0xd9, 0xe7, 0x48,
-	0x30, 0x3f, 0xd2, 0xda, 0x27, 0x88, 0x00, 0x43, 0x9c, 0x48, 0x2e, 0x81, 0xf5, 0xe8, 0x2b, 0x58,
-	0x81, 0xbb, 0x94, 0xa1, 0x88, 0xa6, 0xb3, 0xb9, 0x70, 0xbf, 0x4d, 0x03, 0x8e, 0x81, 0x4a, 0x0c,
-	0xd2, 0xae, 0x39, 0xec, 0x3c, 0x1b, 0x64, 0xa3, 0xcf, 0x1c, 0x03, 0xf3, 0x0f, 0xa1, 0xcd, 0x11,
-	0xfa, 0xc3, 0x62, 0x32, 0xe6, 0xeb, 0xb9, 0xe0, 0x1e, 0x7b, 0x43, 0xab, 0x7c, 0x29, 0x03, 0x91,
-	0x84, 0x72, 0x9d, 0xd3, 0xab, 0xce, 0xa5, 0xc1, 0xba, 0xb4, 0x71, 0xd9, 0x13, 0x1f, 0x29, 0x6a,
-	0x39, 0x5f, 0x52, 0x87, 0x6b, 0xa9, 0x31, 0x6d, 0xc6, 0x09, 0xa4, 0x05, 0x6e, 0x0a, 0x29, 0x44,
-	0x12, 0x55, 0xc5, 0x50, 0xda, 0x2f, 0xfa, 0x86, 0x75, 0xdb, 0xb3, 0x35, 0xfc, 0x32, 0x1a, 0x66,
-	0x40, 0xa7, 0x91, 0x91, 0x4f, 0x5a, 0x79, 0x07, 0x3f, 0xbc, 0xfc, 0x79, 0xd8, 0x74, 0x2e, 0xcf,
-	0x31, 0x35, 0xaa, 0x5e, 0x1b, 0x70, 0x00, 0x63, 0x11, 0x21, 0x98, 0x63, 0xfa, 0xbc, 0x90, 0x62,
-	0x2a, 0x7d, 0xc6, 0x3d, 0x2f, 0x01, 0xc4, 0x53, 0x22, 0x45, 0xc9, 0x5a, 0xb4, 0x22, 0x45, 0x1c,
-	0xba, 0xa8, 0x96, 0x0d, 0xa5, 0x5d, 0x73, 0x4e, 0x15, 0x63, 0xf4, 0x89, 0xc7, 0x25, 0x57, 0x95,
-	0x1c, 0x9e, 0xff, 0xf7, 0x7f, 0x11, 0x4a, 0x47, 0xe8, 0x4f, 0x20, 0x49, 0x43, 0x17, 0xd8, 0x77,
-	0x5a, 0x7f, 0x94, 0x5e, 0xf7, 0x9e, 0xab, 0x1b, 0x51, 0x6b, 0xbd, 0xbb, 0x11, 0xdc, 0xf1, 0xa4,
-	0x3d, 0xfd, 0x71, 0xd8, 0x74, 0xc8, 0xa0, 0xf7, 0x77, 0xa7, 0x93, 0xed, 0x4e, 0x27, 0xff, 0x77,
-	0x3a, 0xf9, 0xbd, 0xd7, 0x4b, 0xdb, 0xbd, 0x5e, 0xfa, 0xb7, 0xd7, 0x4b, 0x5f, 0x5b, 0xb7, 0x2f,
-	0x6d, 0x56, 0xc9, 0xcf, 0xe6, 0xfd, 0x43, 0x00, 0x00, 0x00, 0xff, 0xff, 0x93, 0x2a, 0xc8, 0x2a,
-	0x8a, 0x02, 0x00, 0x00,
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0xb8, 0x14, 0xfa, 0x88, 0x50, 0x7b, 0x45, 0xc1, 0x8a, 0x90, 0xb1, 0x3c, 0x45,
+	0xad, 0xb0, 0x43, 0xd9, 0x18, 0x2b, 0x45, 0xb0, 0x44, 0x8a, 0x5c, 0x09, 0x21, 0x16, 0xeb, 0x62,
+	0x3f, 0xd9, 0x16, 0x89, 0xcf, 0xf5, 0xbb, 0x58, 0xcd, 0x86, 0x18, 0x98, 0xf9, 0x28, 0xfd, 0x18,
+	0x8c, 0x1d, 0x19, 0x51, 0x32, 0x74, 0xe6, 0x1b, 0x20, 0x5f, 0xec, 0x5a, 0x0a, 0xc9, 0x64, 0xbf,
+	0x7b, 0xff, 0xff, 0xff, 0xee, 0xfd, 0xee, 0xe0, 0xb5, 0x0c, 0x95, 0xc8, 0xd0, 0xc3, 0x72, 0x8e,
+	0x59, 0x9c, 0x66, 0xe8, 0xa9, 0x65, 0x8e, 0xe4, 0xa9, 0x1b, 0x37, 0x2f, 0xa4, 0x92, 0xbc, 0xb7,
+	0x11, 0xb8, 0x0f, 0x02, 0x57, 0x0b, 0xfa, 0x2f, 0x43, 0x49, 0x73, 0x49, 0xde, 0x9c, 0x62, 0xaf,
+	0x7c, 0x5b, 0x7d, 0x36, 0x06, 0xe7, 0x33, 0x74, 0x3f, 0x60, 0x86, 0x94, 0xd2, 0x95, 0x12, 0x0a,
+	0xf9, 0x10, 0x5e, 0xe0, 0x0d, 0x86, 0x0b, 0x95, 0xca, 0x2c, 0x98, 0xce, 0x64, 0xf8, 0x35, 0x48,
+	0x04, 0x25, 0x26, 0xb3, 0xd9, 0xa0, 0xeb, 0xf3, 0x87, 0xde, 0x65, 0xd5, 0xfa, 0x28, 0x28, 0xe1,
+	0x3d, 0x38, 0x54, 0x62, 0x3a, 0x43, 0x32, 0x1f, 0x69, 0x4d, 0x5d, 0x39, 0x7f, 0x19, 0x9c, 0x8e,
+	0x29, 0x1e, 0x35, 0x8e, 0x89, 0x58, 0xce, 0xa4, 0x88, 0xf8, 0x2b, 0x38, 0x12, 0x0b, 0x95, 0xc8,
+	0x22, 0x55, 0x4b, 0x1d, 0x7b, 0xe4, 0xb7, 0x0b, 0xfc, 0x1c, 0x4e, 0xda, 0xfd, 0xf3, 0x8d, 0xa5,
+	0x0e, 0x3e, 0xc6, 0xed, 0xa8, 0x09, 0x9c, 0xe6, 0x05, 0x96, 0x8d, 0x2e, 0xc0, 0x12, 0x33, 0x45,
+	0xa6, 0x61, 0x1b, 0x83, 0x67, 0x17, 0xb6, 0xbb, 0x9b, 0x85, 0x3b, 0xfa, 0x34, 0x1e, 0x55, 0x42,
+	0xff, 0xa4, 0x32, 0xd7, 0x59, 0x7a, 0x85, 0xf8, 0x1b, 0x68, 0x47, 0x0c, 0x0a, 0xbc, 0x5e, 0x20,
+	0x29, 0x32, 0x0f, 0x6c, 0x63, 0xd0, 0xf5, 0xdb, 0x83, 0xf9, 0x75, 0xe3, 0xfd, 0xf3, 0xef, 0xf7,
+	0xb7, 0x67, 0xed, 0xe9, 0x9d, 0x3e, 0x98, 0xdb, 0xf3, 0xfa, 0x48, 0xb9, 0xcc, 0x08, 0x9d, 0x09,
+	0x3c, 0x6d, 0x76, 0xe6, 0x26, 0x3c, 0x11, 0x51, 0x54, 0x20, 0x51, 0x0d, 0xb6, 0x29, 0x35, 0x4d,
+	0x99, 0xa7, 0x61, 0x45, 0xd3, 0xd0, 0x34, 0x75, 0xc5, 0x39, 0x1c, 0x44, 0x42, 0x09, 0xd3, 0xd0,
+	0x72, 0xfd, 0x7f, 0xf1, 0x83, 0x01, 0x8c, 0x29, 0xbe, 0xc2, 0xa2, 0x4c, 0x43, 0xe4, 0xd7, 0x70,
+	0xfc, 0x1f, 0xec, 0xf3, 0x7d, 0x10, 0x76, 0xdc, 0x4c, 0x7f, 0xb8, 0x97, 0xd8, 0x9e, 0x99, 0xfa,
+	0x8f, 0xbf, 0xdd, 0xdf, 0x9e, 0xb1, 0xcb, 0xe1, 0xaf, 0x95, 0xc5, 0xee, 0x56, 0x16, 0xfb, 0xb3,
+	0xb2, 0xd8, 0xcf, 0xb5, 0xd5, 0xb9, 0x5b, 0x5b, 0x9d, 0xdf, 0x6b, 0xab, 0xf3, 0xa5, 0xb7, 0xfb,
+	0xc1, 0x4e, 0x0f, 0xf5, 0xeb, 0x7b, 0xf7, 0x6f, 0x00, 0x9e, 0x7d, 0x47, 0x25, 0xd1, 0x02, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -374,6 +392,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Tables) > 0 {
+		i -= len(m.Tables)
+		copy(dAtA[i:], m.Tables)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tables)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ExecutionBlockHash) > 0 {
 		i -= len(m.ExecutionBlockHash)
 		copy(dAtA[i:], m.ExecutionBlockHash)
@@ -404,6 +429,15 @@ func (m *MsgExecutionPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExecutionRequests) > 0 {
+		for iNdEx := len(m.ExecutionRequests) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExecutionRequests[iNdEx])
+			copy(dAtA[i:], m.ExecutionRequests[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ExecutionRequests[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.PrevPayloadEvents) > 0 {
 		for iNdEx := len(m.PrevPayloadEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -525,6 +559,10 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Tables)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -548,6 +586,12 @@ func (m *MsgExecutionPayload) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if len(m.ExecutionRequests) > 0 {
+		for _, b := range m.ExecutionRequests {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -652,6 +696,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				m.ExecutionBlockHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tables = append(m.Tables[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tables == nil {
+				m.Tables = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -802,6 +880,38 @@ func (m *MsgExecutionPayload) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionRequests", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionRequests = append(m.ExecutionRequests, make([]byte, postIndex-iNdEx))
+			copy(m.ExecutionRequests[len(m.ExecutionRequests)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
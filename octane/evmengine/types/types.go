@@ -1,6 +1,11 @@
 package types
 
-import "github.com/ethereum/go-ethereum/common"
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 type AddressProvider interface {
 	// LocalAddress returns the local validator's ethereum address.
@@ -13,3 +18,9 @@ type FeeRecipientProvider interface {
 	// VerifyFeeRecipient returns true if the given address is a valid fee recipient
 	VerifyFeeRecipient(proposedFeeRecipient common.Address) error
 }
+
+type WithdrawalQueue interface {
+	// InsertWithdrawal enqueues an EVM withdrawal of the amount (in wei) to the address.
+	// The amount must be a non-zero multiple of gwei.
+	InsertWithdrawal(ctx context.Context, address common.Address, amountWei *big.Int) error
+}
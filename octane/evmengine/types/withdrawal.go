@@ -0,0 +1,60 @@
+package types
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/omni-network/omni/lib/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BurnKeeper is the subset of the bank keeper used to burn withdrawn $STAKE.
+type BurnKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+}
+
+// BurnAndWithdraw burns the amount of $STAKE from the address's account (via the burner module account)
+// and enqueues an EVM withdrawal of the same $OMNI amount.
+// Since EVM withdrawals are denominated in gwei, any sub-gwei remainder is left in the account.
+// It returns the withdrawn amount, which is zero if the amount is less than one gwei.
+// State changes are only persisted if successful.
+func BurnAndWithdraw(
+	ctx context.Context,
+	queue WithdrawalQueue,
+	bKeeper BurnKeeper,
+	burner string,
+	address common.Address,
+	amountWei *big.Int,
+) (*big.Int, error) {
+	gwei := big.NewInt(params.GWei)
+	amount := new(big.Int).Mul(new(big.Int).Quo(amountWei, gwei), gwei)
+	if amount.Sign() <= 0 {
+		return big.NewInt(0), nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewIntFromBigInt(amount)))
+
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+
+	if err := bKeeper.SendCoinsFromAccountToModule(cacheCtx, address.Bytes(), burner, coins); err != nil {
+		return nil, errors.Wrap(err, "send coins")
+	}
+
+	if err := bKeeper.BurnCoins(cacheCtx, burner, coins); err != nil {
+		return nil, errors.Wrap(err, "burn coins")
+	}
+
+	if err := queue.InsertWithdrawal(cacheCtx, address, amount); err != nil {
+		return nil, errors.Wrap(err, "insert withdrawal")
+	}
+
+	write()
+
+	return amount, nil
+}
@@ -2,10 +2,13 @@ package relayer
 
 import (
 	"context"
+	"encoding/hex"
+	"strings"
 
 	"github.com/omni-network/omni/contracts/bindings"
 	"github.com/omni-network/omni/halo/genutil/evm/predeploys"
 	"github.com/omni-network/omni/lib/buildinfo"
+	"github.com/omni-network/omni/lib/cchain/fanout"
 	cprovider "github.com/omni-network/omni/lib/cchain/provider"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/ethclient"
@@ -54,7 +57,22 @@ func Run(ctx context.Context, cfg Config) error {
 		return err
 	}
 
-	cprov := cprovider.NewABCIProvider(tmClient, network.ID, netconf.ChainVersionNamer(cfg.Network))
+	baseProv, err := newCProvider(ctx, cfg, tmClient, network.ID)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := baseProv.Close(); err != nil {
+			log.Warn(ctx, "Failed closing cprovider", err)
+		}
+	}()
+
+	// Share attestation streams between all workers, instead of streaming per destination chain.
+	cprov, err := fanout.New(baseProv, netconf.ChainVersionNamer(network.ID))
+	if err != nil {
+		return errors.Wrap(err, "new fanout cprovider")
+	}
+
 	xprov := xprovider.New(network, rpcClientPerChain, cprov)
 
 	for _, destChain := range network.EVMChains() {
@@ -112,6 +130,53 @@ func newClient(tmNodeAddr string) (client.Client, error) {
 	return c, nil
 }
 
+// newCProvider returns a light client verified consensus chain provider if configured,
+// or a gRPC provider if configured, otherwise an ABCI provider.
+func newCProvider(ctx context.Context, cfg Config, tmClient client.Client, network netconf.ID) (cprovider.Provider, error) {
+	if cfg.HaloTrustHeight != 0 {
+		if cfg.HaloGRPCURL != "" {
+			return cprovider.Provider{}, errors.New("halo-grpc-url not supported with light client verification")
+		}
+
+		trustHash, err := hex.DecodeString(strings.TrimPrefix(cfg.HaloTrustHash, "0x"))
+		if err != nil {
+			return cprovider.Provider{}, errors.Wrap(err, "decode trusted hash")
+		}
+
+		var witnesses []client.Client
+		for _, url := range cfg.HaloWitnessURLs {
+			witness, err := newClient(url)
+			if err != nil {
+				return cprovider.Provider{}, err
+			}
+			witnesses = append(witnesses, witness)
+		}
+
+		cprov, err := cprovider.NewVerifiedABCIProvider(ctx, tmClient, network, netconf.ChainVersionNamer(network),
+			cprovider.VerifyConfig{
+				TrustHeight: cfg.HaloTrustHeight,
+				TrustHash:   trustHash,
+				Witnesses:   witnesses,
+			})
+		if err != nil {
+			return cprovider.Provider{}, errors.Wrap(err, "new verified cprovider")
+		}
+
+		return cprov, nil
+	}
+
+	if cfg.HaloGRPCURL == "" {
+		return cprovider.NewABCIProvider(tmClient, network, netconf.ChainVersionNamer(network)), nil
+	}
+
+	cprov, err := cprovider.NewGRPCProvider(cfg.HaloGRPCURL, tmClient, network, netconf.ChainVersionNamer(network))
+	if err != nil {
+		return cprovider.Provider{}, errors.Wrap(err, "new grpc cprovider")
+	}
+
+	return cprov, nil
+}
+
 func initializeRPCClients(chains []netconf.Chain, endpoints xchain.RPCEndpoints) (map[uint64]ethclient.Client, error) {
 	rpcClientPerChain := make(map[uint64]ethclient.Client)
 	for _, chain := range chains {
@@ -16,17 +16,24 @@ import (
 )
 
 type Config struct {
-	RPCEndpoints   xchain.RPCEndpoints
-	PrivateKey     string
-	HaloURL        string
-	Network        netconf.ID
-	MonitoringAddr string
+	RPCEndpoints xchain.RPCEndpoints
+	PrivateKey   string
+	HaloURL      string
+	HaloGRPCURL  string
+	// HaloTrustHeight, HaloTrustHash and HaloWitnessURLs enable light client verification of
+	// attestations queried from an untrusted halo node, see cprovider.NewVerifiedABCIProvider.
+	HaloTrustHeight int64
+	HaloTrustHash   string
+	HaloWitnessURLs []string
+	Network         netconf.ID
+	MonitoringAddr  string
 }
 
 func DefaultConfig() Config {
 	return Config{
 		PrivateKey:     "relayer.key",
 		HaloURL:        "localhost:26657",
+		HaloGRPCURL:    "",
 		Network:        "",
 		MonitoringAddr: ":26660",
 	}
@@ -13,5 +13,9 @@ func bindRunFlags(flags *pflag.FlagSet, cfg *relayer.Config) {
 	xchain.BindFlags(flags, &cfg.RPCEndpoints)
 	flags.StringVar(&cfg.PrivateKey, "private-key", cfg.PrivateKey, "The path to the private key e.g path/private.key")
 	flags.StringVar(&cfg.HaloURL, "halo-url", cfg.HaloURL, "The URL of the halo node e.g localhost:26657")
+	flags.StringVar(&cfg.HaloGRPCURL, "halo-grpc-url", cfg.HaloGRPCURL, "The gRPC address of the halo node e.g localhost:9090, defaults to ABCI queries via halo-url if empty")
+	flags.Int64Var(&cfg.HaloTrustHeight, "halo-trust-height", cfg.HaloTrustHeight, "Trusted consensus height enabling light client verification of halo attestations, requires halo-trust-hash and halo-witness-urls")
+	flags.StringVar(&cfg.HaloTrustHash, "halo-trust-hash", cfg.HaloTrustHash, "Hex encoded consensus block hash of the trusted height")
+	flags.StringSliceVar(&cfg.HaloWitnessURLs, "halo-witness-urls", cfg.HaloWitnessURLs, "URLs of independent halo nodes used to cross-check light client headers")
 	flags.StringVar(&cfg.MonitoringAddr, "monitoring-addr", cfg.MonitoringAddr, "The address to bind the monitoring server")
 }